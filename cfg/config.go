@@ -50,6 +50,8 @@ const (
 	UPnP
 	//Normal represents port was opened manually.
 	Normal
+	//Relay represents mynode is behind NAT and relayed by another node.
+	Relay
 )
 
 const (
@@ -61,6 +63,8 @@ const (
 	ThreadURL = "/thread.cgi"
 	//ServerURL is the url to server.cgi
 	ServerURL = "/server.cgi"
+	//TLSHeader is the http header which tells the port for https of the node.
+	TLSHeader = "X-Gou-TLS-Port"
 )
 
 //data Errors.
@@ -104,8 +108,28 @@ var (
 	GetRange             int64
 	SyncRange            int64
 	SaveRemoved          int64
-	DefaultPort          int //DefaultPort is listening port
+	DiskQuota            int64 //bytes
+	ThreadQuota          int64 //bytes
+	DefaultPort          int   //DefaultPort is listening port
+	TLSPort              int   //TLSPort is listening port for https, 0 if disabled
+	TLSCert              string
+	TLSKey               string
+	Proxy                string //Proxy is address of socks5 proxy for outbound connections
+	Tor                  bool   //Tor accepts .onion nodes
 	MaxConnection        int
+	Gateway              string //Gateway is address of the gateway for PCP and NAT-PMP, detected if empty
+	RelayNode            string //RelayNode is nodestr of the node which relays me, chosen from known nodes if empty
+	RelayServer          bool   //RelayServer accepts to relay nodes behind NAT
+	RelayMax             int    //RelayMax is max number of nodes relayed by me
+	RelayRate            int    //RelayRate is max number of requests per minute to one relayed node
+	TalkTimeout          int    //seconds
+	MaxTalks             int    //MaxTalks is max number of concurrent requests to other nodes
+	MaxTalksPerHost      int
+	RateLimit            int //RateLimit is max number of requests per minute to server.cgi from one IP
+	NodeRateLimit        int //NodeRateLimit is max number of updates and joins per minute from one node
+	MaxPendingUpdates    int //MaxPendingUpdates is max number of records being gotten by updates
+	BanThreshold         int //BanThreshold is number of refused requests after which the IP is banned
+	BanTime              int //minutes
 	SpamList             string
 	InitnodeList         string
 	NodeAllowFile        string
@@ -126,6 +150,7 @@ var (
 	EnableProf           bool
 	HeavyMoon            bool
 	EnableEmbed          bool
+	MaxStreams           int //MaxStreams is max number of streams of new records
 )
 
 //SuffixTXT is suffix of text files.
@@ -210,6 +235,8 @@ func networkMode(i *ini.File) {
 		NetworkMode = Normal
 	case "upnp":
 		NetworkMode = UPnP
+	case "relay":
+		NetworkMode = Relay
 	default:
 		log.Println("cannot understand mode", networkModeStr)
 		NetworkMode = Normal
@@ -241,7 +268,28 @@ func initVariables(i *ini.File) {
 		NodeAllowFile = filepath.Join(cwd, "file", "node_allow.txt")
 		NodeDenyFile = filepath.Join(cwd, "file", "node_deny.txt")
 	}
+	TLSPort = getIntValue(i, "Network", "tls_port", 0)
+	TLSCert = getRelativePathValue(i, "Network", "tls_cert", filepath.Join(RunDir, "gou_cert.pem"), Docroot)
+	TLSKey = getRelativePathValue(i, "Network", "tls_key", filepath.Join(RunDir, "gou_key.pem"), Docroot)
+	Proxy = getStringValue(i, "Network", "proxy", "")
+	Tor = getBoolValue(i, "Network", "tor", false)
+	if Tor && Proxy == "" {
+		Proxy = "127.0.0.1:9050"
+	}
 	MaxConnection = getIntValue(i, "Network", "max_connection", 100)
+	Gateway = getStringValue(i, "Network", "gateway", "")
+	RelayNode = getStringValue(i, "Network", "relay_node", "")
+	RelayServer = getBoolValue(i, "Network", "relay_server", false)
+	RelayMax = getIntValue(i, "Network", "relay_max", 10)
+	RelayRate = getIntValue(i, "Network", "relay_rate", 60)
+	TalkTimeout = getIntValue(i, "Network", "talk_timeout", 15)
+	MaxTalks = getIntValue(i, "Network", "max_talks", 32)
+	MaxTalksPerHost = getIntValue(i, "Network", "max_talks_per_host", 2)
+	RateLimit = getIntValue(i, "Network", "rate_limit", 300)
+	NodeRateLimit = getIntValue(i, "Network", "node_rate_limit", 60)
+	MaxPendingUpdates = getIntValue(i, "Network", "max_pending_updates", 64)
+	BanThreshold = getIntValue(i, "Network", "ban_threshold", 100)
+	BanTime = getIntValue(i, "Network", "ban_time", 60)
 	ReAdminStr = getStringValue(i, "Gateway", "admin", "^(127|\\[::1\\])")
 	ReFriendStr = getStringValue(i, "Gateway", "friend", "^(127|\\[::1\\])")
 	ReVisitorStr = getStringValue(i, "Gateway", "visitor", ".")
@@ -255,6 +303,7 @@ func initVariables(i *ini.File) {
 	EnableProf = getBoolValue(i, "Gateway", "enable_prof", false)
 	HeavyMoon = getBoolValue(i, "Gateway", "moonlight", false)
 	EnableEmbed = getBoolValue(i, "Gateway", "enable_embed", true)
+	MaxStreams = getIntValue(i, "Gateway", "max_streams", 100)
 	ThreadPageSize = getIntValue(i, "Application Thread", "page_size", 50)
 	DefaultThumbnailSize = getStringValue(i, "Application Thread", "thumbnail_size", "")
 	ForceThumbnail = getBoolValue(i, "Application Thread", "force_thumbnail", false)
@@ -269,6 +318,8 @@ func initVariables(i *ini.File) {
 	if SyncRange > time.Now().Unix() {
 		log.Fatal("sync_range is too big")
 	}
+	DiskQuota = getInt64Value(i, ctype, "disk_quota", 0) << 20
+	ThreadQuota = getInt64Value(i, ctype, "thread_quota", 0) << 20
 	SaveRemoved = getInt64Value(i, ctype, "save_removed", 50*24*60*60)
 	if SaveRemoved > time.Now().Unix() {
 		log.Fatal("save_removed is too big")
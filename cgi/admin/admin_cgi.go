@@ -29,21 +29,25 @@
 package admin
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"html"
 	"log"
 	"math/rand"
+	"net"
 	"net/http"
-	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
-	"errors"
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/myself"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
 	"github.com/shingetsu-gou/shingetsu-gou/node/manager"
@@ -61,9 +65,13 @@ var adminSID = ""
 //Setup registers handlers for admin.cgi
 func Setup(s *cgi.LoggingServeMux) {
 	s.RegistCompressHandler(cfg.AdminURL+"/status", printStatus)
+	s.RegistCompressHandler(cfg.AdminURL+"/status.json", printStatusJSON)
 	s.RegistCompressHandler(cfg.AdminURL+"/edittag", printEdittag)
 	s.RegistCompressHandler(cfg.AdminURL+"/savetag", saveTagCGI)
 	s.RegistCompressHandler(cfg.AdminURL+"/search", printSearch)
+	s.HandleFunc(cfg.AdminURL+"/backup", printBackup)
+	s.RegistCompressHandler(cfg.AdminURL+"/prune", printPrune)
+	s.RegistCompressHandler("/metrics", printMetrics)
 	s.RegistCompressHandler(cfg.AdminURL+"/", execCmd)
 }
 
@@ -100,15 +108,101 @@ func printSearch(w http.ResponseWriter, r *http.Request) {
 		log.Println(err)
 		return
 	}
-	query := a.Req.FormValue("query")
-	if query == "" {
-		a.Header(a.M["search"], "", nil, true)
-		fmt.Fprintf(a.WR, "<p>%s</p>", a.M["desc_search"])
-		a.printSearchForm("")
-		a.Footer(nil)
-	} else {
-		a.printSearchResult(query)
+	a.PrintSearch(a.Req.FormValue("query"), cfg.AdminURL+"/search")
+}
+
+//printBackup streams a snapshot of the db in bolt format.
+func printBackup(w http.ResponseWriter, r *http.Request) {
+	if _, err := new(w, r); err != nil {
+		log.Println(err)
+		return
 	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	fname := fmt.Sprintf("gou_bolt_%s.db", time.Now().Format("20060102150405"))
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+fname+"\"")
+	if _, err := db.DB.WriteTo(w); err != nil {
+		log.Println(err)
+	}
+}
+
+//pruneThread is a summary of records to be pruned in a thread.
+type pruneThread struct {
+	Title   string
+	Prune   int
+	Records int
+	Oldest  int64
+	Newest  int64
+}
+
+//pruneThreads is for sorting pruneThread by # of records to be pruned.
+type pruneThreads []*pruneThread
+
+func (p pruneThreads) Len() int {
+	return len(p)
+}
+
+func (p pruneThreads) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+func (p pruneThreads) Less(i, j int) bool {
+	return p[i].Prune > p[j].Prune
+}
+
+//printPrune renders records which would be removed by save_record and save_size
+//without removing them.
+func printPrune(w http.ResponseWriter, r *http.Request) {
+	a, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	m := make(map[string]*pruneThread)
+	var ts pruneThreads
+	hs := thread.Prunable()
+	for _, h := range hs {
+		t, ok := m[h.Datfile]
+		if !ok {
+			ca := thread.NewCache(h.Datfile)
+			t = &pruneThread{
+				Title:   ca.Gettitle(),
+				Records: ca.Len(record.All),
+				Oldest:  h.Stamp,
+			}
+			m[h.Datfile] = t
+			ts = append(ts, t)
+		}
+		t.Prune++
+		t.Newest = h.Stamp
+	}
+	sort.Sort(ts)
+	d := struct {
+		Threads    pruneThreads
+		Total      int
+		SaveRecord int64
+		SaveSize   int
+		ThreadCGI  string
+		Message    cgi.Message
+	}{
+		ts,
+		len(hs),
+		cfg.SaveRecord / (24 * 60 * 60),
+		cfg.SaveSize,
+		cfg.ThreadURL,
+		a.M,
+	}
+	a.Header(a.M["prune"], "", nil, true)
+	cgi.RenderTemplate("prune", d, a.WR)
+	a.Footer(nil)
+}
+
+//printMetrics renders metrics of the node in text format for monitoring tools.
+func printMetrics(w http.ResponseWriter, r *http.Request) {
+	if _, err := new(w, r); err != nil {
+		log.Println(err)
+		return
+	}
+	metrics.Handler(w, r)
 }
 
 //printStatus renders status info, including
@@ -135,11 +229,13 @@ func printStatus(w http.ResponseWriter, r *http.Request) {
 	case cfg.Normal:
 		port0 = a.M["opened"]
 	case cfg.UPnP:
-		port0 = "UPnP"
+		port0 = myself.GetPortStatus().Method
 	case cfg.Port0:
 		port0 = a.M["port0"]
 	case cfg.Disconnected:
 		port0 = a.M["disconnected"]
+	case cfg.Relay:
+		port0 = a.M["relayed"] + " (" + myself.GetRelay() + ")"
 	}
 
 	s := map[string]string{
@@ -152,18 +248,37 @@ func printStatus(w http.ResponseWriter, r *http.Request) {
 		"alloc_mem":         fmt.Sprintf("%.1f%s", float64(mem.Alloc)/1024/1024, a.M["mb"]),
 		"connection_status": port0,
 	}
+	for k, v := range portStatus(a.M) {
+		s[k] = v
+	}
+	ls := limit.GetStats()
+	s["rate_limited"] = strconv.FormatInt(ls.RateLimited, 10)
+	s["refused_by_ban"] = strconv.FormatInt(ls.Banned, 10)
+	s["dropped_updates"] = strconv.FormatInt(ls.Dropped, 10)
+	s["pending_updates"] = strconv.FormatInt(ls.Pending, 10)
+	var banned []string
+	for _, b := range limit.Bans() {
+		banned = append(banned, b.Host+" ("+time.Unix(b.Until, 0).Format("2006-01-02 15:04")+")")
+	}
 	ns := map[string][]string{
 		"known_nodes":  manager.GetNodestrSlice(),
 		"linked_nodes": manager.GetNodestrSliceInList(),
+		"banned_hosts": banned,
 	}
 
 	d := struct {
 		Status     map[string]string
 		NodeStatus map[string][]string
+		Health     []*manager.Health
+		Now        int64
+		AdminCGI   string
 		Message    cgi.Message
 	}{
 		s,
 		ns,
+		manager.AllHealth(),
+		time.Now().Unix(),
+		cfg.AdminURL,
 		a.M,
 	}
 	a.Header(a.M["status"], "", nil, true)
@@ -171,6 +286,59 @@ func printStatus(w http.ResponseWriter, r *http.Request) {
 	a.Footer(nil)
 }
 
+//portStatus returns the status of the port mapping and the reachability to be shown.
+func portStatus(m cgi.Message) map[string]string {
+	ps := myself.GetPortStatus()
+	s := map[string]string{
+		"port_mapping":     ps.Method,
+		"external_address": "",
+		"lease_expiry":     "",
+		"reachability":     "",
+	}
+	if ps.MappingError != "" {
+		s["port_mapping"] += " (" + ps.MappingError + ")"
+	}
+	if ps.ExternalPort != 0 {
+		s["external_address"] = net.JoinHostPort(ps.ExternalIP, strconv.Itoa(ps.ExternalPort))
+	}
+	if ps.LeaseExpiry != 0 {
+		s["lease_expiry"] = time.Unix(ps.LeaseExpiry, 0).Format("2006-01-02 15:04")
+	}
+	if ps.CheckedAt != 0 {
+		r := m["unreachable"]
+		if ps.Reachable {
+			r = m["reachable"]
+		}
+		s["reachability"] = fmt.Sprintf("%s (%s, %s)", r, ps.CheckedBy, time.Unix(ps.CheckedAt, 0).Format("2006-01-02 15:04"))
+	}
+	return s
+}
+
+//printStatusJSON renders the status of the connection in JSON.
+func printStatusJSON(w http.ResponseWriter, r *http.Request) {
+	if _, err := new(w, r); err != nil {
+		log.Println(err)
+		return
+	}
+	d := struct {
+		Status      string
+		Node        string
+		KnownNodes  int
+		LinkedNodes int
+		myself.PortStatus
+	}{
+		myself.ConnectionString(),
+		node.Me(true).Nodestr,
+		manager.NodeLen(),
+		manager.ListLen(),
+		myself.GetPortStatus(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(d); err != nil {
+		log.Println(err)
+	}
+}
+
 //printEdittag renders the page for editing tags in thread specified by form "file".
 func printEdittag(w http.ResponseWriter, r *http.Request) {
 	a, err := new(w, r)
@@ -402,43 +570,3 @@ func (a *adminCGI) doDeleteFile(files []string) {
 	}
 	a.Print302(cfg.GatewayURL + "/" + "changes")
 }
-
-//printSearchForm renders search_form.txt
-func (a *adminCGI) printSearchForm(query string) {
-	d := struct {
-		Query    string
-		AdminCGI string
-		Message  cgi.Message
-	}{
-		query,
-		cfg.AdminURL,
-		a.M,
-	}
-	cgi.RenderTemplate("search_form", d, a.WR)
-}
-
-//printSearchResult renders cachelist that its datfile matches query.
-func (a *adminCGI) printSearchResult(query string) {
-	strQuery := html.EscapeString(query)
-	title := fmt.Sprintf("%s: %s", a.M["search"], strQuery)
-	a.Header(title, "", nil, true)
-	fmt.Fprintf(a.WR, "<p>%s</p>", a.M["desc_search"])
-	a.printSearchForm(strQuery)
-	reg := html.EscapeString(query)
-	regg, err := regexp.Compile(reg)
-	if err != nil {
-		log.Println(err)
-		return
-	}
-	result := thread.Search(reg)
-	for _, i := range thread.AllCaches() {
-		if result.Has(i) {
-			continue
-		}
-		if regg.MatchString(util.FileDecode(i.Datfile)) {
-			result = append(result, i)
-		}
-	}
-	sort.Sort(sort.Reverse(thread.NewSortByStamp(result, false)))
-	a.PrintIndexList(result, "", true, false, "", "")
-}
@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi/gateway"
+	threadcgi "github.com/shingetsu-gou/shingetsu-gou/cgi/thread"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+//URL is the url to the API.
+const URL = "/api/v1"
+
+//Setup setups handlers for the JSON API.
+func Setup(s *cgi.LoggingServeMux) {
+	rtr := mux.NewRouter()
+	rtr.HandleFunc(URL+"/threads", listThreads).Methods(http.MethodGet)
+	rtr.HandleFunc(URL+"/threads/{datfile:thread_[0-9A-F]+}", getThread).Methods(http.MethodGet)
+	rtr.HandleFunc(URL+"/threads/{datfile:thread_[0-9A-F]+}", postRecord).Methods(http.MethodPost)
+	rtr.HandleFunc(URL+"/tags", listTags).Methods(http.MethodGet)
+	s.RegistCompressHandler(URL+"/", rtr.ServeHTTP)
+}
+
+//apiCGI is for the JSON API.
+type apiCGI struct {
+	*cgi.CGI
+}
+
+//new returns apiCGI obj if the client is allowed as a visitor.
+func new(w http.ResponseWriter, r *http.Request) (*apiCGI, error) {
+	c, err := cgi.NewCGI(w, r)
+	if err != nil {
+		return nil, err
+	}
+	a := apiCGI{
+		CGI: c,
+	}
+	if !a.CheckVisitor() {
+		a.render(http.StatusForbidden, errorResp("permission denied"))
+		return nil, errors.New("permission denied")
+	}
+	return &a, nil
+}
+
+//render writes v with status in JSON.
+func (a *apiCGI) render(status int, v interface{}) {
+	a.WR.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	a.WR.WriteHeader(status)
+	if err := json.NewEncoder(a.WR).Encode(v); err != nil {
+		log.Println(err)
+	}
+}
+
+//errorResp returns the body of error response.
+func errorResp(msg string) map[string]string {
+	return map[string]string{"error": msg}
+}
+
+//listThreads renders all threads with columns in form "cols",
+//which are same as ones of gateway.cgi/csv.
+func listThreads(w http.ResponseWriter, r *http.Request) {
+	a, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	cols := gateway.Columns
+	if c := a.Req.FormValue("cols"); c != "" {
+		cols = strings.Split(c, ",")
+	}
+	tag := a.Req.FormValue("tag")
+	rows := []map[string]string{}
+	for _, ca := range thread.AllCaches() {
+		if tag != "" && !user.Has(ca.Datfile, tag) {
+			continue
+		}
+		rows = append(rows, a.row(ca, cols))
+	}
+	a.render(http.StatusOK, rows)
+}
+
+//row returns columns cols of thread ca.
+func (a *apiCGI) row(ca *thread.Cache, cols []string) map[string]string {
+	title := util.FileDecode(ca.Datfile)
+	p := cfg.ThreadURL + "/" + util.StrEncode(title)
+	row := make(map[string]string, len(cols))
+	for _, c := range cols {
+		row[c] = gateway.MakeOneRow(a.Host(), c, ca, p, title)
+	}
+	return row
+}
+
+//Attach is metadata of an attached file.
+type Attach struct {
+	Suffix string `json:"suffix"`
+	Size   int    `json:"size"`
+	URL    string `json:"url"`
+}
+
+//Record is a record with parsed fields.
+type Record struct {
+	ID        string  `json:"id"`
+	Stamp     int64   `json:"stamp"`
+	Name      string  `json:"name,omitempty"`
+	Mail      string  `json:"mail,omitempty"`
+	Body      string  `json:"body,omitempty"`
+	Attach    *Attach `json:"attach,omitempty"`
+	Pubkey    string  `json:"pubkey,omitempty"`
+	SignValid bool    `json:"sign_valid"`
+}
+
+//newRecord returns Record parsed from r.
+func newRecord(r *record.Record) *Record {
+	rec := &Record{
+		ID:     r.ID,
+		Stamp:  r.Stamp,
+		Name:   r.GetBodyValue("name", ""),
+		Mail:   r.GetBodyValue("mail", ""),
+		Body:   r.GetBodyValue("body", ""),
+		Pubkey: r.GetBodyValue("pubkey", ""),
+	}
+	if rec.Pubkey != "" {
+		rec.SignValid = r.Verify() == nil
+	}
+	if at := r.GetBodyValue("attach", ""); at != "" {
+		suffix := r.GetBodyValue("suffix", cfg.SuffixTXT)
+		data, err := base64.StdEncoding.DecodeString(at)
+		if err != nil {
+			log.Println(err)
+		}
+		rec.Attach = &Attach{
+			Suffix: suffix,
+			Size:   len(data),
+			URL:    fmt.Sprintf("%s/%s/%s/%d.%s", cfg.ThreadURL, r.Datfile, r.ID, r.Stamp, suffix),
+		}
+	}
+	return rec
+}
+
+//getThread renders columns of the thread and its records.
+//records can be limited by form "from" and "to" stamps.
+func getThread(w http.ResponseWriter, r *http.Request) {
+	a, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	ca := thread.NewCache(mux.Vars(r)["datfile"])
+	if !ca.Exists() {
+		a.render(http.StatusNotFound, errorResp("thread not found"))
+		return
+	}
+	from, errf := a.stamp("from", 0)
+	to, errt := a.stamp("to", time.Now().Unix())
+	if errf != nil || errt != nil {
+		a.render(http.StatusBadRequest, errorResp("illegal stamp"))
+		return
+	}
+	recs := ca.LoadRecords(record.Alive)
+	rs := []*Record{}
+	for _, k := range recs.Keys() {
+		rec := recs[k]
+		if rec.Stamp < from || rec.Stamp > to {
+			continue
+		}
+		if err := rec.Load(); err != nil {
+			log.Println(err)
+			continue
+		}
+		rs = append(rs, newRecord(rec))
+	}
+	d := struct {
+		Thread  map[string]string `json:"thread"`
+		Records []*Record         `json:"records"`
+	}{
+		a.row(ca, gateway.Columns),
+		rs,
+	}
+	a.render(http.StatusOK, d)
+}
+
+//stamp returns int64 value of form key, or def if not specified.
+func (a *apiCGI) stamp(key string, def int64) (int64, error) {
+	v := a.Req.FormValue(key)
+	if v == "" {
+		return def, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+//Post is a request for posting a record.
+//Attach is base64 encoded file.
+type Post struct {
+	Name     string `json:"name"`
+	Mail     string `json:"mail"`
+	Body     string `json:"body"`
+	Passwd   string `json:"passwd"`
+	Attach   string `json:"attach"`
+	Filename string `json:"filename"`
+	Suffix   string `json:"suffix"`
+	Dopost   bool   `json:"dopost"`
+}
+
+//postRecord posts a record in the request body to the thread
+//in the same way as thread.cgi.
+func postRecord(w http.ResponseWriter, r *http.Request) {
+	a, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	var p Post
+	lr := http.MaxBytesReader(w, r.Body, int64(cfg.RecordLimit)<<11)
+	if err := json.NewDecoder(lr).Decode(&p); err != nil {
+		a.render(http.StatusBadRequest, errorResp(err.Error()))
+		return
+	}
+	body := make(map[string]string)
+	for k, v := range map[string]string{"name": p.Name, "mail": p.Mail, "body": p.Body} {
+		if v != "" {
+			body[k] = util.Escape(v)
+		}
+	}
+	if p.Attach != "" {
+		if _, err := base64.StdEncoding.DecodeString(p.Attach); err != nil {
+			a.render(http.StatusBadRequest, errorResp("attach is not base64"))
+			return
+		}
+		body["attach"] = p.Attach
+		body["suffix"] = threadcgi.GuessSuffix(p.Filename, p.Suffix)
+	}
+	if len(body) == 0 {
+		a.render(http.StatusBadRequest, errorResp("null article"))
+		return
+	}
+	ca := thread.NewCache(mux.Vars(r)["datfile"])
+	rec := record.New(ca.Datfile, "", 0)
+	rec.Build(time.Now().Unix(), body, p.Passwd)
+	log.Printf("post %s/%d_%s from %s via api\n", ca.Datfile, rec.Stamp, rec.ID, a.Req.RemoteAddr)
+	switch err := threadcgi.Post(ca, rec, p.Dopost); err {
+	case nil:
+		a.render(http.StatusCreated, newRecord(rec))
+	case threadcgi.ErrNoThread:
+		a.render(http.StatusNotFound, errorResp(err.Error()))
+	case threadcgi.ErrBigFile:
+		a.render(http.StatusRequestEntityTooLarge, errorResp(err.Error()))
+	default:
+		a.render(http.StatusForbidden, errorResp(err.Error()))
+	}
+}
+
+//listTags renders user tags and # of threads which have each tag.
+func listTags(w http.ResponseWriter, r *http.Request) {
+	a, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	type tagResp struct {
+		Tag     string `json:"tag"`
+		Threads int    `json:"threads"`
+	}
+	ts := []*tagResp{}
+	for _, t := range user.Get() {
+		ts = append(ts, &tagResp{
+			Tag:     t.Tagstr,
+			Threads: len(user.Threads(t.Tagstr)),
+		})
+	}
+	a.render(http.StatusOK, ts)
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+)
+
+//setup makes threads thread_41 with tag foo and two records, and thread_42 with no record,
+//and returns the mux serving the API.
+func setup() (*cgi.LoggingServeMux, []*record.Record) {
+	db.DB = db.NewMemory()
+	var recs []*record.Record
+	for _, datfile := range []string{"thread_41", "thread_42"} {
+		thread.NewCache(datfile).Subscribe()
+	}
+	user.Set("thread_41", []string{"foo"})
+	for i, body := range []map[string]string{
+		{"name": "gou", "body": "hello"},
+		{"body": "file", "attach": base64.StdEncoding.EncodeToString([]byte("abc")), "suffix": "png"},
+	} {
+		r := record.New("thread_41", "", 0)
+		r.Build(int64(100*(i+1)), body, "")
+		r.Sync()
+		recs = append(recs, r)
+	}
+	sm := cgi.NewLoggingServeMux()
+	Setup(sm)
+	return sm, recs
+}
+
+//serve requests path with method and body to sm, and decodes the response in JSON to v.
+func serve(t *testing.T, sm *cgi.LoggingServeMux, method, path, body string, v interface{}) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	sm.ServeHTTP(w, req)
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatal(err, w.Body.String())
+	}
+	return w.Code
+}
+
+func TestListThreads(t *testing.T) {
+	sm, _ := setup()
+	var rows []map[string]string
+	if c := serve(t, sm, "GET", URL+"/threads?cols=file,records,tag&tag=foo", "", &rows); c != http.StatusOK {
+		t.Fatal("illegal status", c)
+	}
+	if len(rows) != 1 || len(rows[0]) != 3 || rows[0]["file"] != "thread_41" ||
+		rows[0]["records"] != "2" || rows[0]["tag"] != "foo" {
+		t.Fatal("illegal threads", rows)
+	}
+	if serve(t, sm, "GET", URL+"/threads", "", &rows); len(rows) != 2 || rows[0]["title"] == "" {
+		t.Fatal("illegal threads", rows)
+	}
+}
+
+func TestGetThread(t *testing.T) {
+	sm, recs := setup()
+	var d struct {
+		Thread  map[string]string
+		Records []*Record
+	}
+	if c := serve(t, sm, "GET", URL+"/threads/thread_41", "", &d); c != http.StatusOK {
+		t.Fatal("illegal status", c)
+	}
+	if d.Thread["file"] != "thread_41" || len(d.Records) != 2 ||
+		d.Records[0].Name != "gou" || d.Records[0].Body != "hello" || d.Records[0].Attach != nil {
+		t.Fatal("illegal thread", d)
+	}
+	d.Records = nil
+	serve(t, sm, "GET", URL+"/threads/thread_41?from=150&to=250", "", &d)
+	if len(d.Records) != 1 || d.Records[0].ID != recs[1].ID {
+		t.Fatal("illegal records", d.Records)
+	}
+	at := d.Records[0].Attach
+	u := fmt.Sprintf("/thread.cgi/thread_41/%s/200.png", recs[1].ID)
+	if at == nil || at.Suffix != "png" || at.Size != 3 || at.URL != u {
+		t.Fatal("illegal attach", at)
+	}
+	var e map[string]string
+	if c := serve(t, sm, "GET", URL+"/threads/thread_41?from=a", "", &e); c != http.StatusBadRequest {
+		t.Fatal("illegal status", c)
+	}
+	if c := serve(t, sm, "GET", URL+"/threads/thread_43", "", &e); c != http.StatusNotFound || e["error"] == "" {
+		t.Fatal("illegal status", c, e)
+	}
+}
+
+func TestPostRecord(t *testing.T) {
+	sm, _ := setup()
+	f, err := ioutil.TempFile("", "gou_spam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			t.Error(err)
+		}
+	}()
+	if _, err = f.WriteString("spamword\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SpamList = f.Name()
+	cfg.RecordLimit = 1
+
+	var r Record
+	if c := serve(t, sm, "POST", URL+"/threads/thread_42", `{"name":"gou","body":"<b>"}`, &r); c != http.StatusCreated {
+		t.Fatal("illegal status", c)
+	}
+	if r.Name != "gou" || r.Body != "&lt;b&gt;" {
+		t.Fatal("illegal record", r)
+	}
+	if n := thread.NewCache("thread_42").Len(record.Alive); n != 1 {
+		t.Fatal("record is not posted", n)
+	}
+	var e map[string]string
+	if c := serve(t, sm, "POST", URL+"/threads/thread_43", `{"body":"hello"}`, &e); c != http.StatusNotFound {
+		t.Fatal("illegal status", c, e)
+	}
+	big := `{"body":"` + strings.Repeat("a", 1500) + `"}`
+	if c := serve(t, sm, "POST", URL+"/threads/thread_42", big, &e); c != http.StatusRequestEntityTooLarge {
+		t.Fatal("illegal status", c, e)
+	}
+	c := serve(t, sm, "POST", URL+"/threads/thread_42", `{"body":"buy spamword"}`, &e)
+	if c != http.StatusForbidden || e["error"] != cfg.ErrSpam.Error() {
+		t.Fatal("illegal status", c, e)
+	}
+	if c := serve(t, sm, "POST", URL+"/threads/thread_42", `{}`, &e); c != http.StatusBadRequest {
+		t.Fatal("illegal status", c, e)
+	}
+	if n := thread.NewCache("thread_42").Len(record.Alive); n != 1 {
+		t.Fatal("illegal # of records", n)
+	}
+}
+
+func TestListTags(t *testing.T) {
+	sm, _ := setup()
+	user.Set("thread_42", []string{"foo", "bar"})
+	var ts []struct {
+		Tag     string
+		Threads int
+	}
+	if c := serve(t, sm, "GET", URL+"/tags", "", &ts); c != http.StatusOK {
+		t.Fatal("illegal status", c)
+	}
+	n := make(map[string]int)
+	for _, tg := range ts {
+		n[tg.Tag] = tg.Threads
+	}
+	if len(n) != 2 || n["foo"] != 2 || n["bar"] != 1 {
+		t.Fatal("illegal tags", ts)
+	}
+}
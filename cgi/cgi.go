@@ -41,6 +41,7 @@ import (
 
 	"github.com/russross/blackfriday"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/search"
 	"github.com/shingetsu-gou/shingetsu-gou/tag"
 	"github.com/shingetsu-gou/shingetsu-gou/tag/suggest"
 	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
@@ -521,6 +522,46 @@ func (c *CGI) PrintIndexList(cl []*thread.Cache, target string, footer bool, sea
 	}
 }
 
+//PrintSearch renders search_form.txt whose action is action, and
+//search_result.txt which renders records matching query if query!="".
+func (c *CGI) PrintSearch(query, action string) {
+	const searchLimit = 100
+
+	title := c.M["search"]
+	if query != "" {
+		title = fmt.Sprintf("%s: %s", c.M["search"], query)
+	}
+	c.Header(title, "", nil, true)
+	fmt.Fprintf(c.WR, "<p>%s</p>", c.M["desc_search"])
+	d := struct {
+		Query   string
+		Action  string
+		Message Message
+	}{
+		query,
+		action,
+		c.M,
+	}
+	RenderTemplate("search_form", d, c.WR)
+	if query != "" {
+		q, err := search.Parse(query)
+		if err != nil {
+			log.Println(err)
+			fmt.Fprintf(c.WR, "<p>%s %s</p>", c.M["query_error"], template.HTMLEscapeString(err.Error()))
+		} else {
+			s := struct {
+				Results []*search.Result
+				Defaults
+			}{
+				search.Search(q, searchLimit),
+				*c.Defaults(),
+			}
+			RenderTemplate("search_result", s, c.WR)
+		}
+	}
+	c.Footer(nil)
+}
+
 //PrintNewElementForm renders new_element_form.txt for posting new thread.
 func (c *CGI) PrintNewElementForm() {
 	const titleLimit = 30 //Charactors
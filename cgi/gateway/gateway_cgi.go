@@ -29,13 +29,16 @@
 package gateway
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"html"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"regexp"
 	"sort"
@@ -45,7 +48,9 @@ import (
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
 	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/stream"
 	"github.com/shingetsu-gou/shingetsu-gou/tag"
 	"github.com/shingetsu-gou/shingetsu-gou/tag/suggest"
 	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
@@ -65,11 +70,14 @@ func Setup(s *cgi.LoggingServeMux) {
 	s.RegistCompressHandler(cfg.GatewayURL+"/changes", printIndexChanges)
 	s.RegistCompressHandler(cfg.GatewayURL+"/recent", printRecent)
 	s.RegistCompressHandler(cfg.GatewayURL+"/new", printNew)
+	s.RegistCompressHandler(cfg.GatewayURL+"/search", printSearch)
 	s.RegistCompressHandler(cfg.GatewayURL+"/thread", printGatewayThread)
 	s.RegistCompressHandler(cfg.GatewayURL+"/", PrintTitle)
 	s.RegistCompressHandler(cfg.GatewayURL+"/csv/index/", printCSV)
 	s.RegistCompressHandler(cfg.GatewayURL+"/csv/changes/", printCSVChanges)
 	s.RegistCompressHandler(cfg.GatewayURL+"/csv/recent/", printCSVRecent)
+	//not compressed for flushing each events.
+	s.HandleFunc(cfg.GatewayURL+"/stream", printStream)
 }
 
 //printGateway just redirects to correspoinding url using thread.cgi.
@@ -171,8 +179,15 @@ func printRSS(w http.ResponseWriter, r *http.Request) {
 	}
 	rsss := cgi.NewRSS("UTF-8", "", g.M["logo"], "http://"+g.Host(), "",
 		"http://"+g.Host()+cfg.GatewayURL+"/"+"rss", g.M["description"], xslURL)
-	for _, ca := range thread.AllCaches() {
-		g.appendRSS(rsss, ca)
+	done := make(map[string]struct{})
+	for _, h := range record.HeadsInRange(time.Now().Unix()-cfg.RSSRange, math.MaxInt64) {
+		if _, exist := done[h.Datfile]; exist {
+			continue
+		}
+		done[h.Datfile] = struct{}{}
+		if ca := thread.NewCache(h.Datfile); ca.Exists() {
+			g.appendRSS(rsss, ca)
+		}
 	}
 	g.WR.Header().Set("Content-Type", "text/xml; charset=UTF-8")
 	if rsss.Len() != 0 {
@@ -197,6 +212,111 @@ func printMergedJS(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+//printStream sends server-sent events of new records until the client is closed.
+//events can be filtered by datfile in form "file" or user tag in form "tag".
+//the id of an event is the stamp of the record, and records since Last-Event-ID
+//are sent again when the client reconnects.
+//the connection is hijacked to be released from the limit of connections and timeouts.
+func printStream(w http.ResponseWriter, r *http.Request) {
+	g, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	const (
+		keepAlive   = 30 * time.Second
+		replayRange = 24 * 60 * 60
+	)
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		g.Print404(nil, "")
+		return
+	}
+	files := r.Form["file"]
+	tag := g.Req.FormValue("tag")
+	ch := stream.Subscribe(cfg.MaxStreams)
+	if ch == nil {
+		http.Error(w, "too many streams", http.StatusServiceUnavailable)
+		return
+	}
+	defer stream.Unsubscribe(ch)
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer util.Fclose(conn)
+	limit.Release(r.RemoteAddr)
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		log.Println(err)
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		//clients send nothing after the request, so it returns when closed.
+		_, _ = rw.ReadByte()
+		cancel()
+	}()
+
+	send := func(e *stream.Event) error {
+		if !matchStream(e, files, tag) {
+			return nil
+		}
+		b, err := json.Marshal(e)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		_, err = fmt.Fprintf(rw, "id: %d\nevent: record\ndata: %s\n\n", e.Stamp, b)
+		return err
+	}
+	fmt.Fprint(rw, "HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nCache-Control: no-cache\r\nConnection: close\r\n\r\n")
+	fmt.Fprint(rw, "retry: 3000\n\n")
+	if last, err := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64); err == nil {
+		if begin := time.Now().Unix() - replayRange; last < begin {
+			last = begin
+		}
+		for _, h := range record.HeadsInRange(last, math.MaxInt64) {
+			e := &stream.Event{
+				Datfile: h.Datfile,
+				ID:      h.ID,
+				Stamp:   h.Stamp,
+			}
+			if send(e) != nil {
+				return
+			}
+		}
+	}
+	if rw.Flush() != nil {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keepAlive):
+			if _, err := fmt.Fprint(rw, ": keepalive\n\n"); err != nil {
+				return
+			}
+		case e := <-ch:
+			if send(e) != nil {
+				return
+			}
+		}
+		if rw.Flush() != nil {
+			return
+		}
+	}
+}
+
+//matchStream returns true if e is in files and its thread has the tag.
+func matchStream(e *stream.Event, files []string, tag string) bool {
+	if len(files) > 0 && !util.HasString(files, e.Datfile) {
+		return false
+	}
+	return tag == "" || user.Has(e.Datfile, tag)
+}
+
 //printMotd renders motd.
 func printMotd(w http.ResponseWriter, r *http.Request) {
 	g, err := new(w, r)
@@ -230,6 +350,16 @@ func printNew(w http.ResponseWriter, r *http.Request) {
 	g.Footer(nil)
 }
 
+//printSearch renders the page for searching records, and results if query!="".
+func printSearch(w http.ResponseWriter, r *http.Request) {
+	g, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	g.PrintSearch(r.FormValue("query"), cfg.GatewayURL+"/search")
+}
+
 //PrintTitle renders list of newer thread in the disk for the top page
 func PrintTitle(w http.ResponseWriter, r *http.Request) {
 	g, err := new(w, r)
@@ -377,8 +507,11 @@ func (g *gatewayCGI) appendRSS(rsss *cgi.RSS, ca *thread.Cache) {
 	}
 }
 
-//makeOneRow makes one row of CSV depending on c.
-func (g *gatewayCGI) makeOneRow(c string, ca *thread.Cache, p, title string) string {
+//Columns is names of columns which MakeOneRow supports.
+var Columns = []string{"file", "stamp", "date", "path", "uri", "type", "title", "records", "size", "tag", "sugtag"}
+
+//MakeOneRow makes one column of CSV depending on c.
+func MakeOneRow(host, c string, ca *thread.Cache, p, title string) string {
 	switch c {
 	case "file":
 		return ca.Datfile
@@ -389,8 +522,8 @@ func (g *gatewayCGI) makeOneRow(c string, ca *thread.Cache, p, title string) str
 	case "path":
 		return p
 	case "uri":
-		if g.Host() != "" && p != "" {
-			return "http://" + g.Host() + p
+		if host != "" && p != "" {
+			return "http://" + host + p
 		}
 	case "type":
 		return "thread"
@@ -424,7 +557,7 @@ func (g *gatewayCGI) renderCSV(cl thread.Caches) {
 		p := cfg.ThreadURL + "/" + util.StrEncode(title)
 		row := make([]string, len(cols))
 		for i, c := range cols {
-			row[i] = g.makeOneRow(c, ca, p, title)
+			row[i] = MakeOneRow(g.Host(), c, ca, p, title)
 		}
 		err := cwr.Write(row)
 		if err != nil {
@@ -32,27 +32,54 @@ import (
 	"log"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
+)
+
+var (
+	requests = metrics.NewCounter("gou_http_requests_total",
+		"Number of HTTP requests by URL pattern.", "pattern")
+	requestSeconds = metrics.NewSummary("gou_http_request_seconds",
+		"Time to handle HTTP requests by URL pattern.", "pattern")
 )
 
 //LoggingServeMux is ServerMux with logging
 type LoggingServeMux struct {
 	*http.ServeMux
+	tlsPort int32 //port of the serving https server, 0 if not serving
 }
 
 //NewLoggingServeMux returns loggingServeMux obj.
 func NewLoggingServeMux() *LoggingServeMux {
 	return &LoggingServeMux{
-		http.NewServeMux(),
+		ServeMux: http.NewServeMux(),
 	}
 }
 
+//SetTLSPort sets the port told by TLSHeader, which should be set only while
+//the https server is serving. 0 stops telling it.
+func (s *LoggingServeMux) SetTLSPort(port int) {
+	atomic.StoreInt32(&s.tlsPort, int32(port))
+}
+
 //ServeHTTP just calles http.ServeMux.ServeHTTP after logging.
 func (s *LoggingServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Println(r.RemoteAddr, r.Method, r.URL.Path, r.Header.Get("User-Agent"), r.Header.Get("Referer"))
+	start := time.Now()
+	if port := atomic.LoadInt32(&s.tlsPort); port != 0 {
+		w.Header().Set(cfg.TLSHeader, strconv.Itoa(int(port)))
+	}
 	s.ServeMux.ServeHTTP(w, r)
+	//use registered pattern not to make too many kinds of labels.
+	_, pattern := s.ServeMux.Handler(r)
+	requests.Inc(pattern)
+	requestSeconds.Observe(time.Since(start).Seconds(), pattern)
 }
 
 //RegistCompressHandler registers fn to s after registering CompressHandler with path.
@@ -216,6 +216,7 @@ func (m *mchCGI) threadApp(board, datkey string) {
 		download.GetCache(true, data)
 	}
 
+	data.Touch()
 	thread := keylib.MakeDat(data, board, m.Req.Host)
 	str := strings.Join(thread, "\n") + "\n"
 	m.serveContent("a.txt", time.Unix(data.Stamp(), 0), str)
@@ -315,6 +316,7 @@ func (m *mchCGI) postComment(threadKey, name, mail, body, passwd, tag string) er
 		return errSpamM
 	}
 	rec.Sync()
+	c.MarkPosted()
 	if tag != "" {
 		user.Set(c.Datfile, []string{tag})
 	}
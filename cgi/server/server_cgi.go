@@ -40,9 +40,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/shingetsu-gou/go-nat"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
 	"github.com/shingetsu-gou/shingetsu-gou/node/manager"
 	"github.com/shingetsu-gou/shingetsu-gou/recentlist"
@@ -50,23 +50,42 @@ import (
 	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
 	"github.com/shingetsu-gou/shingetsu-gou/thread"
 	"github.com/shingetsu-gou/shingetsu-gou/updateque"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
 //Setup setups handlers for server.cgi
 func Setup(s *cgi.LoggingServeMux) {
-	s.RegistCompressHandler(cfg.ServerURL+"/ping", doPing)
-	s.RegistCompressHandler(cfg.ServerURL+"/node", doNode)
-	s.RegistCompressHandler(cfg.ServerURL+"/join/", doJoin)
-	s.RegistCompressHandler(cfg.ServerURL+"/bye/", doBye)
-	s.RegistCompressHandler(cfg.ServerURL+"/have/", doHave)
-	s.RegistCompressHandler(cfg.ServerURL+"/get/", doGetHead)
-	s.RegistCompressHandler(cfg.ServerURL+"/head/", doGetHead)
-	s.RegistCompressHandler(cfg.ServerURL+"/update/", doUpdate)
-	s.RegistCompressHandler(cfg.ServerURL+"/recent/", doRecent)
-	s.RegistCompressHandler(cfg.ServerURL+"/", doMotd)
+	s.RegistCompressHandler(cfg.ServerURL+"/ping", limited(doPing))
+	s.RegistCompressHandler(cfg.ServerURL+"/node", limited(doNode))
+	s.RegistCompressHandler(cfg.ServerURL+"/join/", limited(doJoin))
+	s.RegistCompressHandler(cfg.ServerURL+"/bye/", limited(doBye))
+	s.RegistCompressHandler(cfg.ServerURL+"/pingback/", limited(doPingback))
+	s.RegistCompressHandler(cfg.ServerURL+"/have/", limited(doHave))
+	s.RegistCompressHandler(cfg.ServerURL+"/get/", limited(doGetHead))
+	s.RegistCompressHandler(cfg.ServerURL+"/head/", limited(doGetHead))
+	s.RegistCompressHandler(cfg.ServerURL+"/update/", limited(doUpdate))
+	s.RegistCompressHandler(cfg.ServerURL+"/recent/", limited(doRecent))
+	s.RegistCompressHandler(cfg.ServerURL+"/capabilities", limited(doCapabilities))
+	s.RegistCompressHandler(cfg.ServerURL+"/batch/", limit.Handler(doBatch, batchCost))
+	s.RegistCompressHandler(cfg.ServerURL+"/digest/", limited(doDigest))
+	s.RegistCompressHandler(cfg.ServerURL+"/", limited(doMotd))
 
 }
 
+//limited returns the handler which refuses requests exceeding the rate limit or from banned IPs.
+func limited(fn func(w http.ResponseWriter, r *http.Request)) func(w http.ResponseWriter, r *http.Request) {
+	return limit.Handler(fn, nil)
+}
+
+//batchCost returns # of threads in the batch request, which costs one request per thread.
+func batchCost(r *http.Request) int {
+	args := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, cfg.ServerURL+"/batch/"), "/"), "/")
+	if n := (len(args) - 1) / 2; n > 1 {
+		return n
+	}
+	return 1
+}
+
 //doPing just resopnse PONG with remote addr.
 func doPing(w http.ResponseWriter, r *http.Request) {
 	log.Println(r.Header)
@@ -80,9 +99,10 @@ func doPing(w http.ResponseWriter, r *http.Request) {
 
 //doNode returns one of nodelist. if nodelist.len=0 returns one of initNode.
 func doNode(w http.ResponseWriter, r *http.Request) {
-	if manager.ListLen() > 0 {
+	switch {
+	case manager.ListLen() > 0:
 		fmt.Fprintln(w, manager.GetNodestrSliceInList()[0])
-	} else {
+	case len(cfg.InitNode.GetData()) > 0:
 		fmt.Fprintln(w, cfg.InitNode.GetData()[0])
 	}
 }
@@ -104,6 +124,11 @@ func doJoin(w http.ResponseWriter, r *http.Request) {
 	if err != nil || !n.IsAllowed() {
 		return
 	}
+	if !limit.AllowNode(n.Nodestr, n.Host()) {
+		log.Println("too many joins from", n.Nodestr)
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
 	if _, err := n.Ping(); err != nil {
 		return
 	}
@@ -134,6 +159,31 @@ func doBye(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintln(s.WR, "BYEBYE")
 }
 
+//doPingback pings the node specified in url, which must be the remote host,
+//and says REACHABLE if ponged or UNREACHABLE if not.
+func doPingback(w http.ResponseWriter, r *http.Request) {
+	s, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	host, path, port := s.extractHost("pingback")
+	host = s.checkRemote(host)
+	if host == "" {
+		return
+	}
+	n, err := node.MakeNode(host, path, port)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if _, err := n.Ping(); err != nil {
+		fmt.Fprintln(s.WR, "UNREACHABLE")
+		return
+	}
+	fmt.Fprintln(s.WR, "REACHABLE")
+}
+
 //doHave checks existance of cache whose name is specified in url.
 func doHave(w http.ResponseWriter, r *http.Request) {
 	s, err := new(w, r)
@@ -193,6 +243,11 @@ func doUpdate(w http.ResponseWriter, r *http.Request) {
 		log.Println("detects spam")
 		return
 	}
+	if !limit.AllowNode(n.Nodestr, n.Host()) {
+		log.Println("too many updates from", n.Nodestr)
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
 	manager.AppendToTable(datfile, n)
 	nstamp, err := strconv.ParseInt(stamp, 10, 64)
 	if err != nil {
@@ -204,7 +259,12 @@ func doUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	rec := record.New(datfile, id, nstamp)
-	go updateque.UpdateNodes(rec, n)
+	if !limit.Go(func() {
+		updateque.UpdateNodes(rec, n)
+	}) {
+		log.Println("too many pending updates, dropped", rec.Idstr())
+		return
+	}
 	fmt.Fprintln(w, "OK")
 }
 
@@ -265,8 +325,14 @@ func doGetHead(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	method, datfile, stamp := m[1], m[2], m[3]
-	ca := thread.NewCache(datfile)
 	begin, end, id := s.parseStamp(stamp, math.MaxInt32)
+	s.writeRecords(method, datfile, "", begin, end, id)
+}
+
+//writeRecords writes records of datfile in the range with prefix.
+//records are written by Recstr if method is get, or by stamp and id if not.
+func (s *serverCGI) writeRecords(method, datfile, prefix string, begin, end int64, id string) {
+	ca := thread.NewCache(datfile)
 	var recs record.Map
 	if method == "removed" {
 		recs = ca.LoadRecords(record.Removed)
@@ -280,10 +346,10 @@ func doGetHead(w http.ResponseWriter, r *http.Request) {
 					log.Println(err)
 					continue
 				}
-				fmt.Fprintln(s.WR, r.Recstr())
+				fmt.Fprintln(s.WR, prefix+r.Recstr())
 				continue
 			}
-			fmt.Fprintln(s.WR, strings.Replace(r.Idstr(), "_", "<>", -1))
+			fmt.Fprintln(s.WR, prefix+strings.Replace(r.Idstr(), "_", "<>", -1))
 		}
 	}
 	if method == "get" {
@@ -291,6 +357,82 @@ func doGetHead(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+//doCapabilities returns capabilities of this node after the header line.
+func doCapabilities(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintln(w, node.CapabilitiesHeader)
+	for _, c := range node.Capabilities {
+		fmt.Fprintln(w, c)
+	}
+}
+
+//doBatch gets or heads records of multiple threads specified by url
+//in the form of batch/(get|head)/datfile/begin-end/datfile/begin-end/...
+//each line is prefixed with its datfile and "<>".
+func doBatch(w http.ResponseWriter, r *http.Request) {
+	s, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	reg := regexp.MustCompile("^batch/(get|head)/([-0-9A-Za-z_/]+)$")
+	m := reg.FindStringSubmatch(s.Path())
+	if m == nil {
+		log.Println("illegal url", s.Path())
+		return
+	}
+	method := m[1]
+	args := strings.Split(strings.TrimSuffix(m[2], "/"), "/")
+	if len(args)%2 != 0 || len(args) > 2*node.MaxBatch {
+		log.Println("illegal url", s.Path())
+		return
+	}
+	datreg := regexp.MustCompile("^[0-9A-Za-z_]+$")
+	for i := 0; i < len(args); i += 2 {
+		datfile, stamp := args[i], args[i+1]
+		if !datreg.MatchString(datfile) {
+			log.Println("illegal datfile", datfile)
+			continue
+		}
+		begin, end, _ := s.parseStamp(stamp, math.MaxInt32)
+		s.writeRecords(method, datfile, datfile+"<>", begin, end, "")
+	}
+}
+
+//digestSlack is the allowance of the end of the range in digest for clock skew of nodes.
+const digestSlack = time.Hour
+
+//doDigest renders digests of records in the thread specified by url
+//in the form of digest/datfile/begin-end/#buckets.
+//each line is a bucket made by thread.Bucket.String.
+func doDigest(w http.ResponseWriter, r *http.Request) {
+	s, err := new(w, r)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	reg := regexp.MustCompile("^digest/([0-9A-Za-z_]+)/([0-9]+)-([0-9]+)/([0-9]+)$")
+	m := reg.FindStringSubmatch(s.Path())
+	if m == nil {
+		log.Println("illegal url", s.Path())
+		return
+	}
+	begin, err1 := strconv.ParseInt(m[2], 10, 64)
+	end, err2 := strconv.ParseInt(m[3], 10, 64)
+	num, err3 := strconv.Atoi(m[4])
+	if err1 != nil || err2 != nil || err3 != nil || num <= 0 || num > thread.MaxBuckets {
+		log.Println("illegal url", s.Path())
+		return
+	}
+	//records in the future are not accepted, so such a range is illegal.
+	if end < begin || end > time.Now().Add(digestSlack).Unix() {
+		log.Println("illegal range", s.Path())
+		return
+	}
+	for _, b := range thread.NewCache(m[1]).Digest(begin, end, num) {
+		fmt.Fprintln(s.WR, b)
+	}
+}
+
 //serverCGI is for server.cgi handler.
 type serverCGI struct {
 	*cgi.CGI
@@ -337,11 +479,12 @@ func isGlobal(remoteAddr string) bool {
 		log.Println(remoteAddr, "has illegal format")
 		return false
 	}
-	return nat.IsGlobalIP(ip) != ""
+	return util.IsGlobalIP(ip)
 }
 
 //checkRemote returns remoteaddr
 //if host is specified returns remoteaddr if host==remoteaddr.
+//.onion host is trusted in tor mode only if the request comes from localhost.
 func (s *serverCGI) checkRemote(host string) string {
 	remoteAddr, _, err := net.SplitHostPort(s.Req.RemoteAddr)
 	if err != nil {
@@ -351,6 +494,14 @@ func (s *serverCGI) checkRemote(host string) string {
 	if host == "" {
 		return remoteAddr
 	}
+	if cfg.Tor && strings.HasSuffix(host, ".onion") {
+		//onion host cannot be resolved, and comes through tor in localhost.
+		if ip := net.ParseIP(remoteAddr); ip == nil || !ip.IsLoopback() {
+			log.Println(host, "is not from tor in localhost")
+			return ""
+		}
+		return host
+	}
 	ipaddr, err := net.LookupIP(host)
 	if err != nil {
 		log.Println(err)
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package cgi
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/search"
+)
+
+func TestSearchResult(t *testing.T) {
+	tmp := newHtemplate("/nonexistent")
+	s := struct {
+		Results []*search.Result
+		Defaults
+	}{
+		[]*search.Result{
+			{
+				Head: &record.Head{
+					Datfile: "thread_41",
+					Stamp:   1234567890,
+					ID:      "0123456789abcdef0123456789abcdef",
+				},
+				Sid:     "01234567",
+				Title:   "A",
+				Snippet: []search.Fragment{{Text: "foo"}, {Text: "bar", Hit: true}},
+			},
+		},
+		Defaults{ThreadCGI: "/thread.cgi"},
+	}
+	var buf bytes.Buffer
+	if err := tmp.ExecuteTemplate(&buf, "search_result", s); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `href="/thread.cgi/A/01234567"`) {
+		t.Fatal("illegal link", out)
+	}
+	if !strings.Contains(out, `<strong class="hit">bar</strong>`) {
+		t.Fatal("illegal snippet", out)
+	}
+}
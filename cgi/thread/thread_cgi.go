@@ -277,6 +277,7 @@ func (t *threadCGI) printThreadHead(path, id string, page int, ca *thread.Cache,
 			log.Println(err)
 		}
 		newcookie = t.setCookie(ca, access)
+		ca.Touch()
 	}
 	t.Header(path, rss, newcookie, false)
 	return nil
@@ -484,7 +485,7 @@ func (t *threadCGI) printPostForm(ca *thread.Cache) {
 }
 
 //renderAttach render the content of attach file with content-type=typ.
-func (t *threadCGI) renderAttach(rec *record.Record, suffix string, stamp int64, thumbnailSize string) {
+func (t *threadCGI) renderAttach(rec *record.Record, data []byte, suffix string, stamp int64, thumbnailSize string) {
 	attachFile := rec.AttachPath(thumbnailSize)
 	if attachFile == "" {
 		return
@@ -498,16 +499,7 @@ func (t *threadCGI) renderAttach(rec *record.Record, suffix string, stamp int64,
 	if !util.IsValidImage(typ, attachFile) {
 		t.WR.Header().Set("Content-Disposition", "attachment")
 	}
-	decoded, err := base64.StdEncoding.DecodeString(rec.GetBodyValue("attach", ""))
-	if err != nil {
-		log.Println(err)
-		t.Print404(nil, "")
-		return
-	}
-	if thumbnailSize != "" && (cfg.ForceThumbnail || thumbnailSize == cfg.DefaultThumbnailSize) {
-		decoded = util.MakeThumbnail(decoded, suffix, thumbnailSize)
-	}
-	_, err = t.WR.Write(decoded)
+	_, err := t.WR.Write(data)
 	if err != nil {
 		log.Println(err)
 		t.Print404(nil, "")
@@ -530,15 +522,16 @@ func (t *threadCGI) printAttach(datfile, id string, stamp int64, thumbnailSize,
 		t.Print404(ca, "")
 		return
 	}
-	if err := rec.Load(); err != nil {
-		t.Print404(ca, "")
-		return
+	if thumbnailSize != "" && !cfg.ForceThumbnail && thumbnailSize != cfg.DefaultThumbnailSize {
+		thumbnailSize = ""
 	}
-	if rec.GetBodyValue("suffix", "") != suffix {
+	data, sfx, err := record.AttachData(rec.Head, thumbnailSize)
+	if err != nil || sfx != suffix {
+		log.Println(err)
 		t.Print404(ca, "")
 		return
 	}
-	t.renderAttach(rec, suffix, stamp, thumbnailSize)
+	t.renderAttach(rec, data, suffix, stamp, thumbnailSize)
 }
 
 //errorTime calculates gaussian distribution by box-muller transformation.
@@ -552,14 +545,20 @@ func (t *threadCGI) errorTime() int64 {
 
 //guessSuffix guess suffix of attached at from formvalue "suffix"
 func (t *threadCGI) guessSuffix(at *attached) string {
-	guessSuffix := cfg.SuffixTXT
+	var filename string
 	if at != nil {
-		if e := path.Ext(at.Filename); e != "" {
-			guessSuffix = strings.ToLower(e)
-		}
+		filename = at.Filename
 	}
+	return GuessSuffix(filename, t.Req.FormValue("suffix"))
+}
 
-	suffix := t.Req.FormValue("suffix")
+//GuessSuffix returns suffix of the attached file from suffix, or
+//from extension of filename if suffix is "" or "AUTO".
+func GuessSuffix(filename, suffix string) string {
+	guessSuffix := cfg.SuffixTXT
+	if e := path.Ext(filename); e != "" {
+		guessSuffix = strings.ToLower(e)
+	}
 	switch {
 	case suffix == "" || suffix == "AUTO":
 		suffix = guessSuffix
@@ -616,31 +615,49 @@ func (t *threadCGI) doPost() string {
 	proxyClient := t.Req.Header.Get("X_FORWARDED_FOR")
 	log.Printf("post %s/%d_%s from %s/%s\n", ca.Datfile, ca.Stamp(), rec.ID, t.Req.RemoteAddr, proxyClient)
 
-	if len(rec.Recstr()) > cfg.RecordLimit<<10 {
+	switch Post(ca, rec, t.Req.FormValue("dopost") != "") {
+	case nil:
+	case ErrBigFile:
 		t.Header(t.M["big_file"], "", nil, true)
 		t.Footer(nil)
 		return ""
-	}
-	if rec.IsSpam() {
+	case cfg.ErrSpam:
 		t.Header(t.M["spam"], "", nil, true)
 		t.Footer(nil)
 		return ""
-	}
-
-	if ca.Exists() {
-		rec.Sync()
-	} else {
+	default:
 		t.Print404(nil, "")
 		return ""
 	}
+	return rec.ID[:8]
 
-	if t.Req.FormValue("dopost") != "" {
+}
+
+//errors returned by Post.
+var (
+	ErrBigFile  = errors.New("record is too big")
+	ErrNoThread = errors.New("thread not found")
+)
+
+//Post saves rec to the thread ca if rec is not too big and not spam,
+//and tells the update to other nodes if dopost.
+func Post(ca *thread.Cache, rec *record.Record, dopost bool) error {
+	if len(rec.Recstr()) > cfg.RecordLimit<<10 {
+		return ErrBigFile
+	}
+	if rec.IsSpam() {
+		return cfg.ErrSpam
+	}
+	if !ca.Exists() {
+		return ErrNoThread
+	}
+	rec.Sync()
+	ca.MarkPosted()
+	if dopost {
 		log.Println(rec.Datfile, rec.ID, "is queued")
 		go updateque.UpdateNodes(rec, nil)
 	}
-
-	return rec.ID[:8]
-
+	return nil
 }
 
 //attached represents attached file name and contents.
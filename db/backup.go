@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+)
+
+//validator checks contents of a snapshot.
+type validator struct {
+	description string
+	fn          func(Tx) error
+}
+
+//validators are checks which are run before restoring a snapshot.
+var validators []*validator
+
+//AddValidator registers fn which checks contents of a snapshot before restoring.
+//it should be called in init() of packages which owns buckets to be checked.
+func AddValidator(description string, fn func(Tx) error) {
+	validators = append(validators, &validator{
+		description: description,
+		fn:          fn,
+	})
+}
+
+//copyBuckets copies all buckets and k/v pairs in src to dst.
+func copyBuckets(dst, src Tx) error {
+	return src.ForEach(func(name []byte, sb Bucket) error {
+		db, err := dst.CreateBucketIfNotExists(name)
+		if err != nil {
+			return err
+		}
+		return sb.ForEach(func(k, v []byte) error {
+			return db.Put(k, v)
+		})
+	})
+}
+
+//Validate checks that s has all buckets with supported version
+//and passes all registered validators.
+func Validate(s Storage) error {
+	return s.View(func(tx Tx) error {
+		v := Version(tx)
+		if v > LatestVersion() {
+			return fmt.Errorf("db version %d is newer than supported version %d", v, LatestVersion())
+		}
+		for _, b := range buckets {
+			if tx.Bucket([]byte(b)) == nil {
+				return errors.New("bucket not found " + b)
+			}
+		}
+		for _, va := range validators {
+			log.Println("validating", va.description)
+			if err := va.fn(tx); err != nil {
+				return fmt.Errorf("%s: %s", va.description, err)
+			}
+		}
+		return nil
+	})
+}
+
+//Restore validates the snapshot file src and swaps it in as the db file dst.
+//old dst is renamed to dst+".old".
+//dst must not be opened, i.e. Gou must be stopped.
+func Restore(src, dst string) error {
+	s, err := OpenBolt(src, true, time.Second)
+	if err != nil {
+		return err
+	}
+	err = Validate(s)
+	if errr := s.Close(); errr != nil {
+		log.Println(errr)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(dst); err == nil {
+		d, errr := OpenBolt(dst, false, time.Second)
+		if errr != nil {
+			return fmt.Errorf("cannot lock %s, stop Gou first: %s", dst, errr)
+		}
+		if errr := d.Close(); errr != nil {
+			log.Println(errr)
+		}
+	}
+	tmp := dst + ".new"
+	if err = copyFile(tmp, src); err != nil {
+		return err
+	}
+	if _, err = os.Stat(dst); err == nil {
+		if err = os.Rename(dst, dst+".old"); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, dst)
+}
+
+//copyFile copies the file src to dst and syncs it.
+func copyFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err == nil {
+		err = out.Sync()
+	}
+	if errr := out.Close(); err == nil {
+		err = errr
+	}
+	return err
+}
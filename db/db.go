@@ -34,17 +34,19 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"path"
 
 	"encoding/json"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 )
 
 /*
 bucket key value
 
+meta "version" schema version(uint64)
 keylibST Stamp Thread
 keylibTS Thread Stamp
 lookupT Thread json(map[addr]struct{})
@@ -55,6 +57,16 @@ usertag Thread json(map[tags]struct{})
 usertagTag Tag json(map[threads]struct{})
 recent thread:stamp:hash json(Datfile,Stamp.ID)
 record thread:stamp:hash json(Datfile,Stamp.ID,Body,Deleted)
+recordStamp stamp:thread:hash nil
+recordIndex gram:thread:stamp:hash #gram(uint64)
+blob sha256 attached file
+blobRef sha256:thread:stamp:hash nil
+blobThumb sha256:size thumbnail
+threadRead Thread stamp(int64)
+localPost Thread ""
+threadStat Thread json(Size,Stamp)
+nodeHealth Nodestr json(Health)
+evicted Thread stamp(int64)
 
 
 var tables = []string{
@@ -68,17 +80,35 @@ var tables = []string{
 }
 */
 
-//DB is bolt.DB for operating database.
-var DB *bolt.DB
+//DB is Storage for operating database.
+//it is a bolt db in the run dir by Setup, or may be replaced by NewMemory() for tests.
+var DB Storage
+
+//Path returns the path of db file.
+func Path() string {
+	return path.Join(cfg.RunDir, "gou_bolt.db")
+}
+
+func init() {
+	metrics.NewGaugeFunc("gou_db_bytes", "Size of the db file.", func() float64 {
+		fi, err := os.Stat(Path())
+		if err != nil {
+			return 0
+		}
+		return float64(fi.Size())
+	})
+}
 
 //Setup setups db.
 func Setup() {
-	dbpath := path.Join(cfg.RunDir, "gou_bolt.db")
 	var err error
-	DB, err = bolt.Open(dbpath, 0644, nil)
+	DB, err = NewBolt(Path())
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := Migrate(DB); err != nil {
+		log.Fatal(err)
+	}
 }
 
 // Tob returns an 8-byte big endian representation of v.
@@ -148,7 +178,7 @@ func b2v(from []byte, to interface{}) error {
 }
 
 //Get gets one value from db and converts it to value type.
-func Get(tx *bolt.Tx, bucket string, key []byte, value interface{}) ([]byte, error) {
+func Get(tx Tx, bucket string, key []byte, value interface{}) ([]byte, error) {
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
 		return nil, errors.New("bucket not found " + bucket)
@@ -161,7 +191,7 @@ func Get(tx *bolt.Tx, bucket string, key []byte, value interface{}) ([]byte, err
 }
 
 //Put sets one key/value pair.
-func Put(tx *bolt.Tx, bucket string, key []byte, value interface{}) error {
+func Put(tx Tx, bucket string, key []byte, value interface{}) error {
 	val, err := Tob(value)
 	if err != nil {
 		return err
@@ -174,7 +204,7 @@ func Put(tx *bolt.Tx, bucket string, key []byte, value interface{}) error {
 }
 
 //HasKey returns true if db has key.
-func HasKey(tx *bolt.Tx, bucket string, key []byte) (bool, error) {
+func HasKey(tx Tx, bucket string, key []byte) (bool, error) {
 	var v []byte
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
@@ -185,28 +215,28 @@ func HasKey(tx *bolt.Tx, bucket string, key []byte) (bool, error) {
 }
 
 //Count counts #data whose key has prefix.
-func Count(tx *bolt.Tx, bucket string, prefix []byte) (int, error) {
+func Count(tx Tx, bucket string, prefix []byte) (int, error) {
 	var cnt int
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
 		return 0, errors.New("bucket not found " + bucket)
 	}
 	c := b.Cursor()
-	for k, _ := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
 		cnt++
 	}
 	return cnt, nil
 }
 
 //GetStrings returns string values whose key has prefix.
-func GetStrings(tx *bolt.Tx, bucket string, prefix []byte) ([]string, error) {
+func GetStrings(tx Tx, bucket string, prefix []byte) ([]string, error) {
 	var cnt []string
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
 		return nil, errors.New("bucket not found " + bucket)
 	}
 	c := b.Cursor()
-	for k, v := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, v = c.Next() {
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
 		var str string
 		if err := b2v(v, &str); err != nil {
 			return nil, err
@@ -217,7 +247,7 @@ func GetStrings(tx *bolt.Tx, bucket string, prefix []byte) ([]string, error) {
 }
 
 //KeyStrings returns string keys.
-func KeyStrings(tx *bolt.Tx, bucket string) ([]string, error) {
+func KeyStrings(tx Tx, bucket string) ([]string, error) {
 	var cnt []string
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
@@ -235,7 +265,7 @@ func KeyStrings(tx *bolt.Tx, bucket string) ([]string, error) {
 }
 
 //Del deletes one key-value pair.
-func Del(tx *bolt.Tx, bucket string, key []byte) error {
+func Del(tx Tx, bucket string, key []byte) error {
 	b := tx.Bucket([]byte(bucket))
 	if b == nil {
 		return errors.New("bucket not found " + bucket)
@@ -244,14 +274,14 @@ func Del(tx *bolt.Tx, bucket string, key []byte) error {
 }
 
 //GetMap gets map[string]struct{} value.
-func GetMap(tx *bolt.Tx, bucket string, key []byte) (map[string]struct{}, error) {
+func GetMap(tx Tx, bucket string, key []byte) (map[string]struct{}, error) {
 	var rs map[string]struct{}
 	_, err := Get(tx, bucket, key, &rs)
 	return rs, err
 }
 
 //PutMap adds val to map[string]struct{} type value.
-func PutMap(tx *bolt.Tx, bucket string, key []byte, val string) error {
+func PutMap(tx Tx, bucket string, key []byte, val string) error {
 	rs, err := GetMap(tx, bucket, key)
 	if err != nil {
 		rs = make(map[string]struct{})
@@ -261,7 +291,7 @@ func PutMap(tx *bolt.Tx, bucket string, key []byte, val string) error {
 }
 
 //DelMap deletes val from map[string]struct{} type value.
-func DelMap(tx *bolt.Tx, bucket string, key []byte, val string) error {
+func DelMap(tx Tx, bucket string, key []byte, val string) error {
 	rs, err := GetMap(tx, bucket, key)
 	if err != nil {
 		return err
@@ -274,7 +304,7 @@ func DelMap(tx *bolt.Tx, bucket string, key []byte, val string) error {
 }
 
 //MapKeys returns []string from keys of map[string]struct{} type value
-func MapKeys(tx *bolt.Tx, bucket string, key []byte) ([]string, error) {
+func MapKeys(tx Tx, bucket string, key []byte) ([]string, error) {
 	m, err := GetMap(tx, bucket, key)
 	if err != nil {
 		return nil, err
@@ -289,7 +319,7 @@ func MapKeys(tx *bolt.Tx, bucket string, key []byte) ([]string, error) {
 }
 
 //HasVal returns true if map[string]struct{} type values has val.
-func HasVal(tx *bolt.Tx, bucket string, key []byte, val string) bool {
+func HasVal(tx Tx, bucket string, key []byte, val string) bool {
 	m, err := GetMap(tx, bucket, key)
 	if err != nil {
 		return false
@@ -299,7 +329,7 @@ func HasVal(tx *bolt.Tx, bucket string, key []byte, val string) bool {
 }
 
 //GetPrefixs get string prefixs of keys.
-func GetPrefixs(tx *bolt.Tx, bucket string) ([]string, error) {
+func GetPrefixs(tx Tx, bucket string) ([]string, error) {
 	var cnt []string
 	var last string
 	var blast []byte
@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package db
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//storages returns storages to be tested and a func for cleaning up.
+func storages(t *testing.T) (map[string]Storage, func()) {
+	dir, err := ioutil.TempDir("", "gou_db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewBolt(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]Storage{
+		"bolt":   b,
+		"memory": NewMemory(),
+	}, func() {
+		if err := b.Close(); err != nil {
+			t.Error(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestStorage(t *testing.T) {
+	ss, cleanup := storages(t)
+	defer cleanup()
+	for name, s := range ss {
+		testStorage(t, name, s)
+	}
+}
+
+func testStorage(t *testing.T, name string, s Storage) {
+	err := s.Update(func(tx Tx) error {
+		for _, k := range []string{"b", "a", "c"} {
+			if err := Put(tx, "test", ToKey("thread_1", k), k); err != nil {
+				return err
+			}
+		}
+		if err := Put(tx, "test", ToKey("thread_2", "x"), "x"); err != nil {
+			return err
+		}
+		if err := PutMap(tx, "map", []byte("k"), "v1"); err != nil {
+			return err
+		}
+		return PutMap(tx, "map", []byte("k"), "v2")
+	})
+	if err != nil {
+		t.Fatal(name, err)
+	}
+	err = s.View(func(tx Tx) error {
+		var v string
+		if _, err := Get(tx, "test", ToKey("thread_1", "a"), &v); err != nil || v != "a" {
+			t.Error(name, "get failed", v, err)
+		}
+		strs, err := GetStrings(tx, "test", ToKey("thread_1"))
+		if err != nil || len(strs) != 3 || strs[0] != "a" || strs[2] != "c" {
+			t.Error(name, "illegal prefix scan", strs, err)
+		}
+		prefixs, err := GetPrefixs(tx, "test")
+		if err != nil || len(prefixs) != 2 || prefixs[1] != "thread_2" {
+			t.Error(name, "illegal prefixs", prefixs, err)
+		}
+		if m, err := MapKeys(tx, "map", []byte("k")); err != nil || len(m) != 2 {
+			t.Error(name, "illegal map", m, err)
+		}
+		if tx.Bucket([]byte("nothing")) != nil {
+			t.Error(name, "bucket must be nil")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(name, err)
+	}
+
+	errRollback := errors.New("rollback")
+	err = s.Update(func(tx Tx) error {
+		if err := Del(tx, "test", ToKey("thread_1", "a")); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatal(name, err)
+	}
+	err = s.Update(func(tx Tx) error {
+		if has, err := HasKey(tx, "test", ToKey("thread_1", "a")); !has || err != nil {
+			t.Error(name, "not rollbacked", err)
+		}
+		for _, k := range []string{"a", "b", "c"} {
+			if err := Del(tx, "test", ToKey("thread_1", k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(name, err)
+	}
+	err = s.View(func(tx Tx) error {
+		if cnt, err := Count(tx, "test", ToKey("thread_1")); cnt != 0 || err != nil {
+			t.Error(name, "not deleted", cnt, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(name, err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	s := NewMemory()
+	var called int
+	AddMigration(LatestVersion()+1, "test", func(tx Tx) error {
+		called++
+		return Put(tx, "record", []byte("k"), "v")
+	})
+	defer func() {
+		migrations = migrations[:len(migrations)-1]
+	}()
+	for i := 0; i < 2; i++ {
+		if err := Migrate(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if called != 1 {
+		t.Fatal("migration must be called once", called)
+	}
+	err := s.View(func(tx Tx) error {
+		if v := Version(tx); v != LatestVersion() {
+			t.Error("illegal version", v)
+		}
+		for _, b := range buckets {
+			if tx.Bucket([]byte(b)) == nil {
+				t.Error("bucket is not created", b)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Update(func(tx Tx) error {
+		return setVersion(tx, LatestVersion()+1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Migrate(s); err == nil {
+		t.Fatal("must fail with newer version")
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gou_db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	s := NewMemory()
+	if err = Migrate(s); err != nil {
+		t.Fatal(err)
+	}
+	err = s.Update(func(tx Tx) error {
+		return Put(tx, "thread", []byte("thread_1"), "")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := filepath.Join(dir, "snapshot.db")
+	f, err := os.Create(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(dir, "gou_bolt.db")
+	d, err := NewBolt(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Restore(snapshot, dst); err == nil {
+		t.Fatal("must fail while db is opened")
+	}
+	if err = d.Close(); err != nil {
+		t.Fatal(err)
+	}
+	AddValidator("test", func(tx Tx) error {
+		return errors.New("invalid")
+	})
+	if err = Restore(snapshot, dst); err == nil {
+		t.Fatal("must fail with invalid snapshot")
+	}
+	validators = validators[:len(validators)-1]
+	if err = Restore(snapshot, dst); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(dst + ".old"); err != nil {
+		t.Fatal("old db is not saved", err)
+	}
+	d, err = NewBolt(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	err = d.View(func(tx Tx) error {
+		if has, errr := HasKey(tx, "thread", []byte("thread_1")); !has || errr != nil {
+			t.Error("data is not restored", errr)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,381 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package db
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+//errTxNotWritable is returned when writing in a read-only transaction.
+var errTxNotWritable = errors.New("tx not writable")
+
+//memStorage is Storage on memory, mainly for tests.
+//Update works on copies of buckets and swaps them in when fn succeeds.
+type memStorage struct {
+	mutex   sync.RWMutex
+	buckets map[string]*memBucket
+}
+
+//NewMemory returns an empty Storage which keeps all data on memory.
+func NewMemory() Storage {
+	return &memStorage{
+		buckets: make(map[string]*memBucket),
+	}
+}
+
+//View executes fn in a read-only transaction.
+func (m *memStorage) View(fn func(Tx) error) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return fn(&memTx{buckets: m.buckets})
+}
+
+//Update executes fn in a read-write transaction.
+//changes are discarded if fn returns error.
+func (m *memStorage) Update(fn func(Tx) error) error {
+	m.mutex.Lock()
+	t := &memTx{
+		buckets:  make(map[string]*memBucket, len(m.buckets)),
+		cloned:   make(map[string]struct{}),
+		writable: true,
+	}
+	for k, v := range m.buckets {
+		t.buckets[k] = v
+	}
+	if err := fn(t); err != nil {
+		m.mutex.Unlock()
+		return err
+	}
+	m.buckets = t.buckets
+	m.mutex.Unlock()
+	for _, h := range t.commitHandlers {
+		h()
+	}
+	return nil
+}
+
+//WriteTo copies all buckets to a temporary bolt db and writes it to w.
+func (m *memStorage) WriteTo(w io.Writer) (int64, error) {
+	dir, err := ioutil.TempDir("", "gou_mem")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Println(err)
+		}
+	}()
+	b, err := NewBolt(filepath.Join(dir, "snapshot.db"))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+	err = m.View(func(src Tx) error {
+		return b.Update(func(dst Tx) error {
+			return copyBuckets(dst, src)
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return b.WriteTo(w)
+}
+
+//Close does nothing.
+func (m *memStorage) Close() error {
+	return nil
+}
+
+//memBucket is sorted k/v pairs.
+type memBucket struct {
+	keys [][]byte
+	vals [][]byte
+}
+
+//search returns the index of the first key >= k.
+func (b *memBucket) search(k []byte) int {
+	return sort.Search(len(b.keys), func(i int) bool {
+		return bytes.Compare(b.keys[i], k) >= 0
+	})
+}
+
+//clone returns a copy of b. keys and values themselves are shared
+//because they are never modified in place.
+func (b *memBucket) clone() *memBucket {
+	c := &memBucket{
+		keys: make([][]byte, len(b.keys)),
+		vals: make([][]byte, len(b.vals)),
+	}
+	copy(c.keys, b.keys)
+	copy(c.vals, b.vals)
+	return c
+}
+
+//memTx is a transaction of memStorage.
+type memTx struct {
+	buckets        map[string]*memBucket
+	cloned         map[string]struct{}
+	writable       bool
+	commitHandlers []func()
+}
+
+//Writable returns true if the tx is for Update.
+func (t *memTx) Writable() bool {
+	return t.writable
+}
+
+//OnCommit adds fn which is called after Update is finished successfully.
+func (t *memTx) OnCommit(fn func()) {
+	t.commitHandlers = append(t.commitHandlers, fn)
+}
+
+//Bucket returns the bucket named name, or nil if not exists.
+func (t *memTx) Bucket(name []byte) Bucket {
+	if _, exist := t.buckets[string(name)]; !exist {
+		return nil
+	}
+	return &memBucketRef{tx: t, name: string(name)}
+}
+
+//CreateBucketIfNotExists creates the bucket if not exists and returns it.
+func (t *memTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if !t.writable {
+		return nil, errTxNotWritable
+	}
+	if len(name) == 0 {
+		return nil, errors.New("bucket name required")
+	}
+	if _, exist := t.buckets[string(name)]; !exist {
+		t.buckets[string(name)] = &memBucket{}
+		t.cloned[string(name)] = struct{}{}
+	}
+	return &memBucketRef{tx: t, name: string(name)}, nil
+}
+
+//DeleteBucket deletes the bucket named name.
+func (t *memTx) DeleteBucket(name []byte) error {
+	if !t.writable {
+		return errTxNotWritable
+	}
+	if _, exist := t.buckets[string(name)]; !exist {
+		return errors.New("bucket not found")
+	}
+	delete(t.buckets, string(name))
+	delete(t.cloned, string(name))
+	return nil
+}
+
+//ForEach calls fn for each names of buckets in name order.
+func (t *memTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	names := make([]string, 0, len(t.buckets))
+	for k := range t.buckets {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if err := fn([]byte(n), &memBucketRef{tx: t, name: n}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//memBucketRef is Bucket in a memTx.
+type memBucketRef struct {
+	tx   *memTx
+	name string
+}
+
+//get returns the bucket for reading.
+func (r *memBucketRef) get() *memBucket {
+	if b, exist := r.tx.buckets[r.name]; exist {
+		return b
+	}
+	return &memBucket{}
+}
+
+//mutable returns the bucket for writing, cloning it at the first write in the tx.
+func (r *memBucketRef) mutable() (*memBucket, error) {
+	if !r.tx.writable {
+		return nil, errTxNotWritable
+	}
+	b, exist := r.tx.buckets[r.name]
+	if !exist {
+		return nil, errors.New("bucket not found")
+	}
+	if _, exist := r.tx.cloned[r.name]; !exist {
+		b = b.clone()
+		r.tx.buckets[r.name] = b
+		r.tx.cloned[r.name] = struct{}{}
+	}
+	return b, nil
+}
+
+//Get returns the value of key, or nil if not exists.
+func (r *memBucketRef) Get(key []byte) []byte {
+	b := r.get()
+	i := b.search(key)
+	if i < len(b.keys) && bytes.Equal(b.keys[i], key) {
+		return b.vals[i]
+	}
+	return nil
+}
+
+//Put sets the value of key.
+func (r *memBucketRef) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	b, err := r.mutable()
+	if err != nil {
+		return err
+	}
+	k := make([]byte, len(key))
+	copy(k, key)
+	v := make([]byte, len(value))
+	copy(v, value)
+	i := b.search(k)
+	if i < len(b.keys) && bytes.Equal(b.keys[i], k) {
+		b.vals[i] = v
+		return nil
+	}
+	b.keys = append(b.keys, nil)
+	b.vals = append(b.vals, nil)
+	copy(b.keys[i+1:], b.keys[i:])
+	copy(b.vals[i+1:], b.vals[i:])
+	b.keys[i] = k
+	b.vals[i] = v
+	return nil
+}
+
+//Delete deletes key. it does nothing if key doesn't exist.
+func (r *memBucketRef) Delete(key []byte) error {
+	b, err := r.mutable()
+	if err != nil {
+		return err
+	}
+	i := b.search(key)
+	if i >= len(b.keys) || !bytes.Equal(b.keys[i], key) {
+		return nil
+	}
+	b.keys = append(b.keys[:i], b.keys[i+1:]...)
+	b.vals = append(b.vals[:i], b.vals[i+1:]...)
+	return nil
+}
+
+//ForEach calls fn for each k/v pairs in key order.
+func (r *memBucketRef) ForEach(fn func(k, v []byte) error) error {
+	c := r.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Cursor returns a cursor of the bucket.
+func (r *memBucketRef) Cursor() Cursor {
+	return &memCursor{ref: r}
+}
+
+//memCursor is Cursor of memBucketRef.
+//it remembers the current key instead of the index so that
+//the bucket can be modified while iterating.
+type memCursor struct {
+	ref *memBucketRef
+	key []byte
+}
+
+//at returns k/v at index i and sets the current key.
+func (c *memCursor) at(i int) ([]byte, []byte) {
+	b := c.ref.get()
+	if i < 0 || i >= len(b.keys) {
+		c.key = nil
+		return nil, nil
+	}
+	c.key = b.keys[i]
+	return b.keys[i], b.vals[i]
+}
+
+//First moves to the first key.
+func (c *memCursor) First() ([]byte, []byte) {
+	return c.at(0)
+}
+
+//Last moves to the last key.
+func (c *memCursor) Last() ([]byte, []byte) {
+	return c.at(len(c.ref.get().keys) - 1)
+}
+
+//Next moves to the key after the current one.
+func (c *memCursor) Next() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	b := c.ref.get()
+	i := b.search(c.key)
+	if i < len(b.keys) && bytes.Equal(b.keys[i], c.key) {
+		i++
+	}
+	return c.at(i)
+}
+
+//Prev moves to the key before the current one.
+func (c *memCursor) Prev() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	return c.at(c.ref.get().search(c.key) - 1)
+}
+
+//Seek moves to the first key >= seek.
+func (c *memCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.at(c.ref.get().search(seek))
+}
+
+//Delete deletes the current key.
+func (c *memCursor) Delete() error {
+	if c.key == nil {
+		return errors.New("cursor is not on a key")
+	}
+	return c.ref.Delete(c.key)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package db
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+//buckets are names of all buckets used in Gou.
+//see the comment in db.go for layouts.
+var buckets = []string{
+	"keylibST", "keylibTS", "lookupT", "lookupA", "thread",
+	"sugtag", "usertag", "usertagTag", "recent", "record",
+}
+
+//migration upgrades the db to version.
+type migration struct {
+	version     int
+	description string
+	fn          func(Tx) error
+}
+
+//migrations are sorted by version. versions must not be changed
+//once released because they are stored in existing db files.
+var migrations = []*migration{
+	{
+		version:     1,
+		description: "create all buckets",
+		fn: func(tx Tx) error {
+			for _, b := range buckets {
+				if _, err := tx.CreateBucketIfNotExists([]byte(b)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
+
+//AddMigration registers fn which upgrades the db to version.
+//it should be called in init() of packages which owns buckets to be upgraded.
+func AddMigration(version int, description string, fn func(Tx) error) {
+	i := sort.Search(len(migrations), func(i int) bool {
+		return migrations[i].version >= version
+	})
+	if i < len(migrations) && migrations[i].version == version {
+		log.Fatal("duplicate migration version ", version)
+	}
+	migrations = append(migrations, nil)
+	copy(migrations[i+1:], migrations[i:])
+	migrations[i] = &migration{
+		version:     version,
+		description: description,
+		fn:          fn,
+	}
+}
+
+//LatestVersion returns the schema version which this Gou uses.
+func LatestVersion() int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].version
+}
+
+//Version returns the schema version stored in the meta bucket.
+//returns 0 if not stored, i.e. the db was created before versioning.
+func Version(tx Tx) int {
+	var v int
+	if _, err := Get(tx, "meta", []byte("version"), &v); err != nil {
+		return 0
+	}
+	return v
+}
+
+//setVersion stores the schema version.
+func setVersion(tx Tx, v int) error {
+	return Put(tx, "meta", []byte("version"), v)
+}
+
+//Migrate runs migrations which are newer than the stored version in order.
+//each migration runs in one transaction with updating the version,
+//so that the db is not left in the middle of a migration.
+func Migrate(s Storage) error {
+	var current int
+	err := s.View(func(tx Tx) error {
+		current = Version(tx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if current > LatestVersion() {
+		return fmt.Errorf("db version %d is newer than supported version %d", current, LatestVersion())
+	}
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		log.Println("migrating db to version", m.version, ":", m.description)
+		err := s.Update(func(tx Tx) error {
+			if err := m.fn(tx); err != nil {
+				return err
+			}
+			return setVersion(tx, m.version)
+		})
+		if err != nil {
+			return fmt.Errorf("migration to version %d failed: %s", m.version, err)
+		}
+	}
+	return nil
+}
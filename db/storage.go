@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package db
+
+import (
+	"io"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+//Storage is a key/value store which has buckets, like bolt.DB.
+type Storage interface {
+	View(fn func(Tx) error) error
+	Update(fn func(Tx) error) error
+	//WriteTo writes a consistent snapshot in bolt db format to w
+	//without blocking other transactions.
+	WriteTo(w io.Writer) (int64, error)
+	Close() error
+}
+
+//Tx is a transaction of Storage.
+type Tx interface {
+	//Bucket returns the bucket named name, or nil if not exists.
+	Bucket(name []byte) Bucket
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	DeleteBucket(name []byte) error
+	//ForEach calls fn for each names of buckets.
+	ForEach(fn func(name []byte, b Bucket) error) error
+	Writable() bool
+	//OnCommit adds fn which is called after the tx is committed successfully.
+	OnCommit(fn func())
+}
+
+//Bucket is a sorted key/value collection in Storage.
+type Bucket interface {
+	Get(key []byte) []byte
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	ForEach(fn func(k, v []byte) error) error
+	Cursor() Cursor
+}
+
+//Cursor iterates k/v pairs in a bucket in key order.
+//returned k is nil if the cursor reaches the end.
+type Cursor interface {
+	First() (key []byte, value []byte)
+	Last() (key []byte, value []byte)
+	Next() (key []byte, value []byte)
+	Prev() (key []byte, value []byte)
+	Seek(seek []byte) (key []byte, value []byte)
+	Delete() error
+}
+
+//boltStorage is Storage using bolt.DB.
+type boltStorage struct {
+	*bolt.DB
+}
+
+//NewBolt opens a bolt db file and returns Storage.
+func NewBolt(dbpath string) (Storage, error) {
+	b, err := bolt.Open(dbpath, 0644, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &boltStorage{b}, nil
+}
+
+//OpenBolt opens a bolt db file with waiting the file lock at most timeout.
+//if readOnly, the db is opened with a shared lock.
+func OpenBolt(dbpath string, readOnly bool, timeout time.Duration) (Storage, error) {
+	b, err := bolt.Open(dbpath, 0644, &bolt.Options{
+		ReadOnly: readOnly,
+		Timeout:  timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &boltStorage{b}, nil
+}
+
+//View executes fn in a read-only bolt transaction.
+func (b *boltStorage) View(fn func(Tx) error) error {
+	return b.DB.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx})
+	})
+}
+
+//Update executes fn in a read-write bolt transaction.
+func (b *boltStorage) Update(fn func(Tx) error) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx})
+	})
+}
+
+//WriteTo writes the db in a read-only bolt transaction to w.
+func (b *boltStorage) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		n, err = tx.WriteTo(w)
+		return err
+	})
+	return n, err
+}
+
+//boltTx is Tx using bolt.Tx.
+type boltTx struct {
+	*bolt.Tx
+}
+
+//Bucket returns the bucket named name, or nil if not exists.
+func (t *boltTx) Bucket(name []byte) Bucket {
+	b := t.Tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return &boltBucket{b}
+}
+
+//CreateBucketIfNotExists creates the bucket if not exists and returns it.
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.Tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{b}, nil
+}
+
+//ForEach calls fn for each names of buckets.
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.Tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, &boltBucket{b})
+	})
+}
+
+//boltBucket is Bucket using bolt.Bucket.
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+//Cursor returns a cursor of the bucket.
+func (b *boltBucket) Cursor() Cursor {
+	return b.Bucket.Cursor()
+}
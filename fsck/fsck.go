@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package fsck
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+)
+
+//Problem is an inconsistency found in the db.
+type Problem struct {
+	Bucket string
+	Key    string
+	Reason string
+	repair func(tx db.Tx) error
+}
+
+//String returns a line of the report.
+func (p *Problem) String() string {
+	return fmt.Sprintf("%s %q: %s", p.Bucket, p.Key, p.Reason)
+}
+
+//checker collects problems in a transaction.
+type checker struct {
+	tx       db.Tx
+	problems []*Problem
+}
+
+//add adds a problem which is repaired by repair.
+func (c *checker) add(bucket, key, reason string, repair func(tx db.Tx) error) {
+	c.problems = append(c.problems, &Problem{
+		Bucket: bucket,
+		Key:    key,
+		Reason: reason,
+		repair: repair,
+	})
+}
+
+//Check checks consistency of records and buckets in s, and returns problems.
+//if repair, all problems are repaired in one transaction.
+func Check(s db.Storage, repair bool) ([]*Problem, error) {
+	var problems []*Problem
+	fn := func(tx db.Tx) error {
+		c := &checker{tx: tx}
+		for _, f := range []func() error{
+			c.checkRecords,
+			c.checkThreads,
+			c.checkLookup,
+			c.checkKeylib,
+			c.checkUsertag,
+		} {
+			if err := f(); err != nil {
+				return err
+			}
+		}
+		problems = c.problems
+		if !repair {
+			return nil
+		}
+		for _, p := range problems {
+			log.Println("repairing", p)
+			if err := p.repair(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var err error
+	if repair {
+		err = s.Update(fn)
+	} else {
+		err = s.View(fn)
+	}
+	return problems, err
+}
+
+//checkRecords checks md5 and signatures of all records.
+//broken records are deleted by repairing.
+func (c *checker) checkRecords() error {
+	return record.ForEach(c.tx, func(d *record.DB) error {
+		h := *d.Head
+		err := d.Expand(c.tx)
+		var r *record.Record
+		if err == nil {
+			r, err = d.Record()
+		}
+		if err == nil {
+			err = r.Verify()
+		}
+		if err != nil {
+			c.add("record", h.Datfile+"/"+h.Idstr(), err.Error(), func(tx db.Tx) error {
+				(&record.DB{Head: &h}).Del(tx)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+//checkThreads checks that threads in thread bucket have records.
+//threads without records are unsubscribed by repairing.
+func (c *checker) checkThreads() error {
+	threads, err := db.KeyStrings(c.tx, "thread")
+	if err != nil {
+		return err
+	}
+	b := c.tx.Bucket([]byte("record"))
+	if b == nil {
+		return nil
+	}
+	for _, t := range threads {
+		t := t
+		prefix := db.ToKey(t)
+		if k, _ := b.Cursor().Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) {
+			continue
+		}
+		c.add("thread", t, "no records", func(tx db.Tx) error {
+			return db.Del(tx, "thread", []byte(t))
+		})
+	}
+	return nil
+}
+
+//checkMaps checks that bucket a has val in key when bucket b has key in val, and vice versa.
+//missing pairs are added by repairing.
+func (c *checker) checkMaps(a, b string) error {
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		from, to := pair[0], pair[1]
+		keys, err := db.KeyStrings(c.tx, from)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			vals, err := db.MapKeys(c.tx, from, []byte(k))
+			if err != nil {
+				return err
+			}
+			for _, v := range vals {
+				if db.HasVal(c.tx, to, []byte(v), k) {
+					continue
+				}
+				k, v, to := k, v, to
+				c.add(from, k, fmt.Sprintf("%q is not in %s", v, to), func(tx db.Tx) error {
+					return db.PutMap(tx, to, []byte(v), k)
+				})
+			}
+		}
+	}
+	return nil
+}
+
+//checkLookup checks lookupT and lookupA are consistent.
+func (c *checker) checkLookup() error {
+	return c.checkMaps("lookupT", "lookupA")
+}
+
+//checkUsertag checks usertag and usertagTag are consistent.
+func (c *checker) checkUsertag() error {
+	return c.checkMaps("usertag", "usertagTag")
+}
+
+//checkKeylib checks keylibST and keylibTS are inverse maps.
+//missing entries are added, and entries which conflict with the other map are deleted
+//by repairing.
+func (c *checker) checkKeylib() error {
+	st := c.tx.Bucket([]byte("keylibST"))
+	ts := c.tx.Bucket([]byte("keylibTS"))
+	if st == nil || ts == nil {
+		return nil
+	}
+	err := st.ForEach(func(k, v []byte) error {
+		s := append([]byte{}, k...)
+		t := append([]byte{}, v...)
+		if len(s) != 8 {
+			c.add("keylibST", fmt.Sprintf("%x", s), "illegal stamp", func(tx db.Tx) error {
+				return db.Del(tx, "keylibST", s)
+			})
+			return nil
+		}
+		stamp := int64(binary.BigEndian.Uint64(s))
+		switch tv := ts.Get(t); {
+		case tv == nil:
+			c.add("keylibST", fmt.Sprint(stamp), "no stamp for "+string(t), func(tx db.Tx) error {
+				return db.Put(tx, "keylibTS", t, s)
+			})
+		case !bytes.Equal(tv, s):
+			c.add("keylibST", fmt.Sprint(stamp), "stamp of "+string(t)+" is different", func(tx db.Tx) error {
+				return db.Del(tx, "keylibST", s)
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return ts.ForEach(func(k, v []byte) error {
+		t := append([]byte{}, k...)
+		s := append([]byte{}, v...)
+		switch sv := st.Get(s); {
+		case sv == nil:
+			c.add("keylibTS", string(t), "no thread for the stamp", func(tx db.Tx) error {
+				return db.Put(tx, "keylibST", s, t)
+			})
+		case !bytes.Equal(sv, t):
+			c.add("keylibTS", string(t), "thread of the stamp is different", func(tx db.Tx) error {
+				return db.Del(tx, "keylibTS", t)
+			})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package fsck
+
+import (
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+)
+
+func TestCheck(t *testing.T) {
+	db.DB = db.NewMemory()
+	if err := db.Migrate(db.DB); err != nil {
+		t.Fatal(err)
+	}
+	datfile := "thread_E99B91E8AB87"
+	signed := record.New(datfile, "", 0)
+	signed.Build(1467000000, map[string]string{"body": "signed"}, "passwd")
+	signed.Sync()
+	err := db.DB.Update(func(tx db.Tx) error {
+		broken := &record.DB{
+			Head: &record.Head{Datfile: datfile, Stamp: 1467000001, ID: "00"},
+			Body: "body:broken",
+		}
+		if err := broken.Put(tx); err != nil {
+			return err
+		}
+		for _, kv := range [][3]string{
+			{"thread", datfile, ""},
+			{"thread", "thread_00", ""},
+			{"keylibST", string(db.MustTob(int64(1467000000))), datfile},
+			{"keylibTS", "thread_00", string(db.MustTob(int64(1)))},
+		} {
+			if err := db.Put(tx, kv[0], []byte(kv[1]), kv[2]); err != nil {
+				return err
+			}
+		}
+		if err := db.PutMap(tx, "lookupT", []byte(datfile), "127.0.0.1:8000/server.cgi"); err != nil {
+			return err
+		}
+		return db.PutMap(tx, "usertagTag", []byte("foo"), datfile)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems, err := Check(db.DB, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 6 {
+		t.Fatal("illegal # of problems", problems)
+	}
+	if _, err = Check(db.DB, true); err != nil {
+		t.Fatal(err)
+	}
+	if problems, err = Check(db.DB, false); err != nil || len(problems) != 0 {
+		t.Fatal("problems are not repaired", problems, err)
+	}
+	if !signed.Exists() {
+		t.Fatal("signed record is removed")
+	}
+	err = db.DB.View(func(tx db.Tx) error {
+		if !db.HasVal(tx, "lookupA", []byte("127.0.0.1:8000/server.cgi"), datfile) ||
+			!db.HasVal(tx, "usertag", []byte(datfile), "foo") {
+			t.Error("maps are not repaired")
+		}
+		if has, _ := db.HasKey(tx, "thread", []byte("thread_00")); has {
+			t.Error("thread without records is not removed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,3 +1,4 @@
+//go:build !android
 // +build !android
 
 /*
@@ -36,16 +37,21 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/gou"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+	"github.com/shingetsu-gou/shingetsu-gou/saku"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
 func main() {
 	fmt.Println("starting Gou", cfg.Version, "...")
 	var printLog, isSilent bool
+	var backup, restore, importPath, exportPath, importNodes, exportNodes string
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "P2P anonymous BBS shinGETsu Gou %s\n", cfg.Version)
 		fmt.Fprintf(os.Stderr, "%s <options>\n", os.Args[0])
@@ -54,19 +60,81 @@ func main() {
 	flag.BoolVar(&printLog, "verbose", false, "print logs")
 	flag.BoolVar(&printLog, "v", false, "print logs")
 	flag.BoolVar(&isSilent, "silent", false, "suppress logs")
+	flag.StringVar(&backup, "backup", "", "write a snapshot of the db to the file and exit")
+	flag.StringVar(&restore, "restore", "", "validate the snapshot file, restore the db with it and exit")
+	flag.StringVar(&importPath, "import", "", "import threads in saku cache directory or archive file(.gz) and exit")
+	flag.StringVar(&exportPath, "export", "", "export threads to saku cache directory or archive file if ends with .gz, and exit")
+	flag.StringVar(&importNodes, "import-nodes", "", "add nodes in the node list file to known nodes and exit")
+	flag.StringVar(&exportNodes, "export-nodes", "", "write known nodes to the node list file and exit")
 	flag.Parse()
 	cfg.Parse()
 	gou.SetupDirectories()
 	gou.SetLogger(printLog, isSilent)
+	if backup != "" || restore != "" {
+		var err error
+		if backup != "" {
+			err = gou.Backup(backup)
+		} else {
+			err = gou.Restore(restore)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println("done")
+		return
+	}
 	log.Println("********************starting Gou", cfg.Version, "...******************")
 	gou.ExpandAssets()
 	db.Setup()
+	if importPath != "" || exportPath != "" {
+		var nthreads, nrecs int
+		var err error
+		switch {
+		case importPath != "" && util.IsFile(importPath):
+			nthreads, nrecs, err = saku.ImportArchive(importPath)
+		case importPath != "":
+			nthreads, nrecs, err = saku.Import(importPath)
+		case strings.HasSuffix(exportPath, ".gz"):
+			nthreads, nrecs, err = saku.WriteArchive(exportPath)
+		default:
+			nthreads, nrecs, err = saku.Export(exportPath)
+		}
+		if errr := db.DB.Close(); errr != nil {
+			log.Println(errr)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println("done:", nthreads, "threads", nrecs, "records")
+		return
+	}
+	if importNodes != "" || exportNodes != "" {
+		var n int
+		var err error
+		if importNodes != "" {
+			n, err = gou.ImportNodes(importNodes)
+		} else {
+			n, err = gou.ExportNodes(exportNodes)
+		}
+		if errr := db.DB.Close(); errr != nil {
+			log.Println(errr)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println("done:", n, "nodes")
+		return
+	}
 	listener, ch := gou.StartDaemon()
 	c := make(chan os.Signal)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		for range c {
 			fmt.Println("exiting...")
+			node.Close()
 			if err := listener.Close(); err != nil {
 				log.Println(err)
 			}
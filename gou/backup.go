@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package gou
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+)
+
+//Backup writes a snapshot of the db to the file out.
+//if Gou is running, the snapshot is fetched from admin.cgi/backup of the running Gou.
+func Backup(out string) error {
+	tmp := out + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if s, errr := db.OpenBolt(db.Path(), true, time.Second); errr == nil {
+		_, err = s.WriteTo(f)
+		if errr := s.Close(); errr != nil {
+			log.Println(errr)
+		}
+	} else {
+		log.Println(errr, ", fetching from running Gou")
+		err = fetchBackup(f)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if errr := f.Close(); err == nil {
+		err = errr
+	}
+	if err != nil {
+		if errr := os.Remove(tmp); errr != nil {
+			log.Println(errr)
+		}
+		return err
+	}
+	return os.Rename(tmp, out)
+}
+
+//fetchBackup gets a snapshot from admin.cgi/backup of the running Gou and writes it to w.
+func fetchBackup(w io.Writer) error {
+	url := fmt.Sprintf("http://127.0.0.1:%d%s/backup", cfg.DefaultPort, cfg.AdminURL)
+	res, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+	if res.StatusCode != http.StatusOK {
+		return errors.New(url + " returned " + res.Status)
+	}
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+//Restore validates the snapshot file in and replaces the db with it.
+//Gou must be stopped.
+func Restore(in string) error {
+	return db.Restore(in, db.Path())
+}
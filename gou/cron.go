@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
 	"github.com/shingetsu-gou/shingetsu-gou/mch/keylib"
 	"github.com/shingetsu-gou/shingetsu-gou/myself"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
@@ -56,23 +57,23 @@ func cron() {
 		for {
 			log.Println("short cycle cron started")
 			myself.ResetPort()
-			ns := node.MustNew(cfg.InitNode.GetData())
+			limit.Expire()
+			thread.FlushReads()
+			manager.FlushHealth()
+			ns := manager.Bootstrap(node.NewSlice(cfg.InitNode.GetData()))
 			if len(ns) == 0 {
-				log.Fatal("no init nodes")
-			}
-			for _, i := range ns {
-				if _, err := i.Ping(); err == nil {
-					manager.AppendToList(i)
-				}
-			}
-			nodes := ns[0].GetherNodes()
-			doSync(getall)
+				log.Println("no nodes responded, retrying in the next cycle")
+			} else {
+				nodes := ns[0].GetherNodes()
+				doSync(getall)
 
-			manager.Initialize(nodes)
-			doSync(getall)
+				manager.Initialize(nodes)
+				manager.CheckReachability()
+				doSync(getall)
+				getall = false
+			}
 			keylib.Load()
 			log.Println("short cycle cron finished")
-			getall = false
 			<-time.After(shortCycle)
 		}
 	}()
@@ -83,6 +84,7 @@ func cron() {
 			recentlist.Getall(true)
 			thread.CleanRecords()
 			thread.RemoveRemoved()
+			thread.Evict()
 			log.Println("long cycle cron finished")
 		}
 	}()
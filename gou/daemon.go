@@ -41,15 +41,16 @@ import (
 	"strconv"
 	"time"
 
-	"golang.org/x/net/netutil"
-
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi/admin"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi/api"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi/gateway"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi/mch"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi/server"
 	"github.com/shingetsu-gou/shingetsu-gou/cgi/thread"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
+	"github.com/shingetsu-gou/shingetsu-gou/relay"
 	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
@@ -61,12 +62,12 @@ func StartDaemon() (net.Listener, chan error) {
 		log.Fatal(err)
 	}
 
-	h := fmt.Sprintf("0.0.0.0:%d", cfg.DefaultPort)
+	h := fmt.Sprintf(":%d", cfg.DefaultPort) //listens both IPv4 and IPv6
 	listener, err := net.Listen("tcp", h)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	limitListener := netutil.LimitListener(listener, cfg.MaxConnection)
+	limitListener := limit.Listener(listener, cfg.MaxConnection)
 	sm := cgi.NewLoggingServeMux()
 	s := &http.Server{
 		Addr:           h,
@@ -82,6 +83,8 @@ func StartDaemon() (net.Listener, chan error) {
 	server.Setup(sm)
 	gateway.Setup(sm)
 	thread.Setup(sm)
+	api.Setup(sm)
+	relay.Setup(sm)
 
 	if cfg.Enable2ch {
 		fmt.Println("started 2ch interface...")
@@ -91,6 +94,12 @@ func StartDaemon() (net.Listener, chan error) {
 		sm.RegisterPprof()
 	}
 	sm.RegistCompressHandler("/", handleRoot())
+	if cfg.TLSPort != 0 {
+		startTLS(sm)
+	}
+	if cfg.NetworkMode == cfg.Relay {
+		relay.Start(sm)
+	}
 	fmt.Println("started daemon and http server...")
 	ch := make(chan error)
 	go func() {
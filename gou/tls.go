@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package gou
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+//startTLS starts a https server with sm at cfg.TLSPort, and lets sm tell
+//the port only while the server is serving.
+func startTLS(sm *cgi.LoggingServeMux) {
+	cert, err := loadCert(cfg.TLSCert, cfg.TLSKey)
+	if err != nil {
+		log.Println("cannot start https server", err)
+		return
+	}
+	h := fmt.Sprintf(":%d", cfg.TLSPort)
+	listener, err := net.Listen("tcp", h)
+	if err != nil {
+		log.Println("cannot start https server", err)
+		return
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	s := &http.Server{
+		Addr:           h,
+		Handler:        sm,
+		ReadTimeout:    3 * time.Minute,
+		WriteTimeout:   3 * time.Minute,
+		MaxHeaderBytes: 1 << 20,
+		TLSConfig:      config,
+	}
+	tlsListener := tls.NewListener(limit.Listener(listener, cfg.MaxConnection), config)
+	fmt.Println("started https server...")
+	sm.SetTLSPort(cfg.TLSPort)
+	go func() {
+		log.Println(s.Serve(tlsListener))
+		sm.SetTLSPort(0)
+	}()
+}
+
+//loadCert loads the certificate and the key from files.
+//if not exist, generates a self-signed certificate and saves it.
+func loadCert(certFile, keyFile string) (tls.Certificate, error) {
+	if !util.IsFile(certFile) || !util.IsFile(keyFile) {
+		log.Println("generating self-signed certificate to", certFile)
+		if err := generateCert(certFile, keyFile); err != nil {
+			return tls.Certificate{}, err
+		}
+	}
+	return tls.LoadX509KeyPair(certFile, keyFile)
+}
+
+//generateCert generates a self-signed certificate and its key and writes them in PEM.
+func generateCert(certFile, keyFile string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"shinGETsu Gou"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err := writePEM(certFile, "CERTIFICATE", der, 0644); err != nil {
+		return err
+	}
+	return writePEM(keyFile, "EC PRIVATE KEY", keyDer, 0600)
+}
+
+//writePEM writes der in PEM format to file fname.
+func writePEM(fname, typ string, der []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(fname, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer util.Fclose(f)
+	return pem.Encode(f, &pem.Block{Type: typ, Bytes: der})
+}
@@ -0,0 +1,444 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package limit
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
+)
+
+var refusedTotal = metrics.NewCounter("gou_limit_refused_total",
+	"Number of requests to server.cgi refused by reason.", "reason")
+
+//counters are numbers of refused requests shown in admin.cgi.
+var counters struct {
+	rateLimited int64
+	banned      int64
+	dropped     int64
+	pending     int64
+}
+
+//Stats represents numbers of refused requests and pending updates.
+type Stats struct {
+	RateLimited int64 //refused because of the rate limit
+	Banned      int64 //refused because of the ban
+	Dropped     int64 //updates dropped because too many updates are pending
+	Pending     int64 //updates pending now
+}
+
+//GetStats returns the current Stats.
+func GetStats() Stats {
+	return Stats{
+		RateLimited: atomic.LoadInt64(&counters.rateLimited),
+		Banned:      atomic.LoadInt64(&counters.banned),
+		Dropped:     atomic.LoadInt64(&counters.dropped),
+		Pending:     atomic.LoadInt64(&counters.pending),
+	}
+}
+
+//bucket is a token bucket for one key.
+type bucket struct {
+	tokens  float64
+	last    time.Time
+	refused int
+}
+
+//Limiter limits requests by key with token buckets.
+type Limiter struct {
+	sync.Mutex
+	rate    int //requests per minute
+	buckets map[string]*bucket
+	pruned  time.Time
+}
+
+//NewLimiter returns Limiter which allows rate requests per minute for each key.
+//rate<=0 means no limit.
+func NewLimiter(rate int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		buckets: make(map[string]*bucket),
+		pruned:  time.Now(),
+	}
+}
+
+//Allow returns true if the request with key doesn't exceed the rate,
+//and # of refused requests of key since its bucket was full.
+func (l *Limiter) Allow(key string) (bool, int) {
+	return l.AllowN(key, 1)
+}
+
+//AllowN is Allow for the request which costs n requests.
+//n is truncated to the rate so that the request can be allowed when the bucket is full.
+func (l *Limiter) AllowN(key string, n int) (bool, int) {
+	if l.rate <= 0 {
+		return true, 0
+	}
+	if n > l.rate {
+		n = l.rate
+	}
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	l.prune(now)
+	b, exist := l.buckets[key]
+	if !exist {
+		b = &bucket{
+			tokens: float64(l.rate),
+			last:   now,
+		}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Minutes() * float64(l.rate)
+	if b.tokens >= float64(l.rate) {
+		b.tokens = float64(l.rate)
+		b.refused = 0
+	}
+	b.last = now
+	if b.tokens < float64(n) {
+		b.refused++
+		return false, b.refused
+	}
+	b.tokens -= float64(n)
+	return true, b.refused
+}
+
+//reset resets # of refused requests of key.
+func (l *Limiter) reset(key string) {
+	l.Lock()
+	defer l.Unlock()
+	if b, exist := l.buckets[key]; exist {
+		b.refused = 0
+	}
+}
+
+//prune removes buckets which are full, i.e. not used for a minute, every 10 minutes.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.pruned) < 10*time.Minute {
+		return
+	}
+	l.pruned = now
+	for k, b := range l.buckets {
+		if now.Sub(b.last) > time.Minute {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+var limiters struct {
+	once  sync.Once
+	ips   *Limiter
+	nodes *Limiter
+}
+
+//initLimiters makes limiters from cfg.
+func initLimiters() {
+	limiters.once.Do(func() {
+		limiters.ips = NewLimiter(cfg.RateLimit)
+		limiters.nodes = NewLimiter(cfg.NodeRateLimit)
+	})
+}
+
+//isLoopback returns true if host is a loopback address.
+func isLoopback(host string) bool {
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+//torNode matches the node claimed in the path, e.g. "join/xxx.onion:8000+server.cgi".
+var torNode = regexp.MustCompile(`/([^/]*:[0-9]+\+[^/]*)$`)
+
+//torKey returns the key for limiting the request to path which comes through tor.
+//all requests through tor come from localhost, so the node claimed in path is used if exists.
+func torKey(path string) string {
+	if m := torNode.FindStringSubmatch(path); m != nil {
+		return "tor:" + m[1]
+	}
+	return "tor"
+}
+
+//allow checks n requests of key with l, and bans host if requests are refused more than ban_threshold.
+//loopback host is never banned.
+func allow(l *Limiter, key, host string, n int) bool {
+	if IsBanned(host) {
+		atomic.AddInt64(&counters.banned, 1)
+		refusedTotal.Inc("banned")
+		return false
+	}
+	ok, refused := l.AllowN(key, n)
+	if ok {
+		return true
+	}
+	atomic.AddInt64(&counters.rateLimited, 1)
+	refusedTotal.Inc("rate")
+	if cfg.BanThreshold > 0 && refused >= cfg.BanThreshold && !isLoopback(host) {
+		l.reset(key)
+		Ban(host)
+	}
+	return false
+}
+
+//AllowIP returns false if the request to path which costs n requests from host
+//exceeds rate_limit or host is banned.
+//requests from localhost are not limited except in tor mode,
+//in which they are limited by the node claimed in path.
+func AllowIP(host, path string, n int) bool {
+	initLimiters()
+	key := host
+	if isLoopback(host) {
+		if !cfg.Tor {
+			return true
+		}
+		key = torKey(path)
+	}
+	return allow(limiters.ips, key, host, n)
+}
+
+//AllowNode returns false if requests about the node exceed node_rate_limit
+//or the host of the node is banned.
+func AllowNode(nodestr, host string) bool {
+	initLimiters()
+	if isLoopback(host) {
+		return true
+	}
+	return allow(limiters.nodes, nodestr, host, 1)
+}
+
+//Handler returns the handler which refuses requests exceeding rate_limit or from banned hosts.
+//cost returns # of requests which the request costs, or the request costs one if cost is nil.
+func Handler(fn func(w http.ResponseWriter, r *http.Request), cost func(r *http.Request) int) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		n := 1
+		if cost != nil {
+			n = cost(r)
+		}
+		if !AllowIP(host, r.URL.Path, n) {
+			log.Println("too many requests from", host)
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+		fn(w, r)
+	}
+}
+
+//Go runs fn in a new goroutine if # of pending fn run by Go is less than
+//max_pending_updates. It returns false if fn was dropped.
+func Go(fn func()) bool {
+	n := atomic.AddInt64(&counters.pending, 1)
+	if cfg.MaxPendingUpdates > 0 && n > int64(cfg.MaxPendingUpdates) {
+		atomic.AddInt64(&counters.pending, -1)
+		atomic.AddInt64(&counters.dropped, 1)
+		refusedTotal.Inc("pending")
+		return false
+	}
+	go func() {
+		defer atomic.AddInt64(&counters.pending, -1)
+		fn()
+	}()
+	return true
+}
+
+//banMark is the prefix of the comment line put before the regexp of a temporary ban in node_deny.
+const banMark = "#temporary ban until "
+
+//bans maps banned hosts to unixtime when the ban expires.
+var bans = struct {
+	sync.RWMutex
+	m map[string]int64
+}{
+	m: make(map[string]int64),
+}
+
+//IsBanned returns true if host is banned now.
+func IsBanned(host string) bool {
+	bans.RLock()
+	defer bans.RUnlock()
+	return bans.m[host] > time.Now().Unix()
+}
+
+//Ban bans host for ban_time minutes, and writes it to node_deny
+//so that nodes of host are denied too.
+func Ban(host string) {
+	until := time.Now().Add(time.Duration(cfg.BanTime) * time.Minute).Unix()
+	bans.Lock()
+	defer bans.Unlock()
+	if bans.m[host] > time.Now().Unix() {
+		return
+	}
+	bans.m[host] = until
+	log.Println("banned", host, "until", time.Unix(until, 0))
+	if cfg.NodeDenyFile == "" {
+		return
+	}
+	f, err := os.OpenFile(cfg.NodeDenyFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+	if _, err := fmt.Fprintf(f, "%s%d\n^%s$\n", banMark, until, regexp.QuoteMeta(host)); err != nil {
+		log.Println(err)
+	}
+}
+
+//BanInfo represents a banned host.
+type BanInfo struct {
+	Host  string
+	Until int64
+}
+
+//banByHost is for sorting bans by host.
+type banByHost []*BanInfo
+
+//Len returns length of banByHost.
+func (b banByHost) Len() int {
+	return len(b)
+}
+
+//Swap swaps b[i] and b[j].
+func (b banByHost) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+//Less returns true if host of b[i] is less than b[j].
+func (b banByHost) Less(i, j int) bool {
+	return b[i].Host < b[j].Host
+}
+
+//Bans returns hosts banned now sorted by host.
+func Bans() []*BanInfo {
+	now := time.Now().Unix()
+	bans.RLock()
+	defer bans.RUnlock()
+	var r []*BanInfo
+	for h, until := range bans.m {
+		if until > now {
+			r = append(r, &BanInfo{
+				Host:  h,
+				Until: until,
+			})
+		}
+	}
+	sort.Sort(banByHost(r))
+	return r
+}
+
+//Expire removes expired bans from memory and node_deny, and loads bans which
+//are not expired from node_deny, e.g. ones before restarting.
+func Expire() {
+	now := time.Now().Unix()
+	bans.Lock()
+	defer bans.Unlock()
+	for h, until := range bans.m {
+		if until <= now {
+			delete(bans.m, h)
+		}
+	}
+	if cfg.NodeDenyFile == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(cfg.NodeDenyFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Println(err)
+		}
+		return
+	}
+	var out bytes.Buffer
+	expired := false
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := s.Text()
+		if !strings.HasPrefix(line, banMark) {
+			fmt.Fprintln(&out, line)
+			continue
+		}
+		until, err := strconv.ParseInt(strings.TrimPrefix(line, banMark), 10, 64)
+		if err != nil || !s.Scan() {
+			fmt.Fprintln(&out, line)
+			continue
+		}
+		reg := s.Text()
+		if until <= now {
+			expired = true
+			continue
+		}
+		fmt.Fprintln(&out, line)
+		fmt.Fprintln(&out, reg)
+		if host := strings.TrimSuffix(strings.TrimPrefix(reg, "^"), "$"); host != reg {
+			bans.m[unquote(host)] = until
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Println(err)
+		return
+	}
+	if !expired {
+		return
+	}
+	if err := ioutil.WriteFile(cfg.NodeDenyFile, out.Bytes(), 0644); err != nil {
+		log.Println(err)
+	}
+}
+
+//unquote reverses regexp.QuoteMeta.
+func unquote(s string) string {
+	var r []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		r = append(r, s[i])
+	}
+	return string(r)
+}
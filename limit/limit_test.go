@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package limit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+)
+
+func TestLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg.NodeDenyFile = filepath.Join(dir, "node_deny.txt")
+	if err = ioutil.WriteFile(cfg.NodeDenyFile, []byte("^192.168\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg.BanThreshold = 3
+	cfg.BanTime = 60
+
+	l := NewLimiter(5)
+	for i := 0; i < 5; i++ {
+		if ok, _ := l.Allow("a"); !ok {
+			t.Fatal("refused under the limit", i)
+		}
+	}
+	if ok, refused := l.Allow("a"); ok || refused != 1 {
+		t.Fatal("not refused over the limit", refused)
+	}
+	if ok, _ := l.Allow("b"); !ok {
+		t.Fatal("other key is refused")
+	}
+
+	if ok, _ := l.AllowN("c", 5); !ok {
+		t.Fatal("refused under the limit")
+	}
+	if ok, _ := l.AllowN("c", 1); ok {
+		t.Fatal("not refused over the limit")
+	}
+
+	for i := 0; i < 8; i++ {
+		allow(l, "b", "2001:db8::1", 1)
+	}
+	if !IsBanned("2001:db8::1") || allow(l, "d", "2001:db8::1", 1) {
+		t.Fatal("not banned")
+	}
+	for i := 0; i < 8; i++ {
+		allow(l, "tor", "127.0.0.1", 1)
+	}
+	if IsBanned("127.0.0.1") {
+		t.Fatal("loopback is banned")
+	}
+	if k := torKey("/server.cgi/join/xxx.onion:8000+server.cgi"); k != "tor:xxx.onion:8000+server.cgi" {
+		t.Fatal("illegal tor key", k)
+	}
+	if k := torKey("/server.cgi/get/thread_AA/0-"); k != "tor" {
+		t.Fatal("illegal tor key", k)
+	}
+	data, err := ioutil.ReadFile(cfg.NodeDenyFile)
+	if err != nil || !strings.Contains(string(data), "\n^2001:db8::1$\n") {
+		t.Fatal("not written to node_deny", string(data), err)
+	}
+
+	bans.Lock()
+	bans.m = make(map[string]int64)
+	bans.Unlock()
+	Expire()
+	if bs := Bans(); len(bs) != 1 || bs[0].Host != "2001:db8::1" {
+		t.Fatal("bans are not loaded", bs)
+	}
+	bans.Lock()
+	bans.m["2001:db8::1"] = time.Now().Unix() - 1
+	bans.Unlock()
+	data = []byte("^192.168\n" + banMark + "1\n^2001:db8::1$\n")
+	if err = ioutil.WriteFile(cfg.NodeDenyFile, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	Expire()
+	data, err = ioutil.ReadFile(cfg.NodeDenyFile)
+	if err != nil || string(data) != "^192.168\n" || IsBanned("2001:db8::1") {
+		t.Fatal("ban is not expired", string(data), err)
+	}
+
+	cfg.MaxPendingUpdates = 1
+	ch := make(chan struct{})
+	if !Go(func() { <-ch }) {
+		t.Fatal("refused under the limit")
+	}
+	if Go(func() {}) {
+		t.Fatal("not refused over the limit")
+	}
+	close(ch)
+}
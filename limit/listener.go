@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package limit
+
+import (
+	"net"
+	"sync"
+)
+
+//conns maps remote addresses to connections accepted by Listener.
+var conns = struct {
+	sync.Mutex
+	m map[string]*conn
+}{
+	m: make(map[string]*conn),
+}
+
+//listener accepts at most n connections at once like netutil.LimitListener,
+//but connections can be released from the limit by Release.
+type listener struct {
+	net.Listener
+	sem chan struct{}
+}
+
+//Listener returns a listener which accepts at most n simultaneous connections from l.
+func Listener(l net.Listener, n int) net.Listener {
+	return &listener{
+		Listener: l,
+		sem:      make(chan struct{}, n),
+	}
+}
+
+//Accept waits until the number of connections is less than n and accepts one.
+func (l *listener) Accept() (net.Conn, error) {
+	l.sem <- struct{}{}
+	c, err := l.Listener.Accept()
+	if err != nil {
+		<-l.sem
+		return nil, err
+	}
+	lc := &conn{
+		Conn: c,
+		sem:  l.sem,
+		addr: c.RemoteAddr().String(),
+	}
+	conns.Lock()
+	conns.m[lc.addr] = lc
+	conns.Unlock()
+	return lc, nil
+}
+
+//conn is a connection accepted by listener.
+type conn struct {
+	net.Conn
+	sem  chan struct{}
+	addr string
+	once sync.Once
+}
+
+//release releases c from the limit of the listener.
+func (c *conn) release() {
+	c.once.Do(func() {
+		<-c.sem
+		conns.Lock()
+		if conns.m[c.addr] == c {
+			delete(conns.m, c.addr)
+		}
+		conns.Unlock()
+	})
+}
+
+//Close closes the connection and releases it.
+func (c *conn) Close() error {
+	err := c.Conn.Close()
+	c.release()
+	return err
+}
+
+//Release releases the connection from addr from the limit of its listener,
+//so that long-lived connections, e.g. streams, don't block others.
+//It returns false if the connection is not found.
+func Release(addr string) bool {
+	conns.Lock()
+	c, exist := conns.m[addr]
+	conns.Unlock()
+	if !exist {
+		return false
+	}
+	c.release()
+	return true
+}
@@ -34,7 +34,6 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/mch"
 	"github.com/shingetsu-gou/shingetsu-gou/recentlist"
@@ -43,14 +42,14 @@ import (
 	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
-func getThread(tx *bolt.Tx, stamp int64) (string, error) {
+func getThread(tx db.Tx, stamp int64) (string, error) {
 	var thread string
 	k := db.MustTob(stamp)
 	_, err := db.Get(tx, "keylibST", k, &thread)
 	return thread, err
 }
 
-func getTime(tx *bolt.Tx, thread string) (int64, error) {
+func getTime(tx db.Tx, thread string) (int64, error) {
 	var stamp int64
 	k := db.MustTob(thread)
 	_, err := db.Get(tx, "keylibTS", k, &stamp)
@@ -62,7 +61,7 @@ func getTime(tx *bolt.Tx, thread string) (int64, error) {
 func Load() {
 	allCaches := thread.AllCaches()
 	allRecs := recentlist.GetRecords()
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		for _, c := range allCaches {
 			setFromCache(tx, c)
 		}
@@ -78,7 +77,7 @@ func Load() {
 }
 
 //setEntry stores stamp/value.
-func setEntry(tx *bolt.Tx, stamp int64, filekey string) {
+func setEntry(tx db.Tx, stamp int64, filekey string) {
 	sb := db.MustTob(stamp)
 	fb := db.MustTob(filekey)
 	err := db.Put(tx, "keylibST", sb, fb)
@@ -92,7 +91,7 @@ func setEntry(tx *bolt.Tx, stamp int64, filekey string) {
 }
 
 //setFromCache adds cache.datfile/timestamp pair if not exists.
-func setFromCache(tx *bolt.Tx, ca *thread.Cache) {
+func setFromCache(tx db.Tx, ca *thread.Cache) {
 	_, err := getTime(tx, ca.Datfile)
 	if err == nil {
 		return
@@ -121,7 +120,7 @@ func setFromCache(tx *bolt.Tx, ca *thread.Cache) {
 //if not found, tries to read from cache.
 func GetDatkey(filekey string) (int64, error) {
 	var v int64
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		var errr error
 		v, errr = getTime(tx, filekey)
 		if errr == nil {
@@ -138,7 +137,7 @@ func GetDatkey(filekey string) (int64, error) {
 //GetFilekey returns value from datkey(stamp).
 func GetFilekey(nDatkey int64) string {
 	var v string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var errr error
 		v, errr = getThread(tx, nDatkey)
 		return errr
@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//metric is one family of metrics in text exposition format.
+type metric interface {
+	name() string
+	write(w io.Writer) error
+}
+
+var (
+	mutex   sync.RWMutex
+	metrics = make(map[string]metric)
+)
+
+//register adds m to the metrics list.
+//panics if the same name is registered twice.
+func register(m metric) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if _, exist := metrics[m.name()]; exist {
+		panic("metrics: duplicated name " + m.name())
+	}
+	metrics[m.name()] = m
+}
+
+//vec is values of metrics keyed by label values.
+type vec struct {
+	Name   string
+	Help   string
+	Type   string
+	labels []string
+	mutex  sync.Mutex
+	values map[string][]float64
+}
+
+//newVec returns vec obj.
+func newVec(name, help, typ string, labels []string) *vec {
+	return &vec{
+		Name:   name,
+		Help:   help,
+		Type:   typ,
+		labels: labels,
+		values: make(map[string][]float64),
+	}
+}
+
+func (v *vec) name() string {
+	return v.Name
+}
+
+//key returns the label part of the metric made from label values lvs.
+func (v *vec) key(lvs []string) string {
+	if len(lvs) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s needs %d labels", v.Name, len(v.labels)))
+	}
+	if len(lvs) == 0 {
+		return ""
+	}
+	ls := make([]string, len(lvs))
+	for i, l := range lvs {
+		ls[i] = fmt.Sprintf("%s=%q", v.labels[i], l)
+	}
+	return "{" + strings.Join(ls, ",") + "}"
+}
+
+//add adds vs to the values with label values lvs.
+func (v *vec) add(vs []float64, lvs []string) {
+	k := v.key(lvs)
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	old, exist := v.values[k]
+	if !exist {
+		old = make([]float64, len(vs))
+		v.values[k] = old
+	}
+	for i := range vs {
+		old[i] += vs[i]
+	}
+}
+
+//set sets value with label values lvs.
+func (v *vec) set(val float64, lvs []string) {
+	k := v.key(lvs)
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.values[k] = []float64{val}
+}
+
+//writeHeader writes HELP and TYPE lines.
+func writeHeader(w io.Writer, name, help, typ string) error {
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+	return err
+}
+
+func (v *vec) write(w io.Writer) error {
+	if err := writeHeader(w, v.Name, v.Help, v.Type); err != nil {
+		return err
+	}
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	keys := make([]string, 0, len(v.values))
+	for k := range v.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		vs := v.values[k]
+		var err error
+		if v.Type == "summary" {
+			_, err = fmt.Fprintf(w, "%s_sum%s %g\n%s_count%s %g\n", v.Name, k, vs[0], v.Name, k, vs[1])
+		} else {
+			_, err = fmt.Fprintf(w, "%s%s %g\n", v.Name, k, vs[0])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Counter is a value which only increases.
+type Counter struct {
+	*vec
+}
+
+//NewCounter registers and returns a Counter with label names.
+func NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{newVec(name, help, "counter", labels)}
+	register(c)
+	return c
+}
+
+//Inc increments the counter with label values lvs.
+func (c *Counter) Inc(lvs ...string) {
+	c.Add(1, lvs...)
+}
+
+//Add adds v to the counter with label values lvs.
+func (c *Counter) Add(v float64, lvs ...string) {
+	c.add([]float64{v}, lvs)
+}
+
+//Gauge is a value which can go up and down.
+type Gauge struct {
+	*vec
+}
+
+//NewGauge registers and returns a Gauge with label names.
+func NewGauge(name, help string, labels ...string) *Gauge {
+	g := &Gauge{newVec(name, help, "gauge", labels)}
+	register(g)
+	return g
+}
+
+//Set sets v to the gauge with label values lvs.
+func (g *Gauge) Set(v float64, lvs ...string) {
+	g.set(v, lvs)
+}
+
+//Inc increments the gauge with label values lvs.
+func (g *Gauge) Inc(lvs ...string) {
+	g.add([]float64{1}, lvs)
+}
+
+//Dec decrements the gauge with label values lvs.
+func (g *Gauge) Dec(lvs ...string) {
+	g.add([]float64{-1}, lvs)
+}
+
+//Summary is sum and count of observed values, e.g. latencies.
+type Summary struct {
+	*vec
+}
+
+//NewSummary registers and returns a Summary with label names.
+func NewSummary(name, help string, labels ...string) *Summary {
+	s := &Summary{newVec(name, help, "summary", labels)}
+	register(s)
+	return s
+}
+
+//Observe adds v to the summary with label values lvs.
+func (s *Summary) Observe(v float64, lvs ...string) {
+	s.add([]float64{v, 1}, lvs)
+}
+
+//gaugeFunc is a gauge whose value is got by calling fn when exposed.
+type gaugeFunc struct {
+	Name string
+	Help string
+	fn   func() float64
+}
+
+//NewGaugeFunc registers a gauge whose value is fn().
+func NewGaugeFunc(name, help string, fn func() float64) {
+	register(&gaugeFunc{
+		Name: name,
+		Help: help,
+		fn:   fn,
+	})
+}
+
+func (g *gaugeFunc) name() string {
+	return g.Name
+}
+
+func (g *gaugeFunc) write(w io.Writer) error {
+	if err := writeHeader(w, g.Name, g.Help, "gauge"); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s %g\n", g.Name, g.fn())
+	return err
+}
+
+//Write writes all metrics to w in text exposition format.
+func Write(w io.Writer) error {
+	mutex.RLock()
+	ms := make([]metric, 0, len(metrics))
+	names := make([]string, 0, len(metrics))
+	for n := range metrics {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		ms = append(ms, metrics[n])
+	}
+	mutex.RUnlock()
+	for _, m := range ms {
+		if err := m.write(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Handler renders all metrics.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := Write(w); err != nil {
+		log.Println(err)
+	}
+}
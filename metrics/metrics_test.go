@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	c := NewCounter("test_total", "test counter", "node", "result")
+	c.Inc("127.0.0.1:8000/server.cgi", "ok")
+	c.Add(2, "127.0.0.1:8000/server.cgi", "ok")
+	c.Inc("127.0.0.1:8000/server.cgi", "error")
+	g := NewGauge("test_gauge", "test gauge")
+	g.Inc()
+	g.Inc()
+	g.Dec()
+	s := NewSummary("test_seconds", "test summary", "pattern")
+	s.Observe(0.5, "/server.cgi/ping")
+	s.Observe(1.5, "/server.cgi/ping")
+	NewGaugeFunc("test_func", "test gauge func", func() float64 {
+		return 42
+	})
+	var buf bytes.Buffer
+	if err := Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range []string{
+		"# TYPE test_total counter",
+		`test_total{node="127.0.0.1:8000/server.cgi",result="ok"} 3`,
+		`test_total{node="127.0.0.1:8000/server.cgi",result="error"} 1`,
+		"# TYPE test_gauge gauge",
+		"test_gauge 1",
+		`test_seconds_sum{pattern="/server.cgi/ping"} 2`,
+		`test_seconds_count{pattern="/server.cgi/ping"} 2`,
+		"test_func 42",
+	} {
+		if !strings.Contains(buf.String(), l+"\n") {
+			t.Fatal("not found", l, buf.String())
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package myself
 
 import (
+	"fmt"
 	"log"
 	"net"
 	"sync"
@@ -8,12 +9,34 @@ import (
 
 	nat "github.com/shingetsu-gou/go-nat"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/portmap"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
 var ip string
 var externalPort *int32
 var mutex sync.RWMutex
 var status int
+var relay string
+var portStatus PortStatus
+
+//renewing is true while the loop renewing the port mapping is running.
+var renewing bool
+
+//mapLifetime is the lifetime of port mappings by PCP and NAT-PMP.
+const mapLifetime = 2 * time.Hour
+
+//PortStatus is the status of the port mapping and the reachability of myself.
+type PortStatus struct {
+	Method       string //UPnP, PCP, NAT-PMP, relay or manual
+	ExternalIP   string
+	ExternalPort int
+	LeaseExpiry  int64  //0 if unknown
+	MappingError string //error of the last port mapping
+	Reachable    bool
+	CheckedBy    string //nodestr which checked the reachability
+	CheckedAt    int64  //0 if not checked
+}
 
 //init returns Myself obj.
 func init() {
@@ -50,6 +73,81 @@ func GetIPPort() (string, int32) {
 	return ip, *externalPort
 }
 
+//GetRelay returns my nodestr through the relay node, or "" if not relayed.
+func GetRelay() string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return relay
+}
+
+//SetRelay sets my nodestr through the relay node.
+func SetRelay(nodestr string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	relay = nodestr
+	portStatus.Method = ""
+	if nodestr != "" {
+		portStatus.Method = "relay"
+	}
+}
+
+//GetPortStatus returns the status of the port mapping and the reachability.
+func GetPortStatus() PortStatus {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	ps := portStatus
+	if ps.Method == "UPnP" {
+		ps.ExternalPort = int(*externalPort)
+	}
+	return ps
+}
+
+//SetReachable sets the result of the reachability check by the node by.
+//The status becomes Normal if reachable, or Port0 if not and was Normal.
+func SetReachable(by string, reachable bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	portStatus.Reachable = reachable
+	portStatus.CheckedBy = by
+	portStatus.CheckedAt = time.Now().Unix()
+	switch {
+	case reachable && (status == cfg.Disconnected || status == cfg.Port0):
+		status = cfg.Normal
+	case !reachable && status == cfg.Normal:
+		status = cfg.Port0
+	}
+}
+
+//setMappingError records the error of port mapping.
+func setMappingError(err error) {
+	log.Println(err)
+	mutex.Lock()
+	defer mutex.Unlock()
+	portStatus.MappingError = err.Error()
+}
+
+//setMapping sets the port mapping by method.
+func setMapping(method string, extIP net.IP, extPort int, lifetime time.Duration) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	p := int32(extPort)
+	externalPort = &p
+	if extIP != nil && util.IsGlobalIP(extIP) {
+		ip = extIP.String()
+	}
+	portStatus.Method = method
+	portStatus.ExternalIP = ""
+	if extIP != nil {
+		portStatus.ExternalIP = extIP.String()
+	}
+	portStatus.ExternalPort = extPort
+	portStatus.LeaseExpiry = 0
+	if lifetime > 0 {
+		portStatus.LeaseExpiry = time.Now().Add(lifetime).Unix()
+	}
+	portStatus.MappingError = ""
+}
+
 //SetIP set my IP.
 func SetIP(ips string) {
 	mutex.Lock()
@@ -59,7 +157,7 @@ func SetIP(ips string) {
 		log.Println("ip", ips, "is illegal format")
 		return
 	}
-	if nat.IsGlobalIP(nip) != "" {
+	if util.IsGlobalIP(nip) {
 		ip = ips
 	}
 }
@@ -69,21 +167,80 @@ func SetIP(ips string) {
 func useUPnP() bool {
 	nt, err := nat.NewNetStatus()
 	if err != nil {
-		log.Println(err)
+		setMappingError(fmt.Errorf("UPnP: %s", err))
 		return false
 	}
 	log.Println("openning port by upnp...")
 	ma, err := nt.LoopPortMapping("tcp", cfg.DefaultPort, "shingetsu-gou", 10*time.Minute)
 	if err != nil {
-		log.Println(err)
+		setMappingError(fmt.Errorf("UPnP: %s", err))
 		return false
 	}
 	log.Println("openned port by upnp.")
+	//the mapping is renewed by nat, so lease expiry is unknown.
+	setMapping("UPnP", nil, int(*ma.ExternalPort), 0)
+	//nat changes the port in ma when it renews the mapping, so the pointer is kept.
+	mutex.Lock()
 	externalPort = ma.ExternalPort
+	mutex.Unlock()
+	return true
+}
+
+//usePortMap maps the port by PCP or NAT-PMP, and renews it before expiry.
+func usePortMap() bool {
+	gw := net.ParseIP(cfg.Gateway)
+	if gw == nil {
+		var err error
+		if gw, err = portmap.Gateway(); err != nil {
+			setMappingError(fmt.Errorf("PCP/NAT-PMP: %s", err))
+			return false
+		}
+	}
+	log.Println("openning port by PCP/NAT-PMP with gateway", gw)
+	m, err := portmap.Map(gw, cfg.DefaultPort, mapLifetime)
+	if err != nil {
+		setMappingError(err)
+		return false
+	}
+	log.Println("openned port by", m.Method)
+	setMapping(m.Method, m.ExternalIP, m.ExternalPort, m.Lifetime)
+	mutex.Lock()
+	start := !renewing
+	renewing = true
+	mutex.Unlock()
+	if start {
+		go renew(gw, m.Lifetime)
+	}
 	return true
 }
 
-func connectionString() string {
+//renew renews the port mapping by PCP or NAT-PMP at the half of lifetime,
+//and sets status to disconnected if failed.
+//only one renew loop runs at a time.
+func renew(gw net.IP, lifetime time.Duration) {
+	defer func() {
+		mutex.Lock()
+		renewing = false
+		mutex.Unlock()
+	}()
+	for {
+		if lifetime < 2*time.Minute {
+			lifetime = 2 * time.Minute
+		}
+		time.Sleep(lifetime / 2)
+		m, err := portmap.Map(gw, cfg.DefaultPort, mapLifetime)
+		if err != nil {
+			setMappingError(err)
+			SetStatus(cfg.Disconnected)
+			return
+		}
+		setMapping(m.Method, m.ExternalIP, m.ExternalPort, m.Lifetime)
+		lifetime = m.Lifetime
+	}
+}
+
+//ConnectionString returns the status of the connection in string.
+func ConnectionString() string {
 	switch GetStatus() {
 	case cfg.UPnP:
 		return "uPnP"
@@ -93,6 +250,8 @@ func connectionString() string {
 		return "normal"
 	case cfg.Disconnected:
 		return "disconnected"
+	case cfg.Relay:
+		return "relay"
 
 	}
 	return ""
@@ -106,11 +265,12 @@ func ResetPort() {
 	switch cfg.NetworkMode {
 	case cfg.Normal:
 		resetConnection()
+		setMapping("manual", nil, cfg.DefaultPort, 0)
 	case cfg.UPnP:
-		if useUPnP() {
+		if useUPnP() || usePortMap() {
 			SetStatus(cfg.UPnP)
 		}
 	}
-	con := connectionString()
+	con := ConnectionString()
 	log.Println("openned", con)
 }
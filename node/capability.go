@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+//capabilities of Gou, which are told by /capabilities.
+const (
+	//CapGzip means the node can send gzip-compressed responses.
+	CapGzip = "gzip"
+	//CapBatch means the node accepts /batch/get and /batch/head.
+	CapBatch = "batch"
+	//CapDigest means the node accepts /digest.
+	CapDigest = "digest"
+)
+
+//Capabilities is the list of capabilities of this node.
+var Capabilities = []string{CapGzip, CapBatch, CapDigest}
+
+//CapabilitiesHeader is the first line of the response of /capabilities.
+//Nodes which don't know /capabilities return motd instead, so it is used to
+//distinguish them.
+const CapabilitiesHeader = "CAPABILITIES"
+
+//MaxBatch is the max number of threads in one batched talk.
+const MaxBatch = 32
+
+//caps maps nodestr to capabilities of the node.
+var caps = struct {
+	sync.RWMutex
+	m map[string]map[string]bool
+}{
+	m: make(map[string]map[string]bool),
+}
+
+//hasCap returns true if n is known to have the capability.
+//it doesn't ask n.
+func (n *Node) hasCap(name string) bool {
+	caps.RLock()
+	defer caps.RUnlock()
+	return caps.m[n.Nodestr][name]
+}
+
+//Capable returns true if n has the capability.
+//capabilities are asked by /capabilities at first and cached.
+//nodes which don't support /capabilities, like saku, have no capabilities.
+func (n *Node) Capable(name string) bool {
+	caps.RLock()
+	c, exist := caps.m[n.Nodestr]
+	caps.RUnlock()
+	if exist {
+		return c[name]
+	}
+	res, err := n.Talk("/capabilities", nil)
+	if err != nil {
+		return false
+	}
+	c = make(map[string]bool)
+	if len(res) > 0 && res[0] == CapabilitiesHeader {
+		for _, r := range res[1:] {
+			c[r] = true
+		}
+	}
+	caps.Lock()
+	caps.m[n.Nodestr] = c
+	caps.Unlock()
+	return c[name]
+}
+
+//Range represents records in a thread whose stamps are from Begin to End.
+//End<=0 means no upper limit.
+type Range struct {
+	Datfile string
+	Begin   int64
+	End     int64
+}
+
+//String returns the range in the form of "begin-end".
+func (r *Range) String() string {
+	if r.End <= 0 {
+		return fmt.Sprintf("%d-", r.Begin)
+	}
+	return fmt.Sprintf("%d-%d", r.Begin, r.End)
+}
+
+//Batch gets or heads records of threads in ranges at once, and returns
+//lines of responses by datfile. method must be "get" or "head".
+//if n doesn't support batch, talks classic /get or /head for each thread.
+func (n *Node) Batch(ctx context.Context, method string, ranges []*Range) (map[string][]string, error) {
+	if method != "get" && method != "head" {
+		return nil, errors.New("illegal method " + method)
+	}
+	res := make(map[string][]string)
+	if !n.Capable(CapBatch) {
+		for _, r := range ranges {
+			lines, err := n.TalkContext(ctx, "/"+method+"/"+r.Datfile+"/"+r.String(), nil)
+			if err != nil {
+				return res, err
+			}
+			res[r.Datfile] = append(res[r.Datfile], lines...)
+		}
+		return res, nil
+	}
+	for len(ranges) > 0 {
+		num := len(ranges)
+		if num > MaxBatch {
+			num = MaxBatch
+		}
+		msg := "/batch/" + method
+		for _, r := range ranges[:num] {
+			msg += "/" + r.Datfile + "/" + r.String()
+		}
+		ranges = ranges[num:]
+		_, err := n.TalkContext(ctx, msg, func(line string) error {
+			buf := strings.SplitN(line, "<>", 2)
+			if len(buf) != 2 {
+				log.Println("illegal line in batch", line)
+				return nil
+			}
+			res[buf[0]] = append(res[buf[0]], buf[1])
+			return nil
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
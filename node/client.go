@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package node
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+)
+
+//client is the http client shared by all talks to other nodes,
+//which keeps connections alive and reuses them.
+var client struct {
+	once sync.Once
+	c    *http.Client
+	t    *http.Transport
+}
+
+//base is the context of all talks, which is canceled by Close.
+var base struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func init() {
+	base.ctx, base.cancel = context.WithCancel(context.Background())
+}
+
+//limits limits the number of concurrent talks in total and per host.
+var limits = struct {
+	sync.Mutex
+	once  sync.Once
+	all   chan struct{}
+	hosts map[string]chan struct{}
+}{
+	hosts: make(map[string]chan struct{}),
+}
+
+//talkTimeout returns the timeout of a talk.
+func talkTimeout() time.Duration {
+	if cfg.TalkTimeout <= 0 {
+		return 15 * time.Second
+	}
+	return time.Duration(cfg.TalkTimeout) * time.Second
+}
+
+//maxTalks returns max number of concurrent talks.
+func maxTalks() int {
+	if cfg.MaxTalks <= 0 {
+		return 32
+	}
+	return cfg.MaxTalks
+}
+
+//maxTalksPerHost returns max number of concurrent talks to one node.
+func maxTalksPerHost() int {
+	if cfg.MaxTalksPerHost <= 0 {
+		return 2
+	}
+	return cfg.MaxTalksPerHost
+}
+
+//httpClient returns the shared http client.
+//certificates of nodes are self-signed usually, so they are not verified.
+//compression is requested only to nodes which support gzip, not by the transport.
+func httpClient() *http.Client {
+	client.once.Do(func() {
+		timeout := talkTimeout()
+		client.t = &http.Transport{
+			Dial: func(network, addr string) (net.Conn, error) {
+				return dial(network, addr, timeout)
+			},
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+			TLSHandshakeTimeout: timeout,
+			DisableCompression:  true,
+			MaxIdleConns:        maxTalks(),
+			MaxIdleConnsPerHost: maxTalksPerHost(),
+			IdleConnTimeout:     90 * time.Second,
+		}
+		client.c = &http.Client{
+			Transport: client.t,
+			Timeout:   timeout,
+		}
+	})
+	return client.c
+}
+
+//acquire waits until a talk to the host is allowed, and returns the func to release it.
+func acquire(ctx context.Context, host string) (func(), error) {
+	limits.once.Do(func() {
+		limits.all = make(chan struct{}, maxTalks())
+	})
+	limits.Lock()
+	h, exist := limits.hosts[host]
+	if !exist {
+		h = make(chan struct{}, maxTalksPerHost())
+		limits.hosts[host] = h
+	}
+	limits.Unlock()
+
+	select {
+	case h <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case limits.all <- struct{}{}:
+	case <-ctx.Done():
+		<-h
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-limits.all
+		<-h
+	}, nil
+}
+
+//withBase returns the context which is canceled when ctx or the base context is done.
+func withBase(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-base.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+//Dial connects to addr in the same way as talks, i.e. through the proxy if set.
+func Dial(addr string) (net.Conn, error) {
+	return dial("tcp", addr, talkTimeout())
+}
+
+//Close cancels all talks and closes idle connections.
+func Close() {
+	base.cancel()
+	httpClient()
+	client.t.CloseIdleConnections()
+}
@@ -0,0 +1,360 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package manager
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+)
+
+const (
+	minBackoff = time.Minute
+	maxBackoff = 24 * time.Hour
+
+	//healthExpiry is the duration after which health of nodes which failed
+	//since the last success is removed.
+	healthExpiry = 30 * 24 * time.Hour
+)
+
+//healthCache stores health of nodes in memory, which is flushed to db by FlushHealth.
+var healthCache = struct {
+	storage db.Storage
+	m       map[string]*Health
+	dirty   map[string]struct{}
+	sync.Mutex
+}{}
+
+func init() {
+	node.Observer = observe
+}
+
+//Health is statistics of talks to a node.
+type Health struct {
+	Nodestr     string
+	Latency     time.Duration //moving average of latency of successful talks
+	Failures    int           //number of consecutive failures
+	LastSuccess int64
+	LastFailure int64
+	Bytes       int64 //bytes served by the node
+	Spams       int   //number of spams delivered by the node
+}
+
+//Backoff returns the time until which the node should not be talked.
+func (h *Health) Backoff() int64 {
+	if h.Failures == 0 {
+		return 0
+	}
+	d := maxBackoff
+	if h.Failures < 32 {
+		d = minBackoff << uint(h.Failures-1)
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return h.LastFailure + int64(d/time.Second)
+}
+
+//Available returns false if the node is in backoff.
+func (h *Health) Available() bool {
+	return time.Now().Unix() >= h.Backoff()
+}
+
+//Score returns weight of the node for selecting,
+//which is higher if the node is faster, more reliable and serves more data.
+func (h *Health) Score() float64 {
+	s := 1 / (1 + h.Latency.Seconds())
+	s /= float64(1 + h.Failures)
+	s /= float64(1 + 2*h.Spams)
+	return s * (1 + math.Log10(1+float64(h.Bytes>>20)))
+}
+
+//loadHealth loads health of all nodes from db if not loaded yet or db was replaced.
+//healthCache must be locked.
+func loadHealth() {
+	if healthCache.m != nil && healthCache.storage == db.DB {
+		return
+	}
+	healthCache.storage = db.DB
+	healthCache.m = make(map[string]*Health)
+	healthCache.dirty = make(map[string]struct{})
+	err := db.DB.View(func(tx db.Tx) error {
+		b := tx.Bucket([]byte("nodeHealth"))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			h := &Health{}
+			if err := json.Unmarshal(v, h); err != nil {
+				return err
+			}
+			healthCache.m[string(k)] = h
+			return nil
+		})
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+//getHealth returns a copy of health of nodestr, or empty one if not found.
+//healthCache must be locked.
+func getHealth(nodestr string) *Health {
+	loadHealth()
+	h, exist := healthCache.m[nodestr]
+	if !exist {
+		return &Health{Nodestr: nodestr}
+	}
+	hh := *h
+	return &hh
+}
+
+//updateHealth calls fn with health of n and marks it to be flushed.
+func updateHealth(n *node.Node, fn func(h *Health)) {
+	healthCache.Lock()
+	defer healthCache.Unlock()
+	loadHealth()
+	h, exist := healthCache.m[n.Nodestr]
+	if !exist {
+		h = &Health{Nodestr: n.Nodestr}
+		healthCache.m[n.Nodestr] = h
+	}
+	fn(h)
+	healthCache.dirty[n.Nodestr] = struct{}{}
+}
+
+//addHealth adds hs which are not known yet.
+func addHealth(hs []*Health) {
+	healthCache.Lock()
+	defer healthCache.Unlock()
+	loadHealth()
+	for _, h := range hs {
+		if _, exist := healthCache.m[h.Nodestr]; exist {
+			continue
+		}
+		healthCache.m[h.Nodestr] = h
+		healthCache.dirty[h.Nodestr] = struct{}{}
+	}
+}
+
+//healthNodes returns nodestrs which have health.
+func healthNodes() []string {
+	healthCache.Lock()
+	defer healthCache.Unlock()
+	loadHealth()
+	r := make([]string, 0, len(healthCache.m))
+	for nodestr := range healthCache.m {
+		r = append(r, nodestr)
+	}
+	return r
+}
+
+//FlushHealth saves changed health to db, and removes health of nodes
+//which have failed since the last success before healthExpiry.
+func FlushHealth() {
+	if err := flushHealth(); err != nil {
+		log.Println(err)
+	}
+}
+
+//flushHealth saves changed health to db and removes expired health.
+func flushHealth() error {
+	healthCache.Lock()
+	loadHealth()
+	limit := time.Now().Add(-healthExpiry).Unix()
+	var expired []string
+	for nodestr, h := range healthCache.m {
+		if h.LastFailure > h.LastSuccess && h.LastSuccess < limit {
+			expired = append(expired, nodestr)
+			delete(healthCache.m, nodestr)
+			delete(healthCache.dirty, nodestr)
+		}
+	}
+	hs := make([]*Health, 0, len(healthCache.dirty))
+	for nodestr := range healthCache.dirty {
+		hh := *healthCache.m[nodestr]
+		hs = append(hs, &hh)
+	}
+	healthCache.dirty = make(map[string]struct{})
+	healthCache.Unlock()
+	if len(hs) == 0 && len(expired) == 0 {
+		return nil
+	}
+	return db.DB.Update(func(tx db.Tx) error {
+		for _, h := range hs {
+			if err := db.Put(tx, "nodeHealth", []byte(h.Nodestr), h); err != nil {
+				return err
+			}
+		}
+		for _, nodestr := range expired {
+			if has, _ := db.HasKey(tx, "nodeHealth", []byte(nodestr)); !has {
+				continue
+			}
+			if err := db.Del(tx, "nodeHealth", []byte(nodestr)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//observe records the result of a talk to n.
+func observe(n *node.Node, elapsed time.Duration, size int64, err error) {
+	updateHealth(n, func(h *Health) {
+		now := time.Now().Unix()
+		if err != nil {
+			h.Failures++
+			h.LastFailure = now
+			return
+		}
+		h.Failures = 0
+		h.LastSuccess = now
+		h.Bytes += size
+		if h.Latency == 0 {
+			h.Latency = elapsed
+		} else {
+			h.Latency = (h.Latency*7 + elapsed) / 8
+		}
+	})
+}
+
+//Spammed records that n delivered a spam.
+func Spammed(n *node.Node) {
+	if n == nil {
+		return
+	}
+	updateHealth(n, func(h *Health) {
+		h.Spams++
+	})
+}
+
+//GetHealth returns health of n.
+func GetHealth(n *node.Node) *Health {
+	healthCache.Lock()
+	defer healthCache.Unlock()
+	return getHealth(n.Nodestr)
+}
+
+//healths returns health of ns.
+func healths(ns node.Slice) []*Health {
+	healthCache.Lock()
+	defer healthCache.Unlock()
+	hs := make([]*Health, len(ns))
+	for i, n := range ns {
+		hs[i] = getHealth(n.Nodestr)
+	}
+	return hs
+}
+
+//AllHealth returns health of all known nodes sorted by score.
+func AllHealth() []*Health {
+	hs := healths(getAllNodes())
+	sort.Sort(sort.Reverse(healthByScore(hs)))
+	return hs
+}
+
+type healthByScore []*Health
+
+func (h healthByScore) Len() int {
+	return len(h)
+}
+
+func (h healthByScore) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h healthByScore) Less(i, j int) bool {
+	return h[i].Score() < h[j].Score()
+}
+
+//weighted is for sorting nodes by random keys weighted by score.
+type weighted struct {
+	ns   node.Slice
+	keys []float64
+}
+
+func (w *weighted) Len() int {
+	return len(w.ns)
+}
+
+func (w *weighted) Swap(i, j int) {
+	w.ns[i], w.ns[j] = w.ns[j], w.ns[i]
+	w.keys[i], w.keys[j] = w.keys[j], w.keys[i]
+}
+
+func (w *weighted) Less(i, j int) bool {
+	return w.keys[i] > w.keys[j]
+}
+
+//available returns nodes which are not in backoff.
+func available(ns node.Slice) node.Slice {
+	hs := healths(ns)
+	r := make(node.Slice, 0, len(ns))
+	for i, n := range ns {
+		if hs[i].Available() {
+			r = append(r, n)
+		}
+	}
+	return r
+}
+
+//shuffle sorts available nodes in ns randomly, in which nodes with higher score tend to come first.
+func shuffle(ns node.Slice) node.Slice {
+	ns = available(ns)
+	w := &weighted{
+		ns:   ns,
+		keys: make([]float64, len(ns)),
+	}
+	for i, h := range healths(ns) {
+		w.keys[i] = math.Pow(rand.Float64(), 1/h.Score())
+	}
+	sort.Sort(w)
+	return ns
+}
+
+//byScore sorts available nodes in ns by score.
+func byScore(ns node.Slice) node.Slice {
+	ns = available(ns)
+	w := &weighted{
+		ns:   ns,
+		keys: make([]float64, len(ns)),
+	}
+	for i, h := range healths(ns) {
+		w.keys[i] = h.Score()
+	}
+	sort.Stable(w)
+	return ns
+}
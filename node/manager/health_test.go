@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+)
+
+func TestHealth(t *testing.T) {
+	db.DB = db.NewMemory()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "PONG")
+	}))
+	defer s.Close()
+	good, err := node.New(s.Listener.Addr().String() + "/server.cgi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad, err := node.New(l.Addr().String() + "/server.cgi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	err = db.DB.Update(func(tx db.Tx) error {
+		for _, n := range []*node.Node{good, bad} {
+			if errr := db.PutMap(tx, "lookupA", []byte(n.Nodestr), ""); errr != nil {
+				return errr
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = good.Talk("/ping", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = bad.Talk("/ping", nil); err == nil {
+		t.Fatal("talked to closed port")
+	}
+	h := GetHealth(good)
+	if h.Failures != 0 || h.LastSuccess == 0 || h.Bytes != 5 || !h.Available() {
+		t.Fatal("illegal health", h)
+	}
+	h = GetHealth(bad)
+	if h.Failures != 1 || h.LastFailure == 0 || h.Available() {
+		t.Fatal("illegal health", h)
+	}
+	if b := h.Backoff() - h.LastFailure; b != 60 {
+		t.Fatal("illegal backoff", b)
+	}
+	h.Failures = 100
+	if b := h.Backoff() - h.LastFailure; b != 24*60*60 {
+		t.Fatal("illegal backoff", b)
+	}
+	if ns := Random(nil, 0); len(ns) != 1 || !ns[0].Equals(good) {
+		t.Fatal("node in backoff is selected", ns)
+	}
+	if ns := NodesForGet("", 10); len(ns) != 1 || !ns[0].Equals(good) {
+		t.Fatal("node in backoff is selected", ns)
+	}
+	Spammed(good)
+	if h = GetHealth(good); h.Spams != 1 {
+		t.Fatal("spam is not recorded", h)
+	}
+
+	updateHealth(bad, func(h *Health) {
+		h.LastSuccess = 1
+	})
+	FlushHealth()
+	err = db.DB.View(func(tx db.Tx) error {
+		if has, _ := db.HasKey(tx, "nodeHealth", []byte(good.Nodestr)); !has {
+			t.Fatal("health is not flushed")
+		}
+		if has, _ := db.HasKey(tx, "nodeHealth", []byte(bad.Nodestr)); has {
+			t.Fatal("expired health is flushed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h = GetHealth(bad); h.Failures != 0 {
+		t.Fatal("expired health remains", h)
+	}
+
+	err = db.DB.Update(func(tx db.Tx) error {
+		return db.PutMap(tx, "lookupT", []byte(list), good.Nodestr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = good.Talk("/ping", nil); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = metrics.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	m := buf.String()
+	if !strings.Contains(m, fmt.Sprintf(`gou_talk_total{node=%q,result="ok"}`, good.Nodestr)) ||
+		!strings.Contains(m, `gou_talk_total{node="other",result="error"}`) ||
+		strings.Contains(m, bad.Nodestr) {
+		t.Fatal("illegal talk metrics", m)
+	}
+}
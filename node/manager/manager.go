@@ -31,14 +31,13 @@ package manager
 import (
 	"errors"
 	"log"
-	"math/rand"
 	"strconv"
 	"strings"
 	"sync"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/myself"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
 )
@@ -51,12 +50,24 @@ const (
 //thread name for list
 var list = string([]byte{0x01})
 
+func init() {
+	metrics.NewGaugeFunc("gou_known_nodes", "Number of known nodes.", func() float64 {
+		return float64(NodeLen())
+	})
+	metrics.NewGaugeFunc("gou_linked_nodes", "Number of linked nodes.", func() float64 {
+		return float64(ListLen())
+	})
+	node.Listed = func(n *node.Node) bool {
+		return hasNodeInTable(list, n)
+	}
+}
+
 //Manager represents the map that maps datfile to it's source node list.
 
 //getFromList returns one node  in the nodelist.
 func getFromList() *node.Node {
 	var rs map[string]struct{}
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		rs, err = db.GetMap(tx, "lookupT", []byte(list))
 		return err
@@ -95,7 +106,7 @@ func ListLen() int {
 
 func listLen(datfile string) int {
 	var rs map[string]struct{}
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		rs, err = db.GetMap(tx, "lookupT", []byte(datfile))
 		return err
@@ -115,7 +126,7 @@ func GetNodestrSlice() []string {
 //getAllNodes returns all nodes in table.
 func getAllNodes() node.Slice {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.KeyStrings(tx, "lookupA")
 		return err
@@ -145,7 +156,7 @@ func GetNodestrSliceInList() []string {
 //GetNodestrSliceInTable returns Nodestr slice of nodes associated datfile thread.
 func GetNodestrSliceInTable(datfile string) []string {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.MapKeys(tx, "lookupT", []byte(datfile))
 		return err
@@ -157,7 +168,8 @@ func GetNodestrSliceInTable(datfile string) []string {
 	return r
 }
 
-//Random selects # of min(all # of nodes,n) nodes randomly except exclude nodes.
+//Random selects # of min(all # of nodes,n) nodes randomly except exclude nodes
+//and nodes in backoff. Nodes with better health are selected more likely.
 func Random(exclude node.Slice, num int) []*node.Node {
 	all := getAllNodes()
 	if exclude != nil {
@@ -170,16 +182,11 @@ func Random(exclude node.Slice, num int) []*node.Node {
 		}
 		all = cand
 	}
-	n := all.Len()
-	if num < n && num != 0 {
-		n = num
-	}
-	r := make([]*node.Node, n)
-	rs := rand.Perm(all.Len())
-	for i := 0; i < n; i++ {
-		r[i] = all[rs[i]]
+	all = shuffle(all)
+	if num < all.Len() && num != 0 {
+		all = all[:num]
 	}
-	return r
+	return all
 }
 
 func appendable(datfile string, n *node.Node) bool {
@@ -192,7 +199,7 @@ func appendable(datfile string, n *node.Node) bool {
 
 //AppendToTable add node n to table if it is allowd and list doesn't have it.
 func AppendToTable(datfile string, n *node.Node) {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		AppendToTableTX(tx, datfile, n)
 		return nil
 	})
@@ -202,7 +209,7 @@ func AppendToTable(datfile string, n *node.Node) {
 }
 
 //AppendToTableTX add node n to table if it is allowd and list doesn't have it.
-func AppendToTableTX(tx *bolt.Tx, datfile string, n *node.Node) {
+func AppendToTableTX(tx db.Tx, datfile string, n *node.Node) {
 	if !appendable(datfile, n) {
 		return
 	}
@@ -222,7 +229,7 @@ func AppendToList(n *node.Node) {
 }
 
 //appendToList add node n to nodelist if it is allowd and list doesn't have it.
-func appendToList(tx *bolt.Tx, n *node.Node) {
+func appendToList(tx db.Tx, n *node.Node) {
 	AppendToTableTX(tx, list, n)
 }
 
@@ -239,7 +246,7 @@ func ReplaceNodeInList(n *node.Node) *node.Node {
 		RemoveFromList(old)
 		old.Bye()
 	}
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		appendToList(tx, n)
 		return nil
 	})
@@ -252,7 +259,7 @@ func ReplaceNodeInList(n *node.Node) *node.Node {
 //hasNodeInTable returns true if nodelist has n.
 func hasNodeInTable(datfile string, n *node.Node) bool {
 	var r bool
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		r = db.HasVal(tx, "lookupT", []byte(datfile), n.Nodestr)
 		return nil
 	})
@@ -264,7 +271,7 @@ func hasNodeInTable(datfile string, n *node.Node) bool {
 
 //removeFromTable removes node n and return true if exists.
 //or returns false if not exists.
-func removeFromTable(tx *bolt.Tx, datfile string, n *node.Node) error {
+func removeFromTable(tx db.Tx, datfile string, n *node.Node) error {
 	if n == nil {
 		err := errors.New("n is nil")
 		log.Println(err)
@@ -283,7 +290,7 @@ func removeFromTable(tx *bolt.Tx, datfile string, n *node.Node) error {
 //RemoveFromTable removes node n and return true if exists.
 //or returns false if not exists.
 func RemoveFromTable(datfile string, n *node.Node) bool {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		return removeFromTable(tx, datfile, n)
 	})
 	if err != nil {
@@ -302,7 +309,7 @@ func RemoveFromList(n *node.Node) bool {
 //RemoveFromAllTable removes node n from all tables and return true if exists.
 //or returns false if not exists.
 func RemoveFromAllTable(n *node.Node) bool {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		threads, err := db.GetMap(tx, "lookupA", []byte(n.Nodestr))
 		if err != nil {
 			return err
@@ -328,8 +335,9 @@ func RemoveFromAllTable(n *node.Node) bool {
 
 //Initialize pings one of initNode except myself and added it if success,
 //and get another node info from each nodes in nodelist.
+//nodes are tried in order of health, and nodes in backoff are skipped.
 func Initialize(allnodes node.Slice) {
-	inodes := allnodes
+	inodes := byScore(allnodes)
 	if len(allnodes) > defaultNodes {
 		inodes = inodes[:defaultNodes]
 	}
@@ -357,7 +365,7 @@ func Initialize(allnodes node.Slice) {
 	wg.Wait()
 
 	log.Println("# of nodelist:", ListLen())
-	if port0 {
+	if port0 && myself.GetStatus() != cfg.Relay {
 		log.Println("port0")
 		myself.SetStatus(cfg.Port0)
 	} else {
@@ -417,10 +425,11 @@ func TellUpdate(datfile string, stamp int64, id string, n *node.Node) {
 }
 
 //NodesForGet returns nodes which has datfile cache , and that extends nodes to #searchDepth .
+//nodes in backoff are skipped, and healthier nodes come first.
 func NodesForGet(datfile string, searchDepth int) node.Slice {
 	var ns, ns2 node.Slice
-	ns = ns.Extend(Get(datfile, nil))
-	ns = ns.Extend(Get(list, nil))
+	ns = ns.Extend(byScore(Get(datfile, nil)))
+	ns = ns.Extend(byScore(Get(list, nil)))
 	ns = ns.Extend(Random(ns, 0))
 
 	for _, n := range ns {
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package manager
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/myself"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+)
+
+//knownNodes returns all nodes in table and nodes which have health.
+func knownNodes() node.Slice {
+	var r []string
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		r, err = db.KeyStrings(tx, "lookupA")
+		if err != nil {
+			log.Println(err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return node.NewSlice(append(r, healthNodes()...)).Uniq()
+}
+
+type byLastSuccess []*Health
+
+func (h byLastSuccess) Len() int {
+	return len(h)
+}
+
+func (h byLastSuccess) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h byLastSuccess) Less(i, j int) bool {
+	return h[i].LastSuccess > h[j].LastSuccess
+}
+
+//KnownGood returns at most num nodes which talked successfully before and are not in backoff,
+//newest first.
+func KnownGood(num int) node.Slice {
+	ns := knownNodes()
+	var hs byLastSuccess
+	for i, h := range healths(ns) {
+		if h.LastSuccess > 0 && h.Available() && !ns[i].Equals(node.Me(true)) {
+			hs = append(hs, h)
+		}
+	}
+	sort.Sort(hs)
+	if len(hs) > num {
+		hs = hs[:num]
+	}
+	var r node.Slice
+	for _, h := range hs {
+		n, err := node.New(h.Nodestr)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		r = append(r, n)
+	}
+	return r
+}
+
+//pingAll pings ns concurrently and returns nodes which ponged.
+func pingAll(ns node.Slice) node.Slice {
+	var r node.Slice
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	for _, n := range ns {
+		wg.Add(1)
+		go func(n *node.Node) {
+			defer wg.Done()
+			if _, err := n.Ping(); err != nil {
+				return
+			}
+			mutex.Lock()
+			r = append(r, n)
+			mutex.Unlock()
+		}(n)
+	}
+	wg.Wait()
+	return r
+}
+
+//Bootstrap pings nodes which talked successfully before, and init nodes only
+//if none of them respond. Nodes which respond are added to nodelist and returned.
+func Bootstrap(inits node.Slice) node.Slice {
+	const tries = 10
+	ns := pingAll(KnownGood(tries))
+	if len(ns) == 0 {
+		log.Println("no known nodes responded, trying init nodes")
+		ns = pingAll(inits)
+	}
+	for _, n := range ns {
+		AppendToList(n)
+	}
+	return ns
+}
+
+//unreachableQuorum is # of nodes which must agree that I am unreachable,
+//so that one broken or malicious node cannot demote the status.
+const unreachableQuorum = 2
+
+//CheckReachability asks nodes in nodelist to ping back me, and records the result.
+//One node is enough to know I am reachable, but unreachableQuorum nodes are needed
+//to know I am unreachable.
+//It is skipped if relayed, because nodes can't ping back through the relay node.
+func CheckReachability() {
+	if myself.GetRelay() != "" {
+		return
+	}
+	me := node.Me(true)
+	var unreachable []string
+	for _, n := range byScore(Get(list, nil)) {
+		res, err := n.Talk("/pingback/"+me.Toxstring(), nil)
+		//nodes other than Gou don't know pingback.
+		if err != nil || len(res) == 0 || (res[0] != "REACHABLE" && res[0] != "UNREACHABLE") {
+			continue
+		}
+		log.Println("reachability checked by", n.Nodestr, ":", res[0])
+		if res[0] == "REACHABLE" {
+			myself.SetReachable(n.Nodestr, true)
+			return
+		}
+		unreachable = append(unreachable, n.Nodestr)
+		if len(unreachable) >= unreachableQuorum {
+			myself.SetReachable(strings.Join(unreachable, " "), false)
+			return
+		}
+	}
+	if len(unreachable) > 0 {
+		log.Println("not enough nodes agreed that I am unreachable")
+		return
+	}
+	log.Println("no nodes checked reachability")
+}
+
+//WriteNodes writes known nodes to w in node list format, and returns # of written nodes.
+//Each line is "nodestr last_seen", where last_seen is unix time of the last
+//successful talk to the node, or 0 if never.
+func WriteNodes(w io.Writer) (int, error) {
+	ns := knownNodes()
+	hs := healths(ns)
+	sort.Sort(byLastSuccess(hs))
+	if _, err := fmt.Fprintln(w, "# node list of shinGETsu, \"nodestr last_seen\" in each line."); err != nil {
+		return 0, err
+	}
+	for _, h := range hs {
+		if _, err := fmt.Fprintln(w, h.Nodestr, h.LastSuccess); err != nil {
+			return 0, err
+		}
+	}
+	return len(hs), nil
+}
+
+//ReadNodes reads nodes in node list format from r and adds them to known nodes,
+//and returns # of read nodes.
+//Empty lines and lines starting with "#" are skipped, and last_seen can be omitted,
+//so initnode.txt can be read too.
+//last_seen is used only if the node has not talked with me.
+func ReadNodes(r io.Reader) (int, error) {
+	var hs []*Health
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		n, err := node.New(f[0])
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if !n.IsAllowed() {
+			continue
+		}
+		h := &Health{Nodestr: n.Nodestr}
+		if len(f) > 1 {
+			if h.LastSuccess, err = strconv.ParseInt(f[1], 10, 64); err != nil {
+				log.Println(err)
+			}
+		}
+		hs = append(hs, h)
+	}
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+	addHealth(hs)
+	return len(hs), flushHealth()
+}
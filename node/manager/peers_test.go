@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/myself"
+)
+
+func TestNodeList(t *testing.T) {
+	db.DB = db.NewMemory()
+	in := `# seeds
+192.0.2.1:8000/server.cgi 100
+
+192.0.2.2:8000/server.cgi 300
+192.0.2.3:8000/server.cgi
+bad node
+`
+	n, err := ReadNodes(strings.NewReader(in))
+	if err != nil || n != 3 {
+		t.Fatal("illegal # of read nodes", n, err)
+	}
+	ns := KnownGood(10)
+	if len(ns) != 2 || ns[0].Nodestr != "192.0.2.2:8000/server.cgi" || ns[1].Nodestr != "192.0.2.1:8000/server.cgi" {
+		t.Fatal("illegal known good nodes", ns)
+	}
+	if ns = KnownGood(1); len(ns) != 1 {
+		t.Fatal("illegal # of known good nodes", ns)
+	}
+
+	var out bytes.Buffer
+	if n, err = WriteNodes(&out); err != nil || n != 3 {
+		t.Fatal("illegal # of written nodes", n, err)
+	}
+	expected := []string{
+		"192.0.2.2:8000/server.cgi 300",
+		"192.0.2.1:8000/server.cgi 100",
+		"192.0.2.3:8000/server.cgi 0",
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "#") {
+		t.Fatal("illegal node list", out.String())
+	}
+	for i, l := range lines[1:] {
+		if l != expected[i] {
+			t.Fatal("illegal node list", out.String())
+		}
+	}
+
+	db.DB = db.NewMemory()
+	if n, err = ReadNodes(&out); err != nil || n != 3 {
+		t.Fatal("cannot read written node list", n, err)
+	}
+}
+
+func TestCheckReachability(t *testing.T) {
+	db.DB = db.NewMemory()
+	var path string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		fmt.Fprintln(w, "REACHABLE")
+	}))
+	defer s.Close()
+	nodestr := s.Listener.Addr().String() + "/server.cgi"
+	err := db.DB.Update(func(tx db.Tx) error {
+		return db.PutMap(tx, "lookupT", []byte(list), nodestr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	CheckReachability()
+	ps := myself.GetPortStatus()
+	if !ps.Reachable || ps.CheckedBy != nodestr || ps.CheckedAt == 0 {
+		t.Fatal("illegal reachability", ps)
+	}
+	if !strings.HasPrefix(path, "/server.cgi/pingback/") {
+		t.Fatal("illegal request", path)
+	}
+
+	db.DB = db.NewMemory()
+	for i := 0; i < unreachableQuorum; i++ {
+		u := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "UNREACHABLE")
+		}))
+		defer u.Close()
+		err = db.DB.Update(func(tx db.Tx) error {
+			return db.PutMap(tx, "lookupT", []byte(list), u.Listener.Addr().String()+"/server.cgi")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		CheckReachability()
+		ps = myself.GetPortStatus()
+		if reachable := i < unreachableQuorum-1; ps.Reachable != reachable {
+			t.Fatal("illegal reachability", i, ps)
+		}
+	}
+	if myself.GetStatus() != cfg.Port0 {
+		t.Fatal("status is not demoted", myself.GetStatus())
+	}
+}
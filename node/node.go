@@ -29,11 +29,15 @@
 package node
 
 import (
+	"compress/gzip"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -41,10 +45,26 @@ import (
 	"time"
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/myself"
 	"github.com/shingetsu-gou/shingetsu-gou/util"
+	"golang.org/x/net/proxy"
 )
 
+var talks = metrics.NewCounter("gou_talk_total",
+	"Number of requests to other nodes by node and result. Nodes not in the node list are counted as other.", "node", "result")
+
+var talkSeconds = metrics.NewSummary("gou_talk_seconds",
+	"Time spent on requests to other nodes.")
+
+//Observer is called with the result of each talk if not nil.
+//size is bytes received from the node.
+var Observer func(n *Node, elapsed time.Duration, size int64, err error)
+
+//Listed returns true if n is in the node list if not nil.
+//talks are counted by nodestr only for such nodes not to make too many kinds of labels.
+var Listed func(n *Node) bool
+
 //Node represents node info.
 type Node struct {
 	Nodestr string
@@ -75,45 +95,188 @@ func New(nodestr string) (*Node, error) {
 		errr := errors.New(fmt.Sprintln("bad format", err, nodestr))
 		return nil, errr
 	}
+	if strings.Contains(nodestr, ".onion:") && !cfg.Tor {
+		return nil, errors.New(fmt.Sprintln("onion node is not allowed without tor mode", nodestr))
+	}
+	nodestr = strings.Replace(nodestr, "+", "/", -1)
+	i := strings.Index(nodestr, "/")
+	host, port, err := net.SplitHostPort(nodestr[:i])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintln("bad format", err, nodestr))
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		//IPv6 address must be in brackets, and be normalized.
+		nodestr = net.JoinHostPort(ip.String(), port) + nodestr[i:]
+	}
 	n := &Node{
-		Nodestr: strings.Replace(nodestr, "+", "/", -1),
+		Nodestr: nodestr,
 	}
 	return n, nil
 }
 
-//urlopen retrievs html data from url
-func (n *Node) urlopen(url string, timeout time.Duration, fn func(string) error) error {
+//Host returns host part of n.
+func (n *Node) Host() string {
+	i := strings.Index(n.Nodestr, "/")
+	host, _, err := net.SplitHostPort(n.Nodestr[:i])
+	if err != nil {
+		log.Println(err)
+	}
+	return host
+}
+
+//tlsBackoff is the duration not to use https to a node after failing to connect by https.
+const tlsBackoff = time.Hour
+
+//tlsPorts maps nodestr to its port for https, which is told by TLSHeader,
+//and failed maps nodestr to the time when it failed to connect by https.
+var tlsPorts = struct {
+	sync.RWMutex
+	m      map[string]int
+	failed map[string]time.Time
+}{
+	m:      make(map[string]int),
+	failed: make(map[string]time.Time),
+}
+
+//tlsPort returns the port for https of n, or 0 if n doesn't support https.
+func (n *Node) tlsPort() int {
+	tlsPorts.RLock()
+	defer tlsPorts.RUnlock()
+	return tlsPorts.m[n.Nodestr]
+}
+
+//setTLSPort sets the port for https of n.
+//the port is ignored while backing off after failing to connect by https.
+func (n *Node) setTLSPort(port int) {
+	tlsPorts.Lock()
+	defer tlsPorts.Unlock()
+	if t, exist := tlsPorts.failed[n.Nodestr]; exist {
+		if time.Since(t) < tlsBackoff {
+			return
+		}
+		delete(tlsPorts.failed, n.Nodestr)
+	}
+	if port <= 0 {
+		delete(tlsPorts.m, n.Nodestr)
+		return
+	}
+	tlsPorts.m[n.Nodestr] = port
+}
+
+//tlsFailed stops using https to n until tlsBackoff passes.
+func (n *Node) tlsFailed() {
+	tlsPorts.Lock()
+	defer tlsPorts.Unlock()
+	delete(tlsPorts.m, n.Nodestr)
+	tlsPorts.failed[n.Nodestr] = time.Now()
+}
+
+//url returns url of the message to n, with https if port>0.
+func (n *Node) url(message string, port int) string {
+	if port <= 0 {
+		return "http://" + n.Nodestr + message
+	}
+	i := strings.Index(n.Nodestr, "/")
+	host, _, err := net.SplitHostPort(n.Nodestr[:i])
+	if err != nil {
+		log.Println(err)
+		return "http://" + n.Nodestr + message
+	}
+	return "https://" + net.JoinHostPort(host, strconv.Itoa(port)) + n.Nodestr[i:] + message
+}
+
+//request sends the message to n by https if n supports it, or by http if not
+//or failed to connect by https.
+func (n *Node) request(ctx context.Context, message string) (*http.Response, error) {
+	if port := n.tlsPort(); port > 0 {
+		resp, err := n.get(ctx, n.url(message, port))
+		if err == nil {
+			return resp, nil
+		}
+		log.Println("cannot connect by https, falling back to http", n.Nodestr, err)
+		n.tlsFailed()
+	}
+	resp, err := n.get(ctx, n.url(message, 0))
+	if err != nil {
+		return nil, err
+	}
+	if port, err := strconv.Atoi(resp.Header.Get(cfg.TLSHeader)); err == nil {
+		n.setTLSPort(port)
+	}
+	return resp, nil
+}
+
+//get requests url with GET method by the shared client.
+func (n *Node) get(ctx context.Context, url string) (*http.Response, error) {
 	ua := "shinGETsuPlus/1.0alpha (Gou/" + cfg.Version + ")"
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
 	req.Header.Set("User-Agent", ua)
-
-	transport := http.Transport{
-		Dial: func(network, addr string) (net.Conn, error) {
-			con, errr := net.DialTimeout(network, addr, timeout)
-			if errr != nil {
-				return nil, errr
-			}
-			errr = con.SetDeadline(time.Now().Add(time.Minute))
-			return con, errr
-		},
+	if n.hasCap(CapGzip) {
+		req.Header.Set("Accept-Encoding", "gzip")
 	}
+	return httpClient().Do(req.WithContext(ctx))
+}
 
-	client := http.Client{
-		Transport: &transport,
-		Timeout:   timeout,
+//dial connects to addr directly, or through the socks5 proxy if cfg.Proxy is set.
+//hostnames are resolved by the proxy, so .onion can be used with tor.
+func dial(network, addr string, timeout time.Duration) (net.Conn, error) {
+	if cfg.Proxy == "" {
+		return net.DialTimeout(network, addr, timeout)
+	}
+	p := cfg.Proxy
+	if !strings.Contains(p, "://") {
+		p = "socks5://" + p
 	}
+	u, err := url.Parse(p)
+	if err != nil {
+		return nil, err
+	}
+	var auth *proxy.Auth
+	if u.User != nil {
+		auth = &proxy.Auth{User: u.User.Username()}
+		auth.Password, _ = u.User.Password()
+	}
+	d, err := proxy.SOCKS5("tcp", u.Host, auth, &net.Dialer{Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	return d.Dial(network, addr)
+}
 
-	resp, err := client.Do(req)
+//urlopen retrievs html data of the message from n.
+func (n *Node) urlopen(ctx context.Context, message string, fn func(string) error) error {
+	ctx, cancel := withBase(ctx)
+	defer cancel()
+	release, err := acquire(ctx, n.Nodestr)
 	if err != nil {
 		log.Println(err)
 		return err
 	}
-	err = util.EachIOLine(resp.Body, func(line string, i int) error {
+	defer release()
+	resp, err := n.request(ctx, message)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer util.Fclose(resp.Body)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return errors.New("too many requests to " + n.Nodestr)
+	}
+	var body io.ReadCloser = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gz, errr := gzip.NewReader(resp.Body)
+		if errr != nil {
+			log.Println(errr)
+			return errr
+		}
+		body = gz
+	}
+	err = util.EachIOLine(body, func(line string, i int) error {
 		return fn(line)
 	})
 	return err
@@ -140,7 +303,12 @@ func (n *Node) Toxstring() string {
 
 //Talk talks with n with the message and returns data.
 func (n *Node) Talk(message string, fn func(string) error) ([]string, error) {
-	const defaultTimeout = 15 * time.Second // Seconds; Timeout for TCP
+	return n.TalkContext(context.Background(), message, fn)
+}
+
+//TalkContext talks with n with the message and returns data.
+//The talk is canceled when ctx is done.
+func (n *Node) TalkContext(ctx context.Context, message string, fn func(string) error) ([]string, error) {
 	var res []string
 	if fn == nil {
 		fn = func(line string) error {
@@ -157,12 +325,29 @@ func (n *Node) Talk(message string, fn func(string) error) ([]string, error) {
 		log.Println(err)
 		return nil, err
 	}
-	msg := "http://" + n.Nodestr + message
+	msg := n.Nodestr + message
 
 	log.Println("Talk:", msg)
-	err := n.urlopen(msg, defaultTimeout, fn)
+	var size int64
+	start := time.Now()
+	err := n.urlopen(ctx, message, func(line string) error {
+		size += int64(len(line)) + 1
+		return fn(line)
+	})
+	elapsed := time.Since(start)
+	talkSeconds.Observe(elapsed.Seconds())
+	result := "ok"
 	if err != nil {
 		log.Println(msg, err)
+		result = "error"
+	}
+	label := "other"
+	if Listed != nil && Listed(n) {
+		label = n.Nodestr
+	}
+	talks.Inc(label, result)
+	if Observer != nil && ctx.Err() == nil {
+		Observer(n, elapsed, size, err)
 	}
 	return res, err
 }
@@ -184,11 +369,16 @@ func (n *Node) Ping() (string, error) {
 }
 
 //IsAllowed returns fase if n is not allowed and denied.
+//Regexps are matched with Nodestr and the host of n without brackets,
+//so that IPv6 addresses can be written as they are.
 func (n *Node) IsAllowed() bool {
 	nodeAllow := util.NewRegexpList(cfg.NodeAllowFile)
 	nodeDeny := util.NewRegexpList(cfg.NodeDenyFile)
+	host := n.Host()
 
-	if !nodeAllow.Check(n.Nodestr) && nodeDeny.Check(n.Nodestr) {
+	allowed := nodeAllow.Check(n.Nodestr) || nodeAllow.Check(host)
+	denied := nodeDeny.Check(n.Nodestr) || nodeDeny.Check(host)
+	if !allowed && denied {
 		return false
 	}
 	return true
@@ -350,7 +540,15 @@ func (ns Slice) Extend(a Slice) Slice {
 }
 
 // Me converts myself to *Node.
+//returns the nodestr through the relay node if relayed.
 func Me(servernameIfExist bool) *Node {
+	if r := myself.GetRelay(); r != "" {
+		n, err := New(r)
+		if err == nil {
+			return n
+		}
+		log.Println(err)
+	}
 	ip, port := myself.GetIPPort()
 	var serverName string
 	if servernameIfExist {
@@ -360,7 +558,7 @@ func Me(servernameIfExist bool) *Node {
 		serverName = ip
 	}
 
-	n, err := New(fmt.Sprintf("%s:%d%s", serverName, port, cfg.ServerURL))
+	n, err := New(net.JoinHostPort(serverName, strconv.Itoa(int(port))) + cfg.ServerURL)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,376 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package node
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+)
+
+func TestTalkTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "https")
+	}))
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, tlsPort, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(cfg.TLSHeader, tlsPort)
+		fmt.Fprintln(w, "http")
+	}))
+	defer s.Close()
+	n, err := New(s.Listener.Addr().String() + "/server.cgi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"http", "https"} {
+		res, err := n.Talk("/ping", nil)
+		if err != nil || len(res) != 1 || res[0] != expected {
+			t.Fatal("illegal response", res, err, expected)
+		}
+	}
+	ts.Close()
+	for i := 0; i < 2; i++ {
+		res, err := n.Talk("/ping", nil)
+		if err != nil || len(res) != 1 || res[0] != "http" {
+			t.Fatal("not fall back to http", res, err)
+		}
+		if n.tlsPort() != 0 {
+			t.Fatal("https is used while backing off")
+		}
+	}
+}
+
+//socks5 is a minimal socks5 server which connects all requests to "to",
+//and sends requested addresses to "req".
+func socks5(t *testing.T, to string, req chan string) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				b := make([]byte, 262)
+				if _, err := io.ReadFull(c, b[:2]); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(c, b[:b[1]]); err != nil {
+					return
+				}
+				c.Write([]byte{5, 0})
+				if _, err := io.ReadFull(c, b[:5]); err != nil {
+					return
+				}
+				var host string
+				var port uint16
+				switch b[3] {
+				case 1:
+					io.ReadFull(c, b[5:10])
+					host = net.IP(b[4:8]).String()
+					port = binary.BigEndian.Uint16(b[8:10])
+				case 3:
+					io.ReadFull(c, b[5:5+b[4]+2])
+					host = string(b[5 : 5+b[4]])
+					port = binary.BigEndian.Uint16(b[5+b[4]:])
+				default:
+					return
+				}
+				req <- net.JoinHostPort(host, strconv.Itoa(int(port)))
+				d, err := net.Dial("tcp", to)
+				if err != nil {
+					return
+				}
+				defer d.Close()
+				c.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+				go io.Copy(d, c)
+				io.Copy(c, d)
+			}(c)
+		}
+	}()
+	return l
+}
+
+func TestTalkProxy(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "proxied")
+	}))
+	defer s.Close()
+	req := make(chan string, 10)
+	l := socks5(t, s.Listener.Addr().String(), req)
+	defer l.Close()
+	defer func(p string, tor bool) {
+		cfg.Proxy = p
+		cfg.Tor = tor
+	}(cfg.Proxy, cfg.Tor)
+
+	const onion = "abcdefghijklmnop.onion:8000/server.cgi"
+	cfg.Proxy = ""
+	cfg.Tor = false
+	if _, err := New(onion); err == nil {
+		t.Fatal("onion node is accepted without tor")
+	}
+	cfg.Proxy = l.Addr().String()
+	cfg.Tor = true
+	n, err := New(onion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := n.Talk("/ping", nil)
+	if err != nil || len(res) != 1 || res[0] != "proxied" {
+		t.Fatal("illegal response", res, err)
+	}
+	if r := <-req; r != "abcdefghijklmnop.onion:8000" {
+		t.Fatal("illegal request to proxy", r)
+	}
+}
+
+func TestTalkPool(t *testing.T) {
+	var mutex sync.Mutex
+	var conns, running, maxRunning int
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		fmt.Fprintln(w, "pooled")
+	}))
+	s.Config.ConnState = func(c net.Conn, st http.ConnState) {
+		if st == http.StateNew {
+			mutex.Lock()
+			conns++
+			mutex.Unlock()
+		}
+	}
+	s.Start()
+	defer s.Close()
+	n, err := New(s.Listener.Addr().String() + "/server.cgi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if res, err := n.Talk("/ping", nil); err != nil || len(res) != 1 || res[0] != "pooled" {
+				t.Error("illegal response", res, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxRunning > maxTalksPerHost() {
+		t.Fatal("too many concurrent talks", maxRunning)
+	}
+	if conns > maxTalksPerHost() {
+		t.Fatal("connections are not reused", conns)
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	n, err := New("[2001:0db8::0001]:8000/server.cgi")
+	if err != nil || n.Nodestr != "[2001:db8::1]:8000/server.cgi" || n.Host() != "2001:db8::1" {
+		t.Fatal("illegal node", n, err)
+	}
+	if n, err = MakeNode("2001:db8::1", "+server.cgi", 8000); err != nil || n.Nodestr != "[2001:db8::1]:8000/server.cgi" {
+		t.Fatal("illegal node", n, err)
+	}
+	if n.Toxstring() != "[2001:db8::1]:8000+server.cgi" {
+		t.Fatal("illegal xstring", n.Toxstring())
+	}
+	if _, err = New("2001:db8::1:8000/server.cgi"); err == nil {
+		t.Fatal("IPv6 address without brackets is accepted")
+	}
+
+	l, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skip("IPv6 is not available", err)
+	}
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "v6")
+	}))
+	s.Listener = l
+	s.Start()
+	defer s.Close()
+	if n, err = New(l.Addr().String() + "/server.cgi"); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := n.Talk("/ping", nil); err != nil || len(res) != 1 || res[0] != "v6" {
+		t.Fatal("illegal response", res, err)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var gzipped, batched, classic int
+	batch := true
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/server.cgi/")
+		var out io.Writer = w
+		if r.Header.Get("Accept-Encoding") == "gzip" {
+			gzipped++
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			defer gz.Close()
+			out = gz
+		}
+		switch {
+		case path == "capabilities" && batch:
+			fmt.Fprint(out, "CAPABILITIES\ngzip\nbatch\n")
+		case path == "capabilities":
+			fmt.Fprint(out, "motd\n")
+		case path == "batch/get/thread_a/10-/thread_b/0-20":
+			batched++
+			fmt.Fprint(out, "thread_a<>11<>a\nthread_b<>12<>b\nthread_a<>13<>c\n")
+		case strings.HasPrefix(path, "get/"):
+			classic++
+			fmt.Fprintln(out, path)
+		}
+	}))
+	defer s.Close()
+	ranges := []*Range{
+		&Range{Datfile: "thread_a", Begin: 10},
+		&Range{Datfile: "thread_b", End: 20},
+	}
+	n, err := New(s.Listener.Addr().String() + "/server.cgi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := n.Batch(context.Background(), "get", ranges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res["thread_a"]) != 2 || res["thread_a"][1] != "13<>c" || len(res["thread_b"]) != 1 {
+		t.Fatal("illegal response", res)
+	}
+	if batched != 1 || classic != 0 || gzipped != 1 {
+		t.Fatal("not batched or gzipped", batched, classic, gzipped)
+	}
+
+	batch = false
+	caps.Lock()
+	delete(caps.m, n.Nodestr)
+	caps.Unlock()
+	res, err = n.Batch(context.Background(), "get", ranges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res["thread_a"]) != 1 || res["thread_b"][0] != "get/thread_b/0-20" {
+		t.Fatal("illegal response", res)
+	}
+	if classic != 2 || gzipped != 1 || n.Capable(CapGzip) {
+		t.Fatal("illegal fallback", classic, gzipped)
+	}
+}
+
+//benchServer returns the server for benchmarks of talks and the url of /ping.
+func benchServer() (*httptest.Server, string) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "PONG")
+	}))
+	return s, s.URL + "/server.cgi/ping"
+}
+
+//BenchmarkTalkPerRequest talks with a new transport for each request.
+func BenchmarkTalkPerRequest(b *testing.B) {
+	s, u := benchServer()
+	defer s.Close()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t := &http.Transport{
+			Dial: func(network, addr string) (net.Conn, error) {
+				return dial(network, addr, talkTimeout())
+			},
+		}
+		c := &http.Client{
+			Transport: t,
+			Timeout:   talkTimeout(),
+		}
+		resp, err := c.Get(u)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err = io.Copy(ioutil.Discard, resp.Body); err != nil {
+			b.Fatal(err)
+		}
+		if err = resp.Body.Close(); err != nil {
+			b.Fatal(err)
+		}
+		t.CloseIdleConnections()
+	}
+}
+
+//BenchmarkTalkShared talks with the shared client.
+func BenchmarkTalkShared(b *testing.B) {
+	s, u := benchServer()
+	defer s.Close()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := httpClient().Get(u)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err = io.Copy(ioutil.Discard, resp.Body); err != nil {
+			b.Fatal(err)
+		}
+		if err = resp.Body.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
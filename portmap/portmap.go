@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package portmap
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"time"
+)
+
+//gatewayPort is the port of PCP and NAT-PMP server in the gateway.
+var gatewayPort = 5351
+
+//Mapping is a port mapping made by PCP or NAT-PMP.
+type Mapping struct {
+	Method       string
+	ExternalIP   net.IP
+	ExternalPort int
+	Lifetime     time.Duration
+}
+
+//Map maps internalPort of TCP to the same external port by PCP,
+//or by NAT-PMP if the gateway doesn't support PCP.
+func Map(gateway net.IP, internalPort int, lifetime time.Duration) (*Mapping, error) {
+	m, err := mapPCP(gateway, internalPort, lifetime)
+	if err == nil {
+		return m, nil
+	}
+	log.Println("PCP failed:", err, ", trying NAT-PMP")
+	m, errr := mapPMP(gateway, internalPort, lifetime)
+	if errr != nil {
+		return nil, fmt.Errorf("PCP: %s, NAT-PMP: %s", err, errr)
+	}
+	return m, nil
+}
+
+//call sends req to the gateway by conn and returns the response which meets check,
+//resending req 4 times with doubled waits from 250ms.
+func call(conn net.Conn, req []byte, check func([]byte) bool) ([]byte, error) {
+	wait := 250 * time.Millisecond
+	buf := make([]byte, 1100)
+	for i := 0; i < 4; i++ {
+		if _, err := conn.Write(req); err != nil {
+			return nil, err
+		}
+		if err := conn.SetReadDeadline(time.Now().Add(wait)); err != nil {
+			return nil, err
+		}
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if e, ok := err.(net.Error); ok && e.Timeout() {
+					break
+				}
+				return nil, err
+			}
+			if check(buf[:n]) {
+				return buf[:n], nil
+			}
+		}
+		wait *= 2
+	}
+	return nil, errors.New("no response from the gateway")
+}
+
+//dial connects to PCP/NAT-PMP server in the gateway.
+func dial(gateway net.IP) (*net.UDPConn, error) {
+	return net.DialUDP("udp", nil, &net.UDPAddr{
+		IP:   gateway,
+		Port: gatewayPort,
+	})
+}
+
+//mapPMP maps the port by NAT-PMP (RFC 6886).
+func mapPMP(gateway net.IP, internalPort int, lifetime time.Duration) (*Mapping, error) {
+	conn, err := dial(gateway)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errr := conn.Close(); errr != nil {
+			log.Println(errr)
+		}
+	}()
+	res, err := call(conn, []byte{0, 0}, func(b []byte) bool {
+		return len(b) >= 12 && b[0] == 0 && b[1] == 128
+	})
+	if err != nil {
+		return nil, err
+	}
+	if code := binary.BigEndian.Uint16(res[2:4]); code != 0 {
+		return nil, fmt.Errorf("result code %d", code)
+	}
+	m := &Mapping{
+		Method:     "NAT-PMP",
+		ExternalIP: net.IPv4(res[8], res[9], res[10], res[11]),
+	}
+
+	req := make([]byte, 12)
+	req[1] = 2 //TCP
+	binary.BigEndian.PutUint16(req[4:], uint16(internalPort))
+	binary.BigEndian.PutUint16(req[6:], uint16(internalPort))
+	binary.BigEndian.PutUint32(req[8:], uint32(lifetime/time.Second))
+	res, err = call(conn, req, func(b []byte) bool {
+		return len(b) >= 16 && b[0] == 0 && b[1] == 130 &&
+			binary.BigEndian.Uint16(b[8:10]) == uint16(internalPort)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if code := binary.BigEndian.Uint16(res[2:4]); code != 0 {
+		return nil, fmt.Errorf("result code %d", code)
+	}
+	m.ExternalPort = int(binary.BigEndian.Uint16(res[10:12]))
+	m.Lifetime = time.Duration(binary.BigEndian.Uint32(res[12:16])) * time.Second
+	return m, nil
+}
+
+//mapPCP maps the port by MAP opcode of PCP (RFC 6887).
+func mapPCP(gateway net.IP, internalPort int, lifetime time.Duration) (*Mapping, error) {
+	conn, err := dial(gateway)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errr := conn.Close(); errr != nil {
+			log.Println(errr)
+		}
+	}()
+	local := conn.LocalAddr().(*net.UDPAddr).IP
+
+	req := make([]byte, 60)
+	req[0] = 2 //version
+	req[1] = 1 //MAP
+	binary.BigEndian.PutUint32(req[4:], uint32(lifetime/time.Second))
+	copy(req[8:24], local.To16())
+	nonce := req[24:36]
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	req[36] = 6 //TCP
+	binary.BigEndian.PutUint16(req[40:], uint16(internalPort))
+	binary.BigEndian.PutUint16(req[42:], uint16(internalPort))
+	copy(req[44:60], net.IPv4zero.To16())
+
+	res, err := call(conn, req, func(b []byte) bool {
+		//NAT-PMP server responses to unsupported version in NAT-PMP format.
+		return (len(b) >= 4 && b[0] == 0) ||
+			(len(b) >= 60 && b[0] == 2 && b[1] == 0x81 && bytes.Equal(b[24:36], nonce))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res[0] != 2 {
+		return nil, errors.New("PCP is not supported")
+	}
+	if res[3] != 0 {
+		return nil, fmt.Errorf("result code %d", res[3])
+	}
+	return &Mapping{
+		Method:       "PCP",
+		ExternalIP:   net.IP(res[44:60]),
+		ExternalPort: int(binary.BigEndian.Uint16(res[42:44])),
+		Lifetime:     time.Duration(binary.BigEndian.Uint32(res[4:8])) * time.Second,
+	}, nil
+}
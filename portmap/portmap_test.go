@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package portmap
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+)
+
+//fakeGateway starts a PCP server if pcp, or a NAT-PMP server if not.
+func fakeGateway(t *testing.T, pcp bool) *net.UDPConn {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gatewayPort = conn.LocalAddr().(*net.UDPAddr).Port
+	go func() {
+		buf := make([]byte, 1100)
+		for {
+			n, addr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			req := buf[:n]
+			var res []byte
+			switch {
+			case req[0] == 2 && pcp:
+				res = make([]byte, 60)
+				copy(res, req)
+				res[1] = 0x81
+				binary.BigEndian.PutUint32(res[4:], 3600)
+				binary.BigEndian.PutUint16(res[42:], 8001)
+				copy(res[44:], net.IPv4(203, 0, 113, 1).To16())
+			case req[0] == 2:
+				res = []byte{0, req[1] | 0x80, 0, 1, 0, 0, 0, 0}
+			case req[1] == 0:
+				res = []byte{0, 128, 0, 0, 0, 0, 0, 0, 203, 0, 113, 2}
+			case req[1] == 2:
+				res = make([]byte, 16)
+				res[1] = 130
+				copy(res[8:10], req[4:6])
+				binary.BigEndian.PutUint16(res[10:], 8002)
+				binary.BigEndian.PutUint32(res[12:], 7200)
+			}
+			if _, err := conn.WriteToUDP(res, addr); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	return conn
+}
+
+func TestMap(t *testing.T) {
+	gw := net.IPv4(127, 0, 0, 1)
+	for _, c := range []struct {
+		pcp      bool
+		method   string
+		ip       string
+		port     int
+		lifetime time.Duration
+	}{
+		{true, "PCP", "203.0.113.1", 8001, time.Hour},
+		{false, "NAT-PMP", "203.0.113.2", 8002, 2 * time.Hour},
+	} {
+		conn := fakeGateway(t, c.pcp)
+		m, err := Map(gw, 8000, time.Hour)
+		if errr := conn.Close(); errr != nil {
+			t.Fatal(errr)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Method != c.method || m.ExternalIP.String() != c.ip || m.ExternalPort != c.port || m.Lifetime != c.lifetime {
+			t.Fatal("illegal mapping", m)
+		}
+	}
+}
@@ -38,7 +38,6 @@ import (
 
 	"encoding/json"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
@@ -64,7 +63,7 @@ func IsInUpdateRange(nstamp int64) bool {
 //Datfiles returns all datfile names in recentlist.
 func Datfiles() []string {
 	var datfile []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		datfile, err = db.GetPrefixs(tx, "recent")
 		return err
@@ -79,7 +78,7 @@ func Datfiles() []string {
 //if not found returns nil.
 func Newest(datfile string) (*record.Head, error) {
 	var rows []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		rows, err = db.GetStrings(tx, "recent", []byte(datfile))
 		return err
@@ -101,7 +100,7 @@ func Newest(datfile string) (*record.Head, error) {
 }
 
 //appendHead add a infos generated from the record.
-func appendHead(tx *bolt.Tx, rec *record.Head) {
+func appendHead(tx db.Tx, rec *record.Head) {
 	if find(rec) {
 		return
 	}
@@ -114,7 +113,7 @@ func appendHead(tx *bolt.Tx, rec *record.Head) {
 
 //Append add a infos generated from the record.
 func Append(rec *record.Head) {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		appendHead(tx, rec)
 		return nil
 	})
@@ -127,7 +126,7 @@ func Append(rec *record.Head) {
 func find(rec *record.Head) bool {
 	k := rec.ToKey()
 	var r int
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.Count(tx, "recent", k)
 		return err
@@ -145,7 +144,7 @@ func RemoveOlds() {
 		return
 	}
 	t := time.Now().Unix() - cfg.RecentRange
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		ba := tx.Bucket([]byte("recent"))
 		if ba == nil {
 			return errors.New("bucket is not found")
@@ -201,7 +200,7 @@ func get(begin int64, wg *sync.WaitGroup, n *node.Node) {
 		log.Println(err)
 		return
 	}
-	err = db.DB.Update(func(tx *bolt.Tx) error {
+	err = db.DB.Update(func(tx db.Tx) error {
 		for _, line := range res {
 			rec, errr := record.Make(line)
 			if errr != nil {
@@ -226,7 +225,7 @@ func get(begin int64, wg *sync.WaitGroup, n *node.Node) {
 func GetRecords() []*record.Head {
 	var inf []*record.Head
 
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		b := tx.Bucket([]byte("recent"))
 		if b == nil {
 			return errors.New("bucket is not found")
@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package record
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+const (
+	//blobBucket stores attached files. key is sha256 of the file, value is the file.
+	blobBucket = "blob"
+	//blobRefBucket stores records which refer blobs.
+	//key is sha256+"\x00"+datfile+"\x00"+stamp(8 bytes)+id+"\x00", value is empty.
+	blobRefBucket = "blobRef"
+	//thumbBucket stores thumbnails of blobs.
+	//key is sha256+"\x00"+thumbnail size+"\x00", value is the thumbnail.
+	thumbBucket = "blobThumb"
+	//blobPrefix is the prefix of attach value in the record bucket
+	//which means the attached file is in blobBucket.
+	blobPrefix = "@blob:"
+)
+
+func init() {
+	db.AddMigration(4, "move attached files to blob bucket", func(tx db.Tx) error {
+		for _, b := range []string{blobBucket, blobRefBucket, thumbBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(b)); err != nil {
+				return err
+			}
+		}
+		b := tx.Bucket([]byte("record"))
+		if b == nil {
+			return nil
+		}
+		var ds []*DB
+		err := b.ForEach(func(k, v []byte) error {
+			if !bytes.Contains(v, []byte("attach:")) {
+				return nil
+			}
+			d := DB{}
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			ds = append(ds, &d)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, d := range ds {
+			if d.Body, err = storeBlobs(tx, d.Head, d.Body); err != nil {
+				return err
+			}
+			if err = db.Put(tx, "record", d.Head.ToKey(), d); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//attachValue returns the value of attach field in body and a func which
+//replaces it with v.
+func attachValue(body string) (string, func(v string) string) {
+	kvs := strings.Split(body, "<>")
+	for i, kv := range kvs {
+		if !strings.HasPrefix(kv, "attach:") {
+			continue
+		}
+		return kv[len("attach:"):], func(v string) string {
+			kvs[i] = "attach:" + v
+			return strings.Join(kvs, "<>")
+		}
+	}
+	return "", nil
+}
+
+//blobHash returns the hash of the blob in body, or "" if the attached file is not in blobBucket.
+func blobHash(body string) string {
+	v, _ := attachValue(body)
+	if !strings.HasPrefix(v, blobPrefix) {
+		return ""
+	}
+	return v[len(blobPrefix):]
+}
+
+//storeBlobs saves the attached file in body of the record h to blobBucket
+//and returns body whose attach field is replaced by the hash.
+//the file is kept in body if it cannot be reconstructed exactly from the decoded file.
+func storeBlobs(tx db.Tx, h *Head, body string) (string, error) {
+	v, replace := attachValue(body)
+	if replace == nil || v == "" || strings.HasPrefix(v, blobPrefix) {
+		return body, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || base64.StdEncoding.EncodeToString(data) != v {
+		return body, nil
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	has, err := db.HasKey(tx, blobBucket, []byte(hash))
+	if err != nil || !has {
+		if err = db.Put(tx, blobBucket, []byte(hash), data); err != nil {
+			return "", err
+		}
+	}
+	if err := db.Put(tx, blobRefBucket, append(db.ToKey(hash), h.ToKey()...), []byte{}); err != nil {
+		return "", err
+	}
+	return replace(blobPrefix + hash), nil
+}
+
+//releaseBlobs removes the reference from the record h to the blob in body,
+//and deletes the blob and its thumbnails if no records refer it.
+func releaseBlobs(tx db.Tx, h *Head, body string) error {
+	hash := blobHash(body)
+	if hash == "" {
+		return nil
+	}
+	prefix := db.ToKey(hash)
+	if err := db.Del(tx, blobRefBucket, append(prefix, h.ToKey()...)); err != nil {
+		return err
+	}
+	if n, err := db.Count(tx, blobRefBucket, prefix); err != nil || n > 0 {
+		return err
+	}
+	if err := db.Del(tx, blobBucket, []byte(hash)); err != nil {
+		return err
+	}
+	b := tx.Bucket([]byte(thumbBucket))
+	if b == nil {
+		return nil
+	}
+	var keys [][]byte
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Expand replaces the hash of attached file in d.Body with base64 of the file,
+//so that d.Body becomes the same as one in the wire format.
+func (d *DB) Expand(tx db.Tx) error {
+	hash := blobHash(d.Body)
+	if hash == "" {
+		return nil
+	}
+	b := tx.Bucket([]byte(blobBucket))
+	if b == nil {
+		return errors.New("bucket not found " + blobBucket)
+	}
+	data := b.Get([]byte(hash))
+	if data == nil {
+		return errors.New("blob not found " + hash)
+	}
+	_, replace := attachValue(d.Body)
+	d.Body = replace(base64.StdEncoding.EncodeToString(data))
+	return nil
+}
+
+//Size returns length of d.Body in the wire format.
+func (d *DB) Size(tx db.Tx) int {
+	hash := blobHash(d.Body)
+	if hash == "" {
+		return len(d.Body)
+	}
+	var n int
+	if b := tx.Bucket([]byte(blobBucket)); b != nil {
+		n = base64.StdEncoding.EncodedLen(len(b.Get([]byte(hash))))
+	}
+	return len(d.Body) - len(blobPrefix+hash) + n
+}
+
+//AttachData returns the attached file of the record h and its suffix.
+//if thumbnailSize!="", returns a thumbnail which is made and saved at the first time.
+func AttachData(h *Head, thumbnailSize string) ([]byte, string, error) {
+	var data []byte
+	var suffix, hash string
+	var thumb bool
+	err := db.DB.View(func(tx db.Tx) error {
+		d, err := GetFromDB(tx, h)
+		if err != nil {
+			return err
+		}
+		r, err := d.Record()
+		if err != nil {
+			return err
+		}
+		suffix = r.GetBodyValue("suffix", "")
+		v := r.GetBodyValue("attach", "")
+		if v == "" {
+			return errors.New("no attached file")
+		}
+		hash = blobHash(d.Body)
+		if hash == "" {
+			data, err = base64.StdEncoding.DecodeString(v)
+			return err
+		}
+		if thumbnailSize != "" {
+			if t, err := db.Get(tx, thumbBucket, db.ToKey(hash, thumbnailSize), nil); err == nil {
+				data = append([]byte{}, t...)
+				thumb = true
+				return nil
+			}
+		}
+		b := tx.Bucket([]byte(blobBucket))
+		if b == nil {
+			return errors.New("bucket not found " + blobBucket)
+		}
+		data = append([]byte{}, b.Get([]byte(hash))...)
+		return nil
+	})
+	if err != nil || thumbnailSize == "" || thumb {
+		return data, suffix, err
+	}
+	data = util.MakeThumbnail(data, suffix, thumbnailSize)
+	if hash == "" || data == nil {
+		return data, suffix, nil
+	}
+	err = db.DB.Update(func(tx db.Tx) error {
+		return db.Put(tx, thumbBucket, db.ToKey(hash, thumbnailSize), data)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return data, suffix, nil
+}
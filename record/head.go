@@ -36,7 +36,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 )
 
@@ -55,7 +54,7 @@ func (u *Head) ToKey() []byte {
 //Exists return true if record file exists.
 func (u *Head) Exists() bool {
 	var r bool
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.HasKey(tx, "record", u.ToKey())
 		return err
@@ -70,7 +69,7 @@ func (u *Head) Exists() bool {
 //Remove moves the record file  to remove path
 func (u *Head) Remove() error {
 	var d *DB
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		var err error
 		d, err = GetFromDB(tx, u)
 		if err != nil {
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package record
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+)
+
+//indexBucket is the name of bucket for full-text search.
+//key is gram+"\x00"+datfile+"\x00"+stamp(8 bytes)+id+"\x00",
+//value is # of the gram in the record.
+const indexBucket = "recordIndex"
+
+//indexedFields are keys of record body which are searched.
+var indexedFields = []string{"name", "body"}
+
+func init() {
+	db.AddMigration(3, "build full-text index of records", func(tx db.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists([]byte(indexBucket)); err != nil {
+			return err
+		}
+		b := tx.Bucket([]byte("record"))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			d := DB{}
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			if d.Deleted {
+				return nil
+			}
+			return putIndex(tx, &d)
+		})
+	})
+}
+
+//Text returns plain text of searched fields in the record.
+func (d *DB) Text() string {
+	contents := make(map[string]string)
+	for _, kv := range strings.Split(d.Body, "<>") {
+		buf := strings.SplitN(kv, ":", 2)
+		if len(buf) == 2 {
+			contents[buf[0]] = buf[1]
+		}
+	}
+	var texts []string
+	for _, k := range indexedFields {
+		if v, exist := contents[k]; exist && v != "" {
+			v = strings.Replace(v, "<br>", "\n", -1)
+			texts = append(texts, html.UnescapeString(v))
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+//Fold folds width, case and spaces of s rune by rune,
+//so that the result has the same # of runes as s.
+func Fold(s string) string {
+	return strings.Map(func(r rune) rune {
+		if f := width.LookupRune(r).Folded(); f != 0 {
+			r = f
+		}
+		if unicode.IsSpace(r) {
+			return ' '
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
+
+//isSeparator returns true if r is not a part of words.
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+//Grams returns bigrams in folded text s with their counts.
+//the last rune of each word is also counted as an unigram,
+//so that every rune in s is the first rune of some grams.
+func Grams(s string) map[string]int {
+	m := make(map[string]int)
+	for _, w := range strings.FieldsFunc(s, isSeparator) {
+		rs := []rune(w)
+		for i := range rs {
+			if i == len(rs)-1 {
+				m[string(rs[i])]++
+			} else {
+				m[string(rs[i:i+2])]++
+			}
+		}
+	}
+	return m
+}
+
+//QueryGrams returns grams to be looked up for finding folded text s.
+//a word which has only one rune is returned as it is, and it should be
+//looked up as a prefix.
+func QueryGrams(s string) []string {
+	var grams []string
+	for _, w := range strings.FieldsFunc(s, isSeparator) {
+		rs := []rune(w)
+		if len(rs) == 1 {
+			grams = append(grams, w)
+			continue
+		}
+		for i := 0; i < len(rs)-1; i++ {
+			grams = append(grams, string(rs[i:i+2]))
+		}
+	}
+	return grams
+}
+
+//indexKey returns key of indexBucket.
+func indexKey(gram string, h *Head) []byte {
+	return append(db.ToKey(gram), h.ToKey()...)
+}
+
+//putIndex adds grams in the record to the index.
+func putIndex(tx db.Tx, d *DB) error {
+	for g, n := range Grams(Fold(d.Text())) {
+		if err := db.Put(tx, indexBucket, indexKey(g, d.Head), uint64(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//delIndex removes grams in the record from the index.
+func delIndex(tx db.Tx, d *DB) error {
+	if tx.Bucket([]byte(indexBucket)) == nil {
+		return nil
+	}
+	for g := range Grams(Fold(d.Text())) {
+		if err := db.Del(tx, indexBucket, indexKey(g, d.Head)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//headFromIndexKey parses a key of indexBucket except gram.
+func headFromIndexKey(k []byte) (*Head, error) {
+	i := bytes.IndexByte(k, 0)
+	if i < 0 {
+		return nil, errors.New("illegal index key")
+	}
+	k = k[i+1:]
+	i = bytes.IndexByte(k, 0)
+	if i < 0 || len(k) < i+1+8+1 {
+		return nil, errors.New("illegal index key")
+	}
+	return &Head{
+		Datfile: string(k[:i]),
+		Stamp:   int64(binary.BigEndian.Uint64(k[i+1 : i+9])),
+		ID:      string(bytes.TrimRight(k[i+9:], "\x00")),
+	}, nil
+}
+
+//Postings returns heads of records which have gram g with # of g in each records.
+//if g has only one rune, grams which start with g are looked up.
+//at most limit heads are returned if limit>0, and false is returned if truncated.
+func Postings(tx db.Tx, g string, limit int) (map[Head]int, bool, error) {
+	b := tx.Bucket([]byte(indexBucket))
+	if b == nil {
+		return nil, false, errors.New("bucket not found " + indexBucket)
+	}
+	prefix := db.ToKey(g)
+	if len([]rune(g)) == 1 {
+		prefix = []byte(g)
+	}
+	m := make(map[Head]int)
+	c := b.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		h, err := headFromIndexKey(k)
+		if err != nil {
+			return nil, false, err
+		}
+		if _, exist := m[*h]; !exist && limit > 0 && len(m) >= limit {
+			return m, false, nil
+		}
+		if len(v) == 8 {
+			m[*h] += int(binary.BigEndian.Uint64(v))
+		}
+	}
+	return m, true, nil
+}
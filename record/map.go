@@ -32,7 +32,6 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 )
 
@@ -51,7 +50,7 @@ const (
 //FromRecordDB makes record map from record db.
 func FromRecordDB(datfile string, kind int) (Map, error) {
 	var r []*DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = GetFromDBs(tx, datfile)
 		return err
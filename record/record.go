@@ -39,15 +39,40 @@ import (
 
 	"encoding/json"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
+	"github.com/shingetsu-gou/shingetsu-gou/stream"
 	"github.com/shingetsu-gou/shingetsu-gou/util"
 )
 
 var cachedRule *util.RegexpList
 
+var (
+	storedRecords  = metrics.NewCounter("gou_records_stored_total", "Number of records stored.")
+	removedRecords = metrics.NewCounter("gou_records_removed_total", "Number of records removed from the db.")
+	spams          = metrics.NewCounter("gou_spam_rejections_total", "Number of records rejected as spam.")
+)
+
+func init() {
+	db.AddValidator("md5 and signatures of records", func(tx db.Tx) error {
+		return ForEach(tx, func(d *DB) error {
+			if err := d.Expand(tx); err != nil {
+				return err
+			}
+			r, err := d.Record()
+			if err != nil {
+				return err
+			}
+			if err := r.Verify(); err != nil {
+				return fmt.Errorf("%s %s: %s", d.Datfile, d.Idstr(), err)
+			}
+			return nil
+		})
+	})
+}
+
 //DB represents one record in db.
 type DB struct {
 	*Head
@@ -56,26 +81,105 @@ type DB struct {
 }
 
 //Del deletes data from db.
-func (d *DB) Del(tx *bolt.Tx) {
+func (d *DB) Del(tx db.Tx) {
+	if stored, err := GetFromDB(tx, d.Head); err == nil {
+		if err := delIndex(tx, stored); err != nil {
+			log.Println(err)
+		}
+		if err := updateStat(tx, stored, -stored.Size(tx)); err != nil {
+			log.Println(err)
+		}
+		if err := updateCount(tx, -1); err != nil {
+			log.Println(err)
+		}
+		if err := releaseBlobs(tx, d.Head, stored.Body); err != nil {
+			log.Println(err)
+		}
+		tx.OnCommit(func() {
+			removedRecords.Inc()
+		})
+	}
 	if err := db.Del(tx, "record", d.Head.ToKey()); err != nil {
 		log.Println(err)
 	}
+	if err := delStamp(tx, d.Head); err != nil {
+		log.Println(err)
+	}
 }
 
 //Put puts this one to db.
-func (d *DB) Put(tx *bolt.Tx) error {
-	return db.Put(tx, "record", d.Head.ToKey(), d)
+//the attached file is saved to blob bucket.
+//new record is published to stream and counted in metrics after committed.
+func (d *DB) Put(tx db.Tx) error {
+	//bucket may not exist yet.
+	has, _ := db.HasKey(tx, "record", d.Head.ToKey())
+	if !has && !d.Deleted {
+		e := &stream.Event{
+			Datfile: d.Datfile,
+			ID:      d.ID,
+			Stamp:   d.Stamp,
+		}
+		tx.OnCommit(func() {
+			stream.Publish(e)
+		})
+	}
+	var size int
+	if has {
+		if old, err := GetFromDB(tx, d.Head); err == nil {
+			size = -old.Size(tx)
+		}
+	}
+	stored := *d
+	var err error
+	if stored.Body, err = storeBlobs(tx, d.Head, d.Body); err != nil {
+		return err
+	}
+	if err = db.Put(tx, "record", d.Head.ToKey(), &stored); err != nil {
+		return err
+	}
+	if d.Deleted {
+		if err := delIndex(tx, d); err != nil {
+			return err
+		}
+	} else {
+		if err := putIndex(tx, d); err != nil {
+			return err
+		}
+	}
+	if err := putStamp(tx, d.Head); err != nil {
+		return err
+	}
+	if err := updateStat(tx, &stored, size+stored.Size(tx)); err != nil {
+		return err
+	}
+	if !has {
+		if err := updateCount(tx, 1); err != nil {
+			return err
+		}
+		tx.OnCommit(func() {
+			storedRecords.Inc()
+		})
+	}
+	return nil
+}
+
+//Record returns parsed Record of d.
+//d must be expanded by Expand if the wire format is needed.
+func (d *DB) Record() (*Record, error) {
+	r := &Record{Head: d.Head}
+	err := r.Parse(fmt.Sprintf("%d<>%s<>%s", d.Stamp, d.ID, d.Body))
+	return r, err
 }
 
 //GetFromDB gets DB db.
-func GetFromDB(tx *bolt.Tx, h *Head) (*DB, error) {
+func GetFromDB(tx db.Tx, h *Head) (*DB, error) {
 	d := DB{}
 	_, err := db.Get(tx, "record", h.ToKey(), &d)
 	return &d, err
 }
 
 //GetFromDBs gets DBs whose thread name is datfile.
-func GetFromDBs(tx *bolt.Tx, datfile string) ([]*DB, error) {
+func GetFromDBs(tx db.Tx, datfile string) ([]*DB, error) {
 	var cnt []*DB
 	bdatfile := make([]byte, len(datfile)+1)
 	copy(bdatfile, datfile)
@@ -97,7 +201,7 @@ func GetFromDBs(tx *bolt.Tx, datfile string) ([]*DB, error) {
 }
 
 //ForEach do eachDo for each k/v to "record" db.
-func ForEach(tx *bolt.Tx, eachDo func(*DB) error) error {
+func ForEach(tx db.Tx, eachDo func(*DB) error) error {
 	b := tx.Bucket([]byte("record"))
 	if b == nil {
 		return errors.New("bucket not found record")
@@ -286,10 +390,13 @@ func (r *Record) Load() error {
 		return errors.New("file not found")
 	}
 	var d *DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		d, err = GetFromDB(tx, r.Head)
-		return err
+		if err != nil {
+			return err
+		}
+		return d.Expand(tx)
 	})
 	if err != nil {
 		log.Println(err)
@@ -343,6 +450,36 @@ func (r *Record) md5check() bool {
 	return util.MD5digest(r.bodystr()) == r.ID
 }
 
+//checkSign returns true if r is not signed or the signature is correct.
+func (r *Record) checkSign() bool {
+	sign := r.GetBodyValue("sign", "")
+	pubkey := r.GetBodyValue("pubkey", "")
+	if sign == "" && pubkey == "" {
+		return true
+	}
+	target := r.GetBodyValue("target", "")
+	var rs []string
+	for _, k := range strings.Split(target, ",") {
+		v, exist := r.contents[k]
+		if !exist {
+			return false
+		}
+		rs = append(rs, k+":"+v)
+	}
+	return util.Verify(util.MD5digest(strings.Join(rs, "<>")), sign, pubkey)
+}
+
+//Verify returns error if md5 of body doesn't match ID or the signature is wrong.
+func (r *Record) Verify() error {
+	if !r.md5check() {
+		return errors.New("md5 unmatch")
+	}
+	if !r.checkSign() {
+		return errors.New("wrong signature")
+	}
+	return nil
+}
+
 //AttachPath returns attach path
 //by creating path from args.
 func (r *Record) AttachPath(thumbnailSize string) string {
@@ -351,7 +488,10 @@ func (r *Record) AttachPath(thumbnailSize string) string {
 		return ""
 	}
 	reg := regexp.MustCompile(`[^-_.A-Za-z0-9]`)
-	reg.ReplaceAllString(suffix, "")
+	suffix = reg.ReplaceAllString(suffix, "")
+	if suffix == "" {
+		return ""
+	}
 	if thumbnailSize != "" {
 		return "s" + r.Idstr() + "." + thumbnailSize + "." + suffix
 	}
@@ -360,7 +500,7 @@ func (r *Record) AttachPath(thumbnailSize string) string {
 
 //SyncTX saves Recstr to the file. if attached file exists, saves it to attached path.
 //if signed, also saves body part.
-func (r *Record) SyncTX(tx *bolt.Tx, deleted bool) error {
+func (r *Record) SyncTX(tx db.Tx, deleted bool) error {
 	has, err := db.HasKey(tx, "record", r.Head.ToKey())
 	if err != nil {
 		log.Println(err)
@@ -373,13 +513,17 @@ func (r *Record) SyncTX(tx *bolt.Tx, deleted bool) error {
 		Body:    r.bodystr(),
 		Deleted: deleted,
 	}
+	//attach value which looks like a reference to blobBucket must not be stored as is.
+	if v, _ := attachValue(d.Body); strings.HasPrefix(v, blobPrefix) {
+		return errors.New(r.Idstr() + ":illegal attached file")
+	}
 	return d.Put(tx)
 }
 
 //Sync saves Recstr to the file. if attached file exists, saves it to attached path.
 //if signed, also saves body part.
 func (r *Record) Sync() {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		return r.SyncTX(tx, false)
 	})
 	if err != nil {
@@ -416,7 +560,11 @@ func (r *Record) IsSpam() bool {
 	if cachedRule == nil {
 		cachedRule = util.NewRegexpList(cfg.SpamList)
 	}
-	return cachedRule.Check(r.Recstr())
+	if cachedRule.Check(r.Recstr()) {
+		spams.Inc()
+		return true
+	}
+	return false
 }
 
 //MakeAttachLink makes and returns attached file link.
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package record
+
+import (
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/stream"
+)
+
+func TestSyncLoad(t *testing.T) {
+	db.DB = db.NewMemory()
+	datfile := "thread_E99BA8"
+	r := New(datfile, "", 0)
+	id := r.Build(1467000000, map[string]string{"name": "gou", "body": "hello"}, "")
+	r.Sync()
+	if !r.Exists() {
+		t.Fatal("record is not synced")
+	}
+	m, err := FromRecordDB(datfile, Alive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 1 {
+		t.Fatal("illegal # of records", len(m))
+	}
+	rr := m.Get(r.Idstr(), nil)
+	if rr == nil || rr.ID != id {
+		t.Fatal("record not found")
+	}
+	if err := rr.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if rr.GetBodyValue("body", "") != "hello" || !rr.md5check() {
+		t.Fatal("illegal body", rr.Recstr())
+	}
+	if hs := HeadsInRange(1467000000, 1467000000); len(hs) != 1 || hs[0].Idstr() != r.Idstr() {
+		t.Fatal("illegal time index", hs)
+	}
+	if hs := HeadsInRange(0, 1466999999); len(hs) != 0 {
+		t.Fatal("illegal time index", hs)
+	}
+	if err := rr.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = FromRecordDB(datfile, Removed); err != nil || len(m) != 1 {
+		t.Fatal("record is not removed", err)
+	}
+	err = db.DB.Update(func(tx db.Tx) error {
+		d, errr := GetFromDB(tx, r.Head)
+		if errr != nil {
+			return errr
+		}
+		d.Del(tx)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hs := HeadsInRange(0, 1467000000); len(hs) != 0 {
+		t.Fatal("time index is not deleted", hs)
+	}
+}
+
+func TestBlob(t *testing.T) {
+	db.DB = db.NewMemory()
+	datfile := "thread_E99BA8"
+	attach := "aGVsbG8gd29ybGQ="
+	var rs []*Record
+	for i, name := range []string{"gou", "saku"} {
+		r := New(datfile, "", 0)
+		r.Build(int64(1467000000+i), map[string]string{"name": name, "attach": attach, "suffix": "txt"}, "")
+		r.Sync()
+		rs = append(rs, r)
+	}
+	err := db.DB.View(func(tx db.Tx) error {
+		if n, errr := db.Count(tx, blobBucket, nil); errr != nil || n != 1 {
+			t.Fatal("blob is not shared", n, errr)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rs {
+		rr := New(datfile, r.ID, r.Stamp)
+		if err := rr.Load(); err != nil {
+			t.Fatal(err)
+		}
+		if rr.Recstr() != r.Recstr() || !rr.md5check() {
+			t.Fatal("illegal record", rr.Recstr())
+		}
+		data, suffix, err := AttachData(r.Head, "")
+		if err != nil || string(data) != "hello world" || suffix != "txt" {
+			t.Fatal("illegal attach", string(data), suffix, err)
+		}
+	}
+	r := New(datfile, "", 0)
+	r.Build(1467000002, map[string]string{"attach": blobPrefix + "00", "suffix": "txt"}, "")
+	err = db.DB.Update(func(tx db.Tx) error {
+		return r.SyncTX(tx, false)
+	})
+	if err == nil {
+		t.Fatal("blob reference is accepted")
+	}
+	st := GetStat(datfile)
+	if st.Size != int64(len(rs[0].bodystr())+len(rs[1].bodystr())) || st.Stamp != rs[1].Stamp {
+		t.Fatal("illegal stat", st)
+	}
+	err = db.DB.Update(func(tx db.Tx) error {
+		for _, r := range rs {
+			d, errr := GetFromDB(tx, r.Head)
+			if errr != nil {
+				return errr
+			}
+			d.Del(tx)
+		}
+		n, errr := db.Count(tx, blobBucket, nil)
+		if errr == nil && n != 0 {
+			t.Fatal("blob is not deleted", n)
+		}
+		return errr
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := GetStat(datfile); st.Size != 0 {
+		t.Fatal("stat is not updated", st)
+	}
+}
+
+func TestStream(t *testing.T) {
+	db.DB = db.NewMemory()
+	ch := stream.Subscribe(0)
+	defer stream.Unsubscribe(ch)
+	r := New("thread_E99BA8", "", 0)
+	r.Build(1467000000, map[string]string{"body": "hello"}, "")
+	r.Sync()
+	r.Sync()
+	select {
+	case e := <-ch:
+		if e.Datfile != r.Datfile || e.ID != r.ID || e.Stamp != r.Stamp {
+			t.Fatal("illegal event", e)
+		}
+	default:
+		t.Fatal("event is not published")
+	}
+	select {
+	case e := <-ch:
+		t.Fatal("event is published twice", e)
+	default:
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package record
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+)
+
+//stampBucket is the name of bucket which indexes records by stamp.
+//key is stamp(8 bytes)+datfile+"\x00"+id+"\x00", value is empty.
+const stampBucket = "recordStamp"
+
+func init() {
+	db.AddMigration(2, "build time index of records", func(tx db.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists([]byte(stampBucket)); err != nil {
+			return err
+		}
+		b := tx.Bucket([]byte("record"))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			d := DB{}
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			return putStamp(tx, d.Head)
+		})
+	})
+}
+
+//stampKey returns key of h for stampBucket.
+func (h *Head) stampKey() []byte {
+	return db.ToKey(h.Stamp, h.Datfile, h.ID)
+}
+
+//putStamp adds h to the time index.
+func putStamp(tx db.Tx, h *Head) error {
+	return db.Put(tx, stampBucket, h.stampKey(), []byte{})
+}
+
+//delStamp removes h from the time index.
+func delStamp(tx db.Tx, h *Head) error {
+	return db.Del(tx, stampBucket, h.stampKey())
+}
+
+//headFromStampKey parses a key of stampBucket.
+func headFromStampKey(k []byte) (*Head, error) {
+	if len(k) < 8 {
+		return nil, errors.New("illegal stamp key")
+	}
+	strs := bytes.Split(k[8:], []byte{0})
+	if len(strs) < 2 {
+		return nil, errors.New("illegal stamp key")
+	}
+	return &Head{
+		Stamp:   int64(binary.BigEndian.Uint64(k[:8])),
+		Datfile: string(strs[0]),
+		ID:      string(strs[1]),
+	}, nil
+}
+
+//ForEachInRange calls eachDo for heads of records whose stamp is in begin~end,
+//in stamp order.
+//records must not be added or deleted in eachDo.
+func ForEachInRange(tx db.Tx, begin, end int64, eachDo func(*Head) error) error {
+	b := tx.Bucket([]byte(stampBucket))
+	if b == nil {
+		return errors.New("bucket not found " + stampBucket)
+	}
+	c := b.Cursor()
+	for k, _ := c.Seek(db.ToKey(begin)); k != nil; k, _ = c.Next() {
+		h, err := headFromStampKey(k)
+		if err != nil {
+			return err
+		}
+		if h.Stamp > end {
+			return nil
+		}
+		if err := eachDo(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ForEachInThread calls eachDo for heads of records in datfile whose stamp is in begin~end,
+//in stamp order.
+//records must not be added or deleted in eachDo.
+func ForEachInThread(tx db.Tx, datfile string, begin, end int64, eachDo func(*Head) error) error {
+	b := tx.Bucket([]byte("record"))
+	if b == nil {
+		return errors.New("bucket not found record")
+	}
+	prefix := db.ToKey(datfile)
+	c := b.Cursor()
+	for k, _ := c.Seek(db.ToKey(datfile, begin)); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		k = k[len(prefix):]
+		if len(k) < 8+1 {
+			return errors.New("illegal record key")
+		}
+		h := &Head{
+			Datfile: datfile,
+			Stamp:   int64(binary.BigEndian.Uint64(k[:8])),
+			ID:      string(bytes.TrimRight(k[8:], "\x00")),
+		}
+		if h.Stamp > end {
+			return nil
+		}
+		if err := eachDo(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//HeadsInRange returns heads of records whose stamp is in begin~end, in stamp order.
+func HeadsInRange(begin, end int64) []*Head {
+	var hs []*Head
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		hs, err = headsInRange(tx, begin, end)
+		return err
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return hs
+}
+
+//headsInRange returns heads of records whose stamp is in begin~end, in stamp order.
+func headsInRange(tx db.Tx, begin, end int64) ([]*Head, error) {
+	var hs []*Head
+	err := ForEachInRange(tx, begin, end, func(h *Head) error {
+		hs = append(hs, h)
+		return nil
+	})
+	return hs, err
+}
+
+//CountByDatfile returns # of records whose stamp is in begin~end for each datfiles.
+func CountByDatfile(begin, end int64) map[string]int {
+	m := make(map[string]int)
+	for _, h := range HeadsInRange(begin, end) {
+		m[h.Datfile]++
+	}
+	return m
+}
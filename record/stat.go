@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package record
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+)
+
+const (
+	//statBucket is the name of bucket which stores stats of threads.
+	//key is datfile, value is json of Stat.
+	statBucket = "threadStat"
+	//countKey is the key of # of all records in meta bucket.
+	countKey = "records"
+)
+
+func init() {
+	db.AddMigration(5, "build stats of threads and count records", func(tx db.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists([]byte(statBucket)); err != nil {
+			return err
+		}
+		b := tx.Bucket([]byte("record"))
+		if b == nil {
+			return nil
+		}
+		stats := make(map[string]*Stat)
+		var count int
+		err := b.ForEach(func(k, v []byte) error {
+			count++
+			d := DB{}
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			st, exist := stats[d.Datfile]
+			if !exist {
+				st = &Stat{}
+				stats[d.Datfile] = st
+			}
+			st.add(&d, d.Size(tx))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for datfile, st := range stats {
+			if err := db.Put(tx, statBucket, []byte(datfile), st); err != nil {
+				return err
+			}
+		}
+		return db.Put(tx, "meta", []byte(countKey), count)
+	})
+}
+
+//Stat represents stats of records in a thread.
+type Stat struct {
+	Size  int64 //sum of body length of records in the wire format
+	Stamp int64 //the newest stamp of records which were put as not deleted
+}
+
+//add adds the record d whose size is size to st.
+func (st *Stat) add(d *DB, size int) {
+	st.Size += int64(size)
+	if !d.Deleted && d.Stamp > st.Stamp {
+		st.Stamp = d.Stamp
+	}
+}
+
+//getStat returns the stat of datfile.
+func getStat(tx db.Tx, datfile string) *Stat {
+	st := &Stat{}
+	if _, err := db.Get(tx, statBucket, []byte(datfile), st); err != nil {
+		return &Stat{}
+	}
+	return st
+}
+
+//updateStat adds size of d to the stat of its thread.
+//size is negative when d is removed.
+func updateStat(tx db.Tx, d *DB, size int) error {
+	st := getStat(tx, d.Datfile)
+	st.add(d, size)
+	return db.Put(tx, statBucket, []byte(d.Datfile), st)
+}
+
+//DelStat removes the stat of datfile.
+func DelStat(tx db.Tx, datfile string) error {
+	return db.Del(tx, statBucket, []byte(datfile))
+}
+
+//GetStat returns the stat of datfile.
+func GetStat(datfile string) *Stat {
+	var st *Stat
+	err := db.DB.View(func(tx db.Tx) error {
+		st = getStat(tx, datfile)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return st
+}
+
+//Datfiles returns datfiles of all threads which have records.
+func Datfiles(tx db.Tx) ([]string, error) {
+	return db.KeyStrings(tx, statBucket)
+}
+
+//Stats returns stats of all threads.
+func Stats() map[string]*Stat {
+	m := make(map[string]*Stat)
+	err := db.DB.View(func(tx db.Tx) error {
+		b := tx.Bucket([]byte(statBucket))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			st := &Stat{}
+			if err := json.Unmarshal(v, st); err != nil {
+				return err
+			}
+			m[string(k)] = st
+			return nil
+		})
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return m
+}
+
+//Count returns # of all records in db.
+func Count(tx db.Tx) int {
+	var n int
+	if _, err := db.Get(tx, "meta", []byte(countKey), &n); err != nil {
+		return 0
+	}
+	return n
+}
+
+//updateCount adds n to # of all records.
+func updateCount(tx db.Tx, n int) error {
+	c := Count(tx) + n
+	if c < 0 {
+		c = 0
+	}
+	return db.Put(tx, "meta", []byte(countKey), c)
+}
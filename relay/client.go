@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package relay
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/myself"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+	"github.com/shingetsu-gou/shingetsu-gou/node/manager"
+)
+
+//Start connects to a relay node and serves requests forwarded from it with h,
+//reconnecting when disconnected.
+func Start(h http.Handler) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatal(err)
+	}
+	token := hex.EncodeToString(b)
+	go func() {
+		for {
+			for _, n := range candidates() {
+				if err := serve(n, token, h); err != nil {
+					log.Println("relay", n.Nodestr, err)
+				}
+			}
+			time.Sleep(time.Minute)
+		}
+	}()
+}
+
+//candidates returns nodes which may relay me.
+func candidates() node.Slice {
+	if cfg.RelayNode != "" {
+		n, err := node.New(cfg.RelayNode)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		return node.Slice{n}
+	}
+	return manager.KnownGood(10)
+}
+
+//connect connects to n and requests to relay me as token.
+func connect(n *node.Node, token string) (net.Conn, *bufio.Reader, error) {
+	i := strings.Index(n.Nodestr, "/")
+	conn, err := node.Dial(n.Nodestr[:i])
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequest(http.MethodGet, "http://"+n.Nodestr+"/relay/"+token, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", upgrade)
+	req.Header.Set("User-Agent", "shinGETsuPlus/1.0alpha (Gou/"+cfg.Version+")")
+	br := bufio.NewReader(conn)
+	err = req.Write(conn)
+	var resp *http.Response
+	if err == nil {
+		resp, err = http.ReadResponse(br, req)
+	}
+	if err == nil && resp.StatusCode != http.StatusSwitchingProtocols {
+		err = errors.New("not relayed: " + resp.Status)
+	}
+	if err != nil {
+		if errr := conn.Close(); errr != nil {
+			log.Println(errr)
+		}
+		return nil, nil, err
+	}
+	return conn, br, nil
+}
+
+//serve serves requests from n with h until disconnected.
+func serve(n *node.Node, token string, h http.Handler) error {
+	conn, br, err := connect(n, token)
+	if err != nil {
+		return err
+	}
+	log.Println("relayed by", n.Nodestr)
+	myself.SetRelay(n.Nodestr + "/relay/" + token)
+	myself.SetStatus(cfg.Relay)
+	defer func() {
+		myself.SetRelay("")
+		myself.SetStatus(cfg.Port0)
+	}()
+	s := &http.Server{
+		Handler:     relayed(h),
+		IdleTimeout: 3 * pingCycle,
+	}
+	err = s.Serve(newConnListener(&bufConn{Conn: conn, br: br}))
+	if err == io.EOF {
+		err = errors.New("disconnected")
+	}
+	return err
+}
+
+//relayed returns the handler which serves only server.cgi with h,
+//and sets the address of the requester told by the relay node to the request.
+func relayed(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, cfg.ServerURL+"/") {
+			http.NotFound(w, r)
+			return
+		}
+		if f := r.Header.Get(forwardedHeader); f != "" {
+			r.RemoteAddr = net.JoinHostPort(f, "0")
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+//bufConn is net.Conn which reads from bufio.Reader.
+type bufConn struct {
+	net.Conn
+	br   *bufio.Reader
+	once sync.Once
+	done chan struct{}
+}
+
+//Read reads data from br.
+func (c *bufConn) Read(b []byte) (int, error) {
+	return c.br.Read(b)
+}
+
+//Close closes the connection and tells it to the listener.
+func (c *bufConn) Close() error {
+	c.once.Do(func() {
+		close(c.done)
+	})
+	return c.Conn.Close()
+}
+
+//connListener is net.Listener which accepts only one connection.
+type connListener struct {
+	conn *bufConn
+	ch   chan net.Conn
+}
+
+//newConnListener returns connListener which accepts c.
+func newConnListener(c *bufConn) *connListener {
+	c.done = make(chan struct{})
+	l := &connListener{
+		conn: c,
+		ch:   make(chan net.Conn, 1),
+	}
+	l.ch <- c
+	close(l.ch)
+	return l
+}
+
+//Accept returns the connection at first, and waits until the connection is closed after that.
+func (l *connListener) Accept() (net.Conn, error) {
+	if c, ok := <-l.ch; ok {
+		return c, nil
+	}
+	<-l.conn.done
+	return nil, io.EOF
+}
+
+//Close closes the connection.
+func (l *connListener) Close() error {
+	return l.conn.Close()
+}
+
+//Addr returns the local address of the connection.
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
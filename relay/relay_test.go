@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package relay
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/myself"
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+)
+
+func TestRelay(t *testing.T) {
+	db.DB = db.NewMemory()
+	sm := cgi.NewLoggingServeMux()
+	Setup(sm)
+	s := httptest.NewServer(sm)
+	defer s.Close()
+	relayNode := s.Listener.Addr().String() + cfg.ServerURL
+
+	cfg.RelayServer = false
+	cfg.RelayMax = 1
+	cfg.RelayRate = 2
+	n, err := node.New(relayNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = connect(n, "deadbeef"); err == nil {
+		t.Fatal("relayed without opt-in")
+	}
+	cfg.RelayServer = true
+
+	h := http.NewServeMux()
+	h.HandleFunc(cfg.ServerURL+"/have/", func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, "YES\n"+host+"\n")
+	})
+	cfg.RelayNode = relayNode
+	Start(h)
+	for i := 0; i < 50 && myself.GetRelay() == ""; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if myself.GetStatus() != cfg.Relay {
+		t.Fatal("not relayed")
+	}
+	me, err := node.New(myself.GetRelay())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !me.Equals(node.Me(true)) {
+		t.Fatal("illegal nodestr of myself", node.Me(true))
+	}
+	if _, _, err = connect(n, "other"); err == nil {
+		t.Fatal("relayed over relay_max")
+	}
+
+	if res, err := me.Talk("/ping", nil); err != nil || len(res) != 2 || res[0] != "PONG" {
+		t.Fatal("illegal ping", res, err)
+	}
+	for i := 0; i < 2; i++ {
+		res, err := me.Talk("/have/thread_30", nil)
+		if err != nil || len(res) != 2 || res[0] != "YES" || res[1] != "127.0.0.1" {
+			t.Fatal("illegal response", res, err)
+		}
+	}
+	if res, err := me.Talk("/have/thread_30", nil); err == nil {
+		t.Fatal("not rate-limited", res)
+	}
+	if res, _ := me.Talk("/node", nil); len(res) != 1 || res[0] != "not relayed" {
+		t.Fatal("illegal method is relayed", res)
+	}
+}
@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package relay
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/cgi"
+	"github.com/shingetsu-gou/shingetsu-gou/limit"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
+)
+
+const (
+	//upgrade is the protocol name in Upgrade header to start relaying.
+	upgrade = "gou-relay"
+	//forwardedHeader tells the relayed node the address of the requester.
+	forwardedHeader = "X-Gou-Relayed-For"
+	//pingCycle is the interval of pings to check relayed nodes are alive.
+	pingCycle = time.Minute
+)
+
+//methods are methods of server.cgi which are forwarded to relayed nodes.
+var methods = map[string]bool{
+	"have":   true,
+	"get":    true,
+	"head":   true,
+	"update": true,
+	"recent": true,
+}
+
+//client is a node behind NAT relayed by me.
+type client struct {
+	sync.Mutex
+	token string
+	conn  net.Conn
+	br    *bufio.Reader
+	rate  *limit.Limiter
+}
+
+//clients maps tokens to relayed nodes.
+var clients = struct {
+	sync.RWMutex
+	m map[string]*client
+}{
+	m: make(map[string]*client),
+}
+
+func init() {
+	metrics.NewGaugeFunc("gou_relayed_nodes", "Number of nodes relayed by me.", func() float64 {
+		clients.RLock()
+		defer clients.RUnlock()
+		return float64(len(clients.m))
+	})
+}
+
+//Setup setups handlers for relaying.
+func Setup(s *cgi.LoggingServeMux) {
+	s.HandleFunc(cfg.ServerURL+"/relay/", limit.Handler(handle, nil))
+}
+
+//handle accepts a node to be relayed if the request is for upgrading,
+//or forwards the request to the relayed node.
+func handle(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, cfg.ServerURL+"/relay/")
+	p := strings.SplitN(path, "/", 2)
+	if len(p) == 1 {
+		if !cfg.RelayServer {
+			http.Error(w, "relay is not allowed", http.StatusForbidden)
+			return
+		}
+		accept(w, r, p[0])
+		return
+	}
+	c := getClient(p[0])
+	if c == nil {
+		http.NotFound(w, r)
+		return
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	method := strings.SplitN(p[1], "/", 2)[0]
+	if method == "ping" {
+		fmt.Fprint(w, "PONG\n"+host+"\n")
+		return
+	}
+	if !methods[method] {
+		http.Error(w, "not relayed", http.StatusForbidden)
+		return
+	}
+	if !c.allow() {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+	u := cfg.ServerURL + "/" + p[1]
+	if r.URL.RawQuery != "" {
+		u += "?" + r.URL.RawQuery
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	req.Header.Set(forwardedHeader, host)
+	resp, body, err := c.do(req)
+	if err != nil {
+		log.Println("relayed node", c.token, "is disconnected", err)
+		c.close()
+		http.Error(w, "relayed node is disconnected", http.StatusBadGateway)
+		return
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.WriteHeader(resp.StatusCode)
+	if _, err := w.Write(body); err != nil {
+		log.Println(err)
+	}
+}
+
+//accept hijacks the connection and starts relaying it as token.
+func accept(w http.ResponseWriter, r *http.Request, token string) {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), upgrade) || token == "" {
+		http.Error(w, "illegal request", http.StatusBadRequest)
+		return
+	}
+	clients.Lock()
+	defer clients.Unlock()
+	if _, exist := clients.m[token]; exist {
+		http.Error(w, "token is used", http.StatusConflict)
+		return
+	}
+	if len(clients.m) >= cfg.RelayMax {
+		http.Error(w, "too many relayed nodes", http.StatusServiceUnavailable)
+		return
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		log.Println("cannot hijack")
+		return
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	limit.Release(r.RemoteAddr)
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		log.Println(err)
+	}
+	_, err = fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", upgrade)
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err != nil {
+		log.Println(err)
+		if errr := conn.Close(); errr != nil {
+			log.Println(errr)
+		}
+		return
+	}
+	c := &client{
+		token: token,
+		conn:  conn,
+		br:    rw.Reader,
+		rate:  limit.NewLimiter(cfg.RelayRate),
+	}
+	clients.m[token] = c
+	log.Println("started relaying", token, "from", r.RemoteAddr)
+	go c.keepAlive()
+}
+
+//getClient returns the relayed node of token, or nil if not found.
+func getClient(token string) *client {
+	clients.RLock()
+	defer clients.RUnlock()
+	return clients.m[token]
+}
+
+//allow returns true if the request to c doesn't exceed the rate limit.
+func (c *client) allow() bool {
+	ok, _ := c.rate.Allow(c.token)
+	return ok
+}
+
+//do sends req to c and returns the response with its body.
+//requests are sent one by one because there is only one connection.
+func (c *client) do(req *http.Request) (*http.Response, []byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	if err := c.conn.SetDeadline(time.Now().Add(time.Minute)); err != nil {
+		return nil, nil, err
+	}
+	if err := req.Write(c.conn); err != nil {
+		return nil, nil, err
+	}
+	resp, err := http.ReadResponse(c.br, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if errr := resp.Body.Close(); errr != nil {
+		log.Println(errr)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, c.conn.SetDeadline(time.Time{})
+}
+
+//keepAlive pings c periodically, and closes it if no response.
+func (c *client) keepAlive() {
+	for {
+		time.Sleep(pingCycle)
+		if getClient(c.token) != c {
+			return
+		}
+		req, err := http.NewRequest(http.MethodGet, cfg.ServerURL+"/ping", nil)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		if _, _, err := c.do(req); err != nil {
+			log.Println("relayed node", c.token, "is disconnected", err)
+			c.close()
+			return
+		}
+	}
+}
+
+//close closes the connection of c and stops relaying it.
+func (c *client) close() {
+	clients.Lock()
+	if clients.m[c.token] == c {
+		delete(clients.m, c.token)
+	}
+	clients.Unlock()
+	if err := c.conn.Close(); err != nil {
+		log.Println(err)
+	}
+}
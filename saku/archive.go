@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package saku
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+// WriteArchive writes records which are not removed in all threads to a gzipped file path.
+// records of a thread follows a line of its datfile, i.e.
+//	thread_XXXX
+//	stamp<>id<>body...
+//	stamp<>id<>body...
+//	thread_YYYY
+//	...
+// returns # of archived threads and records.
+func WriteArchive(path string) (int, int, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer util.Fclose(f)
+	gw := gzip.NewWriter(f)
+	w := bufio.NewWriter(gw)
+	var nthreads, nrecs int
+	for _, ca := range thread.AllCaches() {
+		ds, err := records(ca.Datfile)
+		if err != nil {
+			return nthreads, nrecs, err
+		}
+		if _, err = fmt.Fprintln(w, ca.Datfile); err != nil {
+			return nthreads, nrecs, err
+		}
+		for _, d := range ds {
+			if d.Deleted {
+				continue
+			}
+			r, err := d.Record()
+			if err != nil {
+				return nthreads, nrecs, err
+			}
+			if _, err = fmt.Fprintln(w, r.Recstr()); err != nil {
+				return nthreads, nrecs, err
+			}
+			nrecs++
+		}
+		nthreads++
+	}
+	if err = w.Flush(); err != nil {
+		return nthreads, nrecs, err
+	}
+	return nthreads, nrecs, gw.Close()
+}
+
+//ImportArchive reads the gzipped file path written by WriteArchive and saves records to the db.
+//records of each thread are saved in one transaction.
+//returns # of imported threads and records.
+func ImportArchive(path string) (int, int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		util.Fclose(f)
+		return 0, 0, err
+	}
+	defer util.Fclose(f)
+	var nthreads, nrecs int
+	var datfile string
+	var recs []*record.Record
+	flush := func() error {
+		if datfile == "" {
+			return nil
+		}
+		err := db.DB.Update(func(tx db.Tx) error {
+			thread.NewCache(datfile).SubscribeTX(tx)
+			for _, r := range recs {
+				if err := r.SyncTX(tx, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		nthreads++
+		nrecs += len(recs)
+		recs = recs[:0]
+		return nil
+	}
+	err = util.EachIOLine(gr, func(line string, i int) error {
+		if line == "" {
+			return nil
+		}
+		if !strings.Contains(line, "<>") {
+			if !strings.HasPrefix(line, "thread_") || util.FileDecode(line) == "" {
+				return fmt.Errorf("line %d: illegal thread name", i+1)
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+			datfile = line
+			return nil
+		}
+		if datfile == "" {
+			return fmt.Errorf("line %d: no thread name before records", i+1)
+		}
+		buf := strings.SplitN(line, "<>", 3)
+		r, err := record.NewIDstr(datfile, buf[0]+"_"+buf[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %s", i+1, err)
+		}
+		if err = r.Parse(line); err != nil || !r.Meets(0, 0) {
+			log.Println("line", i+1, "is broken, skipped")
+			return nil
+		}
+		recs = append(recs, r)
+		return nil
+	})
+	if err != nil {
+		return nthreads, nrecs, err
+	}
+	return nthreads, nrecs, flush()
+}
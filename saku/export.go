@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package saku
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/suggest"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+)
+
+//Export writes all threads in the db to dir as saku cache directories,
+//i.e. dir/datfile/{dat.stat,record/,removed/,attach/,tag.txt,sugtag.txt}.
+//returns # of exported threads and records.
+func Export(dir string) (int, int, error) {
+	var nthreads, nrecs int
+	for _, ca := range thread.AllCaches() {
+		n, err := exportThread(filepath.Join(dir, ca.Datfile), ca.Datfile)
+		if err != nil {
+			return nthreads, nrecs, err
+		}
+		nthreads++
+		nrecs += n
+	}
+	return nthreads, nrecs, nil
+}
+
+//records returns all records in the thread datfile in the wire format.
+func records(datfile string) ([]*record.DB, error) {
+	var ds []*record.DB
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		ds, err = record.GetFromDBs(tx, datfile)
+		if err != nil {
+			return err
+		}
+		for _, d := range ds {
+			if err := d.Expand(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return ds, err
+}
+
+//exportThread writes records and tags of the thread datfile to saku cache dir.
+//returns # of exported records.
+func exportThread(dir, datfile string) (int, error) {
+	for _, d := range []string{"record", "removed", "attach"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			return 0, err
+		}
+	}
+	ds, err := records(datfile)
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range ds {
+		r, err := d.Record()
+		if err != nil {
+			return 0, err
+		}
+		sub := "record"
+		if d.Deleted {
+			sub = "removed"
+		}
+		path := filepath.Join(dir, sub, r.Idstr())
+		if err = ioutil.WriteFile(path, []byte(r.Recstr()+"\n"), 0644); err != nil {
+			return 0, err
+		}
+		if d.Deleted || !r.HasBodyValue("attach") {
+			continue
+		}
+		attach, err := base64.StdEncoding.DecodeString(r.GetBodyValue("attach", ""))
+		if err != nil {
+			continue
+		}
+		name := r.AttachPath("")
+		if name == "" {
+			log.Println(r.Idstr(), "has no suffix of the attached file, skipped")
+			continue
+		}
+		adir := filepath.Join(dir, "attach")
+		path = filepath.Join(adir, name)
+		if !strings.HasPrefix(path, adir+string(filepath.Separator)) {
+			log.Println("illegal path of the attached file", path)
+			continue
+		}
+		if err = ioutil.WriteFile(path, attach, 0644); err != nil {
+			return 0, err
+		}
+	}
+	files := map[string]string{
+		"dat.stat":   datfile,
+		"tag.txt":    strings.Join(user.GetStrings(datfile), "\n"),
+		"sugtag.txt": strings.Join(suggest.Get(datfile, nil).GetTagstrSlice(), "\n"),
+	}
+	for name, cont := range files {
+		if cont != "" {
+			cont += "\n"
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(cont), 0644); err != nil {
+			return 0, err
+		}
+	}
+	return len(ds), nil
+}
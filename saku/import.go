@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package saku
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/suggest"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+//Import reads threads in saku cache directory dir and saves them to the db.
+//each thread is saved in one transaction.
+//attach/ directories are not read because attached files are also in record bodies.
+//returns # of imported threads and records.
+func Import(dir string) (int, int, error) {
+	if !util.IsDir(dir) {
+		return 0, 0, errors.New(dir + " is not a directory")
+	}
+	fs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	var nthreads, nrecs int
+	for _, f := range fs {
+		if !f.IsDir() {
+			continue
+		}
+		n, err := importThread(filepath.Join(dir, f.Name()))
+		if err != nil {
+			log.Println(f.Name(), err)
+			continue
+		}
+		nthreads++
+		nrecs += n
+	}
+	return nthreads, nrecs, nil
+}
+
+//datfile returns thread name of saku cache dir.
+//it is in dat.stat because name of the dir is hashed if cache_hash_method is not asis.
+func datfile(dir string) string {
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "dat.stat")); err == nil {
+		if d := strings.TrimSpace(string(b)); d != "" {
+			return d
+		}
+	}
+	return filepath.Base(dir)
+}
+
+//readLines returns non-empty lines in the file path.
+//returns nil if the file doesn't exist.
+func readLines(path string) ([]string, error) {
+	var lines []string
+	err := util.EachLine(path, func(line string, i int) error {
+		if line != "" {
+			lines = append(lines, line)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return lines, err
+}
+
+//importThread saves records and tags in saku cache dir of a thread.
+//records in removed/ are saved with deleted flag.
+//returns # of imported records.
+func importThread(dir string) (int, error) {
+	dat := datfile(dir)
+	if !strings.HasPrefix(dat, "thread_") || util.FileDecode(dat) == "" {
+		return 0, errors.New("illegal thread name " + dat)
+	}
+	tags, err := readLines(filepath.Join(dir, "tag.txt"))
+	if err != nil {
+		return 0, err
+	}
+	sugtags, err := readLines(filepath.Join(dir, "sugtag.txt"))
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	err = db.DB.Update(func(tx db.Tx) error {
+		thread.NewCache(dat).SubscribeTX(tx)
+		for _, d := range []string{"removed", "record"} {
+			nn, err := importRecords(tx, dat, filepath.Join(dir, d), d == "removed")
+			if err != nil {
+				return err
+			}
+			n += nn
+		}
+		if err := user.AddTX(tx, dat, tags); err != nil {
+			return err
+		}
+		suggest.AddString(tx, dat, sugtags)
+		return nil
+	})
+	return n, err
+}
+
+//importRecords saves records files in dir to the thread dat.
+//records which are already in the db are skipped.
+func importRecords(tx db.Tx, dat, dir string, deleted bool) (int, error) {
+	if !util.IsDir(dir) {
+		return 0, nil
+	}
+	fs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for _, f := range fs {
+		if f.IsDir() {
+			continue
+		}
+		r, err := record.NewIDstr(dat, f.Name())
+		if err != nil {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return n, err
+		}
+		if err := r.Parse(string(b)); err != nil {
+			continue
+		}
+		if !r.Meets(0, 0) {
+			log.Println(dat, f.Name(), "is broken, skipped")
+			continue
+		}
+		if err := r.SyncTX(tx, deleted); err != nil {
+			log.Println(dat, f.Name(), err, "skipped")
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
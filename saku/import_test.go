@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package saku
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/suggest"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+)
+
+func TestImport(t *testing.T) {
+	db.DB = db.NewMemory()
+	dir, err := ioutil.TempDir("", "gou_saku")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	datfile := "thread_E99B91E8AB87"
+	tdir := filepath.Join(dir, "0123abcd")
+	files := map[string]string{
+		"dat.stat":    datfile + "\n",
+		"tag.txt":     "foo\nbar\n",
+		"sugtag.txt":  "baz\n",
+		"record/1_00": "broken",
+	}
+	for i, kind := range []string{"record", "removed"} {
+		r := record.New(datfile, "", 0)
+		r.Build(1467000000+int64(i), map[string]string{"body": kind}, "")
+		files[kind+"/"+r.Idstr()] = r.Recstr() + "\n"
+	}
+	//a record which cannot be saved must be skipped without aborting the import.
+	r := record.New(datfile, "", 0)
+	r.Build(1467000002, map[string]string{"attach": "@blob:00", "suffix": "txt"}, "")
+	files["record/"+r.Idstr()] = r.Recstr() + "\n"
+	for name, cont := range files {
+		path := filepath.Join(tdir, name)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(cont), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nthreads, nrecs, err := Import(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nthreads != 1 || nrecs != 2 {
+		t.Fatal("illegal # of imported", nthreads, nrecs)
+	}
+	if !thread.NewCache(datfile).Exists() {
+		t.Fatal("thread is not imported")
+	}
+	for kind, n := range map[int]int{record.Alive: 1, record.Removed: 1} {
+		m, err := record.FromRecordDB(datfile, kind)
+		if err != nil || len(m) != n {
+			t.Fatal("illegal # of records", kind, len(m), err)
+		}
+	}
+	if !user.Has(datfile, "bar") || !suggest.HasTagstr(datfile, "baz") {
+		t.Fatal("tags are not imported")
+	}
+}
+
+func TestExportArchive(t *testing.T) {
+	db.DB = db.NewMemory()
+	dir, err := ioutil.TempDir("", "gou_saku")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	datfile := "thread_E99B91E8AB87"
+	thread.NewCache(datfile).Subscribe()
+	user.Add(datfile, []string{"foo"})
+	for i := 0; i < 3; i++ {
+		r := record.New(datfile, "", 0)
+		r.Build(1467000000+int64(i), map[string]string{"body": "hello<br>world"}, "")
+		r.Sync()
+		if i == 0 {
+			if err = r.Remove(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	cache := filepath.Join(dir, "cache")
+	if nthreads, nrecs, errr := Export(cache); errr != nil || nthreads != 1 || nrecs != 3 {
+		t.Fatal("illegal export", nthreads, nrecs, errr)
+	}
+	archive := filepath.Join(dir, "gou.gz")
+	if nthreads, nrecs, errr := WriteArchive(archive); errr != nil || nthreads != 1 || nrecs != 2 {
+		t.Fatal("illegal archive", nthreads, nrecs, errr)
+	}
+
+	db.DB = db.NewMemory()
+	if nthreads, nrecs, errr := Import(cache); errr != nil || nthreads != 1 || nrecs != 3 {
+		t.Fatal("illegal import", nthreads, nrecs, errr)
+	}
+	if !user.Has(datfile, "foo") {
+		t.Fatal("tags are not exported")
+	}
+	db.DB = db.NewMemory()
+	if nthreads, nrecs, errr := ImportArchive(archive); errr != nil || nthreads != 1 || nrecs != 2 {
+		t.Fatal("illegal import", nthreads, nrecs, errr)
+	}
+	m, err := record.FromRecordDB(datfile, record.Alive)
+	if err != nil || len(m) != 2 {
+		t.Fatal("illegal # of records", len(m), err)
+	}
+}
+
+func TestExportAttach(t *testing.T) {
+	db.DB = db.NewMemory()
+	dir, err := ioutil.TempDir("", "gou_saku")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	datfile := "thread_E99B91E8AB87"
+	thread.NewCache(datfile).Subscribe()
+	attach := base64.StdEncoding.EncodeToString([]byte("attached"))
+	var recs []*record.Record
+	for i, suffix := range []string{"/../../../escaped", ""} {
+		body := map[string]string{"body": "attach", "attach": attach}
+		if suffix != "" {
+			body["suffix"] = suffix
+		}
+		r := record.New(datfile, "", 0)
+		r.Build(1467000000+int64(i), body, "")
+		r.Sync()
+		recs = append(recs, r)
+	}
+	cache := filepath.Join(dir, "out", "cache")
+	if nthreads, nrecs, errr := Export(cache); errr != nil || nthreads != 1 || nrecs != 2 {
+		t.Fatal("illegal export", nthreads, nrecs, errr)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "out", "escaped")); err == nil {
+		t.Fatal("attached file is written outside the thread directory")
+	}
+	name := recs[0].AttachPath("")
+	if strings.Contains(name, "/") {
+		t.Fatal("suffix is not cleaned", name)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(cache, datfile, "attach", name))
+	if err != nil || string(data) != "attached" {
+		t.Fatal("attached file is not exported", string(data), err)
+	}
+	if recs[1].AttachPath("") != "" {
+		t.Fatal("attach path without suffix", recs[1].AttachPath(""))
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package search
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+)
+
+const (
+	//maxQueryLen is max length of a query.
+	maxQueryLen = 256
+	//maxTerms is max # of terms in a query.
+	maxTerms = 16
+	//dateFormat is the format of since: and until: filters.
+	dateFormat = "2006-01-02"
+)
+
+//Query is a parsed search query.
+//records which match any of Clauses and all filters are searched.
+type Query struct {
+	Clauses [][]string //OR-ed list of AND-ed folded terms.
+	Thread  string     //folded string which thread title must contain.
+	Tag     string     //tag which thread must have.
+	Since   int64      //records must be written since this stamp.
+	Until   int64      //records must be written until this stamp.
+}
+
+//token is a word in the query.
+type token struct {
+	str    string
+	quoted bool //true if the word starts with double quote.
+}
+
+//tokenize splits q into words by spaces.
+//words surrounded by double quotes are treated as a token without quotes.
+func tokenize(q string) ([]token, error) {
+	var tokens []token
+	var cur []rune
+	var quoted, inQuote bool
+	flush := func() {
+		if len(cur) > 0 {
+			tokens = append(tokens, token{string(cur), quoted})
+		}
+		cur = cur[:0]
+		quoted = false
+	}
+	for _, r := range q {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			if len(cur) == 0 {
+				quoted = true
+			}
+		case unicode.IsSpace(r) && !inQuote:
+			flush()
+		default:
+			cur = append(cur, r)
+		}
+	}
+	if inQuote {
+		return nil, errors.New("unterminated quote")
+	}
+	flush()
+	return tokens, nil
+}
+
+//Parse parses q and returns Query.
+//words separated by spaces are AND-ed, and "OR" or "|" makes OR.
+//words surrounded by double quotes are searched as a phrase.
+//"thread:", "tag:", "since:" and "until:" prefixes are filters.
+//dates are written like 2006-01-02.
+func Parse(q string) (*Query, error) {
+	if len(q) > maxQueryLen {
+		return nil, errors.New("query is too long")
+	}
+	tokens, err := tokenize(q)
+	if err != nil {
+		return nil, err
+	}
+	query := &Query{}
+	var clause []string
+	nterms := 0
+	for _, t := range tokens {
+		if !t.quoted && (t.str == "OR" || t.str == "|") {
+			if len(clause) > 0 {
+				query.Clauses = append(query.Clauses, clause)
+			}
+			clause = nil
+			continue
+		}
+		if kv := strings.SplitN(t.str, ":", 2); !t.quoted && len(kv) == 2 {
+			ok, err := query.setFilter(kv[0], kv[1])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+		}
+		term := strings.TrimSpace(record.Fold(t.str))
+		if len(record.QueryGrams(term)) == 0 {
+			continue
+		}
+		if nterms++; nterms > maxTerms {
+			return nil, errors.New("too many terms")
+		}
+		clause = append(clause, term)
+	}
+	if len(clause) > 0 {
+		query.Clauses = append(query.Clauses, clause)
+	}
+	if len(query.Clauses) == 0 && query.Thread == "" && query.Tag == "" {
+		return nil, errors.New("no search terms")
+	}
+	return query, nil
+}
+
+//setFilter sets filter k to v and returns true if k is a filter name.
+func (q *Query) setFilter(k, v string) (bool, error) {
+	switch k {
+	case "thread":
+		q.Thread = record.Fold(v)
+	case "tag":
+		q.Tag = v
+	case "since", "until":
+		t, err := time.ParseInLocation(dateFormat, v, time.Local)
+		if err != nil {
+			return false, err
+		}
+		if k == "since" {
+			q.Since = t.Unix()
+		} else {
+			q.Until = t.AddDate(0, 0, 1).Unix() - 1
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,378 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package search
+
+import (
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+const (
+	//snippetLen is # of runes in a snippet.
+	snippetLen = 120
+	//snippetBefore is # of runes before the first hit in a snippet.
+	snippetBefore = 30
+	//maxCandidates is max # of records which are checked in a search.
+	maxCandidates = 10000
+)
+
+//errFull is returned when candidates are full.
+var errFull = errors.New("too many candidates")
+
+//Fragment is a part of snippet.
+type Fragment struct {
+	Text string
+	Hit  bool
+}
+
+//Result is a record which matches the query.
+type Result struct {
+	*record.Head
+	Sid     string //short id used in the url of thread.cgi
+	Title   string
+	Score   float64
+	Snippet []Fragment
+}
+
+//results is for sorting Results by score.
+type results []*Result
+
+//Len returns # of results.
+func (r results) Len() int {
+	return len(r)
+}
+
+//Less returns true if score of r[i] is less than r[j].
+//if scores are same, returns true if r[i] is older.
+func (r results) Less(i, j int) bool {
+	if r[i].Score != r[j].Score {
+		return r[i].Score < r[j].Score
+	}
+	return r[i].Stamp < r[j].Stamp
+}
+
+//Swap swaps order of results.
+func (r results) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
+
+//hitSlice is for sorting hit positions.
+type hitSlice [][2]int
+
+//Len returns # of hits.
+func (h hitSlice) Len() int {
+	return len(h)
+}
+
+//Less returns true if h[i] begins before h[j].
+func (h hitSlice) Less(i, j int) bool {
+	return h[i][0] < h[j][0]
+}
+
+//Swap swaps order of hits.
+func (h hitSlice) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+//searcher holds states while searching in a transaction.
+type searcher struct {
+	tx      db.Tx
+	q       *Query
+	total   int
+	df      map[string]int
+	threads map[string]bool
+}
+
+//Search returns at most limit records which match q, ordered by score.
+func Search(q *Query, limit int) []*Result {
+	var rs []*Result
+	err := db.DB.View(func(tx db.Tx) error {
+		s := &searcher{
+			tx:      tx,
+			q:       q,
+			df:      make(map[string]int),
+			threads: make(map[string]bool),
+		}
+		s.total = record.Count(tx)
+		cands, err := s.candidates()
+		if err != nil {
+			return err
+		}
+		for h := range cands {
+			h := h
+			if r := s.match(&h); r != nil {
+				rs = append(rs, r)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	sort.Sort(sort.Reverse(results(rs)))
+	if limit > 0 && len(rs) > limit {
+		rs = rs[:limit]
+	}
+	return rs
+}
+
+//candidates returns at most maxCandidates heads of records which may match the query.
+func (s *searcher) candidates() (map[record.Head]struct{}, error) {
+	if len(s.q.Clauses) == 0 {
+		return s.filtered()
+	}
+	cands := make(map[record.Head]struct{})
+	for _, clause := range s.q.Clauses {
+		var m, common map[record.Head]int
+		for _, term := range clause {
+			for _, g := range record.QueryGrams(term) {
+				p, all, err := record.Postings(s.tx, g, maxCandidates)
+				if err != nil {
+					return nil, err
+				}
+				df := len(p)
+				if !all {
+					df = s.total
+				}
+				if d, exist := s.df[term]; !exist || df < d {
+					s.df[term] = df
+				}
+				//grams which are too common are not used for narrowing candidates,
+				//because terms are checked by match anyway.
+				if !all {
+					if common == nil {
+						common = p
+					}
+					continue
+				}
+				m = intersect(m, p)
+			}
+		}
+		if m == nil {
+			m = common
+		}
+		for h := range m {
+			if len(cands) >= maxCandidates {
+				return cands, nil
+			}
+			cands[h] = struct{}{}
+		}
+	}
+	return cands, nil
+}
+
+//filtered returns at most maxCandidates heads of records in threads which meet
+//thread and tag filters, for the query without terms.
+func (s *searcher) filtered() (map[record.Head]struct{}, error) {
+	cands := make(map[record.Head]struct{})
+	end := s.q.Until
+	if end == 0 {
+		end = math.MaxInt64
+	}
+	datfiles, err := record.Datfiles(s.tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, datfile := range datfiles {
+		if !s.threadOK(datfile) {
+			continue
+		}
+		err := record.ForEachInThread(s.tx, datfile, s.q.Since, end, func(h *record.Head) error {
+			if len(cands) >= maxCandidates {
+				return errFull
+			}
+			cands[*h] = struct{}{}
+			return nil
+		})
+		if err == errFull {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cands, nil
+}
+
+//intersect returns heads which are both in m and p.
+//m==nil means all heads.
+func intersect(m, p map[record.Head]int) map[record.Head]int {
+	if m == nil {
+		return p
+	}
+	r := make(map[record.Head]int)
+	for h := range m {
+		if _, exist := p[h]; exist {
+			r[h] = 0
+		}
+	}
+	return r
+}
+
+//threadOK returns true if thread datfile meets thread and tag filters.
+func (s *searcher) threadOK(datfile string) bool {
+	if ok, exist := s.threads[datfile]; exist {
+		return ok
+	}
+	ok := true
+	if s.q.Thread != "" {
+		ok = strings.Contains(record.Fold(util.FileDecode(datfile)), s.q.Thread)
+	}
+	if ok && s.q.Tag != "" {
+		ok = db.HasVal(s.tx, "usertag", []byte(datfile), s.q.Tag) ||
+			db.HasVal(s.tx, "sugtag", []byte(datfile), s.q.Tag)
+	}
+	s.threads[datfile] = ok
+	return ok
+}
+
+//match checks the record h meets the query and returns the result with score and snippet.
+//returns nil if not matched.
+func (s *searcher) match(h *record.Head) *Result {
+	if h.Stamp < s.q.Since || (s.q.Until > 0 && h.Stamp > s.q.Until) {
+		return nil
+	}
+	if !s.threadOK(h.Datfile) {
+		return nil
+	}
+	d, err := record.GetFromDB(s.tx, h)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	if d.Deleted {
+		return nil
+	}
+	text := []rune(d.Text())
+	folded := []rune(record.Fold(string(text)))
+	r := &Result{
+		Head:  h,
+		Sid:   h.ID[:8],
+		Title: util.FileDecode(h.Datfile),
+	}
+	var hits [][2]int
+	matched := len(s.q.Clauses) == 0
+	for _, clause := range s.q.Clauses {
+		score, hs := s.scoreClause(folded, clause)
+		if hs == nil {
+			continue
+		}
+		matched = true
+		if score > r.Score {
+			r.Score = score
+		}
+		hits = append(hits, hs...)
+	}
+	if !matched {
+		return nil
+	}
+	r.Snippet = snippet(text, hits)
+	return r
+}
+
+//scoreClause returns score and hit positions of terms in clause in the folded text.
+//returns nil hits if one of the terms is not found.
+func (s *searcher) scoreClause(folded []rune, clause []string) (float64, [][2]int) {
+	var score float64
+	var hits [][2]int
+	for _, term := range clause {
+		hs := find(folded, []rune(term))
+		if len(hs) == 0 {
+			return 0, nil
+		}
+		hits = append(hits, hs...)
+		idf := math.Log(1 + float64(s.total)/float64(s.df[term]+1))
+		tf := float64(len(hs))
+		score += idf * tf * 2.2 / (tf + 1.2)
+	}
+	return score, hits
+}
+
+//find returns positions of all term in text.
+func find(text, term []rune) [][2]int {
+	var hits [][2]int
+	for i := 0; i+len(term) <= len(text); i++ {
+		if string(text[i:i+len(term)]) == string(term) {
+			hits = append(hits, [2]int{i, i + len(term)})
+			i += len(term) - 1
+		}
+	}
+	return hits
+}
+
+//snippet returns a part of text around the first hit, with hits marked.
+func snippet(text []rune, hits [][2]int) []Fragment {
+	sort.Sort(hitSlice(hits))
+	begin := 0
+	if len(hits) > 0 && hits[0][0] > snippetBefore {
+		begin = hits[0][0] - snippetBefore
+	}
+	end := begin + snippetLen
+	if end > len(text) {
+		end = len(text)
+	}
+	var fs []Fragment
+	if begin > 0 {
+		fs = append(fs, Fragment{Text: "..."})
+	}
+	pos := begin
+	for _, h := range hits {
+		if h[1] <= pos {
+			continue
+		}
+		if h[0] >= end {
+			break
+		}
+		if h[0] > pos {
+			fs = append(fs, Fragment{Text: string(text[pos:h[0]])})
+			pos = h[0]
+		}
+		he := h[1]
+		if he > end {
+			he = end
+		}
+		fs = append(fs, Fragment{Text: string(text[pos:he]), Hit: true})
+		pos = he
+	}
+	if pos < end {
+		fs = append(fs, Fragment{Text: string(text[pos:end])})
+	}
+	if end < len(text) {
+		fs = append(fs, Fragment{Text: "..."})
+	}
+	return fs
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package search
+
+import (
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/util"
+)
+
+func TestParse(t *testing.T) {
+	q, err := Parse(`Ｇｏｕ "new thread" OR 月 tag:foo since:2016-06-01 thread:"雑談 スレ"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Clauses) != 2 || len(q.Clauses[0]) != 2 || q.Clauses[0][0] != "gou" ||
+		q.Clauses[0][1] != "new thread" || q.Clauses[1][0] != "月" {
+		t.Fatal("illegal clauses", q.Clauses)
+	}
+	if q.Tag != "foo" || q.Thread != "雑談 スレ" || q.Since == 0 || q.Until != 0 {
+		t.Fatal("illegal filters", q)
+	}
+	for _, s := range []string{`"abc`, "since:2016", "", "!!"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatal("should be error", s)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	db.DB = db.NewMemory()
+	bodies := []string{
+		"新月は匿名掲示板です",
+		"新月のノード<br>新月のノード",
+		"Shingetsu &amp; Gou",
+		"今日は晴れ",
+	}
+	datfile := util.FileEncode("thread", "雑談")
+	var rs []*record.Record
+	for i, b := range bodies {
+		r := record.New(datfile, "", 0)
+		r.Build(1467000000+int64(i), map[string]string{"body": b}, "")
+		r.Sync()
+		rs = append(rs, r)
+	}
+	search := func(s string) []*Result {
+		q, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Search(q, 0)
+	}
+	res := search("新月")
+	if len(res) != 2 || res[0].ID != rs[1].ID || res[1].ID != rs[0].ID {
+		t.Fatal("illegal result", res)
+	}
+	if len(res[1].Snippet) != 2 || !res[1].Snippet[0].Hit || res[1].Snippet[0].Text != "新月" {
+		t.Fatal("illegal snippet", res[1].Snippet)
+	}
+	if res = search(`ｓｈｉｎｇｅｔｓｕ "& gou"`); len(res) != 1 || res[0].ID != rs[2].ID {
+		t.Fatal("illegal result", res)
+	}
+	if res = search("掲示板 OR 晴"); len(res) != 2 {
+		t.Fatal("illegal result", res)
+	}
+	if res = search("新月 晴れ"); len(res) != 0 {
+		t.Fatal("illegal result", res)
+	}
+	if res = search("thread:雑談 until:2016-06-01"); len(res) != 0 {
+		t.Fatal("illegal result", res)
+	}
+	if res = search("thread:雑談"); len(res) != 4 {
+		t.Fatal("illegal result", res)
+	}
+	err := db.DB.View(func(tx db.Tx) error {
+		if n := record.Count(tx); n != len(bodies) {
+			t.Fatal("illegal # of records", n)
+		}
+		if p, all, errr := record.Postings(tx, "新月", 1); errr != nil || all || len(p) != 1 {
+			t.Fatal("postings are not truncated", p, all, errr)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rs[0].Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if res = search("掲示板"); len(res) != 0 {
+		t.Fatal("removed record is found", res)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package stream
+
+import "sync"
+
+//Event tells a record is added.
+type Event struct {
+	Datfile string `json:"datfile"`
+	ID      string `json:"id"`
+	Stamp   int64  `json:"stamp"`
+}
+
+//size of channel buffer for each subscribers.
+//events are dropped if a subscriber is slower than this.
+const bufferSize = 64
+
+var (
+	mutex       sync.RWMutex
+	subscribers = make(map[chan *Event]struct{})
+)
+
+//Subscribe returns a channel which receives published events.
+//It returns nil if there are already max subscribers. max<=0 means no limit.
+func Subscribe(max int) chan *Event {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if max > 0 && len(subscribers) >= max {
+		return nil
+	}
+	ch := make(chan *Event, bufferSize)
+	subscribers[ch] = struct{}{}
+	return ch
+}
+
+//Unsubscribe stops sending events to ch.
+func Unsubscribe(ch chan *Event) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(subscribers, ch)
+}
+
+//Publish sends e to all subscribers without blocking.
+func Publish(e *Event) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	for ch := range subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
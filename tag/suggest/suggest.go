@@ -31,7 +31,6 @@ package suggest
 import (
 	"log"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/record"
@@ -42,7 +41,7 @@ import (
 //Get returns copy of Slice associated with datfile or returns def if not exists.
 func Get(datfile string, def tag.Slice) tag.Slice {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.MapKeys(tx, "sugtag", []byte(datfile))
 		return err
@@ -66,7 +65,7 @@ func Get(datfile string, def tag.Slice) tag.Slice {
 //keys return datfile names of Sugtaglist.
 func keys() []string {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.KeyStrings(tx, "sugtag")
 		return err
@@ -79,7 +78,7 @@ func keys() []string {
 }
 
 //AddString adds tags to datfile from tagstrings.
-func AddString(tx *bolt.Tx, datfile string, vals []string) {
+func AddString(tx db.Tx, datfile string, vals []string) {
 	for _, v := range vals {
 		if !tag.IsOK(v) {
 			continue
@@ -93,7 +92,7 @@ func AddString(tx *bolt.Tx, datfile string, vals []string) {
 //HasTagstr return true if one of tags has tagstr
 func HasTagstr(datfile string, tagstr string) bool {
 	var r bool
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		r = db.HasVal(tx, "sugtag", []byte(datfile), tagstr)
 		return nil
 	})
@@ -121,7 +120,7 @@ func Prune(recs []*record.Head) {
 			tmp = append(tmp[:l], tmp[l+1:]...)
 		}
 	}
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		for _, datfile := range tmp {
 			err := db.Del(tx, "sugtag", []byte(datfile))
 			if err != nil {
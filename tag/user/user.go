@@ -31,7 +31,6 @@ package user
 import (
 	"log"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/tag"
 )
@@ -45,7 +44,7 @@ func String(thread string) string {
 //Len  returns # of usertags.
 func Len(thread string) int {
 	var r map[string]struct{}
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var errr error
 		r, errr = db.GetMap(tx, "usertag", []byte(thread))
 		return errr
@@ -59,7 +58,7 @@ func Len(thread string) int {
 //Has returns true if thread has the tag.
 func Has(thread string, tag ...string) bool {
 	rr := false
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		for _, t := range tag {
 			if db.HasVal(tx, "usertag", []byte(thread), t) {
 				rr = true
@@ -77,7 +76,7 @@ func Has(thread string, tag ...string) bool {
 //Get tags from the disk and returns Slice.
 func Get() tag.Slice {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.KeyStrings(tx, "usertagTag")
 		return err
@@ -91,7 +90,7 @@ func Get() tag.Slice {
 //GetStrings gets thread tags from the disk
 func GetStrings(thread string) []string {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.MapKeys(tx, "usertag", []byte(thread))
 		return err
@@ -102,6 +101,20 @@ func GetStrings(thread string) []string {
 	return r
 }
 
+//Threads returns thread names which have the tag.
+func Threads(tag string) []string {
+	var r []string
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		r, err = db.MapKeys(tx, "usertagTag", []byte(tag))
+		return err
+	})
+	if err != nil {
+		return nil
+	}
+	return r
+}
+
 //GetByThread gets thread tags from the disk
 func GetByThread(thread string) tag.Slice {
 	r := GetStrings(thread)
@@ -110,7 +123,7 @@ func GetByThread(thread string) tag.Slice {
 
 //Add saves tag strings.
 func Add(thread string, tag []string) {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		return AddTX(tx, thread, tag)
 	})
 	if err != nil {
@@ -119,7 +132,7 @@ func Add(thread string, tag []string) {
 }
 
 //AddTX saves tag strings.
-func AddTX(tx *bolt.Tx, thread string, tag []string) error {
+func AddTX(tx db.Tx, thread string, tag []string) error {
 	for _, t := range tag {
 		if err := db.PutMap(tx, "usertag", []byte(thread), t); err != nil {
 			return err
@@ -133,7 +146,7 @@ func AddTX(tx *bolt.Tx, thread string, tag []string) error {
 
 //Set remove all tags and saves tag strings.
 func Set(thread string, tag []string) {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		ts, err := db.GetMap(tx, "usertag", []byte(thread))
 		if err != nil {
 			log.Println(err)
@@ -30,10 +30,10 @@ package thread
 
 import (
 	"log"
+	"math"
 	"strings"
 	"time"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
 	"github.com/shingetsu-gou/shingetsu-gou/recentlist"
@@ -59,7 +59,7 @@ func NewCache(datfile string) *Cache {
 //Stamp returns latest stampl of records in the cache.
 func (c *Cache) Stamp() int64 {
 	var r []*record.DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = record.GetFromDBs(tx, c.Datfile)
 		return err
@@ -91,48 +91,33 @@ func (c *Cache) Len(kind int) int {
 	return len(m)
 }
 
-//Velocity returns number of records in one days in the cache.
+//Velocity returns number of records in one week in the cache.
+//only records of the cache are counted; NewSortByVelocity counts all caches at once.
 func (c *Cache) Velocity() int {
-	var r []*record.DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
-		var err error
-		r, err = record.GetFromDBs(tx, c.Datfile)
-		return err
+	var n int
+	t := time.Now().Add(-7 * 24 * time.Hour).Unix()
+	err := db.DB.View(func(tx db.Tx) error {
+		return record.ForEachInThread(tx, c.Datfile, t, math.MaxInt64, func(h *record.Head) error {
+			n++
+			return nil
+		})
 	})
 	if err != nil {
 		log.Print(err)
 		return 0
 	}
-	cnt := 0
-	t := int64(time.Now().Add(-7 * 24 * time.Hour).Second())
-	for _, rr := range r {
-		if rr.Stamp > t {
-			cnt++
-		}
-	}
-	return cnt
+	return n
+}
+
+//velocities returns number of records in one week for each datfiles.
+func velocities() map[string]int {
+	t := time.Now().Add(-7 * 24 * time.Hour).Unix()
+	return record.CountByDatfile(t, math.MaxInt64)
 }
 
 //Size returns sum of body char length of records in the cache.
 func (c *Cache) Size() int64 {
-	if c.Len(record.All) == 0 {
-		return 0
-	}
-	var r []*record.DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
-		var err error
-		r, err = record.GetFromDBs(tx, c.Datfile)
-		return err
-	})
-	if err != nil {
-		log.Print(err)
-		return 0
-	}
-	var cnt int64
-	for _, rr := range r {
-		cnt += int64(len(rr.Body))
-	}
-	return cnt
+	return record.GetStat(c.Datfile).Size
 }
 
 //LoadRecords loads and returns record maps from the disk..
@@ -145,8 +130,8 @@ func (c *Cache) LoadRecords(kind int) record.Map {
 	return m
 }
 
-//subscribe add the thread to thread db.
-func (c *Cache) subscribe(tx *bolt.Tx) {
+//SubscribeTX add the thread to thread db.
+func (c *Cache) SubscribeTX(tx db.Tx) {
 	err := db.Put(tx, "thread", []byte(c.Datfile), []byte(""))
 	if err != nil {
 		log.Print(err)
@@ -154,9 +139,13 @@ func (c *Cache) subscribe(tx *bolt.Tx) {
 }
 
 //Subscribe add the thread to thread db.
+//the thread is not regarded as evicted anymore.
 func (c *Cache) Subscribe() {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
-		c.subscribe(tx)
+	err := db.DB.Update(func(tx db.Tx) error {
+		c.SubscribeTX(tx)
+		if evicted, _ := db.HasKey(tx, "evicted", []byte(c.Datfile)); evicted {
+			return db.Del(tx, "evicted", []byte(c.Datfile))
+		}
 		return nil
 	})
 	if err != nil {
@@ -168,7 +157,7 @@ func (c *Cache) Subscribe() {
 //adds the rec to cache if meets conditions.
 //if spam or big data, remove the rec from disk.
 //returns spam/getting error.
-func (c *Cache) CheckData(tx *bolt.Tx, res string, stamp int64,
+func (c *Cache) CheckData(tx db.Tx, res string, stamp int64,
 	id string, begin, end int64) error {
 	r := record.New(c.Datfile, "", 0)
 	if errr := r.Parse(res); errr != nil {
@@ -201,7 +190,7 @@ func (c *Cache) CheckData(tx *bolt.Tx, res string, stamp int64,
 
 //Remove Remove all files and dirs of cache.
 func (c *Cache) Remove() {
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		r, err := record.GetFromDBs(tx, c.Datfile)
 		if err != nil {
 			return err
@@ -209,17 +198,28 @@ func (c *Cache) Remove() {
 		for _, rr := range r {
 			rr.Del(tx)
 		}
+		for _, b := range []string{"threadRead", "localPost"} {
+			if err := db.Del(tx, b, []byte(c.Datfile)); err != nil {
+				log.Println(err)
+			}
+		}
+		if err := record.DelStat(tx, c.Datfile); err != nil {
+			log.Println(err)
+		}
 		return db.Del(tx, "thread", []byte(c.Datfile))
 	})
 	if err != nil {
 		log.Println(err)
 	}
+	reads.Lock()
+	delete(reads.stamps, c.Datfile)
+	reads.Unlock()
 }
 
 //HasRecord return true if  cache has more than one records or removed records.
 func (c *Cache) HasRecord() bool {
 	var r []*record.DB
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = record.GetFromDBs(tx, c.Datfile)
 		return err
@@ -239,7 +239,7 @@ func (c *Cache) HasRecord() bool {
 //Exists return true is datapath exists.
 func (c *Cache) Exists() bool {
 	var cnt bool
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		cnt, err = db.HasKey(tx, "thread", []byte(c.Datfile))
 		return err
@@ -280,14 +280,16 @@ func (c *Cache) GetContents() []string {
 }
 
 //CreateAllCachedirs creates all dirs in recentlist to be retrived when called recentlist.getall.
+//evicted threads are not created.
 //(heavymoon)
 func CreateAllCachedirs() {
 	recs := recentlist.GetRecords()
-	err := db.DB.Update(func(tx *bolt.Tx) error {
+	err := db.DB.Update(func(tx db.Tx) error {
 		for _, rh := range recs {
 			ca := NewCache(rh.Datfile)
-			if !ca.Exists() {
-				ca.subscribe(tx)
+			evicted, _ := db.HasKey(tx, "evicted", []byte(rh.Datfile))
+			if !ca.Exists() && !evicted {
+				ca.SubscribeTX(tx)
 			}
 		}
 		return nil
@@ -32,18 +32,31 @@ import (
 	"log"
 	"time"
 
-	"regexp"
-
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/record"
 )
 
+func init() {
+	metrics.NewGaugeFunc("gou_threads", "Number of subscribed threads.", func() float64 {
+		var n int
+		err := db.DB.View(func(tx db.Tx) error {
+			var err error
+			n, err = db.Count(tx, "thread", nil)
+			return err
+		})
+		if err != nil {
+			log.Println(err)
+		}
+		return float64(n)
+	})
+}
+
 //AllCaches returns all  thread names
 func AllCaches() Caches {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.KeyStrings(tx, "thread")
 		return err
@@ -62,7 +75,7 @@ func AllCaches() Caches {
 //Len returns # of Caches
 func Len() int {
 	var r []string
-	err := db.DB.View(func(tx *bolt.Tx) error {
+	err := db.DB.View(func(tx db.Tx) error {
 		var err error
 		r, err = db.GetPrefixs(tx, "record")
 		return err
@@ -75,51 +88,69 @@ func Len() int {
 	return len(r)
 }
 
-//Search reloads records in Caches in cachelist
-//and returns slice of cache which matches query.
-func Search(q string) Caches {
-	reg, err := regexp.Compile(q)
-	if err != nil {
-		log.Println(err)
-		return nil
+//CleanRecords removes records expired by save_record and save_size.
+func CleanRecords() {
+	if cfg.SaveRecord <= 0 {
+		return
 	}
-	var cnt []string
-	err = db.DB.View(func(tx *bolt.Tx) error {
-		return record.ForEach(tx,
-			func(d *record.DB) error {
-				if reg.Match([]byte(d.Body)) {
-					cnt = append(cnt, d.Datfile)
-				}
-				return nil
-			})
+	err := db.DB.Update(func(tx db.Tx) error {
+		olds, err := prunable(tx)
+		if err != nil {
+			return err
+		}
+		for _, h := range olds {
+			d := record.DB{Head: h}
+			d.Del(tx)
+		}
+		return nil
 	})
 	if err != nil {
 		log.Println(err)
 	}
-	result := make([]*Cache, len(cnt))
-
-	for i, rr := range cnt {
-		result[i] = NewCache(rr)
-	}
-	return result
 }
 
-//CleanRecords remove old or duplicates records for each Caches.
-func CleanRecords() {
+//Prunable returns heads of records which would be removed by CleanRecords.
+func Prunable() []*record.Head {
 	if cfg.SaveRecord <= 0 {
-		return
+		return nil
 	}
-	err := db.DB.Update(func(tx *bolt.Tx) error {
-		return record.ForEach(tx, func(rec *record.DB) error {
-			if rec.Head.Stamp < time.Now().Unix()-cfg.SaveRecord {
-				rec.Del(tx)
-			}
-			return nil
-		})
+	var hs []*record.Head
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		hs, err = prunable(tx)
+		return err
 	})
 	if err != nil {
 		log.Println(err)
 	}
+	return hs
+}
+
+//prunable returns heads of records older than save_record,
+//except save_size newest records in each thread, which are kept regardless of age.
+func prunable(tx db.Tx) ([]*record.Head, error) {
+	olds, err := headsBefore(tx, time.Now().Unix()-cfg.SaveRecord)
+	if err != nil {
+		return nil, err
+	}
+	expire := make(map[string]int)
+	var hs []*record.Head
+	for _, h := range olds {
+		n, ok := expire[h.Datfile]
+		if !ok {
+			cnt, err := db.Count(tx, "record", db.ToKey(h.Datfile))
+			if err != nil {
+				return nil, err
+			}
+			n = cnt - cfg.SaveSize
+		}
+		//olds are sorted by stamp, so newer ones are kept.
+		if n > 0 {
+			hs = append(hs, h)
+		}
+		expire[h.Datfile] = n - 1
+	}
+	return hs, nil
 }
 
 //RemoveRemoved removes files in removed dir if old.
@@ -127,16 +158,34 @@ func RemoveRemoved() {
 	if cfg.SaveRemoved <= 0 {
 		return
 	}
-	err := db.DB.Update(func(tx *bolt.Tx) error {
-		return record.ForEach(tx,
-			func(rec *record.DB) error {
-				if rec.Deleted && rec.Head.Stamp < time.Now().Unix()-cfg.SaveRemoved {
-					rec.Del(tx)
-				}
-				return nil
-			})
+	err := db.DB.Update(func(tx db.Tx) error {
+		olds, err := headsBefore(tx, time.Now().Unix()-cfg.SaveRemoved)
+		if err != nil {
+			return err
+		}
+		for _, h := range olds {
+			rec, err := record.GetFromDB(tx, h)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if rec.Deleted {
+				rec.Del(tx)
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		log.Println(err)
 	}
 }
+
+//headsBefore returns heads of records whose stamp is older than stamp.
+func headsBefore(tx db.Tx, stamp int64) ([]*record.Head, error) {
+	var hs []*record.Head
+	err := record.ForEachInRange(tx, 0, stamp-1, func(h *record.Head) error {
+		hs = append(hs, h)
+		return nil
+	})
+	return hs, err
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package thread
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+)
+
+func TestPrunable(t *testing.T) {
+	db.DB = db.NewMemory()
+	cfg.SaveRecord = 24 * 60 * 60
+	cfg.SaveSize = 2
+	now := time.Now().Unix()
+	var olds []*record.Record
+	for i, stamp := range []int64{now - 4000000, now - 3000000, now - 2000000, now - 1000000} {
+		r := record.New("thread_31", "", 0)
+		r.Build(stamp, map[string]string{"body": string('a' + rune(i))}, "")
+		r.Sync()
+		olds = append(olds, r)
+	}
+	r := record.New("thread_32", "", 0)
+	r.Build(now-4000000, map[string]string{"body": "old"}, "")
+	r.Sync()
+	r = record.New("thread_32", "", 0)
+	r.Build(now, map[string]string{"body": "new"}, "")
+	r.Sync()
+
+	hs := Prunable()
+	if len(hs) != 2 || hs[0].Idstr() != olds[0].Idstr() || hs[1].Idstr() != olds[1].Idstr() {
+		t.Fatal("illegal prunable records", hs)
+	}
+	CleanRecords()
+	if n := NewCache("thread_31").Len(record.All); n != 2 {
+		t.Fatal("records are not pruned", n)
+	}
+	if n := NewCache("thread_32").Len(record.All); n != 2 {
+		t.Fatal("records are pruned", n)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	db.DB = db.NewMemory()
+	cfg.DiskQuota = 1
+	cfg.ThreadQuota = 0
+	for _, datfile := range []string{"thread_31", "thread_32", "thread_33"} {
+		ca := NewCache(datfile)
+		ca.Subscribe()
+		r := record.New(datfile, "", 0)
+		r.Build(time.Now().Unix(), map[string]string{"body": datfile}, "")
+		r.Sync()
+	}
+	user.Add("thread_31", []string{"tag"})
+	NewCache("thread_32").MarkPosted()
+	Evict()
+	for datfile, exists := range map[string]bool{"thread_31": true, "thread_32": true, "thread_33": false} {
+		if NewCache(datfile).Exists() != exists {
+			t.Fatal("illegal eviction", datfile)
+		}
+	}
+	if !NewCache("thread_33").Evicted() || NewCache("thread_31").Evicted() {
+		t.Fatal("evicted thread is not remembered")
+	}
+	if st := record.GetStat("thread_33"); st.Size != 0 {
+		t.Fatal("stat of evicted thread remains", st)
+	}
+	NewCache("thread_33").Subscribe()
+	if NewCache("thread_33").Evicted() {
+		t.Fatal("subscribed thread is regarded as evicted")
+	}
+}
+
+func TestVelocity(t *testing.T) {
+	db.DB = db.NewMemory()
+	now := time.Now().Unix()
+	for i, stamp := range []int64{now - 8*24*60*60, now - 60, now} {
+		r := record.New("thread_31", "", 0)
+		r.Build(stamp, map[string]string{"body": string('a' + rune(i))}, "")
+		r.Sync()
+	}
+	r := record.New("thread_32", "", 0)
+	r.Build(now, map[string]string{"body": "other"}, "")
+	r.Sync()
+	if v := NewCache("thread_31").Velocity(); v != 2 {
+		t.Fatal("illegal velocity", v)
+	}
+	if v := velocities()["thread_31"]; v != 2 {
+		t.Fatal("illegal velocities", v)
+	}
+}
@@ -99,8 +99,9 @@ func NewSortByVelocity(cs Caches) *SortByVelocity {
 		velocity: make([]int, cs.Len()),
 		size:     make([]int64, cs.Len()),
 	}
+	vs := velocities()
 	for i, v := range cs {
-		s.velocity[i] = v.Velocity()
+		s.velocity[i] = vs[v.Datfile]
 		s.size[i] = v.Size()
 	}
 	return s
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package thread
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+)
+
+//MaxBuckets is the max number of buckets in one digest.
+const MaxBuckets = 64
+
+//Bucket is the digest of alive records in a thread whose stamps are from Begin to End.
+//Hash is xor of md5 of Idstr of the records, so it doesn't depend on the order of records.
+type Bucket struct {
+	Begin int64
+	End   int64
+	Count int
+	Hash  [md5.Size]byte
+}
+
+//add adds the record whose Idstr is idstr to b.
+func (b *Bucket) add(idstr string) {
+	h := md5.Sum([]byte(idstr))
+	for i := range b.Hash {
+		b.Hash[i] ^= h[i]
+	}
+	b.Count++
+}
+
+//String returns b in the form of "begin-end<>count<>hash".
+func (b *Bucket) String() string {
+	return fmt.Sprintf("%d-%d<>%d<>%s", b.Begin, b.End, b.Count, hex.EncodeToString(b.Hash[:]))
+}
+
+//Equals returns true if b and bb have same range and same records.
+func (b *Bucket) Equals(bb *Bucket) bool {
+	return b.Begin == bb.Begin && b.End == bb.End && b.Count == bb.Count && b.Hash == bb.Hash
+}
+
+//ParseBucket parses line made by Bucket.String.
+func ParseBucket(line string) (*Bucket, error) {
+	errFormat := errors.New("illegal format " + line)
+	strs := strings.Split(line, "<>")
+	if len(strs) != 3 {
+		return nil, errFormat
+	}
+	stamps := strings.Split(strs[0], "-")
+	if len(stamps) != 2 {
+		return nil, errFormat
+	}
+	b := &Bucket{}
+	var err error
+	if b.Begin, err = strconv.ParseInt(stamps[0], 10, 64); err != nil {
+		return nil, err
+	}
+	if b.End, err = strconv.ParseInt(stamps[1], 10, 64); err != nil {
+		return nil, err
+	}
+	if b.Count, err = strconv.Atoi(strs[1]); err != nil {
+		return nil, err
+	}
+	h, err := hex.DecodeString(strs[2])
+	if err != nil || len(h) != md5.Size {
+		return nil, errFormat
+	}
+	copy(b.Hash[:], h)
+	return b, nil
+}
+
+//SplitRange splits stamps from begin to end into at most num buckets with same width
+//except the last one.
+//bounds are calculated in uint64 so that they don't overflow with any range.
+func SplitRange(begin, end int64, num int) []*Bucket {
+	if end < begin || num <= 0 {
+		return nil
+	}
+	span := uint64(end) - uint64(begin)
+	width := span/uint64(num) + 1
+	if width == 0 {
+		//span is max of uint64 and num is 1.
+		return []*Bucket{{Begin: begin, End: end}}
+	}
+	var bs []*Bucket
+	for i := 0; i < num; i++ {
+		off := uint64(i) * width
+		if off > span {
+			break
+		}
+		e := end
+		if width-1 < span-off {
+			e = int64(uint64(begin) + off + width - 1)
+		}
+		bs = append(bs, &Bucket{
+			Begin: int64(uint64(begin) + off),
+			End:   e,
+		})
+	}
+	return bs
+}
+
+//Digest returns digests of recs whose stamps are from begin to end,
+//in num buckets made by SplitRange.
+func Digest(recs record.Map, begin, end int64, num int) []*Bucket {
+	bs := SplitRange(begin, end, num)
+	for _, r := range recs {
+		if r.Stamp < begin || r.Stamp > end {
+			continue
+		}
+		i := sort.Search(len(bs), func(i int) bool {
+			return bs[i].End >= r.Stamp
+		})
+		bs[i].add(r.Idstr())
+	}
+	return bs
+}
+
+//Digest returns digests of alive records in c whose stamps are from begin to end.
+func (c *Cache) Digest(begin, end int64, num int) []*Bucket {
+	return Digest(c.LoadRecords(record.Alive), begin, end, num)
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package thread
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+)
+
+func TestDigest(t *testing.T) {
+	bs := SplitRange(0, 99, 16)
+	if len(bs) != 15 || bs[0].End != 6 || bs[14].Begin != 98 || bs[14].End != 99 {
+		t.Fatal("illegal split", bs)
+	}
+	if bs := SplitRange(10, 12, 16); len(bs) != 3 || bs[2].Begin != 12 {
+		t.Fatal("illegal split", bs)
+	}
+	for _, r := range [][2]int64{{0, math.MaxInt64}, {math.MaxInt64 - 1, math.MaxInt64}, {math.MinInt64, math.MaxInt64}} {
+		for _, num := range []int{1, 16, MaxBuckets} {
+			bs := SplitRange(r[0], r[1], num)
+			if len(bs) == 0 || len(bs) > num || bs[0].Begin != r[0] || bs[len(bs)-1].End != r[1] {
+				t.Fatal("illegal split", r, num, bs)
+			}
+			for i := 1; i < len(bs); i++ {
+				if bs[i].Begin != bs[i-1].End+1 || bs[i].End < bs[i].Begin {
+					t.Fatal("illegal split", r, num, bs[i-1], bs[i])
+				}
+			}
+		}
+	}
+
+	a := make(record.Map)
+	b := make(record.Map)
+	for i := int64(0); i < 100; i++ {
+		r := &record.Record{
+			Head: &record.Head{
+				Datfile: "thread_digest",
+				Stamp:   i * 10,
+				ID:      fmt.Sprintf("%032d", i),
+			},
+		}
+		a[r.Idstr()] = r
+		if i != 42 {
+			b[r.Idstr()] = r
+		}
+	}
+	d1 := Digest(a, 0, 999, 16)
+	d2 := Digest(b, 0, 999, 16)
+	var diff []*Bucket
+	count := 0
+	for i := range d1 {
+		count += d1[i].Count
+		if !d1[i].Equals(d2[i]) {
+			diff = append(diff, d1[i])
+		}
+	}
+	if count != 100 || len(diff) != 1 || diff[0].Begin > 420 || diff[0].End < 420 {
+		t.Fatal("illegal digests", count, diff)
+	}
+	bb, err := ParseBucket(diff[0].String())
+	if err != nil || !bb.Equals(diff[0]) {
+		t.Fatal("illegal parsed bucket", bb, err)
+	}
+	if _, err := ParseBucket("1-2<>3"); err == nil {
+		t.Fatal("illegal bucket was parsed")
+	}
+}
@@ -29,15 +29,16 @@
 package download
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
 	"sync"
 	"time"
 
-	"github.com/boltdb/bolt"
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
 	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
 	"github.com/shingetsu-gou/shingetsu-gou/node/manager"
 	"github.com/shingetsu-gou/shingetsu-gou/recentlist"
@@ -182,16 +183,21 @@ func (dm *Manager) Finished(n *node.Node, success bool) {
 	dm.checkFinished()
 }
 
-//headWithRange checks node n has records with range and adds records which should be downloaded to downloadmanager.
-func headWithRange(n *node.Node, c *thread.Cache, dm *Manager) bool {
-	begin := time.Now().Unix() - cfg.GetRange
-	if rec, err := recentlist.Newest(c.Datfile); err == nil {
-		begin = rec.Stamp - cfg.GetRange
-	}
-	if cfg.GetRange == 0 || begin < 0 {
-		begin = 0
+//headWithRange checks node n has records after begin and adds records which should be downloaded to downloadmanager.
+//if n supports digest, only heads of records in ranges whose digests differ are asked.
+func headWithRange(n *node.Node, c *thread.Cache, dm *Manager, begin int64) bool {
+	var res []string
+	var err error
+	if n.Capable(node.CapDigest) {
+		var count int
+		res, count, err = reconcile(n, c, begin, time.Now().Unix())
+		if err == nil && count > 0 && len(res) == 0 {
+			manager.AppendToTable(c.Datfile, n)
+			return false
+		}
+	} else {
+		res, err = n.Talk(fmt.Sprintf("/head/%s/%d-", c.Datfile, begin), nil)
 	}
-	res, err := n.Talk(fmt.Sprintf("/head/%s/%d-", c.Datfile, begin), nil)
 	if err != nil {
 		return false
 	}
@@ -209,6 +215,31 @@ func headWithRange(n *node.Node, c *thread.Cache, dm *Manager) bool {
 	return true
 }
 
+//rangeBegin returns the stamp from which records of c are gotten.
+func rangeBegin(c *thread.Cache) int64 {
+	begin := time.Now().Unix() - cfg.GetRange
+	if rec, err := recentlist.Newest(c.Datfile); err == nil {
+		begin = rec.Stamp - cfg.GetRange
+	}
+	if cfg.GetRange == 0 || begin < 0 {
+		begin = 0
+	}
+	return begin
+}
+
+//syncBegin returns the stamp from which records of c are gotten when syncing all threads,
+//which is not older than sync_range.
+func syncBegin(c *thread.Cache) int64 {
+	begin := rangeBegin(c)
+	if cfg.SyncRange == 0 {
+		return begin
+	}
+	if limit := time.Now().Unix() - cfg.SyncRange; begin < limit {
+		begin = limit
+	}
+	return begin
+}
+
 //getWithRange gets records with range using node n and adds to cache after checking them.
 //if no records exist in cache, uses head
 //return true if gotten records>0
@@ -221,43 +252,63 @@ func getWithRange(n *node.Node, c *thread.Cache, dm *Manager) bool {
 		}
 
 		var okcount int
+		var spam bool
 		ress, err := n.Talk(fmt.Sprintf("/get/%s/%d-%d", c.Datfile, from, to), nil)
 		if err != nil {
 			dm.Finished(n, false)
 			return false
 		}
-		err = db.DB.Update(func(tx *bolt.Tx) error {
+		err = db.DB.Update(func(tx db.Tx) error {
 			for _, res := range ress {
 				errf := c.CheckData(tx, res, -1, "", from, to)
 				if errf == nil {
 					okcount++
 				}
+				if errf == cfg.ErrSpam {
+					spam = true
+				}
 			}
 			return nil
 		})
 		if err != nil {
 			log.Println(err)
 		}
+		if spam {
+			manager.Spammed(n)
+		}
 		dm.Finished(n, true)
 		log.Println(c.Datfile, okcount, "records were saved from", n.Nodestr)
 		got = okcount > 0
 	}
 }
 
+var (
+	inflightThreads = metrics.NewGauge("gou_download_threads", "Number of threads being downloaded.")
+	inflightTalks   = metrics.NewGauge("gou_download_talks", "Number of nodes being talked for downloading threads.")
+)
+
 //GetCache checks  nodes in lookuptable have the cache.
 //if found gets records.
 func GetCache(background bool, c *thread.Cache) bool {
+	return getCache(background, c, rangeBegin(c))
+}
+
+//getCache checks nodes in lookuptable have the cache and gets records after begin.
+func getCache(background bool, c *thread.Cache, begin int64) bool {
 	const searchDepth = 100 // Search node size
 	ns := manager.NodesForGet(c.Datfile, searchDepth)
 	found := false
 	var wg sync.WaitGroup
 	var mutex sync.RWMutex
 	dm := NewManger(c)
+	inflightThreads.Inc()
 	for _, n := range ns {
 		wg.Add(1)
+		inflightTalks.Inc()
 		go func(n *node.Node) {
 			defer wg.Done()
-			if !headWithRange(n, c, dm) {
+			defer inflightTalks.Dec()
+			if !headWithRange(n, c, dm, begin) {
 				return
 			}
 			if getWithRange(n, c, dm) {
@@ -268,6 +319,10 @@ func GetCache(background bool, c *thread.Cache) bool {
 			}
 		}(n)
 	}
+	go func() {
+		wg.Wait()
+		inflightThreads.Dec()
+	}()
 	if background {
 		bg(c, &wg)
 	} else {
@@ -304,10 +359,158 @@ func bg(c *thread.Cache, wg *sync.WaitGroup) {
 }
 
 //Getall reload all records in cache in cachelist from network.
+//threads which are not updated in sync_range or evicted are skipped,
+//and records older than sync_range are not gotten.
+//records are gotten in batches from nodes which support it at first,
+//and threads which are not gotten are downloaded one by one.
 func Getall() {
+	var cas []*thread.Cache
+	limit := time.Now().Unix() - cfg.SyncRange
 	for _, ca := range thread.AllCaches() {
+		if cfg.SyncRange > 0 && ca.RecentStamp() < limit || ca.Evicted() {
+			continue
+		}
+		cas = append(cas, ca)
+	}
+	for _, ca := range getBatch(cas) {
 		log.Println(ca.Datfile, "is downloading...")
-		GetCache(false, ca)
+		getCache(false, ca, syncBegin(ca))
 		log.Println(ca.Datfile, "end")
 	}
 }
+
+//getBatch syncs cas by batched talks with a node which supports batch.
+//heads of threads are asked at first, and only records which are not in caches are gotten.
+//returns caches which were not synced.
+func getBatch(cas []*thread.Cache) []*thread.Cache {
+	const searchDepth = 5
+	var rest []*thread.Cache
+	for len(cas) > 0 {
+		num := len(cas)
+		if num > node.MaxBatch {
+			num = node.MaxBatch
+		}
+		chunk := cas[:num]
+		cas = cas[num:]
+		var n *node.Node
+		for _, nn := range manager.NodesForGet(chunk[0].Datfile, searchDepth) {
+			if nn.Capable(node.CapBatch) {
+				n = nn
+				break
+			}
+		}
+		if n == nil {
+			rest = append(rest, chunk...)
+			continue
+		}
+		ranges := make([]*node.Range, len(chunk))
+		for i, ca := range chunk {
+			ranges[i] = &node.Range{
+				Datfile: ca.Datfile,
+				Begin:   syncBegin(ca),
+			}
+		}
+		heads, err := n.Batch(context.Background(), "head", ranges)
+		if err != nil {
+			rest = append(rest, chunk...)
+			continue
+		}
+		missing := make(map[string]map[string]struct{})
+		var gets []*node.Range
+		for _, ca := range chunk {
+			if len(heads[ca.Datfile]) == 0 {
+				rest = append(rest, ca)
+				continue
+			}
+			m, rs := missingRanges(ca, heads[ca.Datfile])
+			if len(m) == 0 {
+				continue
+			}
+			missing[ca.Datfile] = m
+			gets = append(gets, rs...)
+		}
+		if len(gets) == 0 {
+			continue
+		}
+		res, err := n.Batch(context.Background(), "get", gets)
+		for _, ca := range chunk {
+			m, exist := missing[ca.Datfile]
+			if !exist {
+				continue
+			}
+			if err != nil || !saveBatch(n, ca, res[ca.Datfile], m) {
+				rest = append(rest, ca)
+			}
+		}
+	}
+	return rest
+}
+
+//missingRanges returns idstrs of records in heads which are not in ca,
+//and ranges of stamps which cover them.
+func missingRanges(ca *thread.Cache, heads []string) (map[string]struct{}, []*node.Range) {
+	recs := ca.LoadRecords(record.All)
+	var hs []*record.Head
+	for _, h := range record.ParseHeadResponse(heads, ca.Datfile) {
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool {
+		return hs[i].Stamp < hs[j].Stamp
+	})
+	missing := make(map[string]struct{})
+	var rs []*node.Range
+	var last *node.Range
+	for _, h := range hs {
+		if _, exist := recs[h.Idstr()]; exist {
+			last = nil
+			continue
+		}
+		missing[h.Idstr()] = struct{}{}
+		if last != nil {
+			last.End = h.Stamp
+			continue
+		}
+		last = &node.Range{
+			Datfile: ca.Datfile,
+			Begin:   h.Stamp,
+			End:     h.Stamp,
+		}
+		rs = append(rs, last)
+	}
+	return missing, rs
+}
+
+//saveBatch saves records in ress gotten from n to ca after checking them,
+//and returns true if all records in missing were saved.
+func saveBatch(n *node.Node, ca *thread.Cache, ress []string, missing map[string]struct{}) bool {
+	var okcount int
+	var spam bool
+	err := db.DB.Update(func(tx db.Tx) error {
+		for _, res := range ress {
+			r := record.New(ca.Datfile, "", 0)
+			if errr := r.Parse(res); errr != nil {
+				continue
+			}
+			if _, exist := missing[r.Idstr()]; !exist {
+				continue
+			}
+			errf := ca.CheckData(tx, res, -1, "", 0, 0)
+			if errf == nil || errf == cfg.ErrSpam {
+				delete(missing, r.Idstr())
+				okcount++
+			}
+			if errf == cfg.ErrSpam {
+				spam = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	if spam {
+		manager.Spammed(n)
+	}
+	log.Println(ca.Datfile, okcount, "records were saved from", n.Nodestr, "in batch")
+	return len(missing) == 0
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package download
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shingetsu-gou/shingetsu-gou/node"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/thread"
+)
+
+const (
+	digestBuckets = 16 // # of buckets in one digest talk
+	headThreshold = 32 // buckets which have records less than this are compared by head
+	maxDepth      = 8  // max depth of splitting buckets
+)
+
+//reconcile compares digests of records in c from begin to end with ones of n,
+//splitting buckets which differ recursively, and returns heads of records in
+//the buckets which differ, with # of records n has in the range.
+func reconcile(n *node.Node, c *thread.Cache, begin, end int64) ([]string, int, error) {
+	recs := c.LoadRecords(record.Alive)
+	var heads []string
+	count := 0
+	var compare func(begin, end int64, depth int) error
+	compare = func(begin, end int64, depth int) error {
+		res, err := n.Talk(fmt.Sprintf("/digest/%s/%d-%d/%d", c.Datfile, begin, end, digestBuckets), nil)
+		if err != nil {
+			return err
+		}
+		local := thread.Digest(recs, begin, end, digestBuckets)
+		if len(res) != len(local) {
+			return errors.New("illegal digest from " + n.Nodestr)
+		}
+		for i, line := range res {
+			b, err := thread.ParseBucket(line)
+			if err != nil {
+				return err
+			}
+			if b.Begin != local[i].Begin || b.End != local[i].End {
+				return errors.New("illegal bucket " + line)
+			}
+			if depth == 0 {
+				count += b.Count
+			}
+			switch {
+			case b.Count == 0 || b.Equals(local[i]):
+			case b.Count < headThreshold || b.Begin == b.End || depth >= maxDepth:
+				res, err := n.Talk(fmt.Sprintf("/head/%s/%d-%d", c.Datfile, b.Begin, b.End), nil)
+				if err != nil {
+					return err
+				}
+				heads = append(heads, res...)
+			default:
+				if err := compare(b.Begin, b.End, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	err := compare(begin, end, 0)
+	return heads, count, err
+}
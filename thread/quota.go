@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2015, Shinya Yagyu
+ * All rights reserved.
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions are met:
+ *
+ * 1. Redistributions of source code must retain the above copyright notice,
+ *    this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright notice,
+ *    this list of conditions and the following disclaimer in the documentation
+ *    and/or other materials provided with the distribution.
+ * 3. Neither the name of the copyright holder nor the names of its
+ *    contributors may be used to endorse or promote products derived from this
+ *    software without specific prior written permission.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+ * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+ * LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+ * CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+ * SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+ * INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+ * CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+ * ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+ * POSSIBILITY OF SUCH DAMAGE.
+ */
+
+package thread
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/record"
+	"github.com/shingetsu-gou/shingetsu-gou/tag/user"
+)
+
+//reads stores the last time when threads were read, which are not flushed to db yet.
+var reads = struct {
+	stamps map[string]int64
+	sync.RWMutex
+}{
+	stamps: make(map[string]int64),
+}
+
+//Touch records that the thread is read now.
+//it is stored to db by FlushReads.
+func (c *Cache) Touch() {
+	reads.Lock()
+	defer reads.Unlock()
+	reads.stamps[c.Datfile] = time.Now().Unix()
+}
+
+//FlushReads stores the last time when threads were read to db.
+func FlushReads() {
+	reads.Lock()
+	stamps := reads.stamps
+	reads.stamps = make(map[string]int64)
+	reads.Unlock()
+	if len(stamps) == 0 {
+		return
+	}
+	err := db.DB.Update(func(tx db.Tx) error {
+		for datfile, stamp := range stamps {
+			if err := db.Put(tx, "threadRead", []byte(datfile), stamp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+//MarkPosted records that a record is posted to the thread from this node.
+func (c *Cache) MarkPosted() {
+	err := db.DB.Update(func(tx db.Tx) error {
+		return db.Put(tx, "localPost", []byte(c.Datfile), []byte(""))
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+//ReadStamp returns the last time when the thread was read.
+func (c *Cache) ReadStamp() int64 {
+	reads.RLock()
+	r, exist := reads.stamps[c.Datfile]
+	reads.RUnlock()
+	if exist {
+		return r
+	}
+	err := db.DB.View(func(tx db.Tx) error {
+		_, err := db.Get(tx, "threadRead", []byte(c.Datfile), &r)
+		return err
+	})
+	if err != nil {
+		return 0
+	}
+	return r
+}
+
+//Evicted returns true if the thread was removed by Evict and is not subscribed again.
+func (c *Cache) Evicted() bool {
+	var r bool
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		r, err = db.HasKey(tx, "evicted", []byte(c.Datfile))
+		return err
+	})
+	if err != nil {
+		return false
+	}
+	return r
+}
+
+//evict removes the thread and remembers it as evicted,
+//so that it is not subscribed automatically again.
+func (c *Cache) evict() {
+	c.Remove()
+	err := db.DB.Update(func(tx db.Tx) error {
+		return db.Put(tx, "evicted", []byte(c.Datfile), time.Now().Unix())
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+//evictable returns true if the thread can be evicted,
+//i.e. it has no user tags and no records posted from this node.
+func (c *Cache) evictable() bool {
+	if len(user.GetStrings(c.Datfile)) > 0 {
+		return false
+	}
+	var posted bool
+	err := db.DB.View(func(tx db.Tx) error {
+		var err error
+		posted, err = db.HasKey(tx, "localPost", []byte(c.Datfile))
+		return err
+	})
+	if err != nil {
+		return true
+	}
+	return !posted
+}
+
+//sortByUsed is for sorting caches by the last time when they were read or updated.
+type sortByUsed struct {
+	Caches
+	used []int64
+	size []int64
+}
+
+//Less returns true if cache[i] is used before cache[j].
+func (c *sortByUsed) Less(i, j int) bool {
+	return c.used[i] < c.used[j]
+}
+
+//Swap swaps order of cache slice.
+func (c *sortByUsed) Swap(i, j int) {
+	c.Caches[i], c.Caches[j] = c.Caches[j], c.Caches[i]
+	c.used[i], c.used[j] = c.used[j], c.used[i]
+	c.size[i], c.size[j] = c.size[j], c.size[i]
+}
+
+//Evict removes threads which are over thread_quota and least recently
+//read or updated threads until the total size is under disk_quota.
+//threads with user tags or records posted from this node are never removed.
+//sizes and stamps of threads are taken from stats stored in db.
+func Evict() {
+	FlushReads()
+	if cfg.DiskQuota <= 0 && cfg.ThreadQuota <= 0 {
+		return
+	}
+	stats := record.Stats()
+	s := &sortByUsed{}
+	var total int64
+	for _, ca := range AllCaches() {
+		st, exist := stats[ca.Datfile]
+		if !exist {
+			continue
+		}
+		total += st.Size
+		if !ca.evictable() {
+			continue
+		}
+		if cfg.ThreadQuota > 0 && st.Size > cfg.ThreadQuota {
+			log.Println(ca.Datfile, "is over thread quota, removing")
+			ca.evict()
+			total -= st.Size
+			continue
+		}
+		used := ca.ReadStamp()
+		if st.Stamp > used {
+			used = st.Stamp
+		}
+		s.Caches = append(s.Caches, ca)
+		s.used = append(s.used, used)
+		s.size = append(s.size, st.Size)
+	}
+	if cfg.DiskQuota <= 0 {
+		return
+	}
+	sort.Sort(s)
+	for i := 0; i < s.Len() && total > cfg.DiskQuota; i++ {
+		log.Println(s.Caches[i].Datfile, "is least recently used, removing")
+		s.Caches[i].evict()
+		total -= s.size[i]
+	}
+	if total > cfg.DiskQuota {
+		log.Println("cannot reduce cache size under disk quota", total)
+	}
+}
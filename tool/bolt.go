@@ -2,28 +2,76 @@ package main
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
-	"github.com/boltdb/bolt"
+	"github.com/shingetsu-gou/shingetsu-gou/db"
+	"github.com/shingetsu-gou/shingetsu-gou/fsck"
 )
 
 func main() {
-	db, err := bolt.Open("run/gou_bolt.db", 0644, nil)
+	var dbpath string
+	var repair bool
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s <options> fsck\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s <options> <bucket name>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.StringVar(&dbpath, "db", "run/gou_bolt.db", "path to the db file")
+	flag.BoolVar(&repair, "repair", false, "repair problems found by fsck in one transaction")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	s, err := db.OpenBolt(dbpath, !repair, time.Second)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer func() {
-		errr := db.Close()
+		errr := s.Close()
 		if errr != nil {
-			log.Println(err)
+			log.Println(errr)
 		}
 	}()
+	if flag.Arg(0) == "fsck" {
+		err = check(s, repair)
+	} else {
+		err = dump(s, flag.Arg(0))
+	}
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
 
-	err = db.View(func(tx *bolt.Tx) error {
-		// Assume bucket exists and has keys
-		b := tx.Bucket([]byte(os.Args[1]))
+//check prints problems in the db and repairs them if repair.
+func check(s db.Storage, repair bool) error {
+	problems, err := fsck.Check(s, repair)
+	if err != nil {
+		return err
+	}
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	switch {
+	case len(problems) == 0:
+		fmt.Println("no problems found")
+	case repair:
+		fmt.Println(len(problems), "problems were repaired")
+	default:
+		return fmt.Errorf("%d problems found, run with -repair to repair them", len(problems))
+	}
+	return nil
+}
+
+//dump prints all k/v in the bucket.
+func dump(s db.Storage, bucket string) error {
+	return s.View(func(tx db.Tx) error {
+		b := tx.Bucket([]byte(bucket))
 		if b == nil {
 			return errors.New("no bucket")
 		}
@@ -45,8 +93,4 @@ func main() {
 		}
 		return nil
 	})
-	log.Println(err)
 }
-
-// output:
-// Hello World!
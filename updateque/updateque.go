@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/shingetsu-gou/shingetsu-gou/cfg"
+	"github.com/shingetsu-gou/shingetsu-gou/metrics"
 	"github.com/shingetsu-gou/shingetsu-gou/node"
 	"github.com/shingetsu-gou/shingetsu-gou/node/manager"
 	"github.com/shingetsu-gou/shingetsu-gou/recentlist"
@@ -46,6 +47,8 @@ import (
 var mutex sync.Mutex
 var updated = make(map[[16]byte]time.Time)
 
+var broadcasts = metrics.NewCounter("gou_update_broadcasts_total", "Number of updates told to other nodes.")
+
 //UpdateNodes do doUpdateNode for each records using related nodes.
 //if success to doUpdateNode, add node to updatelist and recentlist and
 //removes the record from queue.
@@ -57,7 +60,7 @@ func UpdateNodes(rec *record.Record, n *node.Node) {
 	if !cfg.HeavyMoon {
 		return
 	}
-	if ca := thread.NewCache(rec.Datfile); !ca.Exists() {
+	if ca := thread.NewCache(rec.Datfile); !ca.Exists() && !ca.Evicted() {
 		ca.Subscribe()
 	}
 }
@@ -135,6 +138,7 @@ func doUpdateNode(rec *record.Record, n *node.Node) bool {
 		log.Println("no cache or updates by myself, broadcast updates.")
 		UpdatedRecord.register(rec.Head)
 		manager.TellUpdate(ca.Datfile, rec.Stamp, rec.ID, n)
+		broadcasts.Inc()
 		if UpdatedRecord.wait() || n != nil {
 			log.Println(rec.ID, "was gotten or don't have the record")
 		} else {
@@ -154,10 +158,12 @@ func doUpdateNode(rec *record.Record, n *node.Node) bool {
 		return false
 	case cfg.ErrSpam:
 		log.Println("marked spam")
+		manager.Spammed(n)
 		return true
 	default:
 		log.Println("telling update")
 		manager.TellUpdate(ca.Datfile, rec.Stamp, rec.ID, nil)
+		broadcasts.Inc()
 		manager.Join(n)
 		return true
 	}
@@ -12,6 +12,7 @@
 // www/21resanchor.js
 // www/40recform.js
 // www/41postadvanced.js
+// www/50stream.js
 // www/arazuki_saku.png
 // www/bootstrap/css/bootstrap.min.css
 // www/bootstrap/fonts/glyphicons-halflings-regular.eot
@@ -62,10 +63,12 @@
 // gou_template/new_element_form.txt
 // gou_template/page_navi.txt
 // gou_template/post_form.txt
+// gou_template/prune.txt
 // gou_template/record.txt
 // gou_template/remove_file_form.txt
 // gou_template/rss1.txt
 // gou_template/search_form.txt
+// gou_template/search_result.txt
 // gou_template/status.txt
 // gou_template/thread_bottom.txt
 // gou_template/thread_tags.txt
@@ -378,6 +381,26 @@ func www41postadvancedJs() (*asset, error) {
 	return a, nil
 }
 
+var _www50streamJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x4c\x51\x03\xa4\xd6\x2e\xe5\xec\x61\x51\xc4\x55\x83\xc2\x08\x8a\x16\x45\xbb\x58\x6f\x4e\xf9\x28\x18\x72\x64\x31\x90\x49\x81\x1c\xc5\x71\x1b\xff\xf7\x82\x92\x6c\x4b\x8e\x73\xe8\x4a\x17\x41\x7c\x33\xf3\xe6\xcd\xe3\xa4\x1f\x46\xf0\x01\x96\x85\xdb\x80\xc5\x0d\x54\x2e\x50\x00\x63\x81\x0a\x04\x2a\x3c\x4a\x0d\x1b\x43\x85\xab\x09\x3c\x96\x4e\x6a\x63\x57\x22\x86\x2c\x5c\xb5\xf5\x66\x55\x10\xf0\x45\x02\x1f\x67\x17\x9f\x20\x14\xc6\xfe\x7a\xfd\x35\xd4\xf0\xd9\xbb\x27\x54\x14\x81\xe9\x68\x14\xff\xaf\x90\x42\x2d\x8c\x35\x64\x64\x69\xfe\x41\x9e\xd7\x56\x91\x71\x16\x78\x02\xff\x8e\x00\x00\x9e\xa5\x87\xb5\x24\x55\x40\x06\xa5\x53\x32\x9e\x8a\x4a\x52\x61\xe5\x1a\x45\x73\xc2\xd3\x87\xbb\xf4\xaa\xe5\x25\xd4\xca\xdc\xa5\xfc\xf6\xe1\x2e\xbd\x9f\x24\xe3\x34\x99\x37\x59\x4c\x0e\xfc\xbb\x06\x0c\xaf\xaf\x40\xdb\x0a\x5d\x0e\xd7\xcf\x68\x69\xe9\x6a\xaf\x10\xb2\x0c\x58\x6d\x35\xe6\xc6\xa2\x66\x11\x34\xe6\xec\x7b\x8f\xca\x79\x1d\x58\x22\x4a\xb4\x2b\x2a\x22\x6c\xb6\x67\x16\x5f\x8f\x54\x7b\xdb\xd6\xd8\x1d\xf8\x92\xa1\x12\x21\x03\x8d\xca\x69\xbc\xf9\xf2\xdb\xc2\xad\x2b\x67\xd1\x12\x6f\x38\xdc\x5e\xdc\x27\xf3\x51\x03\x3f\x34\x9c\x9b\x12\xaf\x6d\xc4\xf3\x40\xbe\x5f\x23\x2a\xf0\xb8\x25\x0c\x90\x41\x6d\x31\x28\x59\x21\x47\xfb\x26\x75\x0c\x4b\xe6\x83\xb0\x02\x5f\x20\x03\xc6\x8e\x7f\x73\xe7\x81\xc7\x8c\x06\x32\x98\xcd\xc1\xc0\x4f\x6d\xf2\xae\xc3\x39\x98\xc9\xa4\x5f\x3d\xbe\x31\xcd\x24\x03\xce\x66\x0c\x26\x1d\x5c\x15\xd2\x2f\x9c\xc6\x5f\x88\x9b\x44\x90\x5b\x92\x37\x76\xc5\x2f\x3e\x25\x89\x08\xa5\x51\xc8\x7f\xf8\xd8\x63\xb3\x3b\x7c\xb5\x92\x01\x6b\xe7\xf5\x77\xcc\x58\xe0\x8b\x20\x77\x53\x55\xe8\x17\x32\x20\xef\xe2\x76\x27\x12\xc9\xaa\x42\xab\xbf\x34\x33\xe1\x78\x2a\x91\x47\x05\x19\xfc\xbe\xfc\xeb\x4f\x51\x49\x1f\x90\xa3\xd0\x92\x64\x8f\x43\x44\x49\xa3\x21\x03\x8f\x4a\x18\x2d\x42\xfd\x18\x5a\xda\xb3\x29\xfc\xd8\x43\x46\xbb\x34\xf3\x8f\xec\xa4\xd1\x87\xf9\xff\x3c\x1c\xff\xa9\x05\x86\x9d\x8e\x85\x7c\x92\x2f\x7c\x08\xaf\x7d\x79\x09\x47\xef\x7b\xe7\xe8\xb3\xa4\x02\x26\x7b\x45\xa2\x83\xd3\x58\xf7\xcc\x88\x1b\x63\x25\x11\x9b\x76\xcc\xe2\xf7\x55\x2c\x93\x91\xaf\x91\x4d\x07\xb5\x62\xff\x5f\xb7\x15\x5e\x02\x2b\x68\x5d\x9e\x9c\x86\x5a\x29\x0c\xe1\xf2\x28\x30\x8f\xa8\xd3\xfe\xfe\xaf\x1e\xef\xe9\xf2\x56\x9f\xfd\x13\xe7\x32\xee\xae\x1a\x64\x30\x6e\x69\x08\x55\x98\x52\x7b\xb4\x7b\x3b\xf4\x9f\x86\x50\x17\xf2\xde\xdd\xfc\x36\x32\x7b\x22\x22\x37\x25\xa1\xe7\x4c\x13\x4b\x84\xd4\x7a\x51\xca\x10\x38\xb3\xb8\x89\xeb\x90\x9d\x21\x35\xdc\x18\xad\x5b\x0f\x2c\xcf\xe0\x8f\x2e\x58\x3b\x6d\xf2\x6d\xeb\xec\xf0\x5e\xc8\x91\xeb\x6e\x78\x41\xa2\x7e\xa1\x5b\x63\xcd\xbe\xee\x2d\x36\x7e\xde\x6a\x2b\x49\xb8\x91\xdb\xc6\x6b\x81\x3c\xca\xf5\x55\x5c\x40\x59\x74\x55\x6f\x13\xb5\x7e\xeb\xaa\xb5\x25\xa2\x14\x4d\xfe\x3f\x4c\x20\xb4\x51\xa1\x96\x2e\x9b\x0e\x2e\xe8\x14\x72\x59\x06\x4c\xe6\xa3\x5d\x32\x1f\xfd\x37\x00\x9b\xdc\x23\x76\x51\x06\x00\x00")
+
+func www50streamJsBytes() ([]byte, error) {
+	return bindataRead(
+		_www50streamJs,
+		"www/50stream.js",
+	)
+}
+
+func www50streamJs() (*asset, error) {
+	bytes, err := www50streamJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "www/50stream.js", size: 1617, mode: os.FileMode(420), modTime: time.Unix(1792227189, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _wwwArazuki_sakuPng = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x9a\x65\x50\x1b\x4e\xd4\xee\x29\xa5\x34\x50\x1c\x8a\x15\x77\x77\x27\x40\x71\x87\x42\x5b\x5c\x5a\xdc\xdd\xb5\xc5\x5d\x83\x14\x6d\x71\x08\x81\xe2\x0e\x45\x83\x07\xf7\xe0\x10\x1c\x82\x3b\x77\xfe\xef\x9d\xf7\xc3\x9d\x3b\x3b\xfb\xe1\xec\x3c\x67\xf6\xc3\x3e\xb3\x67\x7f\xb3\x27\xfa\x93\x86\x22\x36\x26\x39\x26\x0a\x0a\x0a\xb6\xb2\x92\x9c\x36\x0a\xca\x2b\x20\x0a\x0a\xea\x1b\x00\x3a\x0a\x0a\x8a\x66\xa6\xf4\x33\x0a\x0a\x0a\xba\x99\xaa\xa2\x1c\xca\xcb\x7f\xe3\x77\x47\x69\x2a\x0a\x0a\x0a\x86\x8b\x92\xbe\x3b\x0a\x8a\x91\xc2\x7f\xf3\x15\x11\x48\x56\x1a\x05\x05\xe5\xad\x87\xb2\xba\xfc\xdb\xb5\x77\xb8\xc4\x34\x04\x41\xbf\xaa\x05\x51\x50\x68\x50\x94\xe5\xa4\xbf\xf8\x2c\x1d\x75\x78\x7b\xd3\xeb\xa6\xef\x3c\xb4\x09\x07\xa0\x01\x3f\xc2\x3c\x86\x41\x2a\x90\x2a\x03\x78\xc3\xee\x43\x70\xc8\xaf\x1b\xbf\xdd\xab\x32\x15\x46\x56\x4a\x09\x69\x02\xaa\x9f\x3c\xf3\xed\x52\xa8\x72\x7e\xac\xfc\xf2\x56\x25\x0c\x47\x0d\x2c\xda\x04\x18\x68\xa8\x51\x94\xd0\xa3\xba\xc4\xbf\x73\x54\xaf\xd5\x3f\xd6\x0d\x90\xdb\x57\x22\xa1\x61\xaf\xe3\x8c\x7f\xbf\x62\x38\xbc\xa8\x19\x20\xb7\x7f\xec\xfe\xce\xf8\x6d\x0e\xf1\x3d\x5e\x70\xee\x96\xf0\xdf\xe0\x95\xa8\x9e\x79\xbc\xe0\x33\x87\x1e\xe1\x2a\xb9\x7d\x65\x0d\x53\xbb\xe0\xdc\x7f\x0b\x1b\x90\xff\xb4\x95\x18\xce\xf1\xff\xc5\xea\x3e\xf6\x95\xff\xc5\x3d\x86\xe6\xff\xc5\xff\x57\x3a\x90\x75\xf6\x3f\xd2\xb9\xb0\xa6\x81\xff\x3f\xf5\xff\x95\x9e\x52\x9c\xdc\x13\x3d\xb6\x7c\x87\x58\x8a\xe5\xa6\x58\x92\xc9\x6b\xfd\x6e\x60\x02\x04\xa4\x14\x29\x10\xcc\x0f\xd5\x86\xd0\x78\xb8\xe6\x5c\x75\xb8\xfe\x97\x8a\x14\x1f\x98\x9c\xc4\x8f\x8c\x8c\x14\x20\xc7\xf6\xeb\xa0\x6a\x30\xc0\x66\x65\xb4\xcd\x3a\x9f\xd6\x94\x4a\x46\x88\x9a\xc2\x5f\xbc\xff\xe2\xbb\x3a\xaa\xfb\x34\x61\x40\x04\x53\x87\xc2\x80\xb0\x9a\xb9\xcd\x07\xc9\x63\xaf\x0f\xac\xf3\x88\x27\x26\xda\xca\x06\x73\xb6\xf9\x2d\xc1\x67\x06\x7c\xb8\x05\xdf\xc6\xed\x6d\xa6\x48\xc2\xe2\xda\x1a\xd4\x73\xad\xfd\xd4\xb2\xfc\xcf\xda\xa9\x7b\xc0\x7d\x8e\x89\x77\x6a\x24\xee\x29\xed\xbc\xd6\x6a\xbc\xb9\x64\xbe\x7b\x50\x90\x46\x1e\x02\x4d\xfa\xc7\x96\x08\x80\x96\x25\x26\x64\xd1\x41\x01\x0f\xed\xe8\xe8\x68\xc2\x41\x08\x5f\xa6\x52\x73\xc1\xb9\xd7\x42\xdd\x27\x98\x0e\x64\x39\x34\x34\x84\x81\x22\x2e\x2e\x7e\xdb\xf9\x32\xbe\xc6\x89\xd9\xef\xf0\xe5\x77\xcd\xf6\x1d\x28\x17\x3e\xe6\x69\xe0\xdf\xcb\x33\xf0\x57\x8f\xc3\x85\x22\x92\x07\x6b\xed\x5e\xdc\x37\xcf\xdd\xc5\xe5\xc7\xe9\xc3\x4a\x8b\xbb\x35\x25\x12\x82\xe1\x1c\x7f\x13\x7d\x80\x32\x25\x20\xcf\x2a\x5f\xb7\x18\x50\xdd\xf5\x01\x47\x7b\x58\x86\x50\x9e\xea\xca\x2a\x8a\x17\xdd\x7c\xd4\x90\xbe\x47\x70\x35\x7f\x1b\xa4\x2d\x92\x10\xba\x25\x72\xfa\xe4\xbd\x85\xcc\x54\x28\x6c\x55\x9b\x4f\x5e\x85\x12\xae\xde\x7b\x21\xdf\x46\xce\x65\x75\x7f\xfb\xc1\x1b\xc1\xa0\xbc\x9a\x7d\x84\xb2\xb9\xd0\x4b\x1e\xbb\x35\x3c\x6b\xfe\xf4\x78\x01\x53\x2b\x9d\x7e\x1f\x5d\x85\xfc\x8e\x7f\x3d\x3b\x3b\xcb\x56\xf1\x80\x67\x54\x5a\x6f\x9b\x65\x36\xea\x87\x3d\x06\x35\x53\xf7\x09\xfe\xc6\x52\x2c\x22\x25\x05\x35\x78\xe8\x26\x21\xfd\x41\xdd\x0c\x60\x3d\x7b\x3c\x70\x96\x80\x6d\xad\x76\xf8\x53\x50\x50\xb8\x78\x79\x35\x76\x9e\x49\xa2\xd8\x66\xb9\xf9\xf9\xa9\xe0\xa1\x51\x7b\x4c\xfb\x52\xc6\xf6\x87\x6c\x6d\x6d\x5d\x61\x5b\xab\xfb\xd8\xb7\x53\x2d\x52\x52\x52\x7e\x87\x14\xcf\x1f\x06\xce\x98\x03\x68\x95\xec\x24\xf3\x1e\x36\xcd\x09\xe4\xf1\xd7\x02\x6f\x28\x6d\xb3\xdc\x7c\x7c\x9a\x99\x3a\x6e\x94\xbe\x97\x4e\xb3\x26\x52\x4b\x59\x79\x7b\x7b\xa7\xa5\x07\x71\xf8\x75\x50\x69\xab\x28\x14\x4e\xde\x6f\x57\x61\x38\xc7\x1f\xc6\x1e\x1d\x1d\x61\x15\x13\xf9\xfb\xfb\x77\xed\xd7\xa4\x18\xa0\x8c\x64\x25\x6b\x67\x79\x98\x7c\xab\xdf\x59\xed\xf0\xdf\xf6\x1d\x1f\x1a\xc2\xf0\xcd\xab\xd6\xbc\x37\x4c\x42\x88\xea\xea\xea\x52\x50\x51\x9d\x37\x75\x3d\x1d\x0f\xd8\xe5\x1b\x1a\x1a\xc6\x29\xd0\x13\xc7\xf6\xdf\x33\x54\x63\x38\xc7\x17\x16\xd2\xc7\x14\xac\x4f\x16\x2a\x0a\xdf\x37\xc7\xfa\x0e\xf9\xd4\x7f\x03\x9a\xa0\xa4\x54\x77\x05\x85\xf1\x46\x84\x86\xbe\xae\xe5\x30\xcc\xbf\x9d\xd7\x5e\x08\x0d\x7d\x1d\x53\xb0\xde\x76\xda\xd9\x36\x09\xf5\x32\xce\xd0\x01\xf7\xa7\x32\x6b\x7e\x52\x81\x68\x5e\x47\x5a\x70\x1e\x72\x1d\x53\xbf\x9d\x33\x18\x87\xc1\x44\xa5\xa4\x10\x52\xcf\x5b\x52\x4e\x8f\x48\xe6\xad\x38\x0f\x93\x75\x91\x10\x9e\xf3\x32\x61\x99\xca\x06\x0a\x4a\x4a\xde\x7c\x58\x4b\xf0\xcb\xb3\xf8\xce\xfb\x26\xa4\x41\xc3\x1c\xb8\xfa\x70\x70\xce\xa0\xed\x80\x49\x95\xd5\xb4\xdc\xd3\xf8\xe5\x48\x04\x47\x5b\xa5\x08\xd4\x5c\xd5\x76\x7f\x57\xc2\x40\x7d\x71\x97\x13\x1a\xfa\x7a\xe9\x58\x68\x79\x79\xb9\xc9\x0b\x27\x21\x31\x91\x35\x26\xe4\x2d\x2e\x55\xa5\xd4\xe3\xe9\x1d\xbe\x09\xe1\x2a\x35\x00\x0a\x85\x62\x45\xca\x76\x3e\x5d\x2f\x1b\x26\x27\x8c\x32\x91\xd5\xe9\x27\xd0\x33\x06\xad\x4f\x38\x40\x47\xbe\xe8\x4e\x9e\xde\xfc\x1a\xb3\x72\x70\x74\x7c\x2a\x29\x78\x7c\x28\x8a\xb6\xcd\x2a\x88\xef\x76\xa5\xe0\x20\xb6\xe7\xd0\xf9\xf1\xf7\xf7\xb0\xdb\xfb\xab\xf6\xd5\xb2\x16\xa6\x3e\xfb\x1e\x77\x8a\xb7\xf3\x9e\xae\xc6\x37\x8c\x29\x44\x33\x87\xd4\xc1\xa2\x97\x0f\x81\x25\xd3\xac\xc7\x5e\xd9\x1a\xa5\xa1\xda\xe4\xf3\x9e\x4e\x3b\x46\x7a\xf6\xbe\xe7\xdb\x18\xca\x29\x2b\x6c\x35\xcf\xc9\x00\x67\xce\xb2\xfb\xd0\xf5\xcb\x4b\xd0\xa4\x4d\xdf\xbc\x69\x70\xa0\xbd\x98\x68\x8b\x83\x89\xde\x47\xfb\xd8\x39\x74\x68\x79\x41\x11\x1c\x6e\xac\xa1\xa9\x29\x2a\x2a\xda\xed\x69\x9c\xc2\xd0\x52\x83\xf8\xaa\x52\xa3\xc7\xc1\x12\x83\x5a\x4c\x44\xac\xaa\x0f\x02\x98\x27\x01\x78\x22\x06\x3d\xf5\x6d\xd4\x9d\xb0\x8e\x35\xb2\x75\xc0\x57\xe0\x44\x7f\x75\xb7\x39\x83\x98\x82\xdf\x1c\xaa\x25\xd3\x6f\x0a\x37\xc2\x44\xa2\x25\x7d\xea\xc1\x6b\xa7\xfc\x14\x38\x8b\x70\x78\xb1\x49\x35\x7f\xf6\x0e\xe0\x1f\x04\x8e\xb3\x81\x41\xbb\x00\x87\xef\xec\x4f\x97\x0a\x64\xab\x17\xb0\x18\x98\x02\xba\x74\x70\xf4\xf2\x43\xd6\xa4\x34\x34\x34\xf6\x6e\x1e\x02\xae\x66\x75\xd1\xa4\x5d\xfc\xaf\x12\x75\x07\x7b\xcc\xea\x77\x76\x68\xec\xac\xad\xdf\xfe\xb0\x86\xe5\xb9\xde\x9c\x18\x1f\x5c\xdd\xc7\x03\xab\xe7\xc8\xcd\x39\xb5\x55\xec\x1a\xc8\x95\x53\x5c\x1e\xa7\x61\x33\xe7\xa8\x5a\x6c\x0a\xb6\xc3\x69\xf4\x3c\xae\x6a\x2b\xb6\x5b\xdc\xb0\x6e\xb3\xe4\x1a\x25\x92\x6d\x79\x79\xe0\x54\x72\xd8\x77\x25\x6d\xf0\x46\x7b\xfa\x80\x5f\x9e\x62\x0a\x03\x83\xeb\x78\x7f\x7f\x12\x38\x16\x63\x0b\x09\xd2\x69\x93\x2e\x85\x07\x1d\xcf\x76\x45\x5f\x5c\xb8\x97\x4c\xb3\x7a\x9f\xae\x1a\xe3\xc3\xcc\x4c\xd6\x7d\x71\xe6\x96\x0c\x09\xdf\x45\x7e\x1f\x1c\x18\x40\x87\xe5\x49\x6c\x2d\x3b\x77\x19\xc7\xa6\x04\x1f\xf3\xb7\x6b\xd8\x06\xaf\xed\xdd\x3c\xe4\xe4\xe5\xb9\xdd\x9c\x18\x2f\x1d\x5f\x43\x24\x75\xf3\x32\xb0\xaa\x89\x95\xf4\x8d\xbf\xb8\x1e\x25\xcf\x84\xea\x72\xd5\x1e\xe2\x20\x6d\x04\x36\x57\x3b\xfc\xa9\x29\xf6\xf5\xed\x07\x2f\x42\x78\x00\xbf\x60\x6e\x56\x17\x64\x06\x08\x25\xd6\x3d\x8f\x36\xb0\x6b\xf3\xe9\x0b\xe2\x65\xdb\x7e\x9f\xb7\x8f\x6e\x89\xb3\x5d\x21\xb4\xcc\x84\x1e\x0b\x35\xa6\x60\xfd\xe8\x28\xe7\xae\x3e\x8b\x63\x72\x84\x48\x57\x2c\xe5\xdf\xcd\x19\x6a\x62\x2e\x1c\x6e\x1a\x17\x55\x92\xfa\xe6\xe9\xf9\xbd\x5e\xb8\x0b\x67\x87\x49\xa4\x42\xc7\xdd\x1c\x0b\x72\x7b\x3b\xac\x96\x63\x71\x71\x51\x7f\x96\x07\x12\x1e\x5b\x87\x01\x88\xa2\xcb\xf7\xbb\xb8\xb6\xcb\x36\xb0\xc7\xc1\xc5\x7d\xd5\x1c\xfc\xf2\x7c\x5f\xa0\x57\x5a\x1f\xac\x86\x85\xfe\x1a\x07\x07\xa7\xd9\xfb\x74\x15\x92\x4a\xf9\x83\xa8\xba\xc4\x3b\x36\x5c\xc6\x5e\x96\xe8\xe1\x56\xfa\x4a\x2b\x45\xbb\xb6\xad\x2d\x16\x94\x02\x4d\xa4\xbe\x18\x13\x5e\x6b\xf7\xa6\x2f\x64\xc1\x4c\x48\x4c\x44\xb6\xff\xa8\x9d\xb0\xd8\x47\xe5\xb9\xed\x94\xd5\x27\xf6\xa9\x52\x6d\x5b\x86\xd0\xd5\xb1\xec\xca\x00\xec\xda\x96\xd1\x94\xe3\x5d\x94\xc3\x7c\xf3\x2e\x1e\x87\xdc\x6b\xe5\x1b\x63\x30\x8d\xe7\xbb\x93\x09\x14\x31\x8b\xfe\x16\x4d\xb3\x92\x3c\x49\xb6\xf9\xfb\x9f\xaa\xbd\xd8\x5f\x45\xd1\xd9\x7f\x26\x7a\xa3\x5f\xb3\x7e\xd9\xac\xb9\x3a\xb6\xb8\xb0\x10\xb0\x73\x33\x3e\x3e\xfe\x77\xae\xb6\xa9\xe9\x66\xd8\xa1\x16\x0f\x8f\x59\xf2\x42\x5f\x4b\xd0\xcf\x48\x39\xe7\xb6\xe9\xbc\x53\x24\xa1\x77\x6e\x8e\x38\x32\x32\x32\x0e\xba\x65\x08\xf9\x53\xf5\x79\x49\x50\x12\x06\xb1\xe3\xdb\xd8\x9f\x2e\xfd\xef\x0e\x3e\xf6\x92\x1a\x72\x1f\xb7\x16\xe5\x0e\x11\x35\x9c\x9d\xae\x0c\xd5\x7e\xbf\x2f\x5f\xd3\x14\x73\xdd\xd8\xd8\xf8\x49\x05\x39\xad\x29\x85\xbc\x9a\x37\x7d\xa1\xdb\xb7\x85\xb4\x34\x37\xd3\xa8\x7f\x0e\x4b\x52\x53\x20\x1c\x2e\xa0\x39\x33\x0e\x80\xc9\xab\x48\x57\x09\x56\x21\x20\xda\x13\xfe\xa6\x12\x1f\x01\x48\x15\x90\x10\x55\xbc\x88\x94\x28\xf3\x88\x5b\xd1\x16\x5e\x2b\x4e\x15\xb3\xee\x55\xd5\xe1\x69\x0d\x48\x8d\x89\xb0\xb5\x63\x55\x1c\x43\x13\x50\x26\x18\xa0\x20\xab\xde\x31\x47\x33\x02\xbc\x47\xe8\x5a\xe8\x8f\x3f\x3f\x53\xc9\x28\x8d\x8f\x8f\xeb\x24\xc3\x97\xe3\x48\x53\xfc\x7e\x20\xda\x8a\x2e\x42\xee\x38\xa0\x9e\x3b\x07\x03\x2e\x1f\xca\x1d\xc1\xb3\x66\x3c\xa4\x95\x18\xa7\x19\x4e\x4b\x87\x9f\xcf\x1e\x7a\x1d\x84\xb6\x86\x52\x99\x1d\x1c\x1c\xfe\xd6\xd5\x5d\x28\x53\x08\x10\xc7\x86\xd0\xe9\x02\x68\x93\xb4\xf9\x7e\x61\xf1\x2a\x6c\x0d\xc6\x9c\x95\xa4\xc6\xed\x33\x28\xda\xd7\xd1\xa5\xc3\xe1\xc6\x5e\x5e\x5e\xb7\x77\x88\x7c\xd6\x98\x4d\x48\xa2\x38\x79\xfc\xed\xf3\xc3\x29\x32\x30\x9f\x06\x90\x19\x57\xa2\x07\x51\x36\xcb\xa7\x39\x73\x31\x8e\x04\x8e\x94\x09\x67\xf7\x71\x17\x43\x5d\x29\xba\x64\xbc\x52\x91\x99\x17\xa9\xfc\xb5\x95\x65\x2b\x8a\x01\x6f\x61\xde\x49\xda\x34\xc5\xeb\x3b\x53\x3a\xfa\xe9\x72\x5a\x97\xe0\x31\x4a\x98\x37\xd2\xe8\xad\x39\x87\x34\xde\xa4\x93\x63\x0a\x27\x51\x22\xb5\xd4\xc6\x62\x9d\x35\x91\x3c\x52\xa3\xf6\xf3\xf0\x27\xe2\x2c\x1a\x0f\xdb\x46\x3a\xf2\x31\x80\x53\x4d\x27\x53\xd8\xef\x49\x9b\x4f\x44\xfd\xc3\xf8\xd6\xf1\x82\x1b\x4f\x27\x7f\x40\x9b\x8b\x75\xd6\x33\x76\x82\xd7\xd7\xd7\x92\x18\x8b\xfa\xe6\xbf\x60\x98\x78\xa8\x50\xd7\xcc\xd2\xcf\x03\xa9\xaf\xb4\x48\xf9\xb3\x35\x33\x35\x22\x9b\x13\x64\xd3\xd3\xd5\xa0\x64\x15\x33\xe0\xcb\xc6\xf9\xc3\xc9\x63\x2f\x51\x11\x11\x1f\x88\x8e\xb5\xef\xf5\x11\x99\x66\x1e\x70\x63\xd9\xb9\xab\xdf\x3d\x70\x5c\x73\x1c\x69\x14\xf9\xcf\xdb\x05\x3e\x4c\xc2\x35\xe2\xde\xce\x53\xd4\x56\xf3\x13\xe1\x7b\x5a\xf6\xa0\x3f\x50\x88\x6f\x60\xd8\x97\xb2\x5e\x55\x4c\x33\xd7\x79\x4b\xf0\xc5\x34\x5c\xbb\xde\x00\x62\xea\x78\x06\x78\x65\xb2\x8e\x42\x6c\xcf\x99\x87\x98\x74\xf0\xd0\xb2\x14\x9c\xbe\xe7\xaa\x65\x7f\x37\x66\xd1\xfc\x0d\x38\x3c\x58\x4b\x11\x4b\x0b\x30\x06\x9e\x7a\xad\x81\x79\xb0\x6c\x05\xc8\x2f\x1f\x9e\xae\x2e\x47\xb2\xce\x9e\x49\x2d\x6e\xb6\x12\xa5\xe8\xc2\xd6\xc7\x73\xc5\xa9\x2d\x01\x67\x5a\xfb\x17\x22\xbd\x2c\xa1\x87\x22\x39\x37\x89\x64\xd8\xdc\x0b\x6b\x0f\x31\x17\xb1\x9f\x89\x17\x43\x14\x0a\x43\x6b\xd0\xd1\xb6\x5b\xcc\x80\x06\x49\xe3\x6e\x71\xe5\x61\x9d\xf3\x7d\x67\xb0\xe0\x67\xf7\xf7\xb3\x4f\x67\xbd\xb8\xb7\x9d\xb0\xb5\xe7\x6b\xe1\x30\x3c\x0a\x4a\xca\x0d\x31\xae\x2a\x90\x4f\xe7\x3b\xe2\x5a\x20\xb3\x1a\x81\xd7\xcd\x4d\xf4\xd6\x6d\x06\x2c\x7e\x4e\x84\xbb\x18\x52\xad\xf8\x59\x06\x10\xc7\x3b\x3a\x05\x3a\x19\x40\x1f\xbb\xa9\xf4\xc5\xa4\x2d\xbb\x1c\x26\xc6\x03\x59\x46\x6f\x7d\x19\xfb\x39\x8f\xb1\xc0\xe1\x8c\x45\xf2\x84\xdf\x8b\x44\xb1\xc7\x8a\x6d\xd9\x41\x6e\x95\x8e\x5a\x4d\x6b\x4e\x49\xc3\x57\x56\x8a\x66\xed\x5c\xff\xab\xb8\xdf\x56\xaf\x83\xaa\xda\xea\xbb\x70\x8d\x62\xd5\xac\xeb\xc8\x82\xef\x46\x4c\x25\x17\xe5\x7a\x6d\xf6\xa3\x11\xbe\x92\x91\xd0\x44\x64\x7b\x92\x4e\x5d\xef\x24\xf2\xb3\x9d\x72\xa8\x93\xf7\x21\x64\x9c\xd2\xec\x2e\xb1\x88\x18\x8f\x59\x72\xce\x20\xa2\x97\x56\xea\xe5\x91\x5f\x39\xe5\x6c\x6f\x92\x1e\x08\x93\x55\xea\xf6\x11\xd8\x5d\x84\xc1\xce\x80\xb8\xd0\x64\x91\xa9\x14\xf6\xfe\xdd\x39\xe2\x8e\xf8\xf8\x9b\x18\xb3\x87\x27\x49\xe8\x5b\xc5\x5b\x93\x28\x50\x88\xc8\x39\xdb\x54\xdd\x57\x0f\x9d\x74\x46\xa5\xa1\x81\xeb\x6b\xff\xb6\xb7\x86\xf6\xe0\xfb\x65\x01\xa8\x2b\x45\x4c\xed\x37\x1e\xe0\xd6\x49\xc7\x93\x30\x83\xb0\x7c\x55\xa6\xba\x7b\x7d\x45\x54\xb0\x64\x3e\x1f\xa9\x09\xc7\x61\x9c\x60\x44\xa1\xfb\x09\xbc\x0b\x7a\x32\x7e\x02\xe9\xb2\xee\xdd\x48\x42\xfa\x8c\x8e\x8f\x8f\x43\x86\x41\x41\x91\x7a\xde\xb6\xc1\xdc\x7b\x37\x0f\x6a\x4c\x84\x54\x12\x7e\x97\xca\x83\xe3\x06\x23\x2e\x49\x05\xaa\xa1\x35\x11\xf9\x54\x2f\xa6\x21\x34\x3d\x36\xfd\x1b\x93\xe6\x42\xc2\x5e\xe5\xb3\x59\xe0\x9a\x2a\xe1\xe7\xa0\xbb\xc4\x21\x1f\x69\x7d\x3b\xf2\xb1\x28\xa4\x8f\x5a\x1b\x7c\x77\xa3\x37\x92\x9d\xa0\x6e\xce\x2c\x5e\x7a\x7f\x98\x68\xfa\x9d\x51\xd6\x31\xf1\xb1\xc6\xdb\x79\xcf\x35\x71\xb6\xa5\x85\x85\x7f\xe4\x23\xfb\x43\xa2\x6c\xec\x7a\x16\x04\xf3\xc9\x4a\x8a\xeb\x77\x92\x1f\x0c\x0d\xeb\x25\xcd\x14\xc3\xf4\x76\x1b\x8b\xd9\xc8\x34\xd1\x3d\xfc\x21\xba\xff\x6d\xeb\x90\x55\x97\x49\xc2\xad\xb7\x6a\x60\xa7\x1c\xaa\xd0\xa1\x6e\x62\xb1\x2d\x3f\x90\xdb\xa1\x88\xc5\xf5\x56\x0d\x2a\x85\xcc\x70\xee\xea\x9c\xbd\xa9\xd2\x01\xbb\x7a\x7a\xf6\xbb\x88\x18\x69\x7e\xab\xa4\x6f\x5f\x63\x5e\x94\xf0\x3d\x0f\x8b\xc4\xa5\xae\xad\xa9\x41\x2a\xe7\xb9\x03\x55\x58\xdd\x42\x75\x62\x0a\xa7\x4b\x7d\xaa\x34\x49\xe5\xe4\x5c\x65\x28\x66\xd9\x0a\xfc\x70\x35\x1b\x0f\xbf\x9c\x57\xbe\xc4\xe5\xe6\x3a\x0a\x09\x09\xad\xd2\xf0\x9a\x63\x6d\x21\x49\xe7\x5d\xcb\x67\x09\xfa\x54\xf9\xff\x52\x67\xa1\xb3\x59\x92\xe2\x30\x91\x16\x62\xd0\x27\x32\x44\x4b\xf9\x9d\xf7\x19\x8c\x04\xa4\xf3\xe7\xbf\x27\xda\x41\xdd\xb7\xb9\xbe\x36\x91\xb3\xf1\x2d\xc9\xd4\x74\x9f\xe1\xab\xf0\xd7\xa5\x3c\xbb\x0f\xc6\xac\xb8\x37\x6b\x23\x31\xe3\x6c\x32\x08\x96\x10\x13\x33\x13\xca\x46\xae\x77\x87\x7a\x82\x6e\xb4\xc3\x9a\x98\xda\xb9\x29\xb6\x90\x99\xc1\x4f\x87\xa6\x47\xc7\xc7\x4f\x56\xe0\xd2\x9b\x7e\x87\xac\xd4\x71\x37\xa3\x79\x4b\x01\x32\x3c\x79\xc1\x80\x79\xb0\x12\xce\xc8\x83\x9f\xa6\x8e\x45\xa9\xa8\x48\xc0\x68\xe8\x03\xee\x9e\x53\xbd\x0e\xda\xb5\xea\xf4\x5b\xfd\xf4\x3f\xa0\x02\x1d\x70\x20\xa9\xb4\x25\xd9\x9c\x6b\xd2\xa9\x8d\x53\x00\xd6\x46\x71\x5d\xc8\xbe\x40\xaf\x2b\xe7\x98\x4e\xc8\xa9\x3f\xa9\xb9\xb2\xb5\xe8\x7e\x7b\x25\xba\xcf\x1d\x8f\x76\x02\x81\x74\xe3\xe0\xe2\x42\xbb\x84\xa8\x96\x16\xd1\xe3\x59\x2f\xae\x87\x18\xe5\xf5\xcd\xcd\x3c\xc9\x54\xca\xd9\x9c\x7a\x2c\x37\xa9\x7e\xba\xab\x87\x87\x5c\xe9\xf4\x9d\x85\x85\x75\xbc\x65\xb2\x0e\xe5\x62\xfb\x20\x59\x7c\xd5\x99\x31\xae\x23\xd6\x40\x10\xcd\xa2\xed\xd8\xc6\xa4\xb9\x97\xf7\xe9\x27\x67\x01\x9d\xbe\x6f\x03\x84\x4c\xd3\xfb\x86\xd3\x1b\x8b\x0e\x03\xdf\x78\x6e\xd7\x31\xb0\x8d\x89\x28\x9e\xbb\x5e\x3e\x7c\x5d\xcd\x31\x4e\xc1\x0b\x61\x41\x46\x9b\x65\x38\x35\x45\x9f\xfa\x83\x8a\xad\x80\x6a\xbf\xc6\x4c\x54\xbe\x4b\xed\xf1\x82\x4f\x75\x75\x65\x8f\x38\xfd\x4a\xef\x3b\x35\xeb\x16\x2d\x84\xb2\x21\xe2\x8d\xdc\xdf\xd5\x7d\x4c\x59\x62\x0a\xd6\x91\x5b\x50\x90\xa5\xef\x56\xa7\x57\x97\x8f\xf4\xf0\xf0\xfd\x50\x32\x0e\x94\x19\xb6\xb0\xb2\x00\x11\xfb\x4b\x8d\xa2\xa8\x32\xe5\xa1\xf0\x72\x9e\xa9\x98\x48\x55\xd3\xd6\x24\x8d\x27\xf6\x15\xf7\x3b\xdf\xd6\xe2\xcd\x9a\xf9\x68\x76\xe3\x45\x6c\x7f\xc8\x90\x85\x4c\x1b\xfc\xf9\xf7\x97\x39\x96\xfe\xc9\x97\xa7\xb7\x63\x63\xd8\x8f\xb7\x56\x6f\xf7\xd2\xd8\x44\x45\x25\x3e\x8a\x85\x78\x98\xf0\x90\x17\x11\xb5\x98\xc4\xcf\x07\xf6\x91\x29\xd8\xc7\xd6\x30\x5a\xfa\xb6\x39\x4d\xce\x0b\x0f\x2d\x3a\x34\x76\xfe\x9e\x0b\xbb\xd9\xd9\x5c\xca\x0b\xe4\xfb\x75\x96\xff\x72\x97\x9f\x7f\xd9\xf2\xb5\x9c\x95\x6f\x6c\x76\x9d\x62\xfe\x2a\xaa\x27\x0a\x57\x3f\x9f\x26\xfc\x37\xcb\xbb\x81\xd4\x57\x0f\x0f\xfe\xf5\x4b\xf1\x24\x98\xf8\xe6\x7b\x52\xbe\x32\x87\xdc\x6b\x13\x4e\x82\x6b\x34\xb7\x60\x30\x3b\x79\xfc\x40\x64\x4b\x90\xde\xc8\xde\xa2\x3b\x2a\xad\x8d\xa4\x9f\xcf\x05\x42\xb6\x7c\x07\x58\x65\xc9\x67\xf0\xc5\x4f\xf2\x0e\x79\x9e\x72\x98\x95\xf7\x91\x27\x57\x5d\xbf\xd1\xdb\xe9\x4d\xd5\xe1\xe6\x83\x64\xdd\xe2\x51\xee\x58\xf1\x7f\x1c\xa4\xef\xea\xb8\xb9\x78\x7a\x73\xf7\xb0\xf6\xf2\xbc\x3f\xbd\x27\x1f\x2d\x14\x31\xe4\x30\x38\xb7\x4b\xb5\xb4\x90\x56\xbf\x15\xa8\xfd\xaf\x80\x63\xcf\x34\xef\x6f\x4d\x8d\x34\xf8\x89\xad\xdc\x92\xb9\x74\xb4\xf8\xf7\xa2\x6d\x97\xf4\x59\xe1\x24\x60\xed\x46\xaa\xd9\xa9\x69\xcf\x94\x07\x28\x6a\xf9\xc0\x1d\xdc\x92\x76\x43\x07\x7b\xf7\x17\x67\xda\x35\xda\x23\xe8\xdd\xe4\x57\x42\x36\x19\xbd\x7a\x36\xde\x39\x37\x2f\xaf\x61\x33\xd7\x36\x8d\x49\xb8\x53\xc6\x5d\x40\x67\x63\xa8\x29\xe1\x6a\x46\xf4\x86\xcb\xe0\x74\xa9\xa6\x94\xcd\xde\xf3\x40\x01\x99\xe3\xdf\xb9\x8d\x83\x83\x1c\x3f\x89\x6e\x83\xb6\xac\x17\x0f\xca\x96\x38\x0d\x8e\xf9\xd4\xb7\xdc\x8a\xcc\x32\x00\x10\x1b\x59\xe2\xdb\x7a\x43\x09\xbf\x8e\x13\xf6\x25\x53\x43\xb6\x3c\x4a\xaa\xa9\x40\x17\x6a\x04\x23\x21\xaa\x3d\xe7\xc5\x1d\x45\x31\x51\x76\x76\x36\x35\x31\x46\x46\x4c\x69\x22\xb5\x3b\xe2\x39\x30\xca\x19\x61\xf4\xbe\x45\xbf\x64\xed\xbc\x30\xac\x57\xe6\x8b\xb9\xe3\x92\x4d\x0c\x9f\xb9\x18\x35\xcf\x2f\x1c\x8c\x50\xbc\x06\x0a\xd7\x36\x75\x01\x72\xec\xfa\xb9\x51\xab\xba\xab\x92\x3a\xc1\x8d\x40\xfd\x4c\x8d\xd2\xff\x9c\xb9\xd2\xe1\x9f\xa1\x51\x7a\x31\x94\xbf\xc6\x32\x12\x20\x69\x2e\x52\x85\x56\xd7\x8b\x24\xd3\xa2\xc3\x20\x54\x86\xec\x7f\xb6\x0f\xeb\x1b\x21\xd3\x5d\x0b\xfe\x85\xd6\xad\xd7\xeb\x21\xd6\x73\x01\xf4\xeb\x10\xca\x7b\x39\x50\x3c\xdc\x5c\x74\xdf\x1c\xc3\xce\xaa\xc6\xf0\x1a\xa9\xb5\x10\xa1\xa8\x6d\x68\xb8\xea\x86\xfa\x7b\xfa\x0d\x9b\x80\xd6\x4f\xe1\xe9\x99\xba\xdf\xe2\xe5\xed\xe5\x5b\x95\x58\x67\xaf\x38\x6a\x6e\x67\x95\x34\x54\xb4\xf4\x8d\xdf\x93\x4d\xef\x47\x4d\xce\xb2\x5a\x23\x60\xd1\x05\x3f\xde\xe2\x76\x16\x2c\x1f\x6b\xa7\xc7\xd0\x75\xc1\xbf\x7c\x06\x48\xb7\xc1\xad\x78\x48\xdb\xdd\xf3\xee\x60\x61\x0d\x67\xb7\xef\xa4\xf3\x10\x8f\x87\xa6\x2f\x05\xf3\x87\xae\x88\xf1\x5c\x98\x1b\x96\x7c\x95\x08\xd0\x6f\x60\x6e\x57\x08\x6e\x12\xc9\x10\x0d\xf6\x70\xd7\x83\xa0\x5d\x02\x13\x79\x30\x6d\x85\x2a\x70\x2a\xd8\xb0\x2d\xcb\x87\x2e\x52\xc0\x23\xaa\x2a\xc5\x0b\xcb\x70\xc6\x38\x93\xb4\xf0\xb2\xc5\x9c\xa1\x80\x01\xdd\xe0\xa7\x43\x30\x9a\x3d\x16\x09\x09\x49\xbd\x01\x04\x97\x2a\xb5\x6e\x20\xc9\xd8\x68\xaa\xcd\x9f\x24\x67\xb9\xc5\x19\x86\x70\xdf\xb5\x8d\xe1\x74\x67\xca\x60\x5e\x6f\x55\x9a\x67\x06\x98\x17\xd0\x54\x5c\x86\x8c\x20\x45\xe5\x66\x37\x74\x14\xb4\xd2\x45\x2d\xf5\x3f\x6d\x8b\xb6\x0e\x4e\xc8\x6b\x88\xe3\xd8\x5c\xb5\xca\xb9\x7a\x9e\x00\xd7\xba\x5d\x8d\x76\x2f\x88\xa4\xca\xd6\x0e\x1e\x5a\x3b\x3a\x7a\xef\xee\x44\x2f\xee\x1e\x35\xab\x87\x0c\x09\x57\x49\x50\xcc\xb1\xa4\x9e\x6f\xe9\xb5\x88\xfe\x7b\x43\x05\xa7\x9b\x4f\x0c\xf3\x91\x62\xe1\xc9\xd4\x76\x74\xc8\x6a\xbf\xd3\x65\x98\x3f\xf6\xfa\xa5\x51\x3a\x6a\xc5\x6f\x55\x47\x46\xef\x5d\xbf\x92\x33\xcb\x10\x23\x0b\x1a\xc7\x9f\xfa\xdb\x56\x27\x0b\x21\xee\xc0\x7f\x65\x4c\x91\x3f\x01\x5f\x95\x69\x57\x50\x2b\x9c\x04\xb0\xee\x28\x2e\x92\xe5\x52\x51\x69\xbb\x1b\xcb\x95\x7a\xb2\x23\xcc\xf9\xc8\xdc\x3d\x45\x15\xd2\x62\x4c\xa6\x7e\xbf\x57\xb4\x31\xe4\x33\x51\x6b\xce\xc2\x71\xe9\x0d\x53\xe8\xc0\xd1\xf9\x0d\x52\xb0\xfd\x3a\xbb\x0b\x92\x01\xa3\xd6\x0f\xa4\xa6\x7c\x12\x9b\xc9\x86\xdb\x1d\x14\x7e\xc1\x4a\x4c\x18\xf4\x93\x20\xbf\x42\xff\x49\xcd\x97\x3d\x66\xe3\xed\x94\x78\x56\x59\xa3\xb7\x85\x27\x8f\xd1\xbb\x91\xa4\x50\xe8\x6a\xcf\x8e\x32\x17\xd6\x34\xe0\xbf\x51\x9a\x1f\x44\xa7\xfe\xf9\xf9\xf9\xa6\x2b\x58\x28\xf6\xc4\xe1\x4f\x33\xb9\xd1\xe4\xe1\x35\xa0\x50\x97\xf2\x23\x10\x56\x38\xbd\x3f\xb1\xef\xa1\x34\x0d\xda\x70\x19\x0c\x90\xb4\x16\xca\xde\x78\x90\x94\xd7\xfa\x04\x9e\xb5\xf5\x5e\x73\x10\x29\x2b\xa0\x81\x11\x7b\x9c\x9c\x74\x9d\x2f\xd5\xcf\x15\xd9\xde\x6b\x15\x2c\x54\xb2\x64\xe6\xe7\xd4\x3e\xad\xb3\xf7\x71\xaa\xb1\xab\x95\x7e\xbe\x47\xbc\xde\xfc\xab\x2c\x74\x4a\xd8\x2c\xa9\xb5\xb7\xe1\x8f\x7f\x6c\x90\xfb\xec\x33\x48\x93\x9b\x7c\xf0\x66\x9a\x03\x6a\xa3\xba\x7d\x2f\x02\x00\xb2\x96\xfb\xbe\x0a\xd2\x1b\x7b\x03\xbb\x88\x8b\xdd\x4f\xf2\x5a\xa1\x39\x33\x12\xb2\xc7\xae\xaa\xaa\x6a\x66\x53\xe9\x61\xb1\x90\xcc\xd7\xcd\x5d\x5b\xf6\x5b\x7e\x63\x11\x9b\xa3\xd0\xa1\xfa\xb2\x19\x05\x84\xcd\x87\x35\x0d\x20\x7a\x1b\x54\x8f\xbd\xd7\x02\x6f\x74\xc0\x7f\x34\x5f\xd9\xff\x0e\xd6\xe2\x3c\x71\x6a\xf8\x20\xd2\x95\x44\x37\xe0\xaa\x46\x01\x25\x35\xd7\xb9\xc8\x52\x61\xf5\xeb\x58\x35\x0c\x0e\x76\xf1\x50\x2b\x84\x77\x5f\x98\xaa\x17\x0f\x6c\xf1\xc6\x0f\x40\xb1\x6f\x8d\xa8\xa8\xeb\xb2\x67\xf0\x8f\xe7\xe2\xf9\xfb\x67\x67\x19\xeb\x6c\x15\x08\x9a\x6a\x74\xfe\x5c\x60\x71\xc7\xb9\x52\x46\xeb\x90\xa3\x39\x08\x2e\x3b\x08\x19\x70\x0c\xa7\x24\x8b\x5b\xe7\x91\xd8\xbc\x3e\x6e\xd6\x8a\x8f\xc5\x88\x65\x7f\x33\xa0\x17\x00\x65\x4e\x15\xed\xf5\x90\xe1\xaf\x30\xaf\xfc\x43\x38\x4f\x3a\x66\x3d\x4a\x2c\x8a\xfe\x93\x6d\xec\x83\x20\x2c\xbb\x72\x83\x96\xc3\x55\xaf\x97\x7c\xb0\xf6\x8d\x2b\xc5\x8b\xad\xb7\x69\x65\x36\x71\x3e\x7e\xea\x50\x58\x61\x21\x7d\xe7\xdd\x6e\xf6\x07\x43\x9f\x0b\x57\x28\x2f\xe2\xf1\x11\x04\x81\x70\xc5\xef\x3e\x0c\xc9\x14\x81\xea\x97\x1d\xed\x1a\x96\xda\xbc\x75\x41\x8c\xb1\xc8\xcc\x66\xb8\x8c\x58\xb5\x97\xd5\xdb\xd0\xee\xb7\xaa\x9f\xaa\xe8\x65\x9d\x45\x98\x96\x96\xe4\x6e\x57\xe3\x73\x43\xed\xba\x4e\x68\x17\x65\xb6\xfc\x05\x37\x45\xb6\xb7\xb7\x59\x21\x3c\xdd\xee\x6f\xc0\x1c\xf0\x10\x21\xf4\x77\x72\x9d\x30\x2f\x70\xbf\x49\x93\x6c\x29\x15\xff\x38\x13\x9a\xa4\x00\x5d\xa2\xc1\x9e\x76\xa2\x44\xca\xc4\x9f\xf7\xb3\x18\xc0\xfc\xef\x62\xaf\xd0\x6c\xab\xf6\x07\x30\xb0\x15\xb5\x89\x19\x7d\xbd\xda\x52\xf1\xbe\x46\x77\x13\x28\x62\x5b\xd5\x89\x0e\x64\x4a\x1d\x0b\x36\x31\xb5\xc3\xc2\x80\xb0\xbb\xe7\x87\xd3\xc5\xe5\xe5\x87\xca\xf4\x95\xb9\x9e\xbb\x45\x32\x88\x57\x6c\x2e\xfc\xf8\x26\x8f\xa6\x39\x3d\x55\xcf\x5e\x39\x65\x1b\x62\x3b\x75\xbb\x97\x59\x04\x1f\x88\x35\x49\x8b\xa3\xa0\xa0\xf8\xdb\xd0\x70\xa5\xd5\x7b\x71\xe7\xa1\xb2\xe9\x37\x61\xb7\xa2\xe7\x91\x61\xf9\xae\x8a\xeb\xed\x37\x89\x00\xee\xee\x0b\x60\x42\x42\xfe\x94\x87\x6c\x0f\x0d\xcd\x25\xa5\x09\x22\xea\x30\x7c\x6d\xce\x40\x66\xde\xb2\xd0\xc4\xba\x74\xb6\x88\x0a\xfd\xb6\x2a\x94\x08\x55\x57\xc5\xee\x8f\x22\x41\xeb\x4c\x08\xe9\x5f\xa3\xf1\x01\x00\x83\xfe\xf7\x85\xb2\x79\x8c\xa2\x5e\x0e\x75\xe7\x2b\x09\x2f\x8a\xe8\x86\x08\x3f\x48\xb7\x8b\x88\x01\xe1\x2a\x79\x3e\xd5\x5a\xf0\x8b\x44\x87\x49\x22\x1c\x0e\x87\x65\x99\xa8\x0d\x7c\x3e\xe7\xb3\xb6\x3f\x84\x27\xf4\x38\x87\x6a\xc7\x08\xa8\xaf\x1d\xaf\xd6\x0e\xea\x59\x90\x29\x37\x85\x5e\x10\x90\x11\xcb\x5b\x76\x3f\xd7\xe0\x43\x7e\x8d\x9f\x65\xa9\xf7\x61\xcf\xfe\xa9\xac\x29\xba\x20\xf8\xaa\xd2\xf3\x5b\x27\x7e\x26\x57\x92\x79\x22\x5d\xc1\x08\x9a\xbc\xae\x82\x8c\x25\x6f\xb4\x3f\x07\x43\x79\x9f\x86\xd0\xea\x38\x86\x17\x8b\xc7\xdc\x28\x9a\x67\xe8\x33\x34\xa6\x2c\xb4\xb6\xe3\xa6\x58\x8b\x6b\xb7\x8b\x69\xbd\xe0\x6c\x07\xad\xf2\x9e\x31\x87\x2a\x78\x78\x11\xbc\xbe\x3a\x49\x96\x8b\xf9\xd7\xad\x86\x84\xab\xd6\xff\xea\xac\x61\x49\xda\x6e\x37\x27\x70\x98\xc1\xc7\x46\xe2\xf1\x87\xc0\x00\xd8\x13\x0d\x6a\xed\x5c\x41\x83\x49\x3c\x57\x47\x5a\x26\x57\xb5\x52\x0a\xda\xa9\xe0\x77\xc7\xac\x92\x2e\x65\x73\x96\x58\x82\x26\xfd\x97\xb1\x31\xcc\x62\xa2\xde\xee\xb0\x91\x58\xd6\x0a\x34\x56\xe3\xa2\x24\x23\x9c\x71\x64\x43\x01\x55\xdf\xd7\x63\x76\x97\x35\xff\xfa\x71\xf2\x50\x84\x08\xb0\xab\xbd\xcf\x24\xb6\x36\x63\x0d\x15\xf6\x87\xf8\xee\xd2\x8e\x33\x62\x9f\xc8\x12\xab\xb8\x16\xf0\x4a\x3e\xfd\x33\x71\x13\xd4\x67\xbe\x60\xdf\xae\xda\x39\xb5\xb7\xb7\x57\xea\xb8\x6c\x31\xeb\x4c\x92\x37\xa2\x97\xf6\x29\x28\xdf\xff\xe6\x24\xd0\x4b\xfd\xcb\xac\x63\xee\xd4\x7e\x83\x54\xa6\x0f\x25\xf4\xae\xff\x8b\x91\xc0\x01\xc3\x87\x0f\xd5\x72\xde\x60\xf2\x15\xe7\xbf\xce\x98\xde\x4d\xc9\x57\x4b\x8a\xa5\x75\xd3\xfb\x39\xa9\x5b\xee\xf7\x08\xfa\xf3\xd1\x6f\x58\x4a\x1b\xeb\xa2\x5c\xbd\x60\xfe\x1c\x07\x69\xf1\x2a\xf3\xca\x62\x62\x3f\x9f\xd3\x42\x24\x21\x6a\x4d\x1c\xaa\xb8\xb2\x0c\x7d\xcc\xed\xfe\x65\xe5\xfd\xe9\x7c\xe3\xc5\x97\x64\x93\x3d\xff\xbe\xa0\x3e\x96\xdc\x75\xb1\xb4\xb4\x8e\x39\xc6\x7e\xec\xd6\xf6\x76\x57\x9f\x11\x09\xea\x25\x1b\x3f\x38\x27\x17\x37\x17\xfb\xdb\x3d\xc1\x0d\x88\x6b\x36\xcb\x48\x0a\x75\xf0\x63\xf2\x7f\x67\xd6\xa9\x52\x4d\xf0\x74\xad\x9c\x92\x19\xbf\x0a\xfd\x15\x63\x52\xea\x76\x4a\x07\x24\x43\xaf\xce\x64\x36\x7e\x79\x76\xe6\xed\x04\x69\x85\xdd\x46\x19\x53\xa5\x0e\xc5\xe5\x07\x05\x0c\xf7\xdf\xa1\xb0\xa2\x1a\x41\xa1\xc9\x38\x79\x08\x7e\x98\x95\x1a\x97\x66\x34\x67\x5f\xd0\x0f\xc1\x89\x28\x09\x99\xb5\xbb\xfe\x2f\x3a\x5c\xde\xf0\xf4\x0a\x9a\x31\xbe\x29\x3a\xf3\x74\xce\xb2\x48\xc9\x07\xb8\xd0\x0b\x62\x5d\xf4\x9e\x71\x2c\x1a\xcd\xa0\x46\x73\x33\x72\xf4\x22\x4e\x86\x15\xb8\xff\x63\xce\x42\xd5\x77\x04\x62\xbc\xa7\x21\x78\x87\x1f\x8e\xff\x9d\x94\x9f\x02\xe7\x05\x54\x68\x65\xaf\x3f\xcc\xdc\x97\x28\xf5\xac\xd4\x06\x7f\xde\x0b\x79\x52\x57\xf4\x96\xd7\x3a\x5b\xef\x0e\x4d\x64\x3a\x5d\x74\x88\x40\xf8\xce\x1c\x5e\x09\xd3\xf7\x35\xc9\x7d\x9b\xe7\x1a\xca\x3f\x68\xd0\xd5\x18\x26\x1c\xd7\x78\x68\x4e\xd0\xec\x58\x1d\x70\xcd\x29\x5e\xfe\xfb\xf1\x43\x6f\xff\xe2\xab\x3f\x93\x7d\x26\xcd\x1e\xd6\x4d\x33\xa9\x31\x47\xab\xb6\x16\x74\x68\xa8\x99\x1c\xda\xe8\xb6\xd1\x2e\x74\x46\xdc\x46\x8b\xdd\xd6\xd3\x44\x85\x7c\x4b\xae\x7d\x52\x09\xb8\x77\xcb\xcf\xf0\x59\x59\x51\xed\x09\x15\xba\x5a\x3e\x84\x83\x5b\xbf\x53\x61\x2c\x0b\xdf\x94\x87\x46\x9b\xb1\xe2\xea\x94\x49\xc9\xa1\xbb\x09\xdb\xd8\xdc\x2e\x57\x2a\x32\x13\x02\x81\x48\xe5\x02\x2d\xd5\x7d\xf2\x01\xd6\xd6\x69\xe3\xc7\x27\xf0\xb6\x2e\xd6\xe2\xb6\x72\x25\x77\x11\x0a\x6d\xf0\xac\xa4\x0a\x39\x5d\xd8\x3f\x4f\xe3\x98\x02\x1a\x65\xb6\x37\xc1\x29\x0c\x5d\xad\xfa\xa3\x06\xfe\x87\xd9\x73\xf5\x3a\x45\xfb\x21\xfb\x16\x03\x40\xe3\x1f\x9e\x36\x4d\x8d\x67\x46\x24\x8e\x58\xda\x4a\xa0\xc9\x38\x85\xd7\x31\x77\xa0\x08\xea\x4d\x3f\x2a\xc0\x99\x71\xa4\x88\x07\x92\xf2\xee\x64\x4f\xf1\x8b\x7b\x6f\xa1\x5a\xcd\xc4\x2b\xe5\x8e\x8e\x15\x07\x2b\xfc\x91\x14\xe3\xf5\xf0\x1f\x34\x63\xbb\x51\xe7\x31\xf9\x30\xd8\x9d\x95\x7f\xec\xb0\xcf\x00\xc1\x3d\x4e\x03\x8f\x95\xd6\x2e\xc7\x97\x84\xd7\xa3\x88\xba\x61\xbc\xcb\xf4\x2f\x83\xb3\x74\x4d\x76\x87\x06\x75\x8b\x56\x7e\x7c\x1f\xed\x9f\x16\x9d\xdb\xed\x7b\x1e\x5e\xd3\x8d\xf0\xdd\x3f\x49\x1a\x1a\x1a\xe6\x7f\x75\xf6\x8e\xbf\x0b\xd0\xc8\x43\x50\x53\xc2\xa5\x77\xca\x96\xf7\x3d\x9a\xdb\xdb\xb7\xc7\x73\xc5\x73\xf2\xf3\xf9\xc9\xe3\x17\x96\x96\xfc\x98\xcb\x8d\xc1\x8f\xce\xf4\x15\xcd\xcd\xcd\xeb\x07\x07\x98\xf6\xb4\x38\x59\xb7\x41\x4a\x75\x26\x86\xc9\x49\xbc\xe8\x30\x4f\xb2\x84\xea\xcc\xf8\x6a\x1b\x5f\x7c\x65\x3d\xd1\x1e\xb4\x4e\x06\x1c\xa0\x91\xe2\xbf\x14\xd0\xaa\xf2\x92\x12\xf1\x80\xbc\x38\x7e\x32\x3b\xdb\x69\xe6\xce\xa9\xe9\x4b\x9a\xbe\xd8\x90\x9c\xb4\x1c\xd1\x51\x61\xfc\x6e\xbe\x35\x14\x78\xe4\xe9\x42\x49\xc2\x4a\x8b\x03\xb4\x93\x1f\x65\x50\x8d\x36\xce\xb7\x6d\xa4\x19\x71\x37\x92\xda\x9e\x33\xab\xb2\xb4\x00\x83\x06\x5c\x8d\x40\xda\xf9\x4f\xbb\x6d\x4c\xed\x52\x34\x79\x92\x81\x2a\x78\x68\xf9\x0e\xaf\x60\xdc\xd2\xac\xfd\xdf\x78\x68\x00\x21\xa6\xf1\x83\x41\xd8\xdf\x49\xa7\xec\x1a\xf6\xf2\x4d\xa7\xca\x1c\x93\xde\xf3\x6b\x68\x6a\xda\x0a\x66\xf7\x29\xc7\xe1\x55\x1b\xb0\x29\xb3\x4a\x0d\x9f\xdb\xc9\xb3\x8c\x80\x16\x48\xe3\xb5\xb3\x0a\x58\x5a\x9a\xf7\xf8\x73\xc7\xf7\xea\xc5\x8f\x4e\x56\x7a\x6d\x94\xf5\xd3\x47\x72\xc9\x66\xdf\x38\xa9\x35\x94\x20\x2f\x8f\x44\x6b\x59\x16\x9c\x3c\x3e\x62\x1a\x61\x34\x0c\xf6\x7a\xa6\x33\xa1\x5c\xcf\xd3\x2f\xcb\xeb\xf3\x0c\xab\x14\x94\xfd\x7c\x7a\xcf\x6c\xf3\x30\x75\x78\xe2\x79\xea\x26\xb6\x5f\x37\x5f\xc1\x4d\x12\xf2\x2e\x60\x9e\xfe\xb3\x28\xfa\x3b\x05\xfe\x3c\x35\xa5\x14\x3c\xd7\x77\xd2\x2e\x82\xae\xdd\x29\x13\xdd\x9e\xc0\x61\xcd\x6f\x9a\x0d\x4c\xed\x52\x64\x79\x52\xc1\x09\xda\x31\x8a\x89\x54\x81\xb5\xfd\x8b\x5d\x61\x48\x9f\xd1\x6f\x3c\xeb\xd5\xd5\xa2\x19\x01\x0e\xc6\x2c\xca\x04\xf5\x73\x6a\x25\xe6\xa8\x52\x25\xe9\x3e\x45\x17\x71\xbe\x62\x34\x80\x40\x3b\xe5\x90\x2d\x11\x00\xed\xea\x52\x5a\x90\xa1\x80\xa5\xae\x56\x7a\x31\xc7\xfb\xfd\xa9\x86\x22\x96\xe1\x61\x76\x3d\x11\x52\x73\x7c\x91\xbe\xef\x91\x26\xd6\xb8\xf6\xdf\x93\xd3\xc1\x5b\x6e\x19\xf0\x59\xa4\x87\x9c\x58\xee\xba\x61\xaf\x17\xa9\xd6\xa9\x47\xc5\x24\xb7\xe2\xba\x92\xfe\xaf\xec\xac\x1b\xd6\x55\x5d\x5b\xfb\x2a\x2c\x72\x5c\xa4\x8c\xcf\xb9\x49\x70\xec\x1b\xee\x93\xf3\xc7\x83\x1c\xf1\x5c\x74\xed\x90\x43\x91\x30\x93\x1f\x53\x59\x13\x9e\x64\x89\xa5\x1e\xe2\x94\x05\xb7\xbb\xdc\xaa\x50\x5f\xdb\x2f\x68\xd2\x44\x4c\x39\xf9\xf9\xc1\x97\x3e\x6b\x59\x67\x73\x3e\xf6\x07\x7a\x44\x6f\x1e\x1e\x1e\x4c\xfb\x72\x05\x46\xf8\x34\x34\x34\xf6\xae\xfc\x84\xad\xb8\x0e\x44\x70\xbe\x13\xb9\xf9\xfa\xf6\xc8\x68\x94\xcf\x93\xff\x88\x65\xa0\xc9\xce\xc0\xaa\xd6\x58\x58\x38\xaa\xdc\x15\x66\xe7\xe3\x1f\xf1\xcb\xa6\xcd\xcd\x00\x85\x1b\xcc\x76\xd7\xcf\x59\xdc\x85\x2c\x3a\xa8\x64\x69\xd4\x10\xa7\x34\x6b\x14\x4e\x16\xe8\xdb\x57\x0b\xfd\xf9\xea\x59\x65\x57\xfe\x49\xab\x8c\x71\x12\xa3\x08\x8f\xd4\x5e\x99\x84\xf5\xe9\x99\xf7\x4f\x38\x5c\x23\x83\xe1\xcc\x05\x5e\x4c\xba\xc0\x25\x5c\xfa\x0b\xf7\x37\x87\x56\x3c\xc8\x32\xbc\xe7\x62\x0b\x57\xc8\xaa\xe9\x07\xb3\xb1\xc3\xd4\x0e\x74\xfa\x35\x95\xe3\x31\xad\xd6\xc8\x96\x20\x5a\x02\xb4\xc7\x86\xd8\x36\xe2\x14\x2a\x72\x32\x36\x37\xb7\x3d\xd5\x76\x76\x6f\xa5\xed\x2c\x20\xfa\x08\xfd\xfc\x1f\x9f\x97\xda\x19\x55\x62\x9c\xa6\xff\x6e\x28\x59\x3b\xdd\xf2\xa5\x3a\xf6\x7a\xa8\xa3\x4e\x6c\xf0\xda\xf1\xf3\x7f\x79\x7e\xca\x56\xcf\xc0\xbb\x95\x1c\x2d\x21\x01\xf5\x87\x18\x98\x13\xc2\xd2\x7c\xaa\x22\x53\x3d\xc8\x70\xdd\xcf\x67\x36\x7d\x70\x06\xd8\xea\x75\x48\x1d\x49\x13\xad\xbe\x12\x4a\xb3\x57\x0a\x92\x16\xe2\x4f\x75\xdf\x00\xc9\xaf\x88\x97\x3f\xb4\x35\xe7\x25\xbe\xa9\x67\x9b\x54\xe0\xbe\xe8\x3b\x18\xee\xf0\x22\xac\x8a\xd2\xfe\xee\xa1\xdc\xa8\x97\xc4\x6d\x05\xf8\xea\xd2\x34\x59\xf4\x8b\x41\x64\x2a\x88\xa7\xbb\x23\xb6\xfe\xc8\x79\x49\xfa\x3b\x72\x05\x05\xaa\xa9\x07\x22\x4b\xd8\x5a\xb7\x23\x61\xf9\x64\x80\xdf\x2c\x19\x5b\x49\xa3\xb5\x31\x46\xb8\x5a\x5b\xf7\x58\x40\xb0\xff\x09\x3c\x9b\xa9\xc6\x94\x6f\x4c\xf7\x9f\x63\x50\xc6\x61\xb0\x5f\x1a\xa5\x01\x17\x63\xc2\xa6\x58\xaf\x34\x99\x52\x71\x1b\xb5\x82\xbb\xb2\xbe\xe5\x1b\xd0\xb5\x34\x5d\xfd\xe8\x65\x0e\xbc\xb7\x7e\x5b\x2f\x66\xd8\xa7\x85\x4d\xdb\xe7\x46\x2e\xeb\xe6\xeb\x2a\x4d\xf4\x75\xcb\x90\xc6\x56\x68\x09\xc0\xaa\xd3\xd0\xc9\x66\x6c\xd9\xf2\x30\x57\x10\x2f\x4f\xb2\x18\x5b\x8f\x87\x9c\x32\x31\x0c\x9d\x43\xb7\x17\x88\xed\x8f\xf3\x10\x1a\x90\xfd\x84\x8b\x2a\x94\xed\xb9\x39\x61\x8a\xfe\x73\xf6\xf7\x8b\xfa\xd1\x70\x4b\x55\xc7\x7e\x62\x8e\x7c\x07\xd3\xa8\x88\x2d\x9f\x96\xfb\x52\x95\x62\x39\x05\x47\xba\x11\x6b\xca\xbf\x82\xf4\x07\x2e\xa7\xe7\x88\x9b\x18\x5a\x29\x79\x93\xda\x8e\xc0\xc8\xd4\xd4\xba\xff\x38\x6d\x99\x27\x57\xe3\x97\x46\x29\xd0\xe7\x6c\x3d\xf5\xb4\xd8\x87\x84\x29\x15\xa3\x77\xe3\x4c\xa1\x50\xdc\x1e\x3b\x15\xf9\xde\x92\xd7\xbc\xb4\xe1\x27\x55\xfa\x51\x53\x1b\xda\x38\x68\x3a\x96\xb0\xd6\x20\xd5\xaf\x23\xfe\xd0\x4f\x49\x97\x40\x1e\x63\xdf\x4c\x05\xbc\xe8\x5f\xec\x65\xbd\x56\xc3\x50\xb6\x29\x74\x37\x7c\x4a\x5a\x38\xd9\x5a\xc4\x81\x59\xeb\x91\xc0\x45\xcc\x50\x05\xd5\x98\xa2\x39\x33\xde\x9c\x90\xff\xc6\x3b\xe0\x96\xda\xee\x0a\xa1\x8e\x43\xcd\xd0\x32\xa1\x09\x05\x3c\xe1\x73\xb0\x71\x88\x4c\x75\x8b\x48\x5a\x6a\xad\xb7\x50\x55\x79\xb0\x0c\xa7\x00\x4d\x69\x7a\x49\xed\x2b\x14\x62\x41\xc2\x19\x76\x06\x26\x8e\xc3\x2c\x60\xa4\x85\x92\x7e\xc5\xf2\xd5\x96\x21\xe1\x2a\xf5\x07\x95\xf2\xaf\x59\x67\x8c\x4a\x9f\x7e\xa7\x84\x02\x7d\xcf\xc3\x72\x73\x73\x3f\xcc\x18\xeb\x4f\xdd\x25\x2d\x1e\xa5\x1c\x6b\x90\x94\x94\xb8\xcd\xa7\x65\x39\xd1\x77\xdf\x04\xdc\x17\xe2\x17\x02\xe9\xcb\x85\x5d\x9f\x92\x78\xd4\x32\xc7\x4a\x47\x6c\x63\x0f\xa3\x20\x31\x92\xdb\xaa\xba\x39\x35\x69\x73\xea\xf6\xf1\x82\x5f\x89\xac\xe3\x14\xd8\xe5\x7f\x71\x7e\x92\x57\xd8\x13\xf1\x08\x49\xa2\x39\x59\x17\x41\x9d\x4f\xbe\x35\x11\x54\x77\x31\x7c\x8d\x86\xfa\x93\x1d\x4d\x2f\xfc\x6e\x30\x69\x52\xdb\x53\xad\x97\xc5\x2c\x19\xa7\xec\xed\xab\xce\xad\xe4\xa1\x10\x0e\xc3\xf5\x70\x51\xcb\x72\x80\x0c\x86\x30\xcb\xa7\x9a\xdd\x59\x0b\xa3\x2f\x4d\x6f\x0e\x94\x5f\x17\x0f\x4b\xc5\xff\x57\x38\x84\x6f\x7f\x6a\xb7\x56\x30\x62\xd0\x76\x02\x30\x86\x3d\x2d\xf8\x48\xb1\x5c\x8c\xbd\x1b\x32\xef\x98\x3e\xa5\xbf\x63\x4a\x65\x37\xb8\x42\xff\xe9\xf5\x49\x05\x32\xa8\x0a\x88\x0a\x88\x34\x29\x94\x37\x21\xe2\xd8\xfd\x5c\xeb\xf0\x93\xcd\x8d\x82\x95\x75\x84\x42\x2a\xaa\xa2\x35\xb7\x6a\x4a\xd6\x1c\x4b\x2f\x56\x08\xb3\x50\x50\x87\x9d\x07\x1d\x2c\xa8\x63\xcf\x43\xae\x08\xc8\x92\xfe\x08\xaa\x67\x1f\xf1\xd0\x57\xe7\x32\x4a\xdd\xf1\x9f\xdf\x33\x71\xd2\x0b\xa2\x41\xd5\xdd\x1c\x49\xe1\x80\x45\x85\x02\x1c\xa5\x80\x1d\x69\xda\x9b\x0f\x5c\xe5\xbc\x22\x1a\x8c\x9a\xae\xc5\xa1\xc9\x59\x1e\xbc\x8c\xea\x24\x0c\x0d\xcb\xf9\x75\x73\x06\x84\xfd\x57\xb3\xb0\x7f\x24\x7f\x18\xf4\x08\x57\x4f\x51\x62\xe5\x87\x6c\x04\x0a\xe1\xd1\x0b\xab\xab\xcf\x66\x44\x47\x06\xf6\x5c\x5c\x5c\x4e\xf5\xe9\x36\x10\xf9\x34\x7e\x20\xf4\xcf\x86\x42\x99\xf2\x90\x6a\x36\x49\xcd\xdc\xa6\x68\xca\x3f\x7e\x96\xad\xa4\x41\x34\x7b\x6c\x7d\xb1\xde\x64\x9c\x90\x1d\x15\xa8\x47\xc4\x6b\x9f\x2a\x45\x7d\xe2\xf7\x86\xe4\xa1\xff\x36\x95\x95\xd7\xfd\x9a\x13\x42\x2f\xd9\x6a\x6a\x11\x2a\x74\x6b\xbe\xac\xe4\xf5\xdc\xca\x7a\xab\x5d\x78\xa5\x19\x59\xe2\x88\xd7\x9f\x07\x0e\x3c\x5b\xfa\x9c\xba\x77\x02\xe2\x4e\xef\x44\xb4\x83\x3e\x8d\xa9\x63\x4a\xdb\xaa\x24\x51\x6d\x09\x14\xeb\xda\x6f\x8a\x71\x25\x78\x00\xda\x7f\x54\x8e\xfe\x1a\x2b\xf1\x10\xc7\xae\xdc\x5a\xd1\xab\xd8\x6f\x25\x60\x95\x13\x5c\x7e\x20\xa8\xb8\x91\x2d\x68\xd8\x3d\x38\x88\x19\x1b\x1b\x93\x12\xd8\x87\x60\x9c\x2a\x68\x2b\xf4\xda\x88\x58\x8a\x51\x4e\xef\x33\x99\xce\x34\x8f\xdf\xdd\x89\x76\x9e\xbe\x58\x25\x23\xc1\xba\x41\xd1\x08\xd2\xa5\x78\x72\xbf\x33\xb6\xc5\x0e\x35\x9c\x3f\xa7\x74\x68\xa0\x61\xb3\xca\x7f\x37\x66\x20\xa1\x6f\x02\x77\x40\x6b\x5e\xb0\x97\xc4\x2d\x00\x75\x7f\x5d\x0c\x27\xef\x90\xa3\xb0\xa9\x02\xec\x23\x41\xb0\x33\x68\x4f\x3f\x82\x4b\x2f\x52\xe3\xc1\xd1\x22\x24\x8a\xf7\x83\x37\xbd\x7e\x42\xae\x33\xf4\x74\x18\x37\xf6\x92\x63\x66\x2b\x69\x46\xa3\x85\x34\x6f\xaa\xea\x26\x2a\x04\xe0\x18\xd4\x51\xc0\x1c\x46\x63\x3c\x0a\x38\xc0\xd1\x56\x52\xf9\xca\xa7\x82\x60\x08\x0a\xa1\x97\x9b\x1d\xad\x91\x94\xcf\xab\xd1\xdb\x6e\x0e\xf2\x30\x4f\xc1\xdf\x57\x2a\x62\x9f\x0c\xf7\xdd\x0a\xe4\x1b\xd8\xbc\xa3\x92\x01\x8c\xc3\x60\x8b\x70\xf8\x53\xaf\x09\xd8\xbe\x09\xb4\x16\x45\x37\x7f\x09\xe3\x12\x39\xa5\x9e\x3e\xf4\xc4\xe8\xe5\xb6\xc6\x36\xc9\x43\x50\x50\x50\xac\x9c\x2d\x29\xbe\xb6\x4a\xd8\x12\x51\x35\x6b\xea\x22\x22\xc0\xf7\xd1\xd8\xb9\x6d\xf3\x78\x86\x40\xa9\xae\xfa\x4a\x8d\x13\x01\x01\xf9\x1a\x1d\x1a\x75\x54\x93\x4e\x72\x02\xf6\xaf\x94\xb7\x09\xee\x53\x26\x52\xc3\x3a\x19\xce\xd8\xb4\x53\xe6\xd9\xf1\xa5\x7e\x1c\x71\x24\xed\x32\x5e\xb4\xeb\x15\xd7\x10\x57\x3c\x11\x2d\xa5\x58\xc5\xe0\xda\x35\x1b\xfc\x25\x58\x69\x85\xce\x99\xc4\xfe\x9b\xa7\xb8\xc9\xe7\x57\x86\x72\x7b\xfe\xca\x58\xdb\x28\xae\x3e\x18\x4a\xd3\x26\x2d\xb7\xf1\x5e\x06\xed\x87\x58\xe9\x99\xf3\xe4\x9b\x57\x96\x93\x93\xcd\xe9\x8c\x0f\x2a\xf0\x66\x73\x3a\xad\x48\xf4\x27\x6f\x42\x3c\xa5\xcd\xb1\x64\x00\x42\xc2\xc2\x35\x73\x6e\x6e\x6e\xb5\x09\x67\x73\x3e\xc7\x55\x65\x6f\x52\x87\xc2\xba\x67\x93\xa6\x2f\xcc\x4f\x8e\xd6\x8a\xdb\x90\x96\x0d\x37\xf9\x40\xdf\x9e\x81\x81\x81\xe5\xa2\x3e\xb7\x95\xff\xed\x05\xb8\x51\x0f\xb8\xb4\xd7\xfd\xa6\x5a\x49\xbc\x30\xf3\xe8\xe7\x0f\xab\x8d\x99\xa1\x60\x35\xc5\x4b\xb4\x47\x1a\x2b\x73\x5e\xe3\x79\xdd\x89\xa4\xeb\xc6\xf1\x1f\x3b\xcf\xa4\x02\x68\x07\xd3\xcc\x72\x4a\x56\xf6\xbd\x5c\xd2\xf3\x3c\xb5\x01\xa8\x9a\x7c\x79\x08\x80\xad\xed\xc0\x46\x5b\xf4\x0c\x4a\x02\x0a\x49\x18\xf7\x3f\x92\xf5\xd0\xc8\x91\x0e\x13\x5c\xfd\xcb\x7d\xdc\x04\xfd\x19\xf1\x3e\xe9\xb8\xaf\xc4\xac\x94\x46\xf3\x86\x2a\x7a\x5f\x04\xa1\x1f\x44\xc2\x1a\x7e\x68\x98\x7f\xb0\x65\xdc\xe1\x60\xc6\x31\x8b\xe9\x8f\xf3\xcd\xdb\x10\xc3\x29\xd6\xf1\x49\x88\x9d\x61\xb4\xd4\xff\x5d\xdf\x7d\xb5\x1d\x1f\x68\x95\xc6\xe7\x3c\x86\xbc\x4a\xd1\x2b\x06\x5d\xfa\x49\xe0\xe0\xe2\x8a\x4a\x49\xbd\x94\xed\xec\xd9\xf7\x3c\xf4\x26\x53\x16\xf1\xf7\x77\x6f\xb8\xd7\x6b\x11\xf9\x8e\x5b\x39\x67\x8b\x7b\xdf\x74\x96\x6d\x51\xc4\x2b\x8c\x22\xdc\xff\x63\xf5\xb5\x6d\xb0\xe2\x42\x6d\xc1\xef\xf0\xf2\xce\xbe\x48\x63\x0f\x2f\x32\x73\x3d\x73\xb0\x92\x12\x66\x65\xc8\xb2\x0d\x04\xf3\xdf\xb7\x4c\x04\x63\x78\x2d\x1a\x15\x7b\xae\x29\x51\xf3\xb6\x8a\xbb\x18\xc5\x96\x52\xa4\xc2\xf6\xf7\x51\x55\xae\x7e\x62\x97\x8f\x33\x8c\x3d\x84\x34\x6f\x44\x54\xcf\xeb\x88\xf9\x3f\x37\x2b\xb8\x78\x9b\x87\x5a\x35\xae\x3b\xf0\xfd\x01\x30\x74\x8c\x51\xe9\x7c\xb0\xaa\x13\x95\xf9\x48\xaa\x78\xfb\x53\xb1\xfe\x51\x92\x5c\x93\x2c\xe7\x5a\xd9\xa5\x82\xf5\x3d\x8c\x99\xfd\x1d\x9d\x54\xfb\x6e\x40\x0e\x9b\x73\xe9\xa0\x6a\xc0\x5a\x0b\xaf\xef\x30\x9b\x4c\xd0\x0e\x93\xe5\xe4\xfd\x1b\x7c\x74\xe9\x7f\xe3\xe3\x38\x24\x24\x24\x6a\x4c\xa9\x52\x2b\x7b\xff\xb1\x48\xd2\x11\x7a\xc6\xe7\x84\x84\x7c\x42\x34\xde\x31\xbf\xec\x9f\xad\x9b\xdd\xba\x37\x4e\x5f\xf8\x3d\x09\xbd\x2f\x4a\x35\xf3\xcf\xcf\x36\x7a\x3f\x70\x7f\x34\x04\xb3\xb1\x21\xcb\x94\xd6\x65\x0f\x6c\xb5\x79\x72\x49\x7c\xaa\x2a\x1f\x77\x9d\x22\x11\x55\xbd\xe2\xf5\xbc\xdb\xf2\xd2\x7b\xbe\x16\xe1\x3d\x06\xd2\x14\x3e\x69\xea\x69\x78\x02\x74\xf9\xda\xb3\x3e\x5e\x93\x88\x8d\x5d\xa6\x64\x84\x4e\xf5\x10\x49\x14\xca\x19\x5a\x0f\x24\xe2\x24\xbc\x6c\xd7\x69\xac\xe7\xdb\xe7\x77\x15\x29\x5d\x22\xda\x53\xd1\x84\x76\x41\xff\xbe\x8a\x21\xce\x8f\x48\x99\x31\xa3\x0b\xa2\x68\xa2\xd2\xaf\x0f\x52\x26\x2d\x46\x6f\xed\xa2\xc6\xde\x11\x70\xa3\xe9\xe3\x77\x12\xa5\xac\x80\x46\xc8\xcd\x05\xb9\x10\x80\x9f\x3a\x6f\xf4\x75\xb8\xda\x1a\x36\xd1\x35\x22\xff\x5d\x50\x7a\x58\xad\x36\x73\x96\xf5\x70\x8c\x11\x57\x20\x94\x53\xc2\x6a\x39\x44\xa5\xa4\x98\xf5\xc7\x65\xaa\x31\x4e\x35\xd1\x0b\x37\xf6\xa7\x11\x6a\xa5\x84\x06\x10\xae\x31\xbf\x6c\xa8\x66\xf0\xfa\x73\x47\xf5\xd9\x23\xf7\xe1\x6b\xd7\xff\xf9\xf8\x17\x96\x9c\x2a\x69\xb0\x12\x20\x9d\x70\x9c\xef\x7e\x7d\x88\x42\xbd\xae\xe6\xc4\xc1\x81\x19\x5f\x93\xe6\x20\x4f\x16\x68\x1f\xc7\x5c\x66\x65\x4a\x62\x19\xcd\x1e\xf8\x97\x07\x46\xa4\x8d\x29\x33\xdf\x6d\x17\xcb\xfc\xe6\xb8\x88\x00\x2f\x3f\x4e\x11\x18\xf0\x37\x1c\x2b\xfd\xae\xa9\x9d\xc9\x63\xd0\x8a\x71\xbf\x88\x21\x05\x27\x0f\x64\xc4\xbe\x1d\x46\x04\xfe\xa0\xd6\x60\xd1\xa6\x91\x45\xa2\x46\xcc\x18\x7b\x97\xf9\x8b\x4c\x2b\x93\x40\xb3\xb5\x59\x86\x00\xf0\x76\xfe\x9d\xab\x85\xa0\x31\x2d\x8a\xae\x4e\xf9\x51\xfb\x20\x40\x02\xf7\x5d\x58\x1e\x8c\x27\x17\x9f\x51\xfd\x8b\x6d\xf2\xb9\x55\x58\xb7\xd6\xe6\x67\x22\xe7\x39\xce\x34\xa1\x4a\x99\x53\x46\xf2\x78\x74\xdb\xac\xf3\x0b\x98\x54\x30\xef\x00\x89\x7d\xcf\x83\xdc\xe0\x45\x0e\x75\xd0\x6d\xf2\x84\xe6\xc2\xca\x02\x63\x0c\x01\x0e\x2e\xf5\xb4\xd3\xd5\xde\x65\x67\x65\xab\xb1\x7e\x3a\x05\x25\x25\x32\xff\xe5\x2e\x5f\xf8\x79\x61\xd1\x2c\x66\xce\x4e\xb0\xf9\xe5\x0f\xbe\x42\xb9\xe0\xc0\xd2\x6c\x82\x6e\x87\x5a\x0d\xc7\x4a\xcc\xdb\x9c\x1a\xf4\x0d\x11\x1b\xd6\x5f\x3b\xac\x0d\x34\x66\x9d\x9f\x6e\x1d\xa9\x9b\x4e\x42\xcd\x01\x3c\x83\xa9\xa0\xfe\x82\xed\xd2\x42\xf7\xce\xa7\x13\x9c\x39\xd0\xef\xee\x71\x6c\x21\x61\x1f\x46\x1f\x15\x9e\x21\x4c\x8a\xd2\xa9\x27\x63\xef\x44\xdd\xf2\x7b\xb7\xa4\x6e\x83\x9f\xcc\x46\x46\xfb\xdf\x18\xfb\xe6\x0c\x13\xc6\x8d\x63\xc1\xa6\xb7\x95\x7a\x1c\x3f\x11\xc9\xb7\xb4\x19\x75\x18\x09\x95\xf8\x7e\xaf\x3a\x0f\xcd\x77\x09\xeb\xd9\x58\x31\xc3\x11\x23\x1f\xf6\xe7\xfc\xf2\x64\x0b\xdf\xf3\x92\x73\x98\x44\xef\x55\x36\xb0\x38\xb2\xb5\xdd\x31\x16\x81\xc0\xe4\xab\xfb\xfb\xac\x0f\x0f\x0f\xf6\x3d\x0f\xfc\xeb\x9b\x9b\x21\xd6\xb0\xbc\xba\xc9\x65\xc3\xb1\x88\x92\x7d\x2c\x5a\xe3\xeb\x93\xa3\xf3\x62\xb2\x81\xe7\xf9\xe0\x27\x12\x5c\x2a\xa0\x0f\x42\xa8\x66\x2d\xc4\x1a\x96\xe8\x73\xf9\xd5\xf9\x6b\x6c\x6f\x00\x60\x43\xda\x84\x14\x2d\x0d\xac\xcc\x28\x98\xcc\x02\x41\x52\x20\x76\x6f\xd8\x17\xd8\xdc\xb4\x06\x26\x8e\xeb\x49\xe3\xab\x48\xf7\xc9\x5c\x53\xce\x02\x3c\x86\x8e\x18\x18\x8d\x2d\xf4\xbe\xd9\x17\x0e\x3d\x3f\xa3\x97\x5a\xbf\x0e\x7a\x9e\xe7\x4d\x14\x12\xd2\x6a\xa9\x50\x5a\xe8\xd4\xfb\x47\x1f\xfe\x2d\x85\xf8\xbd\x6b\x74\xc5\x83\xdf\xd3\xb3\xae\x25\x4f\x1b\x26\x77\x38\xde\x60\xc6\x7d\xfd\x35\x63\x96\x7f\x87\x9a\xf6\x3b\x76\xfa\x09\xdb\xf4\x29\x00\x21\x5e\x88\x0e\x51\xaa\x25\xab\x2f\x31\x21\xf3\x6a\x3c\x9c\x20\x60\xad\x78\x5a\x95\x14\x57\x1b\x24\xc4\x3e\xc5\xae\x1a\x8f\xe8\x20\xef\x17\x4d\x90\x6d\x83\x97\xac\x9d\xf2\x53\x25\xbe\xe0\xf1\x37\xd9\xf7\x3c\x84\x97\x9d\xec\xed\x01\xcc\x05\x4c\xe1\x2f\xe8\xf6\xb9\x96\xb9\xc5\x59\xfb\x2b\x6b\x6b\xcc\x58\x5d\x95\x3c\x2f\xeb\xeb\x3f\x9c\x9a\x54\x9b\x96\x3b\x90\x50\xae\x98\x14\x60\x36\xa1\x9c\xf7\xdf\x8a\xed\x5e\x73\xa0\x0e\xaa\xb1\xae\x8e\x9f\xd8\x2b\x8b\x0a\x06\x17\xb8\x30\x5a\x0d\xb5\x8a\x13\xd8\xa0\xfd\xb7\x1d\xc7\x2d\xaa\xf0\x19\x6d\xc3\xe8\x34\xaf\x0e\xa9\xc2\x05\x28\xb4\x4e\xb2\x13\x31\x59\x39\xef\xe3\xe1\x9c\x61\x25\xd1\x2d\x5f\xf0\x39\x3e\x7a\x29\x07\x87\x5b\x94\xf7\xb5\x97\xb8\x23\x68\x0a\xfc\x45\x1b\x45\x6b\x7d\xb3\x35\xe5\xae\xa0\xe8\x13\x7a\x98\x65\xc3\xf1\xa7\xf6\xe3\xbf\x47\xb1\xaf\x03\x10\x7f\x74\xf9\x5b\xe1\x06\xa9\x17\xc8\xf7\x4f\xfb\x49\x63\x7e\x91\x56\x39\x3c\x53\x5f\xb7\x2d\xe3\x76\x98\xf8\x55\xe6\xd3\xea\x5b\x79\xf7\x39\x98\x47\x04\xe6\x96\xec\xd4\xb7\x0d\x8b\xb2\xb4\x88\xb4\xc0\xb3\xcd\xcd\xcd\xff\x6e\x9e\xd6\x3a\xbe\xef\x19\x44\xde\x24\x94\x9d\x74\xbd\x3c\x74\x95\xcf\xda\x05\x3c\x5d\x09\xa3\xfd\xec\x22\xa8\x32\x78\xd7\x49\xa2\x5d\x1d\x69\x3a\xc3\xab\xfb\xce\x7e\x60\xa5\xc5\x1d\xa1\x13\x06\x7b\xfb\x36\x72\xa5\xa3\xae\xc6\x82\xe4\xef\x92\x8e\x7c\xcb\x0d\x82\x61\x46\xfb\x99\xc7\xa4\x48\x09\x4a\x8b\x13\x80\x5e\x47\x26\x42\x1d\xb4\xd6\x90\x6e\xf7\x36\xec\x95\xd1\xcf\x4a\x65\xb9\x65\xe9\x6b\xa3\x9c\xbc\x48\x88\x3f\x85\xa9\x09\x4f\xd6\x68\x34\x1d\xee\x3e\x69\x80\xe6\x99\xd4\xf3\x33\x89\x50\xe3\xd2\x76\x2c\xaa\x78\xea\x60\x79\xbf\x86\xe0\x94\xde\x41\xb2\x6a\xf5\x5c\x89\xd4\x2f\x37\x49\xaa\x2d\x2e\x7b\x3b\x6e\xa9\x72\x04\x80\xbc\x46\x71\x5d\xb4\xf2\x1e\xab\x4b\xf0\x96\x8f\x38\xfa\x1b\x78\x52\x1a\xd0\xbd\xe3\x20\xf7\x47\xb0\x86\x1e\x7f\xa0\x7d\x8f\x6a\x8d\x86\x42\x51\xdb\x5e\xce\xc3\x8f\xb7\x8a\x7d\xa8\xce\xbb\xda\xf1\xc8\xab\x4d\x4e\x4b\xae\x6b\x2d\x18\x91\xde\x2a\xb8\xf1\x84\xf3\x3d\x6e\xe3\xf2\x12\x14\xd4\x05\x13\x5e\x0b\xa8\xab\xf8\xbe\x30\xcf\x55\x4f\xe3\xe6\xba\xc2\xcd\xde\xfa\x70\xfa\xf2\xec\xec\xe5\xe5\xe5\xe4\x08\x08\x10\xcb\x9d\x58\x76\x30\x4f\xbb\x2f\x7b\xf7\xb8\x74\x2c\xf7\xe1\xf3\xdd\xd2\xab\x2b\x96\x5d\x37\x31\xff\x50\xd2\xe3\xe5\xf3\xef\x2d\x8f\x6e\x6f\x7a\xc0\x00\x8d\x46\xca\xed\xf4\xd8\x15\x17\x3c\xec\xe8\xb3\x37\x3a\x3d\x36\xc0\xad\x52\x6a\x5c\x57\x8a\x44\x06\x4c\x32\x2c\xeb\x30\xad\xf8\xae\x27\xa8\xa9\xed\x8c\x61\xc0\xc5\xd5\xfc\xd1\xea\xf2\xee\xe2\x59\x4e\x68\x2c\x3b\x68\x12\x80\xf6\x93\xbd\x1c\xca\xd5\x76\x8c\x4d\x95\x7f\x23\xfa\x01\x9b\xed\x78\xdd\xfe\xed\x9e\x92\xb2\xc4\x75\x14\x2a\x42\xc1\x8f\xcb\x4b\x9f\x9f\x50\xe6\xef\xf2\xd1\x77\xce\xd1\x0b\x2c\xf0\xbb\x7a\x9d\x34\xec\x39\xc7\x41\x2f\x39\xf9\x80\x63\x13\x19\x08\xfe\xf3\xa6\xfd\x22\x1c\xde\x4f\xda\xbb\xb3\xb3\x23\x56\xfc\x44\x4f\xa3\xfe\x19\x07\x07\xe7\x2c\x30\xbf\xba\xeb\xf9\x3e\x8d\x3c\xb3\xce\x23\x56\x70\x44\xc6\x19\x42\x15\xa0\xe5\x25\xf6\x72\x0b\x95\x5a\x58\x59\x29\x9a\xde\x5f\x89\xf1\x87\x68\x63\xfe\x64\xcc\x67\x47\x35\xfd\xf6\x26\x4d\x88\x33\x3d\xb3\xc5\xb7\xda\xdd\xd5\x71\xfe\xcb\x41\x8a\x17\xd1\x37\x90\x82\x41\x64\x3b\x07\xeb\xc5\xf0\x97\xdf\x07\x15\x76\x8f\xff\xba\x71\x73\x0f\xbc\x8e\x38\xae\x9c\xb5\xbf\xd0\x3b\x38\xf3\xe3\xb7\x58\x29\xb3\x37\x1f\x25\x22\xe3\x47\x72\x75\x32\x98\x11\x81\x71\x9e\x0b\x8b\x2b\x25\xb3\xf1\xd2\xe5\x3b\x0a\xf9\x39\xb9\xc7\xd8\xad\xc7\x4c\xba\xba\xb2\x41\x22\xe2\xc5\x1b\x6f\x57\xf6\x1d\x3f\x25\x06\xfc\x7d\x63\x7a\xe5\xd5\x92\x15\xa7\x7c\x91\x53\xdd\xd8\x98\x81\x58\x7f\xe3\xab\xfa\xea\x6a\xa1\x37\x39\x44\xe0\x33\x37\x39\x0a\x35\x2d\xa8\x51\xcf\x68\x7f\x5d\xe5\xad\xa3\xc0\x87\x2b\xa6\xd2\x42\xd5\xb8\xf9\x7f\x16\xee\x76\x96\xa8\x93\xc7\x2d\xbf\x94\x68\xa3\xcc\x5f\x74\x07\xb5\x26\x0e\xaf\x9a\xdb\xdb\x2d\x24\xf3\xd7\xca\x32\x03\x29\x37\x02\xcd\x46\x11\xee\x79\x12\xfe\x0a\x1d\x26\x08\x70\x75\xa7\x5f\xe1\x3a\x75\xd8\xc9\x14\xaf\xce\x4a\x19\xde\xa5\xb0\xfc\x9b\x91\x65\xe4\xdd\x9d\x68\xae\x06\x42\xc7\x1b\xbe\xeb\x9b\x70\x28\xf2\x15\xce\xd5\xc0\x8e\x11\x5a\xa6\x6c\x21\xdb\x19\xd5\x59\xb4\xde\xf0\xab\xe2\x40\x15\x33\xae\xac\xe4\x7d\xfd\x5f\x1f\x4f\xdc\x0f\xef\xea\xb9\x6d\xe6\x08\x23\x8a\x06\xd5\xa9\xad\x7b\x65\xeb\x29\xcf\x01\x16\xcb\xe5\x72\x5d\xc9\x1f\x6f\x51\x37\x3a\x2f\x6d\x8d\x4b\x6a\x75\xf4\x1d\xac\x78\xe9\xe3\xf7\xed\xcf\xa7\xb1\x76\x89\x8d\x6d\xf2\x72\xaf\x17\x9d\x3d\x00\x40\x6a\xa8\x27\x8f\x41\x17\x91\x18\xe6\x27\xf2\x35\xd7\x85\xc5\xd5\x75\xa9\x67\xa8\x29\x7e\x43\xd2\xcb\xd5\x25\x6f\xe9\x16\xaf\xeb\x1e\xc1\x1b\x9c\xdc\xa2\x21\xe0\xe7\xc7\x00\xbf\x90\x43\x91\x6a\x3b\x59\x87\x1d\xc7\x79\x25\x19\x17\xa2\x50\x8f\xf6\x18\x22\x29\xae\xe1\x3a\xf0\x40\x04\x51\x4a\x1b\xdc\x51\x61\x8b\x4c\xb7\x57\xd2\x52\x2b\x08\x7f\x5e\xb9\xc3\x84\x19\x80\xba\x7b\x71\x77\x2a\xd4\xf0\xf4\x59\xd1\x67\xce\x40\xa1\x10\x1f\x0a\x85\x3a\x38\x3a\x76\x4c\x4f\x0d\xb9\x32\x40\x34\xe2\x92\xe6\x8d\x5b\x60\xaa\x3e\x96\xe5\xca\xd6\x8c\xb6\x42\x96\x92\xf9\xb5\x5d\x5d\xc1\xe0\x88\x37\xbe\x5a\x78\x3e\x5c\xbe\x15\x2e\xaa\xd1\xc6\x3f\x00\xf2\x57\xef\x55\xe5\x1e\x53\x50\xee\x1a\x5c\x75\x69\xd9\x3a\xb2\xd8\x04\xb4\x45\x00\xd8\x1a\xbd\xaf\x75\xcd\xdc\x00\x74\xf9\xb1\x43\xa0\xb1\x27\x2a\x02\x59\xd2\x66\x35\x05\xd1\xe9\xef\xd2\x9c\xc8\x8a\x7d\x94\xcf\xb3\x2d\x13\x23\xad\xc6\x2d\x95\xd4\x59\x24\x30\x46\xca\xee\xf6\x51\x2d\x85\x3f\x90\xf5\xb5\xd3\x95\x0d\x33\xbe\xf2\xfa\xa0\x2e\x6d\x29\xd9\x8c\x95\x60\x97\xc4\x0e\x38\x27\x4e\x22\xec\x56\x0a\x0a\xf5\xe8\x89\x9a\xc0\x5e\x2c\x6b\x6a\xc9\xc4\xb9\xeb\x52\x8c\x04\x2e\xad\xf4\x78\xc3\x8b\x43\x6b\x8d\xe5\xbb\x96\x8f\x89\x51\x47\x66\x3d\x54\x24\xd4\xce\x28\x4f\x0b\x0b\x3b\x0e\xbb\xa6\xe7\xfe\x2e\xef\x9d\x5f\x6e\x46\x4d\xfa\xc8\x81\x3f\xfb\xdb\xe2\xc3\x10\xbd\xe3\xe3\x38\x9a\x79\xc0\xee\x9b\x40\x03\xc8\x5c\xd6\x59\x90\xa6\x85\xb7\xab\x6b\xf7\xe2\x22\x59\x22\x95\xc4\xfd\x57\xe1\xe4\xec\x55\xde\xb3\x1a\x16\xb7\xfe\x00\x1d\x9e\x7c\x6b\x25\xe8\xd9\xfa\x73\x20\xc5\x8d\xd7\xda\x40\xed\xa0\x8b\xc4\xdb\xb4\x0f\x49\x04\x71\xfd\xe3\x9c\x4c\x6f\xf0\x39\x4a\xf1\xb7\x63\x67\x2e\xee\xd3\x5d\x72\x6d\x31\x2d\x27\xcb\x23\xa6\xcc\x40\xdd\x9c\xd2\xc5\x59\xfd\x02\xd6\x42\xa6\x9f\x62\x65\x99\x4a\x09\x09\xd6\xa0\x35\x21\xbf\x89\x46\xa1\xa2\x1a\x16\x18\x12\x2d\x53\xcd\x1d\x6e\x2d\xdc\x85\x8a\x35\x53\x97\x64\x25\xb1\x71\x26\x1d\x1a\x9c\x58\x5a\x2a\x1d\xc1\x81\xea\x00\x96\x77\x40\xe8\x06\x58\xfc\x21\xee\xe0\x5d\x95\x02\xfd\x38\x13\x61\xa5\xd0\xcc\x23\x5e\xb1\xa0\x54\x4e\x8e\x8b\x73\xa1\x22\x7d\x8c\xa9\x7e\x27\x44\xa5\x5f\x07\x1c\x0f\x4a\xd9\xea\x4e\x52\xd0\xa3\x3f\xef\x21\x91\x0c\xa4\x4e\xdd\xe5\xa2\x4c\x3f\xbd\xf2\x12\x6d\x15\x29\x34\xa9\x9e\x3e\x11\x48\xd2\x5e\x17\xc9\x3f\xad\x63\x09\xd3\x3b\xa6\xc5\xe9\x3d\x8b\x75\xbd\x34\x21\x5c\xad\x0e\xc8\x8c\xe8\xa5\xb5\xb6\xb6\x0e\xb8\x81\x7b\x37\x57\x3d\xf4\x53\x57\xf3\xa6\x03\x3f\x17\x5d\x84\x70\x8c\xe0\xf5\xd4\xd6\x4c\x76\x78\xd6\xbe\xfe\x64\xb9\x23\x54\xc0\x17\x1f\x9d\x93\x46\x09\x42\x19\xe4\x64\xf2\xef\x56\x0a\xd7\x11\xd1\xde\xec\x24\x72\x25\x47\x63\xfb\xbc\xd0\xf3\x89\x4b\xe5\x5c\xba\xdb\x25\xcb\x4d\x88\x7c\xeb\x8e\x4b\xa8\xe9\xf1\xf2\x28\x2c\xde\x2f\x94\x01\xcb\x46\x0e\xbf\x18\x91\x44\x8c\x09\xbb\x62\x83\xaf\x56\xbb\x28\xd8\x0a\x78\xb7\xe6\x0e\x5f\xa8\x1f\x7e\x15\x72\x1c\xe3\x1e\x7d\x88\xb1\xeb\xfc\xc2\x9d\xcd\x9a\x57\x69\xa4\x9f\x05\xf9\x61\xe5\x9e\xcd\x71\xc8\x9b\x57\xa4\xc6\x44\x18\x53\x29\x47\x7f\x0b\x45\xf2\x7a\xb7\x1a\x37\x66\x43\xbe\xca\xca\xea\xc5\x6a\xc7\xc4\x2b\xa4\x2a\x10\x21\x61\x5b\xe6\x11\xfb\x61\x3a\x5f\x98\xaf\x7b\xdf\x6b\x45\x65\xe4\x28\xae\x45\xa2\xc6\x0b\xfa\x54\x29\xf6\xe2\xde\x41\xa5\x5e\xa4\x3a\x5b\x6f\x48\x52\x8a\x34\xea\x28\x37\xfd\xf2\xab\x6d\xbd\x3a\xc2\xe0\x99\xba\x8a\x53\x73\x61\x37\xe1\x9b\x9b\x9b\x21\xa9\xa9\x84\xcf\xc7\x6b\xcf\xc7\xb1\xcc\xe2\x10\x6c\xf9\xaa\x41\x62\x46\xf9\xef\x9f\x2a\xbe\x89\x7d\xa8\xad\x59\xec\xbe\x90\x1a\xab\xd1\x95\xf4\x68\x34\x08\xce\x64\x4f\x99\x30\x96\x17\x99\x7f\x45\x50\x9b\x95\xc3\x02\xea\xf1\x86\xeb\xf6\xf5\xc9\x84\x14\x1b\xe4\xeb\xed\xa5\xf4\x82\xd9\xa7\x8c\xfe\x7c\x62\xf8\x1e\x72\xf6\xa6\xca\x75\x52\x61\x3a\x5f\x23\x93\x44\xf4\xb8\x59\x41\x4b\xa7\xd3\x53\x79\xfd\xf9\xc0\x45\x71\xcf\x0f\x6c\xbe\x5d\x6c\x1a\x68\x0f\x6a\x22\x02\x92\xe8\x27\xf0\x35\xde\x57\x09\x7c\xe8\x10\xe2\x78\xba\xc0\xc3\x13\x0c\x08\x19\x2c\x5c\x2c\xfb\x93\xf6\x3d\x79\x47\xcd\x8a\x10\xbd\x8e\xac\x85\xea\x6f\x32\xc3\x4c\x08\x0a\xb4\x5c\x20\x4b\x85\x35\x00\xba\xb3\x76\x73\x7a\xb6\xb5\x8f\x01\xab\xd6\x28\x8f\xc4\xc8\x2d\xd2\x1a\x7a\x5d\x1c\x7b\xf8\x65\x2c\xda\x1e\x91\x9e\x16\xf5\x60\x5c\x64\x8d\x95\xeb\x97\xaa\x25\xb6\xb5\xfd\xf8\x23\x77\xac\x0d\x2d\x59\x71\xec\xa1\x9d\xed\x27\x83\x72\x90\xb7\xc5\x4d\x29\x43\xfb\xc5\x85\x7b\x44\x2f\x6d\xf0\xd3\xa1\x69\x97\x4f\x2d\xbb\x5b\x3f\x1d\xe7\x20\x31\xe6\xbe\xd2\x30\x9f\xb9\x48\xeb\x37\xf3\x3a\xd9\xea\xf9\x27\x0b\xe8\xc6\x5b\xae\xad\x64\x99\xe6\x10\x81\xea\xca\x95\xc2\x9d\xed\xee\x58\x1f\xcb\x7d\xf5\xf3\xf4\x70\xe0\xfc\x4b\x16\x38\x65\xe2\xc6\xa7\xb9\x66\x6c\xc7\xa2\xfb\x77\x66\x4d\x53\x9d\x62\x1d\xa1\xcb\x52\xd7\xfa\xe6\x5f\x58\x61\xae\x63\x55\x09\xff\x54\x01\x0d\x26\xea\x94\x5a\x35\x13\x65\x50\xf4\xa5\x0e\x8c\xd5\xa7\xd0\xf5\xef\xfd\x8d\x1d\x4a\xba\xc0\x48\x66\x9c\x75\x5a\xbf\x2f\xe9\x27\x70\x7f\x98\x16\x9a\x5e\x9c\xd0\xb6\xbc\x72\xf9\xb0\xee\x36\x8d\x99\x3e\xa6\xc3\x6c\x76\xfd\x81\xfa\x40\x1d\x6b\xe4\x16\xaf\x27\xcc\x12\xbc\x58\xb1\xfb\x2d\x23\xb9\x80\x9d\x71\xed\xf8\x38\x15\x8d\x96\xb9\x97\xb6\x0e\xd8\x9b\x96\x22\x53\xaa\xea\x76\x1d\x1d\x40\xbd\x56\x4e\x91\xba\xa3\x4a\x6f\x52\xad\x73\xae\x37\xbe\x82\xe9\xb5\xfc\x81\x22\x91\xbb\x85\x34\x67\x33\x12\xa7\xe1\x1e\x61\x4a\xfb\x57\xe5\x70\x1e\x7f\x35\x23\x1a\x0c\x66\xd7\xd0\xd4\xec\xda\x13\x68\x5c\xaa\xb1\xd3\x69\x10\x83\x70\xe6\x1d\x26\x71\xed\x62\x29\x92\x5c\x0a\x2d\x39\x38\x3b\x07\x4f\x99\xc7\xf4\xfc\x90\xcf\xab\xc1\x6f\x64\x51\x19\xe3\xf8\x4c\x34\x8c\x77\x9f\x26\x8e\x6c\x58\xcb\xd5\x2f\x89\xac\x76\x9f\xba\x92\x17\xe7\xe6\xdb\xfc\xc7\xcc\x49\xf4\x8b\xcd\x25\x90\xda\x9c\x28\xea\x87\x53\xdb\xd8\x99\xdd\x68\x40\xb2\x6b\xe9\xbc\xac\xfd\x98\x88\xf1\x27\xf2\x7e\x20\x98\x8a\xff\x3c\x77\xe7\x9c\xec\x81\xa9\x3c\xda\x3e\xd2\xc7\xf0\x78\x58\x4f\x94\x7e\x79\xa7\x33\x8e\x6d\x33\x07\x86\x30\x32\x89\x8f\x2a\xf8\xd1\xf1\xe4\xc8\x93\x4e\x07\xd3\x2f\x7a\x77\x4e\x67\x2d\x94\xcb\xff\x4b\x6d\xa8\x36\x44\x86\x75\xb8\x36\x25\x47\x4e\x2b\x0c\x21\xda\x27\xad\xd6\xb1\xfa\x7b\xda\x86\xc0\x47\xb2\xf4\x89\xbf\xf6\x3c\x24\x5e\x21\x74\xf0\x26\x1a\x18\xfc\xbe\x05\x7e\x7c\xed\x52\x3c\xc2\x93\x3b\x19\x45\x80\x16\x82\x34\x1b\x10\xc8\xe6\x1f\xda\x0d\xcb\xd3\x5c\xbf\x6f\xd6\xde\x1c\x1c\xfe\x2a\x1f\x33\x2c\xbd\x93\x05\x60\xcf\x20\x2e\x0b\xa7\x85\xd7\x8e\xaf\x90\x6b\x68\xe0\xda\x7a\xdb\x85\x6d\x20\x72\xfd\x3e\xa9\x14\x36\xf4\xdc\x3c\xad\x61\xef\x3f\xd9\x02\x9c\xf9\x7e\x4d\x9c\xde\xac\x4d\x2c\x04\xda\xe2\x1b\xeb\xdb\xc5\xc8\xa4\x72\xab\xe0\x85\xd0\xb8\x90\xc5\x57\x67\xaa\x29\x38\x1c\x3b\xfc\x7d\x22\x53\x23\x0b\xe5\xf9\xcd\x82\x19\x57\x17\x97\x81\xb6\x5d\xfc\xe7\x13\xfa\x3c\xfe\x2c\x1d\xa5\xfe\x22\x6f\x5a\x31\x93\x9f\xfa\xa3\x43\xc6\x48\x1d\xc2\x57\x45\x7c\x3f\x04\xc2\xf5\x38\x3e\x30\x50\x4b\x66\x81\x34\x8e\x7c\x12\x5f\xa6\x90\x14\xa7\xbd\x0c\x38\x70\x0d\x3b\x55\xb9\xef\xa7\x30\x45\x57\xc2\xff\x1e\x07\x89\x72\x14\xdd\xf8\x3b\x0a\xcc\x40\x5a\x5e\xa5\x4e\xbd\xdf\x27\x37\x16\xe4\xeb\x4b\x09\x22\xf1\x1d\xa2\x53\xd5\x52\xa9\x6b\x98\xa4\xad\x10\x1e\x24\x61\xed\xed\x4e\x02\x72\x0f\x5e\xe4\x74\xdb\xc4\xcd\xa6\x4f\xe0\x02\x8c\xf2\x07\xdf\xb1\xb6\xa7\xd4\x0f\xb2\xe9\xc7\x12\x59\xc8\xc7\x98\x36\x0b\x90\xf3\x16\xbb\x2e\x96\xcd\xd7\xb4\x5a\xa4\x5b\xc6\x4d\x13\xe9\x8a\x39\x08\x91\x87\x75\x26\x0a\x46\x3c\x99\x44\xfa\x1c\xb8\x09\x66\x17\x0a\x38\x08\x83\x07\x03\xd2\x39\x07\xbf\x68\xe8\x10\x17\x29\x10\x36\x3f\x0f\xf7\xac\x92\xd6\xa9\x8c\x55\x2b\xf3\xb8\x18\x9b\xad\xc9\xbe\x27\x7b\x07\x1c\x87\x55\x2d\xc0\x97\xfb\x2e\x24\x61\xd6\x8b\xa4\xcd\xed\xa4\x13\x36\x73\x73\xac\xc7\x19\xba\x6f\xa7\x29\xa8\xa8\xdc\x5e\x9e\x9f\xb8\x35\x78\x6c\x39\x41\xc5\x05\x83\x82\xbe\x5e\xb9\x0a\x3f\x4f\xfd\x45\xa8\xb0\xd0\x49\xd4\xac\xcd\xaf\x4f\x8e\xa6\x0e\xaf\x25\x47\x78\x55\xf6\xa7\xcb\xa0\x46\x71\x11\x0e\x0a\x9c\xa0\x39\x25\xdb\x0c\x44\x23\x1d\x19\xed\xef\x19\x72\xc7\x19\xc3\x52\xd4\xfa\x06\x0b\x4b\xe9\xf5\x3b\x90\x01\x3f\x3b\xf1\xd1\x9b\xef\xef\xc9\xbe\x08\xd1\xcf\x6c\x46\x79\x35\xf5\x0f\xed\x24\x45\xfe\xd4\xe4\x8c\x59\x2c\x63\xd7\x50\x24\x04\xce\xbf\x5e\xd1\x25\xde\x6d\x6f\x0f\x38\xba\xca\x07\x7b\xbc\xa3\xa0\x65\x89\x4d\x99\xf4\x40\x27\xb8\xf6\x6e\x4a\xae\xea\x75\xe0\xdb\xb8\xbd\x05\xf5\x9b\xfd\xd4\x26\xb7\x65\x14\x94\xa2\x75\x0b\x1d\xf6\xd4\x1b\xb4\x3b\x0d\x2b\x74\x09\x87\xf2\xe4\xd2\x84\xff\x6b\x48\x9f\xf4\x51\x23\x8a\x14\x58\xf2\x24\xbe\xc0\xc8\x2d\xd3\x20\x40\x8b\x23\x66\xd5\x9d\x66\xd5\xe4\xe6\xb2\x99\x25\x10\x73\x0d\xe1\x46\xcb\x35\xc8\x35\x20\x75\xe5\xa3\x70\x11\x38\x9a\x56\xcf\x9a\xe9\x49\x5a\x40\x27\x52\x49\xce\x10\x4d\xa6\xd6\x41\xa8\xeb\xa9\x14\xb3\xc4\x0f\x2c\xb0\xe5\xce\xcf\x25\x95\x63\x85\x86\xfe\xcd\xe2\x53\x6d\x69\x9f\xd1\x03\xb7\xbc\xac\xaf\x5a\xb7\x89\x26\x46\x98\x2f\xe5\xee\x8a\x15\x3f\x4d\x9d\x1d\x2d\xf2\xfb\x75\xac\x92\xc8\x7a\xcd\x85\xe8\x64\xd5\xce\x80\x8a\xff\x70\x10\x59\xea\x93\x11\x8a\x24\xc8\x92\xfa\x8e\x5a\xd1\x5b\xf5\xe2\xcb\x00\x4e\xd6\xba\x5e\x7e\xe5\x5a\x53\x9e\xac\xc2\x77\x5f\xee\x4c\xa5\xa6\x30\x0e\x8d\x60\xd3\x26\x4d\x32\x3b\xe0\xb4\x5a\xe1\xd6\x02\x62\x96\xaf\x96\x39\x24\xcd\x54\x6f\x0b\xdf\x6d\xb2\x74\x27\x6b\xbc\xdf\x10\xc5\x01\xc4\x0a\x44\x30\x60\x90\xc5\x33\x6a\x3e\x04\x9e\x78\xb4\x79\x64\x73\xba\x15\xb7\xe4\xa8\xed\x31\x60\xa3\xc2\x64\xbb\x98\xfc\xde\x9f\xde\x92\x69\x09\xaa\x94\xd8\xc6\x6c\x01\x5a\x73\x89\xa7\x3c\xc9\xb0\x23\x80\xfe\x41\xa3\x66\x79\x04\x91\xf8\xd1\x24\x84\xe2\xaf\x92\x4d\x05\x69\xfe\x36\xa6\x3c\x93\xc5\x1f\xa2\xcb\x49\x7f\x92\xd0\x48\xfe\x8a\x33\x26\x3f\x34\x84\x14\x9d\x72\x4d\xaa\x32\x94\x4f\x91\x99\x50\xb6\x13\x28\xcc\x1c\xd9\x07\x55\xb2\x88\xb0\x43\x45\x64\x3e\xbd\x93\x25\x77\x1d\xed\x58\x65\x92\xca\xcb\xe3\xcf\xd1\x64\xf9\x22\x47\xaf\x52\xa1\x25\x6e\x9f\x2c\xa3\x43\x48\x52\xc5\x70\xfd\xba\xe0\x5f\xbf\x7b\x55\x46\xd2\x07\x37\x08\x4b\x83\x82\x6d\xaf\x55\xef\xf9\xc5\xa2\x68\xef\x1f\x8b\x60\x6a\x8a\x9b\xe9\xf9\xc9\x29\xfd\x08\x6a\x1f\x53\x10\xf2\x38\xeb\xac\xac\xbd\x1d\x08\x7b\x30\x7b\xbc\xbb\x50\x4b\x46\x30\x56\x08\xd8\x3c\x99\xf1\x18\x45\x0d\xff\xea\x59\x08\xe9\xed\x85\x02\x30\x20\x3a\xb1\x4e\x4d\xaa\x78\x01\x27\xfd\x1f\x53\x44\x94\x17\x96\x0f\x79\x28\x34\x6d\x98\xb1\x4d\xe6\xa2\xdb\xd7\x25\x6b\xec\x0c\xa2\x32\xea\x07\x09\xa4\xab\x30\x65\x60\x8a\x34\xcd\x59\xf1\x2d\xfe\x88\x20\xcf\x64\xc3\x5e\x0f\xbe\x7e\xbb\xd0\x53\xff\x19\x7b\x0c\x5c\xea\x55\xc4\xf6\x76\x0e\x35\x4c\x73\xd1\xa9\x71\x7f\xf6\x90\x05\x22\x92\x6d\x34\x19\xfd\x61\xa6\xb0\x28\x45\xae\x3d\xb2\xb9\x37\xb3\x22\x62\xfe\x58\x20\x2a\x35\x6a\x63\xf6\x07\xb3\x26\x2e\x6b\x0c\x81\x94\x5a\x14\x3c\xc9\x0f\x8f\x36\xd7\x4e\xf9\x93\x0e\xf1\xe8\xc5\x1b\x99\x8f\xec\x55\xdc\xd3\x66\xd2\xcc\x55\xe6\xe1\x1f\x31\x7e\xa2\xc5\xe9\x18\x5d\x72\x23\x8d\x49\x36\xbe\x36\x08\x14\x0b\x6a\x03\x1a\xb3\xd4\x55\x8a\x33\x11\xa2\xe1\x3a\xa3\xe7\x71\x5a\x62\x8a\x98\x91\x06\x78\x85\x46\xfd\xed\x7a\x49\x30\x15\x33\xee\x7f\xfa\xf7\x87\xfa\x1f\x59\x43\x09\x22\xfe\xa5\xce\xea\xc1\xf9\x0c\xe2\x81\xf9\x83\x86\x7e\x67\xfa\xaa\x4b\xac\xa2\xf9\xd6\xc0\xe7\x3b\x0c\xef\x93\x95\x7e\xdc\x3e\xe6\xd4\x0a\x96\x62\x65\x92\x77\x29\x0c\xcf\x05\x42\x97\x4f\xfb\x18\xd5\x2c\x98\x03\x5b\xbc\xb8\xd4\x92\x8f\x47\xf1\x51\x0e\x7f\x06\xeb\x08\x8a\x47\x6b\xa3\x9e\x0b\x36\xf6\x5a\xb9\x5c\x8f\x5c\xbd\x82\x46\xbf\xf9\xc8\x3b\x17\x2a\x36\x4d\x17\xeb\x3c\x84\x1c\xd0\x0f\x98\x7b\x37\x8d\x49\xa1\x33\x7b\x14\x97\x8d\x8a\x9b\x42\xd9\x13\x4e\xb9\x0d\x2a\xb4\x31\x6b\x6b\x7f\x53\x85\x83\x5a\xfb\x30\xa5\xeb\x56\xd7\xeb\x83\xd1\x41\x80\xa3\xa9\x6c\xec\xb0\xad\x09\x9b\x99\x85\xd5\x03\xda\xa2\xf5\xfd\x45\x46\xef\xfa\xef\x4d\xd3\x7f\x26\x62\x52\x27\x19\xe3\x03\xba\xad\x0a\x8d\x8f\xc3\x04\xaf\x73\xfb\x98\xc3\x43\x8d\xd2\x05\xec\xae\xc4\x52\x87\xc2\xa0\x9a\x38\x99\x63\x08\x87\x4b\xaf\xa4\x1e\xc6\xca\xa5\x56\x52\x73\x97\x22\x0b\x1e\xca\x69\x00\x61\x9c\xa0\x91\x9a\xa2\xc3\xcc\xb2\x4d\xa1\x6b\xb1\x6c\x2f\x6e\x0b\x1b\xb1\x8f\x49\x18\x9b\x15\x91\xaf\x74\x72\x86\x87\x38\x32\x56\xd7\x81\xd3\xc0\x9e\x82\xd5\x76\x80\x77\xc0\x40\x05\x32\xa8\xda\x6f\x93\x9e\xfc\x8b\xe2\xc7\x02\xee\x50\x9a\x59\x7d\xe6\x74\x8c\x1d\xf7\xf8\xbc\xe7\x05\xf4\x0b\x41\x76\x06\xc2\xdb\x14\xad\x2a\xa4\xef\xc6\x26\x12\xa3\x0e\x99\x19\x1a\x8a\x38\x3a\x86\x77\x5f\x60\x7d\x3f\x82\x3f\x7b\xa6\x19\x9e\x07\x48\x43\x26\xf5\x08\x57\x33\xc2\x6a\x39\x16\xd7\xd6\xba\x04\xdf\x26\x9b\x76\x10\xa6\x67\xd1\xe9\x6e\xb7\xc8\xb0\x7e\x8e\x57\xc6\xf8\xc9\xfb\x15\x93\x50\x41\xf2\xf7\x2f\x0d\xc5\x9c\x1c\x93\x0d\x3f\x2e\x5c\x6a\xc9\x22\x93\xea\x60\xdf\xf4\x25\xba\x7f\x37\xb2\x06\x1d\x51\x75\xb1\xaf\xfc\xe1\x54\xf2\x77\x15\xee\x31\x1f\x7f\x1f\x67\x7b\x29\xc8\x00\xea\xbf\xd6\xa7\xa2\x39\xbe\x87\xf8\xa5\x4e\x5f\x7c\x56\xd8\xfa\xfe\x9e\x75\x69\x18\xab\x7e\x06\xd3\x1c\x3b\xb4\x3b\x6a\xba\xee\x9d\x50\x2b\xa5\x90\x90\x01\x56\xa9\x3b\xb9\xd6\xbf\xc2\x5c\x4a\xcc\xe2\xcc\x8c\xac\xba\xc5\x1f\x9f\x7c\x04\xb6\xc8\x3b\x0f\x0b\x2e\xc1\xf8\xf9\xfd\x9b\x4a\xd1\xf4\x94\xb5\x40\xfa\x5c\x4b\x2c\xc5\xd4\x1d\x67\xc0\x66\x73\xb4\x16\x23\x88\x7b\xf7\x4b\x43\x0d\x8b\x79\x54\x08\x5e\xdb\xa3\x6f\xd6\x98\xf2\xa3\x4d\x5a\xc2\x3c\xe4\xfb\x2b\x52\x76\x64\x51\x19\x39\xcb\xf5\xb1\x70\xcb\xa1\x5f\x21\xb3\x71\xfb\x84\x65\xb2\x61\x3b\xa3\xb4\xb8\xf6\xa6\x28\x55\x2c\x1d\xb3\xee\x9b\xbc\xad\x4d\xa5\x3b\x13\x2d\x70\xb1\x41\x0b\x0b\xe5\x48\xaf\x22\xee\x2d\x12\x9a\xef\x1b\x74\x16\x97\x0b\x91\xa8\xaf\xb9\xfd\x79\x11\xf1\x0b\xa1\x2e\xc4\x6e\x35\x2d\x5a\x3c\x0c\x08\x89\x6f\xc0\x39\x1d\xb1\x03\x2c\xc0\xf8\xee\x53\xac\x19\x17\x37\xc5\xb2\xea\xc0\x27\x05\x35\xc4\x60\xce\x2b\x2b\x35\x52\x5f\x0a\x0a\xa0\xb9\x8b\xa7\xb2\x73\x21\xf4\x39\x37\x70\x53\xe4\x19\x75\x6a\xde\x1b\x3f\xef\xa3\x98\xa7\x97\xb7\x6a\x67\x57\xc0\xf8\x8e\x85\x5a\xe9\xea\x47\x08\x05\x4f\x46\xfc\xbb\x62\x56\x88\xef\x95\x8c\x1e\x57\x4f\xc2\xeb\x7c\xdf\x3c\xfe\x5c\x4d\x70\x49\x48\x59\xc4\xed\xe9\x95\x97\x6e\xae\x72\xa8\xb4\x6d\x3f\x4f\x2e\xf2\x71\x2f\x8c\x01\xb3\x9c\x11\x43\xb3\xba\xca\x66\xec\xf3\x73\x6d\x1d\x54\x4a\x33\x21\x49\x3a\xec\xf4\x0d\x85\x51\x0e\x7e\xb3\x64\xdb\x31\x55\xd5\x56\x95\x5d\xf8\xa7\xb1\x33\xf4\x84\x91\x76\x25\xd6\x98\x10\xa7\x36\xe5\x64\x44\x91\xad\x06\xe9\xbd\xd2\x86\x36\xd6\x08\x89\x15\x80\xc3\x71\x21\xc3\x2d\x9d\x21\x2a\x93\x63\x97\xe1\x79\xaa\xec\x33\x00\x15\x5a\x58\x4f\xbe\x11\x49\x64\x19\x83\xf0\x39\x15\x31\x36\x6b\x49\xaf\x1d\xfe\x4a\xe2\x2b\x6d\xc2\xd6\x93\xe1\xd4\xa9\x72\x58\x41\x4f\x39\xe0\x0c\x28\x0a\x1e\xbb\x5e\x4d\xc3\x21\x55\x87\xe4\x9d\x5d\xcd\x27\x8a\x88\x89\xed\x2c\x76\xc3\x1c\x9c\x8f\xb5\xaa\x85\x33\x97\x73\x68\x2e\x5d\x28\xa4\xf5\x6d\xe2\x1a\xc3\x6a\x6e\xb2\xa3\x5d\x78\xb9\x45\x24\xc4\xcd\xbc\x9d\x0f\xbd\x6d\xd1\xef\x23\x99\xda\xef\x02\x9e\x87\x00\x30\x54\x79\x1d\xbe\xec\x5d\xf0\x9b\x61\xe2\x36\x8f\x6b\xc8\x35\x4d\x58\x8d\x2a\x69\x5b\x9c\xc2\xeb\xce\x17\xe8\x67\xa3\xf9\x9d\xe5\xe0\x87\x74\x1e\xac\x2e\x1f\x7c\x0f\xcf\xf7\xfe\x61\x41\x3d\xc2\x2f\x8d\xb0\x9b\x97\x87\x1f\x0c\x71\x2e\x19\x84\xef\xd3\x49\x64\x66\x84\xb1\xd8\x1d\x19\xc5\xae\x29\x22\x7d\xd3\xcd\x1d\x66\xa3\x57\x97\x16\xac\xc5\xf6\x08\xef\x5e\xf5\x4f\xc9\xdf\xf9\xd1\x1b\x70\x2a\x65\x5a\xf1\xdf\x4f\xe3\x5f\x78\x74\x2b\x58\x87\x8e\xf4\x87\xc4\x0e\xbd\xba\xf1\x97\x08\x92\x94\x62\x5c\x5a\xd9\x7d\x7e\xaa\xce\x1c\x8f\x78\x5c\x91\x2a\xa2\x14\xb6\x81\xc6\xdb\xc5\xa9\x7e\x51\x27\x82\x5d\x9d\xa9\x44\x02\xb9\x6d\xbd\xc5\x7d\xba\x01\x6b\xd8\x51\x5b\x78\xb4\x58\x3b\x3e\x8d\x62\x0a\xfb\x5f\x6c\x77\xae\x8e\xaa\xe4\xf0\x9a\x57\x55\xb5\x32\xfe\xe1\x4f\x38\x31\x58\x84\xc3\xbe\x25\x6d\xde\x39\x33\xb7\xcb\xb0\x4f\xd5\x67\x6d\xbb\x50\x2a\x97\xd3\xe8\xde\xb9\x69\xc1\xa6\x7c\xfe\xb1\xa4\xd0\x54\xa4\xc7\x2d\x67\x68\xbb\xd3\xb2\xa4\x75\xdf\x8f\x2f\xf7\xcf\xcd\x6d\xfa\x53\x2d\xfa\xa0\x7d\xec\x48\x3e\x9b\xed\x7d\xc8\xc9\xbd\x16\x15\x0b\x52\xa8\x6e\x93\x6f\x9b\x35\x97\x1c\x32\x8d\x26\x25\x89\xb5\x3b\x36\x27\xf2\xf7\xe9\x7c\xe8\xc6\x8e\xe4\x98\x05\x4a\x89\x14\xb5\x4d\x16\x2c\x1f\x3f\x72\x89\x6e\xe0\xaa\xfa\xcc\xb6\x97\x37\x5f\x91\xcb\x26\xb3\xc7\xc8\x68\x11\xfb\xcd\xda\x0a\x96\xb4\x9a\x19\xc1\x68\xcb\xc3\x45\x12\xbe\x01\xc9\x5c\xfd\xbc\xd4\xca\x55\x7a\x78\xb6\xb3\x9d\x3b\x69\x95\x53\xb8\x75\x08\x6f\x71\x06\x5e\xff\x4a\xea\x7b\x91\xf2\x77\x5e\x5a\x19\x48\xb3\x3a\x94\xf4\x3f\xcd\xe1\x7d\x9d\x23\x3f\x94\x72\xe4\xf6\x55\x57\x57\x57\x71\x25\xea\x03\xa2\x09\xee\xfc\x44\xd8\xa6\x79\xb9\xff\x39\xd4\x51\x9d\x48\x31\x29\x33\x79\x9a\xea\x24\x52\xed\x47\x91\x9c\x32\x88\xc3\x90\xea\x2f\x9d\xcd\x50\x0a\x80\xe1\xdf\xca\xbb\xd3\xe6\xf4\x9d\x8d\x2d\xc9\x6a\x53\x2c\x5f\x81\x05\x69\x95\x68\xcf\xc6\x25\x73\xcf\x84\x5a\x0e\xe7\xd9\x9c\x9c\x3a\x4f\xc8\x94\x75\x56\xf1\xec\x51\x1e\xc9\xb4\x9d\xe7\xaf\xf8\x71\xe7\x40\x1b\x3d\xdd\x59\x91\xd7\x6b\xd6\x13\x47\x9b\xac\x89\x5b\x93\x6f\xac\x7b\xf9\x3b\x83\x1e\x01\xb0\x8b\x16\x52\x18\xf2\xeb\x3f\xf0\x7c\xb5\x8f\xc7\x6d\x14\xfc\xf8\x75\x63\xe3\x03\x47\x2d\xa3\xae\x2e\x7f\x8c\xbc\x32\x8d\x84\xdf\x18\x71\x32\xf5\x04\x0f\x6d\xac\x40\x7f\xa6\x9d\x3a\x17\x00\xb5\x28\x05\xed\x67\x62\x4d\xc4\xbe\x99\x32\x29\xf4\x13\x79\xfd\xdc\xca\x65\x89\x4d\x98\xd6\x7b\x5f\x83\x26\x26\x00\x2b\x9a\x86\xc8\xa6\x08\x5d\x74\xe1\xd5\x61\xdb\x9d\x98\xda\x71\xe9\x06\x05\xc7\x45\xc8\x24\xb0\xd8\x25\x62\xd4\x36\x8c\xf7\x2b\x4c\x51\xa9\x55\xf9\x9d\xee\x3c\xcf\xbc\xa0\xe6\xef\x99\xf4\x29\x11\x54\xcd\xf6\x42\x49\x8c\xa1\x78\x07\x1a\x2c\x09\x1c\xea\x93\xe9\x7d\x01\x51\x09\x09\xc4\xce\xe4\x0d\xd6\xbd\xb8\xc0\x87\xaf\xbd\xd6\x57\x5b\x2c\x98\xea\x26\x5f\x1e\xc6\xa5\x3f\x79\x0e\xa5\x31\x23\x81\xe1\xda\x9b\x10\xa2\x59\xd2\xd8\x49\x62\xc8\xc6\xe5\x36\xda\x9a\xb7\x83\x23\x76\x90\x67\x43\xdb\x1a\x70\x83\x93\x38\x36\xbf\xd2\xcb\xba\xde\x92\x17\x06\xfe\xf2\x07\xe7\x6d\xa5\x7b\x5a\x4b\x3d\x11\x43\x7c\x92\x15\xbf\x8c\x12\x14\x89\xe4\x85\xe5\x49\xd0\x27\x98\x53\x49\x05\xb5\x57\xc1\x20\x44\x4e\xc1\x81\x49\x4a\xb3\x66\xde\xb8\xb5\x6a\x17\x9b\x97\xa0\x6a\x6f\xea\xf5\x95\xe1\x4c\xc5\xd9\xb7\x70\x08\xbd\xa4\xb9\xa3\xa5\xba\x0d\x56\x6b\x02\x7a\x28\x0f\x03\x96\xcc\x14\x5f\x97\x7c\x42\x31\x2b\xd9\x38\x93\xcf\xb0\x79\x13\xbc\xf0\x3d\xa3\xc7\x07\x47\x0d\xa8\x6c\x11\xf3\x22\x7c\xbf\xa6\x63\xba\x58\x49\x50\xb1\x1a\xe6\x7b\x7d\xf0\xc6\x37\xb9\xae\xad\xa1\x77\x75\xee\x47\xae\x34\xb5\xb1\x41\xc9\x34\xab\xa2\xa2\xf5\x65\x0f\xb1\x2b\x69\x0d\x87\xa0\xaf\xce\x84\x1d\x83\x5a\x21\x56\x8e\x3c\x2b\x35\xc9\x43\xc0\xd5\xde\x87\x10\x1e\x14\x8a\x50\xdf\x81\xbb\x8b\x0c\x8f\x4d\x74\x9c\x33\x50\x51\xee\xed\x76\xf8\xf7\xf4\x74\x11\x66\x96\x73\x54\x5a\x0e\xb8\x53\x24\x1d\xfb\xdf\xcd\x63\xde\x5f\x83\x37\x18\x81\xd4\xb4\xdf\x7e\xd9\xfe\xa5\x2a\xe7\x39\x3a\xc2\x8a\x32\x7c\xb5\x35\xc2\x2b\xd5\x2e\xb2\xf9\x42\x11\x08\x0b\xf2\xfe\x58\x3d\x59\x7e\x18\x45\xcc\x5c\x61\xe3\x0c\x84\xed\x0b\x86\x5e\xf9\x0c\x67\x53\x4b\xe2\x1a\x34\x29\xa6\x81\x08\x3c\x5a\x1d\x0d\x8e\x99\x62\x7d\x87\xeb\xac\x61\x99\x63\xa5\x0e\xd8\x5e\x73\xee\xd1\x60\x24\xad\x3d\xa5\x6f\x15\x6d\x97\x71\xa8\xa7\x70\x44\x8b\x6b\x03\x3a\x18\x81\xbc\xdf\x7e\x2f\x03\x0a\x78\x1a\x9b\xef\x5a\xa9\x1d\x09\x94\xac\xcd\x75\x27\xfc\xfd\x64\x48\xdf\x1e\x1b\xfb\x0e\xa4\xed\xd1\x06\xa7\xbe\xb2\xdd\x2e\x23\x12\xac\xf4\x04\xc9\x37\xf4\xe5\x5b\x5b\x75\xf0\x70\xcb\x33\xce\x13\x2a\x48\x06\xfd\xc6\xeb\xf9\x72\xa8\xda\x4b\x5b\x38\x99\x29\x92\xe0\x7b\xb9\x87\x0f\xd1\xb1\x37\x5e\xbe\x73\xfc\xa7\xbd\xed\x1e\x74\x7b\x22\x38\xb2\x9f\xf3\xb6\xc3\xb1\x88\x28\x8e\x98\x5f\xc5\xf0\xcc\xc2\x3a\x72\xe1\xa6\x66\x4e\x8b\x74\x0c\x0c\x56\x35\xf8\xde\xb7\xcf\xd7\x0f\x12\x45\xef\xce\xef\x7c\xf2\xee\x7c\xd9\xe8\x5a\xc4\x16\xf7\xe9\x8c\xe4\x4d\xb7\x4c\x26\x98\x77\x53\xd3\xff\x35\xad\x6e\x40\xd4\xba\x7d\xb5\x1d\xf3\x14\xfa\x2a\x12\xd7\x4b\xeb\x2a\x26\x9c\x30\x85\xaf\xee\xf1\x29\x5f\xea\x5b\xe5\x0e\x72\x83\x16\x2e\x5d\x3f\xa5\x56\x2a\x90\x5e\x97\xca\xcf\xd4\xcb\xaa\x35\xf1\x7b\xd6\x3e\xb5\x1e\xb5\x9e\xd5\x46\xa0\x55\x4f\xf7\xe9\x08\x08\x33\x4d\x1d\x43\x1e\x12\x0e\x83\x59\xe3\x79\xaa\x06\x97\x6c\xaf\xfd\x3c\x49\x66\xa5\x2b\x04\x99\x63\xc9\xbe\x13\xd4\x34\xc0\x77\x36\xaf\x44\x29\x77\xa6\x13\x52\x97\xb5\x38\xab\x1a\x1f\x5d\xe2\x48\x18\xc3\x85\x2c\xa8\x80\xbe\xf5\xa1\x98\x5f\x8e\x55\xd8\xf5\x46\x4b\xda\x03\x02\x87\x1d\x5a\x69\x52\x5e\x1d\xb3\xd0\x62\x14\x34\xd0\x48\xf3\x5f\x83\xf1\xd3\xc4\xfd\xc8\x74\x58\x1f\xe1\xb9\x37\x02\xa4\x14\x17\x77\x29\x74\x1d\x26\x0d\x84\x32\x2b\x85\x32\x7a\x43\x0b\xef\x58\x95\xea\x18\x7b\x37\x58\x8f\x35\x7a\x0a\x15\x4d\x9f\x96\x9e\x6c\x4b\xbb\x16\x01\xb0\x9b\xc0\x5a\x8e\x87\x6d\x2b\x45\xfd\x2c\x36\x75\x4e\x2c\x85\x65\x3b\xb5\x7f\x5f\x52\xcf\xf2\x4a\xa3\x08\xf2\x69\xce\xce\x5e\x87\x37\x44\xd4\xd5\xf1\x47\xf4\xd2\x1a\xd8\x8b\xe5\xe2\x3c\x3d\xff\x80\x26\xe6\x3f\x8a\xd9\xb9\xe7\x93\xee\xbf\xf9\x9e\x5d\xba\xd6\xf7\x78\x76\x24\xca\xbc\x45\x1b\xf9\x44\x49\x27\x65\x21\x66\x98\x94\xfe\xc6\x15\x8f\x26\xac\x50\x20\x62\xe2\x22\xb3\x44\x55\x46\xca\x42\xc7\xc2\x93\xdc\x66\x51\x6a\xc1\xfa\xf1\x20\xe7\xd8\x89\x9a\x99\x22\x4f\xdb\xc6\x1c\x20\x41\x7d\xc8\x11\xce\x8a\x2c\x2e\x58\xb3\xad\xd3\xb3\x02\x0d\x14\x04\xe0\xe6\xc8\x8a\xa8\xe2\xf5\x74\x3d\xdf\xb1\x16\x4e\xe6\xb4\x4f\xa8\x41\x45\x8f\x35\x00\x03\x9b\xaf\x23\x71\xaf\xf7\x6f\xda\x72\xf2\xe3\x95\xa6\x73\x78\xce\x6c\x1b\x09\x80\x9a\xeb\x67\x2d\xe4\xf1\x5a\x2a\x7c\xa6\x5c\x64\xf9\xa6\x53\xa0\x6e\x08\x48\xa7\x3a\x8b\x46\x50\xdf\xa6\x70\x28\xde\xaa\xed\xfd\x96\xef\x81\x81\xc8\x86\x02\x44\x5d\xa5\x1b\x38\xed\xc9\x39\xc1\x63\xac\xd3\x56\x67\xf0\x83\x5a\xa4\x87\xe7\xf7\x7c\xf9\x4c\x68\x76\x2e\x4e\xf0\x89\x07\x8f\xe5\x90\x83\xd0\xd6\xe6\x66\x4c\x46\xe1\x67\xb3\x1d\x77\x91\x3d\xc2\xb7\xab\xc7\xf7\xab\xf1\x25\x83\x02\x30\x9f\xfc\xb0\x30\x50\x88\x57\xdb\x4f\x47\x51\x8f\x40\x29\x5d\x2b\x09\x02\xa3\x06\x4c\x9f\xd4\xa2\xe2\xa4\xd9\x98\xdf\xbc\x71\x03\x19\xa3\xbb\xba\xf5\x4b\xd8\x9d\x77\xbd\xa9\xa6\x90\xa2\x87\x53\xa6\x62\x47\x01\x72\x2c\xee\x88\xcb\xd6\xd7\x21\x9f\x3a\xf1\xeb\x3a\xbe\xbe\x56\xb0\x97\xfd\x95\x5d\x41\xa5\x3b\x27\xdc\x73\xd0\x99\xb1\xce\x2a\xbf\x70\x16\x95\x82\xc2\x0a\x26\xdf\x38\x3b\x8f\xab\xab\xe3\x3f\x07\x96\xae\x9d\x5e\x6c\x0f\x11\x66\x8d\xed\x3a\xb3\xc4\x3c\xf0\x84\x4c\x20\x70\xd7\x80\x3b\x0f\xd4\x79\xc8\x83\x83\xb8\xb7\x1d\x94\x1f\xbf\xa8\xde\x5c\x7d\x67\xb3\x8f\x6f\xb7\xcd\x22\x80\xc6\xe8\xd4\x8d\x9d\xd9\x0d\x06\xf0\x5a\x5b\x7b\x67\xc7\xce\x33\x8f\xc6\x12\x99\x63\x25\x23\x42\xcb\xa6\x9e\xca\x56\x09\xbf\xaa\xd0\x7e\x8e\x3c\x93\x98\x12\x60\x3c\xcb\x9a\x5f\x45\xab\xaa\xb0\xc6\x46\xe7\xcc\xf1\x1c\x2d\x11\xdd\xd7\x26\x64\x36\x31\x26\xaa\x67\xd0\xea\xeb\x8d\x6c\x2b\x8a\x2d\x58\x52\x4f\x43\x55\xfb\x79\x06\x34\x80\x30\xbd\x3c\x42\xd7\x6c\xf5\xfa\x75\x66\x0a\xa6\x01\xa4\xdc\xc4\xed\xed\x62\xe6\x6d\xb3\x8c\x97\x96\x69\x68\x31\x6c\xa8\x14\xa5\xaa\x05\x0c\x05\xd3\xb6\xc9\xc5\x64\x0a\x1f\x72\x0c\xe4\xd5\xe6\x6f\x74\xb4\x0c\x50\x4d\xe9\x90\x7b\xc2\xe2\xa3\x23\x71\xbd\xb9\xc6\x26\xf9\x79\x13\x76\xf5\x3e\x57\xe7\x58\x87\x58\xca\x14\x53\x4e\x82\x36\x42\xe4\x7d\xca\xa3\xd3\xd5\xf9\x34\x68\x39\xb9\x38\x77\xcf\xdb\x58\xfe\x41\x03\x4d\xe2\xfe\x02\x5b\xb7\xe9\x73\x69\x28\x44\xa8\xb2\xc5\x6d\xf7\xab\x89\x4c\xb4\x00\xd4\x44\x9a\x33\x00\xfd\xd0\xce\x8e\x25\x67\x0c\x0d\xc6\x23\xca\x8e\x2f\x48\x7e\x68\xd6\x66\xd8\x41\xb2\xf7\xfb\xde\x8d\xff\xe3\x9d\xe9\xcb\x8e\x6e\x6e\xe7\x73\xd5\x7f\x16\x05\x9a\x22\x8f\x16\xd9\xd1\x3b\x38\x43\x26\xda\x36\xea\x4d\xc0\x76\x33\xaf\xf3\x2a\xc5\x62\xc1\xe1\x1e\x5a\x81\x62\x11\xc9\x9a\x78\x3a\xb9\x7c\x10\xd5\x5a\x87\xee\xfd\x0b\xfa\x92\x69\xd6\x71\xd1\xc6\xc6\xc6\xdb\x8c\xe0\xbb\x0c\xd3\xdc\x83\x43\x44\xc4\x39\x91\xa9\x84\xdf\xe8\x0d\x79\xd5\x41\xee\x74\x3b\x07\xef\x1c\x5d\xb1\xdc\xbc\x86\x65\xde\xf9\x19\xc5\x65\x6b\x60\x95\x82\xae\xc5\x3e\x01\xeb\x83\x03\x35\xd5\xdf\xb6\x26\xc5\x56\x23\x37\x2f\x8f\xb8\xfc\x0f\x49\x76\x1d\x4e\x3b\x14\x4a\x6f\x0d\x43\x25\x65\x9a\x55\x66\x2e\x38\x85\x6b\xec\x38\x4a\xe0\xb1\x6c\x8b\xd7\xc2\x95\xbb\x20\x3c\x81\x65\xfc\x43\x62\x0e\x1c\x07\x65\x3c\x39\xa0\xdf\x63\xe6\x0e\x92\x0c\xde\x07\xd5\x4d\x81\x6b\xc4\x8a\x9c\x21\x8b\x8a\xc8\xc2\x6d\x0c\x05\x2d\x76\xf5\x07\xd4\xec\x9f\x77\xd6\xbf\x37\x7c\xd5\xc6\x65\x67\xa7\x75\x4e\x4e\x6e\xf8\x4a\x48\x87\x23\x31\xd5\xae\x9d\xae\xec\x29\x4d\xc0\x7c\x74\x7c\x94\xb2\x43\xb6\x26\xf9\xf4\xda\x20\x63\x5b\xbe\x9b\x4f\xdd\x57\xb0\x93\x66\xc4\xdd\x25\x81\x07\xb0\xef\xd9\xfa\xb8\x81\x14\xc6\xb3\x79\x3e\x7d\x09\x80\x9a\xe2\x50\xe1\xf9\xce\x4f\x96\x12\xa2\x16\xaa\xc6\x14\xc4\x14\x9d\x06\xae\x4b\xe4\xc3\xe1\x12\x95\x0d\x45\xb6\xc3\x6e\x2d\x16\x35\x99\xb4\x68\x58\x8d\xe2\xc0\xa7\xdd\x49\xb1\xfe\x17\xce\x4d\x61\x89\xf4\xf3\x5b\x24\x61\x9e\x54\xf0\xc0\xd0\xa2\x6a\x4a\x91\x7a\xdd\x64\xdf\x4d\x68\xc3\x3b\x32\x95\xdf\x72\x9c\xd4\x61\x0a\xd9\xa8\x85\x23\xc3\x7f\x54\x30\x52\xe6\x70\x54\x7c\x47\x11\xee\xb5\x1c\x8a\x2a\xdd\xdf\x78\x1c\xa3\x1a\x06\xff\xb7\x17\xe0\xff\xd0\x68\x16\x4e\x51\xf8\xdf\xd7\x17\x04\x04\xa5\x45\xba\xa5\x96\xee\x5c\x40\x40\x62\x49\x69\x96\xee\xee\x6e\xa4\xbb\x96\xa5\x53\x52\x81\x05\x61\x59\x90\x96\x96\x2e\x01\x01\x59\x5a\x9a\xa5\x3b\x9e\xf9\x7c\x9f\xf9\xfd\x07\x67\xde\x67\xde\xaf\x73\xef\x9d\xb3\x76\xba\x39\xd4\x7d\x65\x38\xb8\x93\x7a\x81\x9e\xaa\x48\x40\xe7\xe1\x11\x3a\xb1\xf5\x90\xd3\xc1\x39\x3d\x4f\xcc\x1e\x3d\x9f\xd7\xc4\x0b\xdc\xc5\xfb\xf5\xba\x6f\xef\x16\x27\x15\x9f\x96\x1f\xac\x8e\xf6\x67\x65\x51\x9e\x71\x5e\x0a\xde\x98\xa5\x63\x9a\x6e\x62\xa1\x0c\xfc\xc6\x76\x30\x4d\x1c\x1d\xd0\x42\xbe\x7b\x0f\x34\x76\x7b\x2c\x7c\x51\x23\x43\x99\x97\xcc\xf2\xde\xa3\x8d\x74\x1b\x63\x97\x69\x8e\xff\xd9\xf1\x25\xd3\xe8\x1b\x85\x80\x91\xd9\xaa\xd4\x13\x20\xe6\xe0\xfc\x74\xc5\x57\xba\xa5\xb9\xc6\xc3\x08\xd9\xe5\xa5\xd8\x05\x4b\x4e\xd5\x2c\x81\x25\x98\xf0\xbc\x9e\xb5\x6d\x93\x45\x2e\xf7\x09\x90\x60\xf5\x7a\x17\x93\x06\xab\x13\xd9\x96\xf2\x15\x51\xc9\xa9\x32\x11\x9b\x6d\xf0\x1a\x23\x62\xda\xb2\x27\xd2\xd4\x5f\xf2\x6c\x93\xba\x4b\x07\x4a\x07\xf2\x5f\xd8\xab\x2b\x9f\xb6\x7f\xb0\xd5\x9a\xc3\x5b\x5f\xa1\xef\x6c\xe4\xf4\xbf\x3d\x63\xe6\x49\xed\x92\x53\x6e\x38\x46\x1a\x06\x49\xca\xae\xdf\xd3\xae\xb8\xa6\x86\x76\x6b\x40\x98\x5a\x06\xe5\x43\x93\x6d\x99\xa5\x45\xa2\xa2\x14\x66\xab\x16\xc5\xad\x83\xea\x87\x4e\xe1\x76\x5b\x36\x32\xe4\x18\x24\x86\x6f\xab\x09\xed\xde\xc1\x44\xb7\x2e\xe7\xc4\x0a\x7d\xb3\xb7\x2d\xc3\x11\x58\x0c\x4c\xb3\x7b\x50\xfd\x49\x53\x5e\xec\xbc\xcc\xd1\x9c\x1a\xac\x10\xa2\x4c\x65\x4f\x7a\x49\xef\x2c\x0b\x5c\xff\xfd\x97\x67\x43\xd7\xf5\xf3\x11\xc3\x44\xa6\xaa\x0c\x18\xb3\x1d\x25\xba\xf9\x6e\x54\x46\x28\x2c\x6d\x3c\xec\xd4\x46\xe6\xc8\x42\x29\xbc\xd5\x3e\x52\xe6\x46\x16\x4f\x13\x4a\x1d\x36\x03\x52\xb1\x22\x9d\xa8\x99\xaa\xdf\xba\x0b\xbe\xb9\x4e\xe5\x69\xaa\x70\x4c\xb3\x23\x29\x06\x5c\x4c\x12\x88\xc2\xfb\x6f\xcd\xae\x7f\xab\x1f\xad\x6a\x7e\xe7\x28\x43\x42\x05\x29\x04\x28\x53\xd3\xf1\xf1\x03\x25\x9f\x8a\x15\x53\x39\x38\xd2\xa9\x4a\x71\x26\xc4\x82\x2b\x72\x2a\x00\x47\xb6\x5e\x5e\x2f\x5d\xb1\x51\xb6\x81\x54\x27\x97\x0b\xab\x27\x97\x0b\x73\x6d\xe0\xbc\xca\x83\x0b\x61\xec\x88\x55\xe3\xc2\x8d\xc4\x74\xd3\xc7\x22\x61\x2a\xbc\xd2\xf2\x06\x64\xcb\xdc\x3c\xf1\x2f\xc3\x8d\xce\xdf\xc0\x5d\x94\x67\xb0\x87\x42\xcb\xca\xfa\xf6\x68\xb6\xb3\x1b\xea\xe4\xf0\x9e\xee\x0f\x7e\xde\x9c\x73\xc1\xf4\x96\x8d\xcc\x9a\x6e\xc2\xcf\x2f\x3b\x0d\x2b\x0e\xee\xc2\xb4\x65\x3e\xa0\x9b\xc5\xd2\x03\xf1\x91\x59\x80\x84\xc4\xcb\xae\xe7\x9d\x6b\xe0\x3e\xc1\xf7\x09\x8b\xda\x2f\x53\x79\xaa\x4e\x82\x29\xae\xed\xf2\x55\xd3\x3f\x33\x11\xd2\xb0\xda\x66\xdb\xf2\xaf\xcd\xb4\x59\x01\x31\x67\xcc\xe8\x7e\x2a\xe3\xf2\x54\xc9\x67\x21\x10\xd1\xd2\xe9\x2b\x7b\xec\xf8\x6f\x42\x04\x3e\x7b\xfb\xf6\x3c\xef\x79\x5e\x9f\x71\x5a\x09\xe7\x97\x46\x6d\x7d\x6f\x97\x17\x50\x76\xaf\xab\x66\x6d\x97\x30\x26\xca\x84\x5a\x8f\x6e\xff\x1b\x5c\x26\x44\xde\x3b\x05\x99\x39\xb6\x5c\xf9\xe9\x2e\xbc\x09\x66\x13\x12\x35\x92\xf7\x5f\x57\x26\xea\x4f\xc7\x60\x28\xe4\xed\xb9\x0e\xa9\xdc\x04\x68\x14\x01\xd7\xf7\x16\x56\xdd\xcf\x56\xbc\x02\x61\x58\x32\x1b\x1e\x7c\x42\x9c\x8d\x50\xa6\x38\x4d\xe5\x12\x68\xaf\x98\x4a\xfa\x0e\x93\xc5\xd9\x6d\x12\x7f\x34\x86\x9d\xbd\x42\x82\x74\xe8\x7d\x54\x07\xdb\x27\x59\x42\x1c\x19\x7d\x5d\x24\x75\xa6\x35\x89\x68\x48\xa7\x42\x2c\x6c\x6b\x0d\x33\x62\x46\x67\x44\x84\xbf\x56\x41\x2d\xc9\x64\xfa\x1b\x3d\xcf\x1b\x48\x79\xb8\x0e\x47\x84\x0f\x9b\x06\x71\x00\x44\x90\x23\x67\xac\x12\x37\xd6\xaa\xfc\x9d\x60\x64\xda\xab\x15\x9a\xe0\xfa\xfd\x31\xd2\xb6\xda\x46\x42\x58\xf5\x34\x54\x64\x56\x8b\xe1\x7b\x37\xe1\xe4\x12\xde\xba\xcb\x49\x7b\x0c\x9d\xbc\x81\x24\x2b\x8f\x50\xb4\xf0\x2f\x83\xba\xfb\x9c\x25\x4a\x71\xcd\x8c\xc4\xc5\xa8\xc8\x95\x90\x99\xed\x24\x5e\x65\xf7\xf0\xfe\x0c\xd2\xd5\x37\x7f\x1c\x99\x95\x40\x15\x10\x6a\xe6\x39\xcb\x47\xe5\xe6\x95\x90\xe3\x1a\x98\xde\x33\x84\x8d\x31\xbe\x77\x6e\xe4\xd5\x8f\x6a\x99\x0d\x65\x2f\x89\xe8\xba\xab\x5d\x36\xef\x77\x47\xc9\xa4\x5e\xe8\xab\x43\xfa\xb4\xb6\x2b\xd8\x35\xbe\x01\xd9\x36\x32\x2d\x12\xf4\xca\xc0\x84\xc6\x72\x36\x65\xef\x6f\xe0\x31\xed\x18\xb2\xf7\x67\x4d\xd6\x2d\x46\x78\x06\xfa\x2f\x97\x75\x23\x77\x6c\x7f\x77\x70\x0a\x86\xd3\xfd\x05\x9d\x15\xc1\xad\x03\xd4\x9b\x86\xb5\x84\xa3\x5a\x7a\xb2\x23\xe7\xf3\xb6\x3d\x41\xed\xb3\xef\x2f\x9c\xf8\x54\xfc\x4c\x82\xb4\xb5\xd6\x24\x7a\x18\x4d\x1a\xdb\x67\x07\x03\xbe\x9f\x9b\x17\x67\x64\x68\xd3\x5e\x05\xfa\x2b\x37\xcc\x39\x4f\xb9\xf4\x47\x5d\x7e\x55\x76\x50\xdd\xfe\x72\xda\xaa\xec\xef\x96\x79\xb1\x3f\x35\xd6\x66\x28\xbd\xa6\xa0\x78\x9d\xac\x9a\xb3\x64\x4e\x6d\x9e\xbe\xd3\x94\x81\xa1\x01\x20\x05\x6b\xfa\x64\x3a\x69\x24\x54\x4c\xd8\x2f\x41\x12\x47\x55\x46\x6a\xcb\x7f\x29\x9f\x35\xfd\x74\x54\xe4\x71\x9c\xde\xd5\xa9\x71\x8a\x9e\xf7\x9b\x40\x7f\x23\x23\xd4\x64\x4c\x09\xf9\xd0\xc9\xcc\x4f\x18\x64\x8a\x10\xe8\x62\xe8\x08\x92\x71\x45\x46\xe0\x49\x89\xfc\x3b\xc7\xac\x1c\x53\x52\x8b\xef\x81\x64\x33\xcb\x76\x09\x89\xab\x74\x4f\x5b\x78\xfb\x0c\x38\x17\x31\x16\x54\xce\x25\x4d\x17\x0d\xed\xf9\x27\xb9\x0b\xd5\x1b\x0c\x59\x77\xad\xf9\xfb\xe3\x87\x33\xde\x00\x60\x00\x7d\x2b\x15\x37\x10\x5b\xe2\xc1\x73\x6b\x91\x51\xaf\xc1\x90\x7d\x57\x09\x85\xbc\x4f\xd5\xef\xf4\x6c\x2c\x27\xae\x73\xe7\x61\x77\xa9\xba\xa8\x0f\xac\x6f\x07\xab\xac\x0e\x3b\x98\x7c\xd1\x22\xcb\xfb\xd4\x5b\x14\x49\xb0\x10\x9c\x5d\x21\xa8\x8d\xc8\x8f\xbc\x1a\xa3\x12\x54\x53\x5f\x76\x04\x8a\xd5\x45\x44\x56\x58\x7a\x97\xd7\x54\x4a\x38\x55\x26\xd4\x35\xc8\x71\x97\x3e\xbf\x1f\xf7\x4a\x2a\x99\x09\x7e\x3b\x9d\x5c\x3b\xc5\x50\x61\x2d\xe1\xe0\x15\xea\x01\xaa\xa1\xd9\x04\xab\x15\x60\x18\x17\x98\xc9\x76\x4a\x3b\xba\x67\x62\x04\x1f\xc2\x17\x96\x19\x2f\x7e\x1b\xd9\xc2\x9b\xb3\xbc\x0c\x93\xe1\x0f\xfe\xc8\x9a\x7e\xb4\x79\x4e\x26\x8e\xf1\x2e\x27\x64\x8d\x92\x86\x53\x1f\xff\xe4\xad\xa3\xd3\x1b\x33\xc0\x6b\x97\xa9\x4a\xe2\x87\xdf\xf3\xbc\x05\x1a\xe4\x82\x4b\x17\x65\xa4\x5d\xfa\xa0\xd9\x7c\xc3\x6f\x8b\x8b\xa9\xd8\x9a\x4e\x03\xd5\x4d\x53\x4c\x22\xd8\x66\x2f\x8a\x48\xa0\x89\x17\xb4\xa3\x96\x54\xce\xd8\x6e\x91\x93\x29\x35\xa3\x1c\x94\xc9\x39\xae\xf5\x8a\xd0\x8f\x05\xa1\xd7\x09\x3d\x25\x43\xce\x5d\x5b\x16\x55\x03\x40\x2e\xfd\x70\x92\x09\x30\xa3\x34\x86\xc9\xa7\x4a\xe6\x2e\x9e\xc5\x63\x9f\x38\xb9\x6e\x47\x74\xa5\xf8\x1d\x8e\x52\x5e\x46\xdc\x03\xbe\x2f\x12\xa5\x5f\xd8\xfb\x1e\x55\x87\x3a\xe7\xfb\x8c\x8e\x0a\xf4\xc0\x3f\x7e\x34\x84\xb2\xef\x43\x97\xd2\x6d\x30\x35\xd7\xf4\xf9\x51\x1f\x4c\xf3\xc4\xdf\x34\x7b\x6f\xb0\x64\x9f\xf3\x3d\x2a\x90\xc5\x06\xa9\x70\x5e\x6a\x73\x73\x70\xe1\xa6\xa3\x3c\xb4\xe7\x40\x38\xa0\x52\x60\xe5\x2c\xa0\xd0\x37\xe8\x3f\x8e\xb8\x45\x16\xbf\xc5\x1f\xb5\x17\x54\x30\xff\x83\x3c\x7e\x2b\x68\x45\x79\x84\xc5\xda\x86\x61\x81\xfd\xc8\x4e\xdc\x46\xb1\x27\x3f\x52\x95\x63\x77\x13\xc9\x12\x8e\x9a\x46\xf9\x22\xfd\x3f\xc5\xce\x22\xcc\x23\x09\xdd\x8e\xba\xea\x9b\x50\xa1\x09\x24\x7e\x26\xdc\xc6\xe9\x26\x26\xd8\x33\x6d\x8d\xe5\xce\xd1\x44\xe1\x3b\x16\xc6\xdb\xc8\x7f\xfb\x4a\x5e\xe9\x55\xf6\x18\x45\x8d\xf4\x24\xf2\x0e\xc8\xa0\xe4\x89\xce\x74\xc9\x55\x34\x2b\x6a\xf0\x17\x87\xc1\xa7\xc0\x17\xe0\xd7\x32\x02\x27\x14\xf8\xb1\x54\xbd\xed\xaf\x84\x85\x3a\xf0\xa4\x38\xb0\x22\xb9\x7c\x7d\xaf\xd9\x10\x6b\x97\xc3\xcc\xb3\x36\x83\xce\xcd\x3f\xb5\x21\xa8\x49\x75\x9c\x97\x97\x7a\xde\x31\x5e\xaa\x55\x6f\x92\xbe\x09\xb3\x15\x20\x5a\xba\x0b\xee\x03\xcc\xfa\x47\x4e\x9a\x59\xfe\x20\x4a\xec\x61\x69\x91\x84\xd6\x01\xe1\xd3\x31\xa2\x27\x9a\x5a\x75\xe3\xa1\x33\x8c\x72\xa0\x81\x6d\xcb\xde\xb2\x58\x8d\x56\xd5\x1d\x6f\x62\x3a\xe6\xd5\x91\x7e\xfd\xa4\x54\x92\x08\x51\x9d\x9b\xa2\x60\x37\x01\x85\x17\x89\xaa\x0b\xcd\x39\x0b\x58\xd2\xe1\x67\x53\xa3\xdb\x5c\x88\x1d\xbc\xff\x3e\xce\xe4\x8b\xd5\x6b\x0a\x5c\xac\xc3\x63\x14\x1c\x81\x18\x45\x3e\xa1\x15\x1e\x93\xde\xdf\x13\x4c\x8f\x6c\x6e\x9f\x3c\x28\x5c\x9f\x53\x72\xbc\x77\x06\x5e\xce\x7a\x9b\x4b\xc7\x5f\xf4\x0b\xcd\xfe\xa5\xff\x60\x45\xad\x5f\x46\xe1\xd0\xdc\x5f\x3f\xbb\x98\xc9\xb2\xe1\x2f\x56\xc5\xe6\x15\x45\xe6\xe9\xfe\x5d\xce\xc9\x2e\xb1\x6c\x94\xc1\xa8\x53\xd8\xef\x35\x6e\x7d\xf9\x33\x4d\x54\xb8\xac\x72\x8f\xc4\x6d\x27\xbe\xeb\x0e\x38\x20\x83\xe7\x34\xb3\xf4\x6d\x0c\x35\x0d\x9d\x9b\xeb\xcf\xff\x50\x22\x1f\x37\x79\x1e\xb7\x19\xe7\xda\xa2\x02\x9f\x2b\x9d\xb6\x3f\x3b\x6a\x59\xb5\x84\x7d\x59\x48\xf8\xee\xf6\xdd\xd0\x90\xa4\xc0\x2c\xa9\xae\xac\xa6\x65\xf3\x27\x8f\x4a\xb0\x08\xb6\x7b\x83\x12\xa0\x89\xc1\x81\xa9\x56\xbc\x65\x1e\x67\xc2\xee\x6d\x01\xa1\x6a\xd6\x1b\x5a\xa9\xc0\xb8\x1d\x15\x5f\x3f\xdf\x5c\x95\xe1\xd3\x01\x8a\x47\x5f\xe3\x49\xe2\xae\x47\xc1\x80\xc2\x33\xe1\xd2\x36\x85\x1d\x64\x88\x98\xbc\x03\x9b\x9e\x29\xa3\x4f\x67\x03\xc5\xc0\xee\x00\xa4\xf4\x2f\x2e\xa3\x1b\xb6\x5b\x24\x0f\x35\xa7\xee\xc7\x69\x57\x44\x9a\x47\x12\x61\x8a\xc2\xee\x6a\xa3\xf7\x05\x54\x51\x8f\x3c\x86\x4c\x2e\x0a\xd5\x1d\xb2\x36\x40\xf5\xed\x1f\x59\x00\xc3\x27\xca\xbe\x60\xd3\xb5\x58\x22\x4d\x42\x1d\xcb\x72\x2d\x5e\x30\xa7\x7b\xa8\x41\xa7\xde\x6a\xd9\xb2\x0b\x8c\x0b\xab\xeb\xf1\xca\xa8\xf1\xe7\xce\x96\xe3\x2f\xd3\xfa\x78\xd4\xb0\x44\x88\xf4\xbf\x5c\x05\x6c\x99\x2a\x8a\xf9\x27\x4a\xbf\x3e\x34\x07\xd7\x79\xaf\xcf\x13\x62\xe8\x80\xbd\x8f\x0c\xb3\x8b\xce\x5e\x7d\x2e\xd8\x6e\x91\x66\xaf\x97\x22\xd1\xb2\x13\x31\x93\x7f\xc5\x1a\xce\xcf\xac\xc7\x3d\xbc\x00\x48\x72\x2b\x7a\xa9\x82\xe7\xa7\x7f\x3b\xf6\xfa\xad\x70\xdf\x44\xd4\x9a\x10\x8d\xfb\x37\xf5\xbf\x96\xaf\x59\x91\x45\xff\x82\xb2\x42\x58\xe5\xed\xf8\xcb\x28\xe5\x55\x79\x07\x3e\x1a\x1d\x24\x86\xa3\xce\xe7\x36\x87\xca\x8b\x86\x56\x4d\xbb\xad\x6b\x96\xe9\x71\x1e\x97\x91\x5d\xa5\x5a\xf3\x45\xdc\x6f\x0a\xbc\x2e\xf2\xd7\x03\xdb\xb7\xb8\xad\xa3\x19\x38\xc6\xb8\x8a\x93\x09\x65\xba\x0c\x9c\x5d\x1b\xff\x7b\x68\x95\x9e\xa7\x09\xfc\x49\x1e\x1e\x40\xb2\xad\x28\xdd\x26\x28\xbe\xaa\x1a\x86\x61\x27\x08\xfa\x6b\x0b\xcc\x65\xfc\xed\x23\xc8\x1a\xf5\x35\x1f\xd3\xec\xb8\x0d\xe6\x33\xc6\x42\xa1\xc0\x11\xd8\x29\x35\xe1\x50\xd0\xf0\x51\xe6\xe7\x9e\xbd\x2a\xc3\xbb\x41\x72\xdc\x08\xf5\x96\x58\x7f\xb9\xfa\xc9\xeb\x90\xae\xa7\x7f\x54\x11\xae\xa9\xcf\xc1\x57\x2f\x41\xe5\xbb\x42\x72\x7a\x6f\x68\x9c\x66\xbc\x74\xad\x7a\xc5\x15\xeb\x89\xd6\x82\xfc\x32\x8a\x08\x79\x55\x46\x11\xd7\x30\xc2\x88\x0f\xca\xce\x79\xfc\xab\x69\xc8\x98\x13\xe1\x9b\xe8\xbf\xee\x5a\x67\x37\xc3\xda\x4f\x93\x89\x64\x24\xcd\xd6\x4d\x39\xd3\x2e\x83\x4d\x9b\x23\xb5\x2c\x0e\xac\x9c\x8d\xc4\x8e\x19\xef\x4f\x6d\xd5\x50\x6f\xbe\x82\x24\xd0\x46\xe5\x41\x79\x86\x0f\x6c\x2c\x49\xc1\xca\x30\x1c\x28\xd3\x38\xbf\x82\xfc\xc8\xfc\xbc\x54\x40\xd1\x0c\xca\x75\xd6\x99\xd9\xcf\xb4\x72\x27\x00\x18\x70\x96\xf3\x38\x94\x7a\x8b\x5a\x50\xe4\x4c\x84\x76\xba\x46\x7e\x35\xa5\xf0\xca\xd4\x90\xa8\x68\x1e\x83\x3b\xba\xd6\x2f\xe0\x3a\x0c\x3e\x49\x8c\x77\xd5\xb4\x0e\x2a\x0f\xf1\xbc\x96\x93\xc5\x18\xaa\x4f\x15\x16\x59\xfa\x4f\xc8\xc0\xc7\x80\xa0\x76\xff\x64\x8c\xed\xfb\xf2\x11\xbe\x02\x55\xd0\xc6\x67\x5f\x12\xc8\x3f\x0a\xd9\xc0\x0e\x51\xf1\x68\xde\x1f\x85\xda\xa1\x38\x8d\x2f\x6d\x37\x3b\x1a\xc3\x7e\x67\xd1\xe3\x9e\x06\x83\xf7\x6e\xc4\xc8\x2b\x54\xae\xe8\x68\x66\x8e\x74\xf3\xbc\x53\xbb\xc4\x83\x51\x77\xf9\x50\xc8\x4a\x21\xcd\xd3\x4d\x76\xb7\xbb\x60\xfe\xe9\xe4\xe6\xfb\xb6\xe4\xb4\x50\x0e\xa6\xaf\x65\xad\xd5\x0a\x55\xe0\x2d\xca\x1d\xea\xf1\x5f\x56\x35\x6f\xdf\xfb\xb7\xaf\xa7\xff\xcb\x92\xd7\x63\x74\x3c\x9d\x91\x81\x83\xe4\xd8\xd4\x27\x27\x45\xfe\xbf\x8e\x8a\x35\x82\xd5\xd0\xd1\x39\xfb\xfe\x8c\x2a\x0d\xd1\x22\x35\x77\x52\x43\xcd\x5f\xc1\x0d\xe6\x4e\x41\xb1\x36\x79\x5e\x01\x52\xb5\x83\x3a\xc7\x87\x7f\x43\xe3\xbe\xb2\x23\x2c\x5d\xd8\x39\xc6\x35\x72\xbd\x1b\x26\xf8\x68\xb9\x5a\xc9\x53\x5a\xf3\x8c\xf9\xf2\x53\xa7\x03\xe3\xf9\xb0\x40\x73\xc9\x97\x46\x5c\x79\x30\xe1\x19\x38\x30\xe0\x2e\xad\xdc\xa3\x9b\xf9\x49\x01\x72\x92\x1d\x76\x2f\xf2\xb1\x6a\x3c\xac\x33\x30\x5e\x38\x2f\x94\xc3\xba\x8a\x6a\xda\x05\xa3\xff\x0b\xe3\x28\xde\xbc\xee\x06\x46\x3d\x37\xcf\x2e\xe2\xfd\xc6\x07\x86\x72\x83\xd4\xde\xcf\x56\xb9\x4e\x67\xbd\xb5\x38\x88\xed\xfb\x4f\x24\xf6\x56\xc2\x22\x4b\xf9\x15\x9f\x57\xab\xd5\x36\xb1\xba\x9d\xc9\xad\x6e\xea\x9f\x1f\xbb\x1d\x58\x70\x96\x25\x96\x8d\xbb\x44\x37\xcc\x9d\x7e\xc2\x4f\xe3\xa4\x9f\xb7\x79\xc6\xec\x04\xea\x62\x76\x9c\x51\x17\x4a\xa5\x5f\x0d\x01\xf7\xd8\x5b\xad\xff\xb6\xac\x55\x4d\xcb\xd9\x34\xbe\xc3\x67\xcb\x56\x5c\x23\x3c\xae\x19\x35\x0e\xcc\x83\x11\x5b\x5b\x0f\x11\xbe\xf0\x68\x54\x50\x87\x54\x40\xb2\x16\x6d\xa1\x29\xd9\x36\xa8\x8e\xbb\xfe\xe6\x2a\x35\x8c\x09\x04\xa1\x22\x8d\xa3\x4b\x9d\xb6\xee\x3d\xad\x79\x6f\x0d\x76\x9f\x3a\x9a\x71\x15\xfc\xa9\x27\x5b\x3a\x2f\x86\x5e\x91\x63\x4d\xb1\xb3\x49\x94\xea\xe7\xcb\x8e\x27\xe5\x32\x3c\x52\xed\x81\x96\xc9\xea\xc2\x71\xf5\x49\x8b\xbe\x60\x8d\x7f\x32\xae\x19\xcb\x5b\xa2\x70\x7c\xdc\x37\x79\xef\xbf\x74\x4b\xfc\x00\x18\x28\x8b\x25\xa0\x30\x35\x9d\x71\x44\x78\x68\xfe\x62\x54\x73\xc0\xd4\x85\x2e\x38\x22\xec\x9b\xda\x3b\x42\x43\x06\x83\x5b\xb7\xac\x76\x27\x70\xd8\x55\x14\x4d\x1a\x5b\x3a\x15\xc9\x99\xa8\x8b\x71\x73\x27\xc9\x9c\x34\x23\x08\xe4\x7d\x33\x34\x47\xae\x79\x4c\xa7\x0e\xbc\xe3\x37\xbf\xea\xf1\xd5\xc5\xa4\x24\xd6\xf6\x93\x8f\x96\xd5\x77\x31\x82\x20\xec\x9e\x37\x44\x7b\xbe\xf5\xb3\xeb\x66\xcf\x67\xf5\x87\xc0\xc0\xfe\x67\x49\xe9\x9d\xe2\xa1\x21\x6b\xc3\x05\x27\x47\x5d\xf6\xee\x31\xcf\xca\x80\xc1\xe7\x90\x47\xca\x83\x00\x7f\xe5\x29\xf5\xe0\xb6\xf1\xef\x4b\xcd\x70\xde\x3c\x1b\xe3\x09\x52\x25\xc0\x27\x05\x01\xba\x64\xd7\x6f\x3c\x6e\x2f\xf6\xa5\x9f\x36\xa5\x9f\xa4\xcd\xdf\x57\x22\x7a\x26\x53\x44\x53\x72\xc7\x6d\x45\xcd\xfd\xdc\x52\x17\x58\x87\x9a\x7a\xb1\xc9\x6e\x98\x3c\x54\x21\x4c\xfe\xa1\xfd\xb7\xb7\x69\xa5\x6c\xd5\x63\x7a\x00\x28\x14\x95\x3c\x13\x45\x28\xba\x66\x29\xda\x7b\x4e\xa2\x85\x1d\x45\xe2\x1f\xae\x48\x3d\x84\x78\xd1\xb3\xb2\xe1\xb5\x64\x38\x31\x39\xd4\x21\x25\xb9\xd3\x83\x94\xb2\x20\xac\x65\x5e\xad\x4a\x14\xd3\xd2\x01\xd8\x54\x2d\xdb\xf2\x92\x07\xeb\xa9\x4c\x30\x81\x44\x81\x40\xa6\x97\xdc\x2c\x19\x09\xb5\xce\xcc\x89\x53\x6a\xc4\xcd\x46\x2e\x67\x8d\x5b\xf7\x19\x0b\x07\xdc\xee\x87\x41\x4c\x16\x1f\xbe\xe1\xc9\xb2\xd7\xc2\x8d\xc7\x58\x9c\x5b\x96\xac\xf7\x20\x63\xe4\x79\x54\x93\x89\x3e\x6b\xdd\x20\x07\x91\x9b\x52\xb6\x84\x08\xba\xd0\x9b\x70\xe9\xc7\x3d\x8d\x82\xe2\xe2\xf3\xbb\xdb\x37\xf8\xdd\x81\xfd\x57\xc7\xc7\x3e\xfd\xff\xa8\xe1\x8b\x49\xb3\xb3\x6f\xd1\x35\x66\xa8\xbc\x41\xbd\x2f\x34\xbe\x39\xf5\x06\x94\xe5\xa1\x3e\xef\x39\xf7\xd8\x0b\xca\x2b\xc9\xb4\xac\x94\xcf\xba\x76\xa5\x32\x0f\x27\x6f\xcf\x25\xbe\xf5\x76\x20\xf7\xbe\x57\x02\x34\x4c\x58\x26\xad\x6f\xec\x43\x80\x93\xa0\xd2\x28\xd9\xec\xc3\x63\x54\x63\x77\xf7\x75\x46\x27\xcf\x84\x58\x16\x78\x64\xfb\x16\x53\xad\xe4\x6d\x14\xf6\x07\xf6\x81\xe1\xb9\x38\x95\x9a\xd3\xe8\x0f\xef\x98\x63\x23\xd1\x8a\xa0\xaa\xa4\x98\xb2\xd8\xd0\xc6\x24\x52\x2f\x71\x0f\x59\x6e\xcc\xf1\xa3\x23\xbc\xbd\x39\xc0\x91\x02\x97\x4d\x24\xcf\xeb\xc9\xf3\xf9\x74\xe4\xfc\x3c\xe9\xec\x1e\x74\xb4\xa6\xc6\x28\x8a\x35\xaa\x64\xc5\x75\xd6\xb5\x45\x4c\x4c\x72\x73\x77\xb7\x40\x5d\xe2\xcb\xa2\xd2\x09\x30\xbe\x1e\x08\x5c\x6d\x4f\x79\x28\x90\x13\xdd\xf5\x36\xf4\xbe\x43\x6c\x16\x08\xd5\x8e\xb3\x20\xe9\xc5\x9d\xb8\xc0\xa6\xee\x17\x67\xb8\xe1\x96\x61\x41\xa9\xb2\x64\x24\x36\x20\x30\x69\x92\x9d\x36\xe9\x39\x66\xa1\x6f\x95\xf0\x6f\x0f\xa8\x74\x43\x76\x14\x61\x84\x42\xc1\xd4\xcd\x7e\xcd\xfe\xd9\xce\x1b\x36\x95\x46\x60\xd5\x9c\x12\xb9\x06\x2b\x30\xf8\x2c\xaa\x66\x3a\x0e\x35\x31\x29\x76\xe8\xad\x24\x5b\x2c\xef\x7a\x72\x6a\x3f\xc0\x97\x9d\x28\x56\x94\xf6\x8b\x9e\x29\x65\x33\x19\xc1\x96\x5f\x39\x80\x65\x46\x3e\x21\x96\x35\xca\x1c\x50\x34\x8b\xba\x06\x73\x92\x16\x14\x15\x79\xba\xbb\x7f\xaa\x28\x46\xfd\xb4\x17\xb4\x95\xca\xd7\xe3\xb6\xb5\x90\xf7\xff\x52\x7f\x12\x5c\x5c\x22\x8c\x4c\x47\x34\x9b\x84\xd4\x98\x55\xf5\x64\x62\x05\xbd\xb3\xd8\x87\x0c\x7b\x0f\x3b\x37\x32\xe1\x5b\xf6\xf2\x5a\xe6\x72\x03\x46\x22\x04\x55\xc0\x95\x00\xe5\xc0\x7e\x4d\x1d\x8e\xd2\x0f\x9a\x59\xde\xf7\x26\xb4\xd2\x01\x0f\x70\x8d\x11\x72\x58\xab\xa8\x34\xeb\xb8\x17\x69\x22\x66\xd8\xed\xa8\x59\x0e\x0f\x69\x47\xe8\x65\x42\xbf\x58\x9b\x47\xa4\x96\x1c\xf1\x1d\x49\xe5\xda\xc0\x80\x18\xbe\xff\x43\xac\xf4\x14\xf2\x4f\xb2\xba\x48\x54\x4b\x0f\x4f\xcc\x8d\xde\xcb\xa6\xd9\x3d\x12\x98\x50\x64\xcf\x5a\xe4\x6a\x15\x82\x65\xd4\x8b\x14\x4e\xb1\x64\x0f\x64\xc6\x79\x99\x4a\x7f\x82\x03\x04\xe7\xda\x8e\x1a\x32\x07\xc8\x87\x6a\x99\x27\x0f\x18\x8e\xda\x0b\xda\xf1\x4d\x9e\x02\x53\x1b\x53\x76\x67\x38\xab\x7e\x33\x17\x5d\x54\xbf\xe3\xf3\x70\x99\xe2\xfa\xac\xe5\x94\x07\xc3\x32\x93\xbc\xdd\x4f\x14\x5d\x13\xdb\x11\x15\x17\xdf\xd8\xd8\x88\xe8\xeb\x93\xf3\xf5\xf5\xe5\x9d\x3f\xbb\xdb\x8d\xf3\xe4\xb7\xa8\x5a\x94\x01\xc8\x13\xdf\x01\xe9\x4c\xbf\xf3\x66\xd9\xb6\xcb\x97\x4d\x97\xac\xa2\xde\x87\x75\xa7\x23\x59\x2a\x56\xd6\xc8\x20\x8e\x7f\xa7\xfc\x9c\x5a\x96\x5b\x54\xab\xa4\xa1\xc4\x22\x92\xa9\xb6\x65\x9a\xf2\x1f\xe6\xb5\xb2\x98\x45\x63\x7d\xf2\x6e\xf9\xeb\x8d\x3b\xd1\x09\x34\x00\xb5\x35\x18\x2f\x06\xcb\x00\xf3\xa2\x49\x75\x0a\xd6\x2c\xb3\x00\xfc\x48\x54\x00\x93\xe6\x87\x6f\x91\x23\x6f\x7d\xdf\x11\x75\x79\xc0\xf0\x8f\x43\x92\xa5\x07\x82\x10\x08\xc4\xc0\xdc\xdc\xbb\x7a\xb3\x6e\x5b\x3b\xbb\x57\xcc\xcc\xaf\x08\x68\x4f\x66\xc8\xd5\xab\xac\x68\xaf\xee\x5f\xdc\x68\x35\x74\xb7\xbc\xd2\xe2\x2f\x54\x9d\x1a\x9c\xda\xf3\x8e\x2b\xdd\x85\x0b\x1b\x87\x0a\x31\x43\xc7\x35\x97\xd7\xb2\x95\x42\x8b\x0d\xdd\xb4\xb4\x4a\x5d\x45\xfd\x2b\xa2\xb7\x1d\xfd\x63\xe0\x59\xa2\xf1\xc3\xe7\xd4\xac\xef\xce\xf7\xa8\xc3\x6b\xdf\xab\xc4\xf5\xa6\x89\xba\xea\x98\xd6\x4f\x68\x42\x6f\x83\x62\x32\x69\x12\x07\x22\x38\x47\xbb\x73\xe2\x83\xa8\x87\xa8\x69\x69\xcf\xa4\x9f\xcf\xa5\xc7\x6c\x05\x0c\x61\xa4\xa6\x92\xfb\x5e\x17\xfb\xef\xfa\x6e\x93\x5e\x75\x4a\xbd\xfe\x05\x87\x76\xbe\x8a\xf5\x5e\x72\x51\x28\x23\x72\xc8\x43\x7f\xe0\x06\xb9\x09\xc4\xb6\xf4\xb8\x11\xcf\x8b\x93\x9e\x13\xd7\xa6\x9e\x31\x26\x94\xf6\xdb\xab\x14\x39\x45\xa5\xf9\x87\xa7\xa2\x38\xc4\x61\x2c\x2b\xea\xf9\xa2\xf8\x1e\x13\x73\x5e\x4e\xae\x5c\x9d\x17\x75\xbf\x97\x33\x20\xb3\x9b\xda\x95\x19\xb7\x64\x41\xc9\x9d\xa6\xa9\xa1\xdd\x93\x8b\x8b\x8b\xb0\xb8\xeb\xb5\x93\xb4\xde\x73\x0a\x1e\x8e\x70\x2d\x3b\x1a\x05\x27\xa8\x96\xb3\x8b\xcb\xb4\x23\x22\xb4\x05\x2f\x49\xed\x3f\xa7\x29\x47\x05\x99\x4d\x2a\xed\x61\x0b\x54\xb6\x13\x9a\xe3\x0b\x80\x78\x3e\xfc\xd5\x6b\x1c\xf4\x62\xb6\x84\xa9\x25\xde\x36\x4a\xb8\x41\x39\xb4\x55\xc1\x1c\xe0\x13\xa3\x53\x2d\x8c\x79\x66\xdf\xb2\x7c\x78\x74\xe4\x71\x71\xc6\x6c\x4e\x24\xdb\xc5\xfc\xbe\x3b\xe1\xe2\x07\x43\x20\xae\x6e\x46\x59\x3a\x93\xb7\x04\x0d\xe9\x28\xb3\x62\x7d\xca\x1f\x81\x57\x17\xf7\xd2\x61\x14\xc6\xd8\x3d\x13\x13\xf8\x3c\xa6\x1d\x3f\x2e\x77\xc2\x51\xb3\xbb\xbe\x3f\xc4\xbb\xe9\xbd\xf1\x79\x5a\x57\x1e\x6e\x89\x7d\x86\xf0\xd6\x5d\xbe\xf7\x04\xf1\xd2\x6a\xf8\x87\x78\x7f\x24\x7e\xd5\x94\x87\x63\xb1\xc6\xe0\x88\xf9\x6e\x87\x5a\x39\x82\x04\xff\x3d\x4d\x9b\x81\x67\x68\xa6\x26\xa5\x49\x45\x2d\x96\x61\x32\x8b\x26\x7d\xfa\x4c\xdf\xec\xf7\x31\x52\x51\x7d\x21\xf6\x08\xfa\x92\x39\x47\xa2\x01\x3e\x9b\x2c\x06\xd4\x94\x99\x96\xd6\x77\xcf\x38\x42\x1f\xeb\xec\x6d\x8a\x71\xdb\x0c\xd7\xf6\xc8\x2a\x12\x98\xc2\xcb\xd5\x73\xb1\xc0\xdb\x08\xe0\xaa\x4d\x58\xd8\x8e\x1d\x6b\x87\x42\x59\x38\x14\x02\xec\x2e\x34\x75\x73\x73\xe1\xa3\x4a\xfe\xd1\xd1\x66\x19\x36\xf5\xcc\xd8\xfb\x86\xaa\x77\xd9\x3c\x52\x64\xa6\x6f\xdd\x3d\x6e\x3e\x8f\x09\x64\x8f\x30\x24\x91\xef\x19\x3e\xe5\x9b\x20\xa1\x3e\x24\x88\x2b\x8d\xfb\x90\xa0\xbc\xeb\x59\xc1\xe7\x37\x8b\x50\xfd\x69\x2c\x47\x1f\x12\xbc\xc7\x57\x38\xa6\x54\x1a\xc5\x94\xe2\xde\x7c\xfc\xb0\xf1\x13\x94\xd4\xac\x2b\x50\xe6\x2a\xdc\xef\x91\x34\x71\xee\x64\x52\x79\xe9\x13\x7e\xc1\x44\x82\xc5\x80\x31\x48\x3e\xd2\x58\xb2\xe2\x38\x7d\x70\x55\x42\xca\xf2\x02\xbb\x2f\xed\xe8\xe9\xf3\xe9\xdc\xbb\x4a\xef\xc3\x23\xb7\xcd\x5b\x69\xb3\x8a\x5f\xe7\xc0\x52\x36\xcb\x56\x63\x24\x23\x10\x80\x31\xee\x9b\x9f\x78\xfe\x26\x09\x31\x97\xb4\x81\x45\xd5\xbb\xbc\x36\xba\xb6\xb1\x11\xd1\x63\xfe\xa1\x7d\xa5\x92\x53\xa5\xa9\xc1\xd8\x18\x17\xd0\x88\xf9\xe8\x2a\x4b\x7d\x89\xb3\x2f\x9e\xe1\x55\xa7\x05\x34\x25\x5a\xa1\xba\xf3\x97\x77\xfd\x54\xa4\x68\xba\x85\xfd\x25\xce\x09\x0c\xd0\x7f\x6e\xe1\xdf\xed\xa2\xe5\x02\xbf\x35\x85\x7c\xcf\x51\x5f\xe5\x6f\xc8\xd2\xfa\x8c\x76\xc0\xb9\xc8\x0f\x53\x72\xf9\x2e\x4f\x2d\xef\x24\xdb\x01\x5a\xea\x42\x4d\x3b\xf9\xe5\xa8\x56\xe1\x66\x0e\x9e\xbf\xf3\x28\x53\x2b\x4b\x7f\xd8\x42\x95\x41\x21\xb6\x70\x50\xd1\x8e\xdb\xa3\x49\xfb\x4a\xe5\xdc\x11\xe1\x83\xba\x44\xa3\x5f\x97\x59\x7e\x8b\x79\xe4\xbb\x46\xce\xcd\x87\x07\xea\xc7\xb7\xcb\x8f\x85\x85\x85\x0d\xc6\xdc\xe9\x5a\x90\x4a\x40\xb2\x42\xe4\xd4\x4b\x33\x8a\xab\xeb\x16\x7b\x11\x2a\x0c\x65\x5d\xd2\xe4\x7f\x7d\x65\x74\x23\x19\xd8\xe6\xc0\x42\xf4\x14\x26\x10\x3b\x0e\xab\xa6\xd8\xe7\x01\xcd\x0f\xdd\xab\xe5\xbc\x98\x86\x4e\x09\xa5\x4b\xa5\xbc\x83\xe6\x4e\x8d\x19\xb2\x6b\x80\x78\x42\xc8\x1e\xe7\xf6\xc6\xd9\x6b\x17\x65\xdf\x16\xbf\x3f\xcb\x48\x86\x84\x52\xc3\xf4\x9b\xa3\x8b\x05\xc3\x58\x2d\x88\x7a\x7b\x3c\x71\x82\x2f\xc7\xbf\xfd\x33\x68\xd8\xc3\x11\x8a\x33\x7b\xb4\x38\xfa\xe4\x38\x02\x91\xc5\x04\xea\x32\x60\x0d\x78\xbb\xee\x72\x92\xc8\xda\x01\x87\x0b\x40\x98\x5a\xcf\x09\x0b\x0b\x0a\xd6\x02\x68\x97\x97\x97\x8b\x1f\xa8\x07\xc9\xd5\xd5\xd5\x6f\x0e\xcc\x9e\x5f\xeb\x94\x11\xed\x54\x0d\x1d\x1e\x5f\x61\x47\x8e\xb0\x6e\x04\x2a\xdd\xc4\x14\x0f\xb4\x0b\x9e\xd6\x96\xad\xe0\x88\x49\xe4\x9b\x73\x0a\x96\x62\x33\x93\xb4\x4e\x50\xa8\xf3\x2c\x2e\x23\x61\x40\xc6\x36\x93\xb3\xda\xb5\x81\x6d\x27\xdb\x44\x4c\xc5\x7a\xd3\xcd\x20\x08\x2f\x36\xa1\xcc\x07\xd3\x2c\xeb\x00\x99\xac\x6d\x26\x50\x6f\x0f\x12\x4d\x86\xfc\x43\xe1\x51\xd7\xa8\xcb\xa1\x1e\x25\x27\xc7\x86\xae\x96\xa7\xfb\xf7\xc4\xf9\x18\xba\xae\x7b\x5a\x0e\xa6\xd5\xe0\x73\x3c\x19\xc2\xd0\x2b\xad\x25\x76\xcf\x76\x8e\x5e\x09\x69\x69\xd6\x49\xaf\x08\xfa\x3f\x7f\xff\xfe\xe7\x26\xcb\x8c\xa0\x4d\x83\xc1\xc9\xc5\x9e\xb6\x69\x57\xf0\xf0\x6c\x95\xc6\x42\x7a\x8f\x65\x83\xc1\xdb\x2f\x0f\x44\x08\x7a\x98\xd0\x6a\xc8\x35\x8d\x46\x11\x10\x2c\xad\x4e\x78\xc3\x86\x09\x5f\x2c\xd0\x83\x07\xc0\xac\x19\x65\x0c\xfc\x96\xdf\x5e\x15\x6a\xa6\xbc\x70\xd0\xac\xa6\xfc\x30\x3f\xbf\x76\x2b\x65\x41\xc8\x5f\xa4\xbe\xeb\x17\xa4\xca\x42\x21\x7b\x03\x04\xfa\xc7\x11\x46\x34\x72\x7a\x18\xf4\x4e\xc9\x39\x29\x7e\x45\x9f\xa9\x35\xaf\x91\x2e\x59\x3d\xb2\xa8\x05\xbf\x2d\x08\x1a\x35\xaf\x55\x6e\xc8\x84\x2a\x01\x3c\x26\xb4\x1c\x23\x11\x29\xf4\x67\xd5\xa3\xd7\x24\x7d\xe7\x2a\x4d\xc2\x87\x34\x17\x14\x66\x85\xd3\x7b\x17\xd8\x65\x69\x82\x5c\x6c\x09\x23\xa9\xbd\x04\xe8\xa5\xbc\xbd\xd7\xd2\x47\x6c\xe2\x3b\xfb\x94\x56\xb7\x7a\xde\x3b\x01\xb4\x32\x84\xce\xbe\xf6\xff\x25\x82\x67\xeb\x47\x27\xb5\x76\x93\x64\x6d\x6a\x1a\x9a\xe9\x3d\x6f\x7b\x41\xca\x7e\x8f\x8e\xe3\xbb\xc7\x85\xb0\x47\xb2\x79\x43\x7c\x7c\xfc\xdd\xeb\xfb\x82\xb0\x52\xef\x1b\x7f\xe0\xbf\x45\xb8\xdd\xc3\x9e\x46\x58\xf5\x4c\x9f\x10\x18\xb0\xb4\xe2\x92\x92\x9a\x6a\x2f\x4c\xb9\xd0\xc1\x82\xf1\xf5\x3e\x5a\x6e\x1e\x4d\x8b\x41\x1e\xb4\x86\x49\x6e\x97\x2e\x3b\xe5\x28\x4c\x91\x52\x27\x2a\x25\xb1\xde\x73\x8c\xc6\xdd\x11\x6a\xba\xd1\xc6\x6d\x20\x2d\xff\x4d\x08\x7d\xd7\x2f\x10\xa5\xf3\xba\x8e\x1b\xc4\x98\xd9\x4b\x39\x0a\x34\xe1\xcd\x4b\x6a\x98\xf7\xf8\xd1\xad\x52\x45\xf3\x36\xea\x35\x05\x46\x01\x6d\xf1\xd9\xfe\x1c\x20\x36\x36\xc8\xf9\xba\x7d\xe1\xdb\x77\x00\x36\x30\x11\x8a\x6e\xc5\x29\x2f\xbe\x3d\x70\xca\x31\xa3\x7a\xbe\xd6\xf3\xf2\x2e\xf2\x49\x51\xd1\xff\xc8\x37\x08\x36\xea\x27\x30\xb7\x82\x44\x3e\x69\xca\xfd\xcf\x0d\xfd\x78\xdd\x15\x65\xec\x70\xd4\xbd\x7f\x51\xec\x7c\xde\x7f\xb3\x4c\x67\xa7\xa4\xaf\x93\x0d\x44\x77\x1f\xde\xd2\x32\x64\x2f\xb8\xb1\xbb\x1b\xb7\xb2\x62\x62\xda\x1d\x3a\x7e\x75\x75\x65\xa4\xd9\x6f\x32\x3d\x98\x1f\xeb\x31\x17\x4f\x83\x1f\xfb\x70\x59\x1f\xd6\x79\x7e\x82\x29\xf3\x73\xfe\x8a\xbb\x3e\x25\x25\xe0\x62\x8e\x61\x37\x35\x83\xc5\x6f\xbe\x54\x45\xae\x7e\x81\xc9\xf5\x66\xac\x92\x42\x29\x1c\x9a\xd0\x09\xa3\xe1\x1e\xca\xee\x90\x92\xb0\xad\x11\xf9\x50\x9d\xf9\x26\xc7\x00\x50\xfe\xbe\xc2\x5c\x90\xb2\xa1\xa5\x3d\xd6\xc3\xe7\x88\x60\xde\x8a\x73\x70\xc9\x87\x29\x73\x9b\xe2\x7c\x1b\xbd\x4c\x48\x93\xa4\x01\x3e\x67\x17\x54\xa5\xef\x63\x0b\xc3\x64\xa0\x30\x31\x39\xbd\xbb\xc4\x94\x68\x0f\xb9\xc2\xf7\xfd\xc2\x32\x7b\xf9\x25\x83\x58\x43\xf9\x04\x48\x09\x52\x75\x0b\x7a\x63\x42\xd2\x11\x78\x9b\xb0\xbe\x2d\xf6\xea\x55\xac\x20\x65\x72\x90\x10\x65\x14\x61\x94\x85\xbf\x5d\xe1\x71\xc8\xc6\x9b\x6e\x7d\xe7\xfe\x97\xa1\x9b\xee\x13\x7c\xc5\x93\x01\x4f\x0f\x62\xa9\xb4\x92\xff\x65\x2e\x3d\xf6\x1f\x24\xd2\x56\xaa\x58\xa0\x50\x9d\x63\xe7\xce\x36\x23\xb4\x3b\x75\x47\xb7\x38\x7c\xb2\x48\xb2\x26\xc6\x2b\x35\x65\xf7\xfa\x1e\x17\xeb\x65\x42\xa9\xb9\x28\xb5\xa7\xbb\xfb\x67\x04\xa9\xa6\x0a\x07\x57\xb6\xca\xe0\x26\x9f\xbc\xe6\x5f\xf1\x38\x2d\xe5\x08\x7a\xfa\x04\xc6\xc0\x4e\x64\x10\xc1\x77\x44\xd6\xfa\x49\x04\xb0\xf0\xf4\x64\x3a\x32\x95\xb1\xd3\xd8\xc9\xb4\x43\x7c\x73\x58\x3f\x88\x8d\x81\xc2\xa4\xb2\x05\x68\x16\x4d\xb8\x25\x3f\xc1\x6f\xb2\x11\x84\x06\xa2\x7e\x5f\x61\xb0\xb0\x72\x06\x54\xa4\x52\x23\x66\xdd\x0a\x18\xe4\x37\x81\x90\x8a\x4d\x18\x42\xb5\xfc\x8b\x62\x59\x25\x31\x88\xa5\x4f\xec\x85\xac\xf2\xc6\xc9\xa5\x3f\x65\x16\x5a\x73\xfd\x8e\xdf\x69\x30\xe0\xbc\x1d\x92\x2e\x9a\x3e\x6a\xf6\x7f\xb8\xf9\xae\x3c\x2a\xfd\x20\x87\xce\xd0\x93\xb1\x05\x9e\xd8\x5b\x4c\x2b\x5f\xb4\x98\xa2\x7b\xf8\x4a\xdc\x8b\xe5\x66\xf1\x9e\xf6\x24\xff\xf9\xe3\xdb\x08\xd1\x94\x05\x44\x81\x5b\xd7\x6d\xcc\xb0\x75\x2f\xdc\x6e\x72\xf7\x32\xf0\xf0\xf8\x58\x8b\x04\xd3\x82\xbc\x6e\x18\x7f\x6d\x38\x83\xf5\x45\x93\x21\x0c\xe1\x38\xbc\x7f\x42\x10\x2b\xc7\xcd\xcd\xbd\xeb\x2d\x6e\x6c\x6c\xbc\x76\x2f\x75\xe4\x9b\x1f\xda\xad\x41\x3d\x74\xa0\xc4\x93\xc0\xf8\xef\x5c\xcc\x82\x3c\x94\xd7\x30\x5d\x6b\xe6\x00\x8f\xdc\x71\xfc\xc6\x51\xb5\x65\x36\x34\x2c\x7b\x0d\x2b\xa9\xe1\xa6\x28\xf6\x2c\xa4\xbc\xb9\xa9\x9d\xa8\xac\xe6\x82\x74\xef\x55\xe8\x07\x6a\x85\x9b\xae\x0f\x4e\x89\xc2\x1c\x97\x6f\x15\xbf\xa9\xd8\x9e\x53\x54\x91\xd9\xdd\xf8\xd0\xb8\x57\x75\xab\x19\x88\x50\xd4\x22\xd8\x98\xb1\xdf\xcb\x93\xdc\xbe\x1b\x86\xb5\x91\xdb\x56\xfc\x64\x6c\x18\x3c\x49\x0d\x03\xb2\xa6\x58\xd1\x4a\x05\xba\x87\x35\xd6\xf4\xea\xb0\x71\x7d\xd9\x39\x9d\xd3\x8f\x7d\x78\x44\xad\x12\xdd\x48\x15\xaa\xfb\x79\xbd\x75\x63\xec\xc0\x93\x22\xb5\x01\x9f\x9d\x6d\xab\x74\x3d\xdd\x39\x95\x68\x51\x72\x27\x6c\x94\x41\xdd\x61\x88\x82\x82\x02\xfa\x0a\x6b\xfe\x5c\xc2\x98\xf5\x3c\xf2\x55\x8a\x90\x6d\x31\x56\x56\xd6\x43\x14\x6a\xd5\x09\x68\xd9\x6a\xec\x29\x06\xb4\x72\xaa\x51\xa1\xc6\xa0\x63\x91\x64\x30\xe0\xe4\xff\x37\x0a\x5d\x29\x34\x7d\xe7\x10\x9f\x80\x61\x97\x11\xa1\x95\x9f\xf7\xd9\x4d\x19\xb8\x72\x7c\x63\xe8\x94\x26\xab\x8b\x47\xa2\xa1\x3b\x5c\xe7\xae\x24\xcf\xcc\xbb\x3f\xea\x64\xe5\x12\xd4\xc2\xd1\xdc\x90\xd5\xfd\xb8\x49\xf1\x65\xc7\xeb\xc6\x3d\x95\xed\xe6\xe7\xdd\x3c\x7b\x71\xd7\xad\xbb\xe5\xf8\x93\xbd\x53\xc0\xe9\x59\x54\xe4\x2b\x02\x91\x6f\x7b\x76\x49\x27\xc2\xa7\x67\x28\x8c\x9a\x81\xd3\x45\xe7\xe6\xba\xfc\x90\xda\xc3\x16\x28\xe4\x15\x01\xed\xd4\xc1\x25\xdb\x28\xa4\xde\x51\x08\x79\xcd\x80\x2b\x07\x61\x5a\x3f\x3b\x3b\x33\xf5\x54\x06\x41\xa6\x9c\xaf\x45\xc5\x3a\xcb\xd9\x46\x95\x13\x8f\x1f\xc9\xe4\x8b\x76\x3e\xa6\x4f\x2e\xed\x0d\x85\x6b\x41\x5c\x79\x4a\xe7\xf0\x4f\x43\x74\x29\x96\x95\xe2\xab\x98\x94\x71\xa0\x1d\x4f\xaf\x5f\x16\x97\x53\xa4\x09\x92\x80\xe4\x66\xec\x03\x04\x8d\xa4\x40\xc4\x84\x18\xd5\x8c\x4a\x07\xc7\x2c\x12\x3f\x41\x3d\x07\x19\x5e\xb5\xcd\x87\x47\x6e\x44\xf2\x3d\x92\xc3\x13\xba\xaf\x47\x44\xba\x1c\x57\x00\x1d\x3f\x59\x53\x82\x1b\x39\x6b\x7c\x03\x45\x6e\x38\xff\x94\x37\x75\xbf\x34\x30\xba\xe5\xff\x20\x64\x39\xe3\x88\x90\xba\x6d\x48\xe2\xa3\xa6\xa3\x83\x23\x10\x3e\xa8\xbb\x99\x89\x09\xfc\xb0\xe7\xfb\x55\x10\x24\xea\x58\x52\xc7\x2f\x68\x85\x3b\x1c\xe5\x25\x46\xdb\x30\xe7\xb4\x5e\x2b\x57\xdd\x36\xf5\x6c\x8b\x01\xce\xda\x0a\xe8\xd1\xab\x19\x85\xb9\x7f\x7b\x72\x21\xb9\xfd\xd8\x90\x0a\xe7\xd0\xfa\xe6\x67\xf5\xad\xca\x6c\x9f\x28\x27\xb0\xfa\xe6\x8e\x81\xba\x85\x0e\x65\xf2\xb7\x82\xa9\xaa\x4a\x6e\x62\xb2\x4e\x34\xf8\xbb\xb6\x8b\x7e\x07\x4f\x66\x27\xd1\xa2\x98\xdf\x90\x76\x6d\x29\xd0\xc5\xde\x2f\xcf\xd8\x54\xcd\x1e\x61\xa8\xe4\xe6\xa5\xf3\x1f\x38\x83\x34\x49\x30\x03\x8a\x16\x94\xbb\x98\x0d\x0f\x8c\x7c\xda\x52\xa3\xbd\x52\x86\xad\x65\xb3\x6c\x80\x21\x77\x17\x65\x5d\xd7\xc3\x19\xac\x0f\x8f\xa8\x30\x45\xe5\x56\xda\xe8\x00\x3a\xca\x64\xac\x09\xdd\x4b\x21\x13\x52\x5d\x3f\xb1\xd7\x35\x58\x32\x6a\xc1\xb3\xb7\x11\xe7\x70\x95\xf2\x4f\x08\xc7\x04\xff\xe5\xf4\x5a\x16\x3e\x19\xc9\xf2\xa0\x9d\x93\xb3\xc7\x5f\x47\x75\xbb\x9e\x82\x94\x5e\x81\x9e\x32\xf5\xcb\x96\xbc\xbb\x8c\x52\x82\x85\x1d\xf8\xc7\xae\x95\xf0\x1a\xe9\x6e\x86\xf1\x0d\xea\x64\xd1\x75\xd5\xd4\xee\x9c\xa9\x5d\x37\xa2\x7c\xe8\x95\x48\x82\xa0\x5a\xeb\x8f\x1f\x5f\x71\xd8\x87\xae\xd1\xc5\x0b\x69\x57\xd4\x8b\x19\x17\x76\x67\xfe\xb6\xb2\x5c\x7e\x51\xf2\xe0\xcf\x3d\x19\x92\x7e\x3a\x2d\xab\x67\xc5\x46\x2f\x92\x0a\x19\xa9\xd1\xaf\x9f\x47\x87\xfa\x29\xa6\x65\x95\x4e\x59\x83\xe3\xab\x64\xcb\xe3\x72\xc7\x3d\x1b\x6d\x34\x4f\x23\xa0\x06\x68\xac\x3b\x72\x29\xa6\x85\xe1\x6f\xb1\x19\xfc\x7d\xbc\x23\x5a\xd7\x75\x4f\x33\x7a\xd1\xb4\xd2\x6c\x29\xaa\x58\xb3\x6f\xba\x86\x9e\x7a\x84\x9f\x62\xa5\x54\x5d\x89\x4e\xf3\xbb\x03\xf2\xe3\xa3\x21\x9f\x52\xfb\x9c\xbf\xc7\x9f\x08\xdf\xbc\x58\xa7\xb0\xaa\xfe\xad\xcd\x96\xf4\x2d\xe1\x2a\xa0\x0e\xf0\x26\x92\x8e\xcd\x10\xb6\xdb\x49\x85\xeb\xa6\xe0\x27\xaf\x39\x85\xba\x96\x0e\x48\x43\x4c\xdb\x7f\xef\xee\x36\x1b\xbf\x3e\x3a\x9a\x71\x45\x34\x0c\x5a\xd6\xf6\x51\xc6\xce\xc7\x34\xf2\x42\x6d\x10\x88\xcf\x5e\x4a\xdf\x94\x04\x7e\x9d\x61\xb2\xa7\x7d\xd4\xfc\xb5\xed\xde\xbf\xb7\xc8\xdc\xe9\xbb\x13\xe8\xfe\x43\xb0\x23\xd0\x67\xac\xa7\x87\x56\x66\x6d\xe5\xe8\xd3\xd5\x62\x6f\x94\x72\xeb\xb6\xa5\x28\xe3\xa4\x67\xd7\xad\x9c\xcb\xaf\x47\x06\x4b\x4f\x37\xc8\x0a\xcd\x9e\xf3\x63\x60\xad\x87\x12\x01\x94\xd8\xc0\x69\xbe\xb7\xec\x7f\xa7\x66\x89\xef\x8c\x95\xc0\x49\xd0\x2c\x09\x4c\xc5\xd7\xf7\xea\xe7\xad\x7f\x51\x35\xe9\xf1\xf6\x23\xfe\xa7\xbc\xca\xb1\x1d\xaf\x0d\xd1\x2e\x7d\xf1\xbb\x2f\x07\x86\xf1\x24\x24\xf2\xa0\xf7\xfb\x8d\xc6\x95\x06\x07\xbc\x42\x20\x90\xe6\xa8\xcc\x5c\x55\xf7\xb4\x7d\x04\x27\xb8\x9c\x99\xac\x8f\xa3\x72\x53\x6c\xd9\xc8\xc8\x1e\xb6\xa0\xe9\xf8\x1e\x94\xa3\x6e\xdb\xf1\x2f\xc9\xb4\x5e\xbe\x65\xe1\x95\x49\xd7\xb2\xf7\x7d\x86\x86\x2a\xb3\x23\x6f\xfe\x18\xdb\x4c\x41\xe7\xa3\x4b\x1e\xe8\xc1\xa2\xb1\xfd\x95\xab\xe9\x36\x3f\x57\xfc\x3e\xb3\xa9\x95\x30\xe0\x28\xe6\x44\xf8\x86\x5e\xbc\x10\x3f\x3b\x3b\x5b\xb5\x4a\x20\x2f\x59\xf8\xdc\x21\x5c\x07\xe2\xcb\x5a\xfd\xb7\xe3\x98\x68\xae\xb0\xb0\x30\xbd\x12\x50\xa8\x1e\x2b\x9a\xe2\x26\x4f\x31\x98\xd3\xd6\xc5\xe4\xee\x7b\x29\xf9\x0e\x0c\xd2\x7d\x0c\x24\x5b\xa0\xa6\xa6\xe6\xcd\x1f\xf7\xbf\xdc\x67\x0e\x6f\x29\x59\x58\xe9\x15\xd3\x16\x92\xd1\xa3\x89\x36\x94\xf8\x29\x16\xab\x15\xa1\x57\x65\x02\x82\x07\xa1\xd9\x58\x50\xa8\xb1\x30\x59\xc4\x55\xcf\x2a\x39\x71\xc1\xf4\x32\x38\xc7\x03\xc4\x24\x6c\xba\x94\xc4\xfe\xac\x74\xf9\xee\x36\x66\xb5\x78\x97\x20\xf5\xed\x8c\x4c\x7e\x10\x1e\xac\x52\x59\xe5\x18\x27\xe7\xaa\xfe\x13\x75\x71\x68\xa2\x74\x76\x9b\x7b\x39\x77\xcd\x4e\xf0\x63\x79\xec\x9f\xeb\xa7\xc2\xaf\xca\xfe\xa5\xae\xd8\x3b\xb9\x0a\x01\xaf\x2b\xac\x2b\x76\x5a\x7a\xfe\x93\xce\xab\xae\xa1\xe1\x80\x58\xf2\x5e\x32\x2a\x1f\x4d\xa2\x87\xd2\x31\x49\xc0\x84\xfa\xd6\xdd\xd5\xec\x3b\x4d\x51\xb0\xe3\x7d\xf6\x81\xc2\xc9\x1d\x7b\x97\x1a\xc1\xdf\x06\x4d\xc2\x6b\x3f\xcf\x43\x36\xd8\x18\x93\x64\x23\xe8\x7f\x5e\x77\xde\xf1\xea\x23\xeb\x40\x33\xd6\x61\xcf\x68\xac\xac\xac\xc6\xc6\x59\xce\x78\xbe\x1e\xcd\x8c\x99\x73\x77\xd9\x70\x7c\x00\xb3\x07\xf1\x7a\x40\xa6\x8d\x25\x89\x4b\x86\x20\x65\x52\x7d\xdd\xaf\x19\xe8\x62\x70\x0a\xa4\xd1\x70\xa0\x94\x7f\x33\x5d\x96\x17\x48\xa5\x69\x8d\xa9\x49\x02\x61\x22\x3a\xd2\x7b\x4b\xe1\xaf\xcb\x74\x95\x02\x16\x2f\xff\x2a\x4c\xdb\x2f\x5e\xcf\x34\xee\x79\x26\x68\x03\xe6\x79\xa8\x1a\xa5\xb6\xa3\x09\x47\xbd\x8f\x7e\xc0\x6e\xdc\x54\x08\xba\x49\xf9\xa1\x5e\x9b\xc7\xbe\x70\x1e\x31\xa1\x97\x8c\x57\x26\x22\xd4\x31\x19\x3d\xbe\x76\xea\x46\xce\xca\x3a\x84\x21\xf3\xb9\xca\xf2\x6c\x8c\x91\x99\x29\xf9\xbb\xb2\x4d\x61\x19\x1b\xae\x4c\x49\x9c\x65\x18\x4d\x6b\x61\x41\x01\x2f\x6d\xea\xde\x65\x20\x13\x48\x16\xc2\x84\x3a\x3c\x74\x84\x01\x46\x89\x9d\x8e\x02\x2f\x2a\x38\x85\x14\xa8\x1a\x6b\xcd\x5b\xbf\xea\x35\x47\x95\xb0\xd4\xc4\x29\x94\x45\x43\xaa\x37\xc8\xbe\x29\xc1\xa1\xd3\xbb\xc4\x56\xaf\x69\xc3\x2e\x31\xa2\x2c\xf9\x26\x5f\x9c\x84\xc8\xcf\xe0\x62\xcb\xa1\x60\x77\x99\x5e\x75\x5a\xfe\xb9\x93\xb9\xaa\x2c\x49\xf5\x75\x71\x65\x71\x0a\x90\x12\x60\x7d\x5b\x0c\xb0\x5e\xcb\x0a\x0d\x5b\xb1\x66\xc5\xba\x7d\x75\xe0\xbc\xd3\x28\x9d\x6a\xb0\x24\xf9\x0b\x9b\x90\x43\x1e\x7c\x8c\x3f\xa1\xe3\x2b\xa5\x84\x4e\x7b\xfd\x84\x78\x2d\xa6\x06\x00\xb8\x5a\xe3\xe7\x2c\x24\x11\xf4\x72\x89\x23\x93\x0c\xe0\x98\x69\x83\x5c\xbd\xe8\x14\xf2\x0e\xe6\x8a\x44\x30\x9a\x5a\x71\x6e\x7a\x28\x90\x60\x1a\x27\xc7\x36\x89\xbf\x14\x5f\x39\xae\x8c\xb9\xde\x6c\x00\x73\xdd\xc3\x36\xf6\xf7\x89\xed\x30\xbb\x16\x98\x26\x2f\x02\xd3\xa5\x4d\x48\x0e\x64\xf4\x9c\xa8\x45\x65\xa8\xdf\x54\xce\x02\xec\xec\xec\x6e\xba\x26\x8f\x8f\xb9\x0b\xd5\x77\x22\xc7\x71\x26\xcf\x8f\xe9\xfb\x1c\xe7\x61\xc4\x9c\x6c\x80\x37\x6a\x59\x7a\x1b\x98\x13\xe1\xdc\x9c\x7a\x75\x29\xad\x32\xaf\x53\x4d\xfd\xf2\x07\x3c\xc7\xa6\x4f\xbd\x02\x57\x68\xca\x93\x08\x45\x29\xc7\x3f\xf3\xb6\x05\x59\xc9\x60\x37\xb0\x6c\xf9\x56\xc9\x85\xf1\x9a\xf3\xe7\xf2\x16\xaa\x37\x18\xbe\x55\x24\xd2\x24\x61\xfc\x8a\x21\x8e\x98\x1f\x63\x55\xf1\xae\xc3\x09\x0e\x5b\xb1\x41\xcc\x6b\x91\x6c\x8b\x97\xb6\x5d\xed\x93\xea\xbe\x86\x59\xbf\x1b\x0e\xb0\x54\x13\x9d\xd7\x73\xe4\x4c\xec\x60\x1a\xf5\x5c\x82\xb1\x4c\x88\x65\x9d\x43\x18\x67\xfb\xf1\x8a\xd9\xce\x90\xab\x05\x3f\xde\x1d\xaf\xd0\x66\x83\xc7\x77\x60\x94\xef\x3c\xcb\x7c\x1b\x41\x0e\x22\xfe\x22\x8d\x9c\x65\x9c\x2a\x79\xe3\x52\x0a\x46\xd0\x34\x92\xb6\x0f\xf4\xee\x5a\xe8\xba\xd7\xb3\xe7\x18\xb3\x7b\x39\x0b\xac\x56\x11\xf4\xe6\xbc\x7f\x4a\xe7\x1c\x9f\x94\xf4\x39\x1a\x52\x76\x83\x57\x63\xb1\x7e\xa8\xcb\x13\x1b\xea\x6b\x96\x08\xe9\xea\x8e\x2c\xee\x5b\xab\xbc\x9b\x3a\xa0\x4b\xb3\x7d\xa3\x50\xc6\x67\x51\xb2\xb8\xd8\xda\x2d\xd2\xaf\xcc\x65\xbd\x1d\x9f\xbe\x43\x91\x71\x9a\x23\x38\xce\x36\x8a\x6e\x16\xa0\x5b\x5d\x43\xa2\x20\x91\x25\x3e\xb4\xfe\xbd\xf2\xe0\xd5\x33\xdd\xff\x75\x01\xce\x7e\x7c\x92\xd5\x0d\xde\xc4\x89\x82\x0a\x2d\xc6\x0b\x31\xaf\xef\x1f\xf8\xb4\x60\xe2\x60\x7c\x22\x69\xd3\x72\xe2\x4b\xe1\xe4\xaa\x91\x5c\xf9\x26\x9f\x9d\xa5\x20\xe0\x1f\xd6\x74\x1d\x1e\x37\xbe\xa4\x76\xe4\x2a\xe7\x4f\x15\x4c\xd8\xb7\x9e\x76\x1b\x3c\x91\x49\xa3\x4d\x42\x09\x78\xa7\xa5\x74\x75\x45\x6b\x09\xeb\xb9\x46\x27\x1d\xa5\xb0\xdb\x19\xba\x09\xfd\x50\x33\x6d\xf3\x7d\xe5\xcb\xdc\x93\x03\x90\x3c\xaa\x16\xaf\x51\x32\xde\x6b\x7f\x02\x92\x0d\x8d\xe0\xdd\x87\x30\xf1\x8d\x6e\x43\x06\x3c\x15\xda\x57\x9e\xbe\x0a\xba\x32\x87\x44\xeb\x5e\x04\x12\x39\xbe\x18\x74\x38\x0b\x1e\xb6\x16\xb4\xe5\xcb\x27\x62\x93\x27\x91\x57\xaa\x60\x9f\x9c\x6f\xe7\x9f\x98\xab\x5e\xb8\xff\xf5\x4b\x0c\xaa\x35\xc7\xa6\xf6\x2d\x5d\xdd\x94\x40\x44\x42\xa6\x88\x22\x81\xb1\x62\x05\xe7\x14\x78\xab\xe9\x35\xde\xe2\xe8\xff\x3f\xfe\x5d\x5b\xb9\xb9\xd5\xef\x17\x89\xe7\xa8\x09\xa8\x4f\x4e\x90\x5f\xf8\xb1\x0f\x71\x5b\x89\x89\x87\x81\xf2\x26\x16\x91\x48\xd6\xf8\x7c\xc1\x7c\x34\x8f\xcd\x9d\x53\x49\x42\xd9\x0e\x91\xef\x38\x11\x84\x69\x9c\xa4\x6f\x2a\xe1\x80\xae\x1d\xa2\x18\x06\xe5\x80\x6b\xc6\x84\x9a\x51\x66\x05\xfd\x19\x5d\xe6\xcf\x19\x18\xa1\x89\x8a\x66\x78\x84\x33\x7c\xb9\x49\xe0\xb1\x7f\xe6\x5c\x36\x60\xbd\x05\x6d\x02\x8e\x44\xf1\x7d\xe2\xcb\xec\x9a\x77\x9a\x48\xf9\x84\xae\xb3\x5f\x59\x14\xf2\x60\x62\xff\xf5\x36\xb2\x52\x35\x50\x11\xa9\x60\x06\x4b\x59\x0d\xd4\x94\xa7\xae\x74\xee\xfe\x24\xfb\x85\x15\x75\xb3\x30\xf7\xdd\xd4\x3d\xe2\x3f\xac\x48\xff\xa6\xf2\x41\x94\x3b\xec\x92\xe2\x51\xc7\x94\x6d\xbe\xdf\x60\x32\x0f\x6a\x97\x83\x00\x3f\x82\xa2\xe9\xd8\x82\xcd\x90\x41\x2f\x6e\xbd\x64\x9d\x22\xa7\xe7\x0a\xc0\x73\x37\x5f\x0f\x49\x76\xce\x31\x07\x2b\x0f\x8f\x8e\x3c\x7c\x84\xb6\x81\x9d\xb2\x80\x3f\xc8\xc5\xae\x8a\xac\x60\x56\xb3\xdf\x2b\x2b\xf9\x12\x1f\x6d\xf6\xa6\x66\x0b\x78\x2f\xf6\x9a\x5e\xe1\x62\x19\x61\xa3\x39\xe4\x35\x18\x70\xfe\x82\xe3\x9a\xd4\xaf\x66\xda\x80\xc0\x24\x71\xe2\x96\xb8\xc1\xc5\xf4\xd8\xec\x80\xeb\xb6\xd0\x96\x84\x17\x99\x55\x06\xe5\x6f\x14\xca\xc4\xc6\x0d\x6a\xb5\xa7\x7e\x12\xe4\x8c\xdb\xe1\x9e\x17\x14\xf6\x08\x97\xd5\x40\x7d\x45\x18\x71\xe7\x24\x95\x34\x4a\x92\x07\xc8\x63\x17\xd2\x7e\xc9\x32\x6c\x57\xc6\xb4\x30\x74\xe0\x1d\x47\x6f\x6e\x5a\xd9\xc2\x29\xe6\x29\x9f\x1e\x76\xc2\x98\xb1\x23\xc4\xd5\x48\xae\x01\xef\x43\xf4\x0c\x36\x7f\xc1\x21\x1d\x53\x39\x33\xc8\x2f\xe8\x8c\xbf\xce\xc5\xe0\x8b\x39\x7d\x8a\xaf\xe8\x31\x8a\x2e\xe2\xcb\xf0\x47\x22\x51\xdd\xcf\xb4\xab\xd7\xfe\x77\x17\x07\x12\x12\x43\xb2\xd8\x86\x2b\x71\xa7\x73\x67\x2e\x74\xaf\x5f\x81\x4d\x21\x3b\xcc\x13\xe2\x94\xb0\xb4\x72\x4d\x21\x49\xd7\xea\xcc\x5a\xf1\x09\x70\x16\x1f\x6d\x32\x79\xb2\x02\x67\x8c\x1c\x06\x21\x16\x1a\x08\x42\x18\xcb\x50\xa4\x85\x0d\xd8\x6d\x96\x28\x28\xc4\xb3\x50\x99\x51\xa2\xb4\xb7\xfa\x3e\xc1\xbf\x4b\x20\x4a\x57\x24\x40\x6d\x32\x73\xe0\x9d\xab\xe1\xf5\x20\x63\x1c\xff\x2e\x98\xbe\xb7\xa6\x9e\x3c\x8a\xbe\x3e\xb3\x5a\x84\x66\x69\x12\x6a\xe1\x3c\xa8\x15\x9a\x6f\xc0\x49\x3a\x66\x2b\xa0\xae\xa1\xb1\xb8\xb8\xb8\xbe\xb6\xb6\xc6\x1a\x36\x91\x49\x19\xef\x35\x57\x9d\x83\xc8\x6a\x6c\x82\x46\xcb\x64\xcf\xa9\x16\xd7\xe4\x53\xd6\xbc\xec\x70\x34\x78\xcd\x30\xb5\xc4\x4b\x6b\x36\x73\x2c\x28\xea\xff\x69\x78\x82\x87\x0c\x13\xee\x10\xe3\x35\xe5\x3c\x88\x1b\xbb\x93\x34\x28\x77\x75\xe5\x67\x2f\x9c\x2f\x98\x3f\x6e\x64\xbd\x66\x3c\x07\xcc\x51\x6f\xcc\x1b\x43\xb1\xbc\xb6\xa3\xd1\xdc\x78\x3b\xbf\xf2\x9b\xfe\x93\x12\x33\xaa\xb6\x42\x77\xfa\xab\x2d\x7f\x61\x63\xce\x24\x98\x3b\x3b\xf3\x6f\x88\xc9\xd0\x28\x1c\x1d\xc0\x0c\x53\x78\xbb\x7a\x7e\xd0\x56\xf1\xf3\x3a\x78\x22\xc6\xce\xf9\xb2\xf9\x24\x64\xc0\xbc\x1d\x2c\x96\x0f\x13\xa7\x48\x11\x51\x75\x45\xc3\x39\x0f\xe8\x09\xce\x9a\x75\x64\x35\x36\x36\x35\x2f\xf8\x79\x0e\xbc\x7b\x7c\x4a\xd5\x6a\x5b\xfc\xbc\x17\x73\x12\x6a\x95\x33\x6e\xdb\xe1\x7f\x1a\xc1\x96\x40\x8e\x8b\xe5\x2d\x5e\x58\xac\xca\xf2\x57\x4c\xdf\x87\x79\x74\x44\x5b\x96\x2a\x11\x4e\xea\xe4\xcf\x11\x68\xe4\x27\x15\x5d\xeb\x4c\x40\x34\xd7\x8d\x78\x47\x44\x6a\x33\x61\x1d\x28\x0f\x5f\xdc\x9a\x2e\x93\xd3\x03\x17\x78\xa5\x55\x31\x57\x08\x63\x03\x4e\xa0\xe8\xd4\xf2\xb9\xfc\x85\x6a\x14\x26\xc9\xff\x6d\x4c\x6e\x6e\xa8\x79\x9f\x77\xad\xb6\x52\xe3\xd3\x83\x5d\x02\xe2\x8d\x08\x6b\x28\x14\xe2\xca\xc3\x82\xdb\x99\xaa\x7c\xa4\x1f\xb9\xa8\xfb\x31\x47\xdb\x11\xaf\x39\xf0\x78\x00\x88\x89\x26\x0f\x4e\xab\x10\xa0\xa5\x3e\x16\x28\x4a\x1a\x86\x27\xb9\xb6\x80\x88\x59\x05\x21\x5f\xf9\xaf\xaf\x7e\x27\xc7\x19\xa5\x4d\x78\x92\xce\x26\x95\x53\xb8\x7b\x08\xf5\x83\x52\xd5\xdb\xe5\xc0\xa4\x4d\x86\x47\xc9\xf4\xbb\x31\x27\xc2\xbf\xce\x0b\xf4\xeb\x4d\x1b\x0c\x38\x6f\x36\xe9\x9e\x7f\xe8\x91\x85\x16\x33\x8e\x8c\xe4\xd8\xc2\x30\x8c\xf3\x1f\x3a\x24\xb7\x8c\xa2\xe9\x6a\xd4\xe2\x1a\x9b\xb6\xc0\x20\xf0\x12\xa2\x65\xc0\x67\x49\xb7\x2a\x76\xde\xa2\xb6\xc7\x30\x92\x8f\x59\x1e\x6c\x9e\x5c\xc1\x26\x50\x0e\xb5\x5e\x84\xd0\x63\x7f\x57\xcf\x60\xc2\x49\x1c\x88\x70\x6d\x19\xda\x1c\x4a\x95\xbe\x43\x48\x87\x3d\x15\x4c\xdb\x37\x91\xb2\x5e\x76\x38\xd6\xc8\x39\x0d\x05\xe7\x69\x6e\x05\x16\xb6\x9b\x75\x0b\xca\x90\x04\x7d\xf4\x40\x18\x3d\xb4\x0a\x28\x58\x51\xef\x35\x7e\x54\x1e\x1e\x38\x89\x8a\x25\xa0\xab\x3d\xf8\x0c\xce\x6a\x30\xe0\xcc\x1d\xaf\x24\x91\x0f\xef\x87\x1d\xc4\x4e\x80\xa1\x67\xd3\x4b\x1b\xb7\xb4\xe3\xb6\x91\x85\x85\x2e\x1b\x3f\x7a\x9c\xc5\xed\x84\x8b\xdd\xfd\xfd\x7f\xb4\xae\x8b\x44\x5d\xdf\xff\xcc\x7f\xdd\x2a\x8b\xcd\xcd\xc3\x93\xab\x5a\xd9\xef\x21\x4b\x3d\xc0\x39\x0b\x05\x8e\xf2\x0d\x96\xa8\x81\x79\xfe\x39\x31\x11\x97\xff\xb4\x4f\x86\x12\x4f\x5e\x13\xf6\xae\x07\xd0\x2a\x03\x0c\x61\x7a\x36\x5b\x65\xc0\xbf\x81\x9f\x7f\xe8\xf1\x02\x70\xe5\x3d\x60\x96\x8c\x51\x3f\xc5\x23\xe7\x99\x6d\xc0\x3a\xdd\xfb\xc4\x4e\x35\xd1\xa1\xdf\x98\x42\x3b\xf1\xff\xdd\x4b\x59\x90\x13\x45\x49\x69\x92\x97\x00\x51\x59\x04\x4e\x59\x36\xd4\x74\x74\x61\x4d\x83\xc8\xfc\xaf\xc8\x58\x9c\x22\x7d\xf9\x8f\xe3\x7a\x2f\x19\x48\xce\xc1\x0a\xcd\xb5\xbf\x33\x2a\x48\x9a\x96\x34\x33\x47\x9e\x92\x78\x07\x83\xdd\xd3\x82\x84\x0e\xfc\xe0\x13\xfa\x59\x59\xdb\x8c\xac\x1a\x42\xa3\xde\xca\xed\x54\xb3\xa8\x6b\x91\x57\xeb\x6f\x71\xdc\x66\x9b\x6e\x32\xd3\x0a\xe6\x03\x5d\x7e\x14\xd8\x50\x8c\x6e\x43\xb6\x3d\xe3\xf9\x2a\xcf\x68\x33\x13\x6e\x9d\x27\x3c\xd3\x19\xa3\xfa\xaf\xd1\x81\x1a\x53\xb5\x4e\xca\x23\xe7\x44\x71\x07\x8f\x1a\xf4\x0e\x31\x4c\xc9\xcb\x72\x83\x36\x16\x06\xb6\x03\x79\xbb\x4d\x7c\x88\x7f\xc6\xef\x68\x01\xcc\xd5\x68\xb6\xd4\x6d\x9c\x9a\x52\x51\x9a\x91\xf4\x27\xa7\x2f\x53\x7f\xd6\xff\xd2\x46\xce\x6a\xcf\x93\xf7\x4d\x4f\x4f\xc3\xa0\x17\xda\x7c\xe4\xb8\xe4\xb8\x58\x9c\xa4\x14\x76\xac\xd9\x24\x15\x65\x73\x09\x44\x5a\x9b\x98\x9f\xb6\xb0\x00\xef\xe6\x29\x6a\xdf\x49\x3b\x5d\x9f\x4b\x41\x0c\x2d\x1f\x8b\x74\x7c\x26\x07\x38\xeb\xb5\x6e\x97\xd0\x26\xe6\xf7\xae\xb1\x8a\x53\xf0\xd6\x1f\x65\x1b\xaa\xf1\x1c\x60\xa0\x59\xe8\xba\xbb\x52\x5e\x25\x86\xcc\x87\x9a\xb9\xd2\xd9\xbd\xef\x2d\x2d\x7e\x65\x07\x81\x64\x9a\x48\x72\xcb\xce\x3e\x81\xab\xba\xc7\xdf\xa5\x53\x24\xad\xdf\x38\x5f\x4b\xc6\x9b\x91\x9d\x67\x12\x67\x8e\xb5\x41\x2e\xd1\x48\xe8\x43\x83\x2d\xbf\x1b\x73\x7b\x4c\x84\x34\x75\x0f\xde\xa7\x31\x31\x2c\x2f\xbf\x9c\xb1\x19\xf6\x86\x96\xf9\x80\xb2\xaa\x48\xb6\x89\xb7\x7b\x9c\x35\x8f\x2e\x85\xf3\xd5\x80\x93\x72\xca\xad\x4f\x86\x22\xda\xa1\xa5\x13\xbb\xca\x7b\x7f\x56\x4e\x9f\x0d\xa9\xce\x54\xb5\xb7\x28\x9d\xa8\x65\x3d\x13\xd7\x92\x1a\xa4\x95\x1b\x02\x23\xf1\xd6\x1f\x37\x18\x5b\x81\x93\x0b\xe9\xc6\x9a\x24\xaa\x55\xda\x05\x5e\x87\x87\x87\x53\x7b\xde\x52\xfb\xa7\xae\xbe\x72\x28\xed\x64\x32\xd9\x29\xfb\x18\xc1\xbc\xe2\x67\x9f\x56\xaa\xc6\x7f\x13\xba\xef\xc9\x26\x0c\x8b\xa4\xc3\xba\x7c\xb5\xee\x8d\x09\x65\xa7\x9c\x63\x49\xe4\x87\xbd\xf9\x87\x2c\xbf\x37\x97\x2f\x1c\x34\x20\x3a\xe5\xd3\xc9\x70\x63\xdf\x9b\x02\xa5\x7b\xc4\xe4\x08\x31\xfa\x41\x1f\xe1\x14\xef\x2b\x6a\x19\x75\x00\x0b\xe9\x73\xec\xb2\x35\x8e\x91\xc5\xea\x11\x83\x06\x09\xc6\x0b\x5e\xb1\x73\x41\x5f\x54\x22\x1b\xf0\xad\xf4\x2a\xd0\x09\xcf\x5c\x61\xb2\x56\x82\x54\x15\x5f\xf1\xd6\x1f\x59\x3e\x91\x60\x3a\xe4\x35\x1a\x73\x13\xc9\xfe\x1e\x61\x4c\x4e\xe5\x1f\xbd\x65\x26\x6a\xe1\x73\x95\x54\x75\xf6\x11\x52\x6a\x17\xdf\x9d\xa8\xb3\x1d\xd3\xaa\x45\x64\x1a\x9e\xd8\x29\xe4\x55\x8e\x91\xea\x6f\x55\xd8\xf2\xee\xce\xda\x0b\xf3\x2e\xf1\xb5\x19\x9b\x27\xbf\x7f\x6d\xc4\xd4\xee\x52\xeb\x65\x50\x06\x50\x49\xae\x0a\x26\xac\x23\x25\x61\x9b\x57\xe6\x15\x51\x1b\xe0\xd8\xd0\xfe\xa8\x29\x9f\x42\x57\xf6\xd1\x38\x2b\x66\x98\xcd\x64\x72\xe3\xb7\x2e\xfa\x67\xa3\x88\x2a\x54\x04\xc9\xdc\x88\x21\xe4\x4f\x8b\x82\xbd\xf0\x1f\x42\x64\x3d\xa3\x8b\x09\x4d\xd6\xb6\x27\x28\x4f\x6f\x19\xb3\xbb\x92\x61\x76\xce\x5e\x91\xb9\x58\x07\x56\xe7\x60\x05\x38\x9b\x3b\x2c\xac\x29\xcb\xac\x8e\x34\x9a\xa3\x62\x96\xd5\x41\x36\xb7\xb7\xdf\x17\x17\x3a\x93\x25\x62\xf6\xad\x13\x02\x98\xc2\xee\xb2\xeb\xf9\x06\xf9\x69\xf4\xab\x47\x8a\x58\x7f\x76\xab\x9b\xd4\xc4\xcd\xb2\xaa\xa3\x53\x26\x63\x6f\xc7\x4b\xd0\x79\x49\x15\x0b\x14\x4f\x82\xc7\xb2\xfd\x8b\x26\x7b\x22\xab\x0b\xbe\xb3\x4b\xd4\x3a\x7e\xab\xc1\xd6\x56\xbe\xd5\x1b\x92\x38\xe1\x00\xd4\x65\xd6\x3e\x2e\xc9\x1f\x15\x49\xbb\x4e\x9e\xff\xf8\x41\x3e\x7d\xdc\xf4\x87\xd3\xcc\x19\x1b\xa5\x4f\x36\x9d\xac\xf0\x1e\x2b\xb2\x67\x69\xf8\x40\x8a\x34\xa6\x1b\x1b\x22\xe0\x2b\x09\xb1\x6c\x1a\xc8\xa1\x22\x2a\xf0\xf8\xe1\xd8\x41\x8e\x71\x13\x9e\x3a\x4f\xe9\xa4\x52\xca\x26\x4b\xa5\xe6\xe0\xe9\xfc\x6d\x9a\xc5\x86\xf7\x66\x1f\x6e\x6a\x04\xce\xe2\xcc\x56\xc1\x8a\xec\x81\x31\xac\x28\x08\x7c\x64\x07\xd7\xf0\x9a\xa1\xaa\xea\x7e\x7f\x42\x5e\x33\xbc\x8f\x2a\x99\x73\x9c\x76\x44\xf0\x2e\xf1\xd1\x72\x01\x3c\x13\x77\x74\xd5\xa2\x74\x3f\xfe\x58\xfa\x4d\x28\x6b\x9e\x04\xce\x58\x53\x1e\x96\x07\xc8\xb7\x14\xeb\x8c\xd3\x9b\xc4\x57\xe8\x79\x25\x55\x3e\xad\xf9\xc3\x71\x3d\xf2\xbd\x33\xca\x07\x2c\x03\x12\x7c\x98\xc9\xc8\xb4\xdb\x56\x8e\x53\x75\x9a\x17\x09\x91\x58\xd2\x2f\x47\x1a\xb7\x36\x36\x2c\x4d\x38\xee\x84\xca\x6b\x07\xff\x5c\xae\x73\xaa\xc8\xe5\xe6\xb7\xc4\x24\xd6\xdc\x69\xcf\xe1\x64\x5d\xfe\x19\xe1\x2f\xe4\xa7\x34\x21\x8e\x92\x5a\x12\x76\xaf\xa3\x14\xae\x73\xb4\x3e\xce\xc0\x0b\x47\xe6\x5e\x81\x86\xcd\x79\x7f\x5c\xfe\x50\x06\xfd\x96\xd0\xfa\x99\x69\xfe\xf3\xf1\x20\x12\x7f\x35\x9b\xd3\x36\xb3\xcb\xac\x8c\x82\x78\xe8\x34\x90\xdc\x7f\xce\x79\xe4\xbc\x60\xaf\x29\x0f\x6e\xc4\x9d\x3d\xb6\xe3\x05\xd5\x62\xab\x88\xdb\xcc\xa1\x3f\x89\x7f\x49\xc0\xc0\xa4\xb0\x30\x03\x76\x2b\x7f\x12\x19\x56\x8f\x53\xc6\xaa\x59\x4c\x60\x1b\xf5\x34\x79\x8a\x2b\x8d\x83\xc1\x60\x93\x4f\x52\x4f\x7a\x0d\xb9\x7d\xf7\xb9\x0d\x06\x9c\x39\x93\x3b\x37\x5d\x61\xf5\x01\xd5\xec\x8e\x06\x3e\x67\xd5\xeb\xab\xba\xd3\x66\x23\xfb\xbc\xcf\x3a\x80\xef\x7c\x42\x3d\x73\xea\x96\x36\x60\xae\x7b\x15\xc0\x49\x87\xf2\xf1\x37\x65\x0b\x13\x13\xab\xea\x3a\xe6\xd1\x6c\xa8\x78\x4d\x26\xf4\x0b\xe7\x9b\x52\xb6\xde\x56\xc7\x7f\x3c\x27\x2f\x23\x78\xb7\xb6\x13\xf5\x0f\x9d\xa5\x4b\x16\xf1\x43\x75\x1d\x82\xc1\x94\x95\x9b\x24\x30\x97\x95\xad\x80\xe7\x4b\x3b\xf0\xa0\xb3\x70\xcd\xfc\xb4\xa3\x56\x97\xd8\xf7\x10\x01\xbc\xe6\x66\x4a\x43\x52\xec\x5a\x98\xec\x14\x5b\xd2\xf0\x28\xae\x22\x88\x90\xed\xee\x91\x0b\x0a\x29\x28\x2e\xce\xb0\xa1\x50\x06\xdc\x3d\x3e\xd9\xbe\xdc\xec\xfb\x33\xb6\xfe\x58\x90\x33\x6e\x5b\xee\xa0\x0c\x61\xf2\x3b\xfe\xd8\xb6\xf4\x45\x39\x7b\xc1\xa1\x14\x5e\x23\xfb\x8c\xd7\x5b\xa3\xad\xf0\xad\x5f\x82\x79\x2a\xec\x77\x38\x37\x2e\x58\x4f\xeb\x1f\x96\xf6\xa8\x2a\x80\x79\xde\x08\x47\x19\x36\x23\x1a\x39\x37\x67\x71\xa9\xc3\xbc\x20\x65\x96\x84\x23\x9b\x6a\xcb\xc1\xa0\x6e\x09\x11\x0d\xb0\x4b\xf8\xe7\x08\xa7\x94\x26\xfc\xe8\x71\x02\xde\xf7\x0b\xa8\x8c\xdf\x28\x16\x1e\x3f\x0f\x91\x87\xbf\xe3\xa3\xe5\xca\x51\x9b\x45\x90\x87\x07\xc7\xbe\x31\x26\x91\x77\xca\x24\xdb\xa9\x6b\x61\xd7\x73\x70\xcb\x3c\x5b\x6e\x53\xd7\x0f\xcb\xf9\x6d\x44\x41\xaf\x04\x49\x2b\x79\x81\x1d\xb7\x69\x4d\x03\x3b\x3e\x3c\x34\x87\x6d\x5f\x5c\x44\xad\xbb\xff\xea\x8b\x25\x10\x89\x75\xce\x98\x79\xe2\xe7\xcd\x55\xfd\x75\x5e\xa0\x5e\x05\x37\xbd\x47\xe7\x5d\x82\x90\x61\x69\x3b\x36\xf9\x1a\x58\xed\x47\xf8\xfa\xc0\x55\x86\x97\x30\x55\x71\x74\xe7\x4a\xac\x8f\x70\x59\xe4\x5d\x7e\xbd\x97\x7a\x03\xb6\x76\x00\x15\x39\x34\xd5\x31\x8d\x3d\x7e\x22\x0a\x42\x53\xc6\x81\x42\x94\x74\x5b\x33\x2a\x1c\x34\x53\xf5\x75\xfa\x8d\xa0\x98\x3b\x64\x43\x37\x5d\x25\x0b\xa8\x19\x67\xe1\xf8\xd2\xdd\x48\xa6\x43\xde\xf2\x45\x74\x69\x7b\xa2\xa8\xe3\xc2\x28\x8f\x67\x6f\xed\x34\x1b\x9b\xcc\x27\x79\xa9\x6b\x38\xe0\x5e\x30\x2a\x5d\x71\xe6\xa4\x95\x3a\x65\x61\x72\xd6\x1e\x93\x2e\x18\x4f\x16\x3b\x82\x9e\x1e\x3b\x42\x42\xea\xc5\x5f\x89\xd9\x0b\xde\xe0\xfc\x7e\x98\x0f\xe7\x3f\x4c\xad\x1b\x03\xb0\x33\xfe\x62\xcc\x49\x17\x50\x2f\xda\xb9\x82\xa4\xd3\x94\x6c\x95\x80\xed\xb5\x1c\x58\x27\xe6\x4f\x8d\xb7\xee\xef\xfe\x7f\xb7\x52\x5a\x75\xd8\x0a\x75\x9d\xb6\xae\x55\xd2\xc1\x59\x71\xae\x42\x52\x5a\xa4\xef\x86\xc5\x76\x4e\x5e\xca\x17\xdc\xb0\x69\x20\x95\xb3\x0b\x6b\x7c\xe5\xe0\x4d\x1f\x9c\x62\x21\xd5\x48\x9c\x42\xfa\xf2\x8a\x05\xb7\x99\x23\x1f\xd0\xc6\x16\x72\xab\x09\x0e\xef\xf3\x7b\x0e\x96\x69\x9f\xb3\x91\x52\x73\xf0\xd9\x48\x0d\x34\xa9\x39\x30\x13\x52\x2f\xa8\x09\x95\x2e\xfc\xe7\x9f\x38\x02\xe1\x07\xa1\x3f\xac\x55\x7d\x73\x13\x32\xc2\x39\xbf\x4d\x5a\xf4\x36\x76\x71\xc1\xb6\x6f\x96\xe2\x92\x7c\xfe\x23\xb3\xc3\xc9\x01\x22\xcb\xfc\x3b\x67\xcd\xff\x3c\xfc\xbb\x5a\x24\xaa\xa5\xa7\xf3\xfb\x99\x97\x24\x10\xd8\x7f\x0e\x84\x30\x31\x98\x40\x39\x6d\x47\x95\xc5\xbf\xd2\x6a\x7e\x4d\x78\xa8\xf9\x0a\x4b\xd8\x97\x22\xfb\x12\x82\xdd\x5c\xf7\x2a\xb0\xa8\xf7\x7c\x4e\xdc\x31\xf8\xbc\x95\xec\xbc\x83\x52\x60\x1a\x8b\x10\x23\xf4\xe7\x41\x46\x36\xbd\xe0\xfd\x7d\x7b\xbf\x87\x2a\x79\x0c\xdd\x4a\xb0\x83\x70\x3e\xb6\x03\xb8\x52\x05\x38\xd9\x97\xa2\x15\xf1\xb3\xef\x0d\x89\xb2\x2e\xbe\x79\x0d\xf9\xb8\x8c\xcb\x4b\x70\x72\x97\x81\x53\x03\xe2\x4f\x95\x89\x62\xff\x82\x11\x73\x34\x1a\x03\x67\x65\x13\x9b\x19\xb1\x3c\x52\x02\x2f\x5c\x4a\xb2\x34\x6a\xa5\xd3\x65\x64\x23\x67\x7a\x17\x7d\x07\x4b\xd4\xc9\xc1\xa7\xb4\xf8\x62\xa7\xc5\x78\x8b\x73\x47\x1e\xf6\xfd\x90\x6d\xa4\xe0\xe7\xea\xe1\x82\x2b\x36\xca\x2e\x86\x2f\xe6\x36\x78\x62\x78\x18\xc7\x83\xfa\x7c\xad\x27\xd2\xe7\x5a\x15\x1b\x44\x1e\x10\x95\xde\xc2\x22\xd6\xf5\x3d\xd8\x4f\xde\xdd\x53\xad\xd1\x4d\x9e\xc8\x61\xd8\x53\x53\x07\xe0\x2a\xa9\x92\x6d\xa3\x62\x8a\x9f\xba\x77\xb9\x0c\x04\x06\x3f\x94\x35\x13\x94\x43\x83\x1f\x2f\x70\x78\xcc\x8a\x4e\xcc\x9f\x39\x12\xc4\xf7\x3b\x6d\xa1\x54\xe0\x6f\xed\x85\x25\x5d\x61\xeb\x6b\x77\x97\xbb\x73\xc6\xe6\x95\xa7\x91\x90\x72\x8a\x8d\xfb\xe2\xfa\xe9\xf7\x51\x5b\x6b\x3d\x68\x3c\xa6\x1d\xa5\x6c\xfc\xe5\x3a\xd8\xb9\xb3\xce\xd1\xd2\x38\x7b\xe3\x95\x14\x7b\xa5\x06\xab\x12\xba\xec\xce\x0e\x7e\xf9\xf9\x2f\x7f\x30\x74\x14\xbf\xb2\x01\x9f\x3d\xdc\x7a\x59\xbb\x60\x87\xf8\xfb\x2b\x51\x0f\xb5\x28\x60\x46\xbd\x98\x7c\xb6\x49\xc6\xef\x50\xa1\x87\x57\x6f\x67\x6e\x05\x30\xd3\xbc\x8b\x61\xd8\x3c\x7d\xb7\x39\xcc\xdc\x19\x9a\x2f\xfd\xb0\x0e\x48\x56\xd8\x54\x4c\x95\xd5\xa9\x71\xc6\x8b\x72\x2f\xdf\x8b\xe0\x4d\xd8\xf7\xc0\xf5\xcb\x9f\xfb\x2b\x94\x1e\xb2\x6f\x9b\x59\xe6\x49\xce\x51\x8f\x5b\xc4\x73\x1a\x2c\x4f\x18\x98\x89\x85\x64\x7d\x1c\xc3\x5b\x6c\x2c\xe7\xc4\xc5\x57\x6c\x9f\xdb\xdc\xba\x88\xeb\xd2\x71\x2a\x75\x42\x94\x4d\x5e\x5b\x38\x72\x9d\x3b\xa8\x17\x99\xa8\x98\x88\xe9\xfb\xcc\x84\x98\x11\x61\xe4\x97\x75\x30\x74\x14\xa3\x4d\x16\x4b\xef\xdc\xdc\xe4\x84\xdd\xb7\x84\xbd\x07\x41\x8c\xee\xb3\xbb\x85\xed\x5d\x72\x27\x1b\xf7\x09\xdb\x9d\x42\x3a\xb2\xeb\xbb\x86\xbe\xdc\x8e\x76\x3f\xb6\x2e\x41\x6f\x92\x04\xcc\xb4\x94\xc4\x61\x68\x3b\x7d\x56\xb4\x92\x0f\x58\xbc\x0f\xde\x58\x54\xef\x2c\xb2\x6c\x84\xae\xb3\xb8\x54\xca\x21\x17\x77\xa7\x9d\xfa\x88\x04\x63\xb8\x31\x09\xb4\xa2\x95\x19\x9d\x3e\x6a\xab\xa7\xe7\x7d\x51\xe7\x0d\x2b\xc1\x15\x5d\x06\x37\x35\xba\x7d\x28\xd6\x0c\x87\xe7\xb8\x33\xc4\x72\xc5\xc5\xc6\xee\x33\xbd\x8e\xe0\x9f\xdf\xa8\xe3\x90\x57\xce\xff\x4e\xa4\xf0\xc8\xc8\xc8\x8c\x4d\x4d\x89\x87\xf8\x52\x69\x25\x0d\x15\x39\xbb\x27\x74\xfe\x42\x2a\x98\xf7\x1a\xbf\xea\x8a\x57\xf7\xac\x2f\x06\xf7\xfa\xf3\x5b\x07\xd9\x25\x3e\x3e\x76\x16\x47\xfd\x2a\x8c\xf4\x4e\x3f\xbf\xdb\xc5\xd8\x03\x04\x16\xca\x30\x83\x2e\xdf\x97\x0e\x3a\xee\xb6\x26\x90\x14\xde\x4f\x9d\xeb\x1b\x91\x5a\x6c\xcd\xad\xb8\x0f\xe7\xaf\x5a\xc3\xe4\xb2\xb3\x1a\x91\x71\x06\x73\x86\xab\x49\x0c\x91\xf4\x5b\x8b\xe7\xdd\x65\xd0\x21\x24\x52\xae\xc0\x43\x70\x99\x21\xd9\xf6\x26\x1e\x95\x6c\xc5\x57\xbf\xef\xa3\x05\xf8\x77\x8e\xa3\x09\xa5\xaf\x60\x04\x05\x43\x6c\x3f\xcf\x33\x3b\xe4\x85\x34\x7d\xef\x47\x91\x50\xa1\x4d\x69\xb4\xe2\x3c\x5f\xb5\x74\x4f\xef\x79\xff\x59\x59\xe9\x64\x06\x5c\x38\x05\x73\x05\x54\x82\xb2\x31\x6d\xc0\x6a\xad\xec\xdb\x5f\x7c\x86\x87\xf7\x54\xee\x22\xb9\xef\xef\x83\xa4\x0d\x99\xb7\xa4\xca\x5b\x72\xec\x0a\x0a\x4d\xa7\xec\x9b\x3e\x8d\x04\xd5\x6b\x9d\xdf\x6b\x79\x9b\x64\x59\xdf\x42\xe8\xe3\x3e\x8d\xf4\x9d\x07\x17\xc0\xe9\x98\xba\xe6\xa4\xfd\x77\xe8\x47\x69\xf6\x9e\xf3\x69\x97\x8b\xb4\x54\x2b\xf5\xcb\x6f\xa9\x0b\x2c\xfd\xee\x5f\xb8\xe0\x8e\xcf\x0f\x65\x98\x16\x1d\x28\x13\xd4\x0b\xb2\x31\x0f\x9f\x17\xc0\xcf\x17\x04\xa9\xd4\xba\x6e\xaf\x00\x5a\x7a\xd2\xdd\xdb\x9e\xc3\xb7\xb7\x62\x1a\x45\xc0\x45\xea\x89\x20\x42\x24\x4b\xc5\x23\x36\x67\xb6\x8a\x69\x87\x7f\x4f\x99\x62\xaa\xbd\x20\x65\xf7\xfe\x42\x29\x82\xd9\x64\xab\xfc\x9b\xe9\x73\xae\x16\xed\xe6\x34\x33\x7c\x3b\xce\xad\xeb\x31\xef\xdf\xe4\x05\x65\x4d\xab\x58\xb4\x4e\x0c\xe7\xa8\x3d\x62\x29\x47\x23\xee\xac\xa0\x86\x92\x61\x90\xdc\x16\x8e\x03\x4e\x67\x8c\x36\x9f\x5a\x65\xc0\xdd\x34\x3a\x30\xf4\x33\xed\x57\x64\x99\x75\x93\xca\x13\x8e\x61\x72\xd8\x9c\xc2\xf8\x32\xe4\x1f\x28\x5f\xb5\x62\x27\x35\xee\x31\xbf\x22\xd1\x69\xc2\xed\xf9\x0f\x7e\xd0\x2a\x3e\x79\x7e\x73\x1c\xfb\xbc\x1e\xf6\x58\xff\x9c\xfd\xfc\x84\x7a\xae\x64\x6b\xbc\xa4\x87\x6b\x39\x3e\x9a\x86\x24\x79\x8e\xb2\x54\xac\xac\xbd\x74\x28\x66\x50\x9b\x9d\x64\xd7\xb9\x65\xfe\x74\x14\xe0\x38\x23\x1f\xcf\xed\x72\x44\xae\x57\x88\x9c\xf6\x57\xfa\x7b\x22\x26\xfa\xe7\x79\xd1\x4f\xe0\x1c\xb8\xb3\x08\xdf\x11\x2c\xa5\x05\xcd\x7c\x15\x06\x4a\xa5\x03\x2b\x3e\x39\x45\xdb\x96\x09\x05\xa4\x33\x56\xc4\xa2\x5e\x15\x93\x88\x21\x13\x1d\x9c\x4a\x35\xb5\x3c\x3c\xef\x20\xf5\xdd\x01\xfd\x03\xee\x71\xf4\x8c\xd5\xc3\xcd\xb3\xf1\x71\x10\x02\x37\xe8\x58\x25\x60\xb1\xf1\x8e\xde\x51\xf0\xfd\xe0\x2c\xc3\xda\x9f\xd5\xd5\x21\xc3\xd1\xb0\xa7\x9d\xb0\x33\xd4\xf3\x13\x8a\x9a\x86\x86\x08\x03\xed\xfc\xb6\xe0\xb9\xb8\x53\xc4\x2d\x95\xbd\xb5\xb1\x33\x8c\xaf\x80\x6f\xe7\xf7\x0b\xcb\xea\x9e\xed\x33\xc4\xf2\x31\xea\x99\x0b\xd9\x52\xec\x7a\x7d\xf9\xc9\x86\x0c\x13\xd3\x7e\xec\x76\x8c\xc8\xe3\x39\x2e\x03\x80\x83\x81\xfd\x9a\x0d\xc7\x53\x5a\x2d\x17\x8b\xc1\xe6\x5f\x3c\x7c\x82\x76\xde\xb4\x66\x15\xa5\xad\xfb\x6e\xf3\xf4\x66\xbe\x78\x3a\x35\x77\xdd\x45\x1e\x51\x47\xd1\xfd\x70\x36\xaa\x40\xb4\xe9\x8b\x5e\xc8\x63\xb6\x33\x1b\xb1\x5d\x5b\xfe\x0d\x0f\x56\x82\x33\x4f\xd9\x68\x39\xf8\xb4\x42\x44\x40\xfb\xf7\x59\x8d\x74\xba\xd0\x8b\x0d\x40\x91\x1c\x44\xd5\x36\xe0\xd3\x63\xfb\x88\x50\xc6\x89\xf8\x40\xac\x00\x51\xe7\x61\x52\x27\xc1\xc7\xee\x83\x7e\x78\x95\xdd\x55\x75\xf3\x02\x4a\x0f\xc0\xb0\xc9\xd5\x84\xde\xb2\x48\x34\x4d\xf0\x53\x1f\x9f\xa2\x8a\x83\x99\xd3\x98\x02\xe1\x22\xed\x06\xd0\x6c\x07\x91\xfa\xd1\x93\x04\xcc\xc4\x43\xa8\xba\x59\xba\xef\xcb\x7f\xe7\x14\x41\x41\x41\xf9\xe1\xd3\x2b\xed\x7e\x01\xa7\x1b\x98\xca\xdf\x92\x45\x65\x5a\x96\x6d\x44\xa9\x5d\xe5\x31\x90\xc4\xcb\x90\xab\x3c\xbe\xfc\x71\xf2\x64\x85\x96\x3a\x6b\x03\x23\xc0\xbf\x9a\x84\x46\xfb\xe0\x9a\xea\xf1\x50\xeb\xe5\xda\x05\x8b\x9f\xa6\xbf\x9f\xd5\xaa\x46\x91\xd5\x0d\xa5\xcb\x1a\x0f\xcd\xfd\xb5\x25\x75\xe3\x15\x52\x8b\xf2\x20\xd6\x69\x16\x5d\xf6\x4f\x18\x5f\xcc\xce\xdb\xf6\x2f\x5f\x85\x9f\xf5\xef\x8d\x18\x7b\x72\x72\x8c\x52\x33\xbf\xd3\xda\x1b\xbf\x26\x25\xdb\xa5\x75\x1b\x65\xec\x31\xe2\xb5\x5d\x4c\x68\x9a\x95\x57\x5e\x47\x3c\x9f\x44\xe0\xcf\x0e\x43\xe7\x1c\x07\x8e\x91\x39\xb7\x82\x65\x6c\xc0\x01\x3b\xcf\xac\x06\xf8\x42\x00\x2c\x30\x91\x2f\x46\xe2\xfe\x5e\xb5\x0f\xf0\x7c\x33\x24\x7d\x73\x77\x50\xbf\x77\x19\x98\x8d\x5c\xee\xe9\x41\x53\x9b\x2d\x41\x0c\x92\xcb\xe9\xea\xb2\x55\xe4\xa8\x57\x19\x29\xc2\xb9\xd6\x42\x72\x0e\x95\x00\x97\x23\x4f\x9b\xe3\xaf\xc4\x15\x75\xe7\x49\xc0\x6a\x56\xce\xed\xd3\x32\xa3\xb0\x73\xd2\x68\x64\xe2\xa5\xed\xe7\x1b\x4a\x77\x68\x56\x92\xaf\xd6\x48\x47\xe5\x2f\xa7\x6e\x38\x47\x67\x78\xda\x6b\xa6\xf0\x2b\x29\x2a\xc3\x6e\x9a\x92\xc8\x29\xa7\x29\x21\x35\x8e\x7a\xa4\xe3\x14\x28\x9d\xd7\x48\xf5\x51\xbc\x10\x9f\x50\xab\xb4\x5c\x96\xe3\x39\x88\xe1\x63\x3e\x51\x91\x46\xe0\xc2\x81\xd9\xbc\xe1\x08\xbb\x9d\x54\x31\xa1\x22\xc0\x03\xea\x7a\x17\xa9\x85\xad\x3e\x99\xe2\x7f\x73\xc2\x30\x59\x2c\xb9\xe3\xb7\xfa\x98\xf2\xc4\x1b\x0d\x56\xcf\xbd\x7f\x35\x11\x6c\xc8\x4b\x6d\xd6\xa9\xe0\xe7\xe9\x19\x01\x85\x74\x76\x4a\x3e\x5d\x54\x49\xf3\xe5\xaa\x92\xa7\x0e\x65\xbf\x70\x83\x75\xe1\x16\xbd\x64\x8f\x2a\xe1\x1c\x75\x01\xb4\x63\x76\x10\x60\xd1\xe1\x62\xbd\xac\x3d\x9d\x15\x68\x05\x23\xb3\xff\xf6\x47\xb9\xa9\x09\x00\x3c\x51\xc5\x6b\x6e\xc4\xe1\x42\x31\x8d\xec\x71\x19\x69\x3d\xdf\x9f\x4f\x77\x5d\x51\x38\x53\x57\x15\x5d\x26\x50\x8c\xa8\x86\xab\xdc\xd9\x25\xd1\xdd\x10\x82\xb6\x2c\x7b\x1a\x57\x5a\x73\x1f\x17\xec\x32\xc9\xb3\x0e\x67\xd6\x76\x31\x78\x30\x88\xfc\x23\x93\x43\xec\x87\x58\x9e\xa3\x96\xc0\x12\x81\x4a\x8b\xae\x9c\x4f\x59\x62\x65\xd3\xb4\x0e\x33\x03\x62\xed\xc3\x0f\x94\xb3\x6b\x05\xdb\x4f\x91\xf7\x0f\xa8\xe7\xa7\x4c\xce\xd1\x5d\x6f\xf1\x8b\x57\x38\xb9\xec\x60\xee\xc7\x2d\x04\x7b\x3e\x87\xfe\x0a\x56\x24\x1a\x2b\x2b\xab\x35\x58\x21\xaf\xf2\x6f\xab\xd7\xce\x51\x05\x02\x5a\xee\xa0\xac\x49\x32\xb6\xe3\xe5\xed\x94\x7a\xdb\x50\x75\x60\xf4\xf3\x1e\xb7\x8c\xed\xf5\x19\xd0\xce\xcd\xad\xd8\x79\xf1\xfb\x88\x9e\xa8\xdd\x94\x72\xba\x2f\xe3\x06\x93\x2c\xa8\x1e\xcf\xef\xed\xe7\xba\x6f\x5c\x35\xba\x20\x13\x4a\xa8\x9e\x28\xb5\x8f\x2e\x43\x54\x99\xab\xb2\xf7\x8b\xf6\xa0\x7b\x91\xd7\x87\xa6\x13\x27\xa6\x4f\x02\x15\x52\x87\x89\x99\x5a\x84\x08\x23\x52\x10\xe5\x27\x12\x21\xc9\xe5\xbe\x45\x49\x75\x56\xd6\x82\x22\xda\x80\x2f\x13\xa2\xf2\x00\x37\x89\x3d\x6a\xa8\x8d\xfa\x7c\xc4\x46\x67\x64\x7d\x9a\x56\x80\x55\x61\xf3\x89\x1e\xbf\xb9\xb8\x84\x75\x87\xc0\x07\x6c\xff\x8b\x5d\x22\x8d\x22\xe0\x36\x41\xd8\x0d\x2d\x03\x86\x83\x08\x3a\xc3\x43\x9b\xa9\x4b\x7c\x1d\xf9\xd2\xa0\xc7\xe1\x75\xc2\xaa\x85\x3d\x1c\xab\xa5\xd2\x41\xb8\x52\xc3\x21\x8f\x9f\x1a\x9f\x07\x70\xee\x6c\xf5\xc6\xdf\x2d\x69\xd8\xba\xd7\x5b\x9c\x39\xc5\x2a\xb0\x53\xaa\x4f\x33\xe2\x37\x5e\x00\xf2\x13\xa9\xd8\x5c\x39\xe3\x04\x9e\xcc\xd7\x0e\x5b\xf0\xd7\x3a\xef\xfc\xbb\xdd\xc5\xf4\xcb\xab\x04\x79\xe4\xa7\x2c\xb5\x37\xc4\x10\x4e\x23\xad\x39\x66\x43\x95\x0d\x26\x6b\x41\xda\x74\xe4\x27\xf1\x64\xc4\x95\xc8\x1e\xc3\xfe\x87\x19\x3c\xc6\x5a\x2f\x41\xc4\xba\xa9\x67\xb6\xc4\xbd\x9f\x70\x42\xaf\x8f\xc9\xac\x20\x1e\xbe\x22\xf9\x20\x2e\x96\x44\x94\xc5\x9f\x3b\x83\xda\x9e\xe3\x8e\x06\x91\xfc\x62\x0f\xd4\x13\x1d\xa1\xec\x14\xea\x3a\xe0\x6c\x0b\x00\xd6\x9b\xda\xf3\xf7\x98\x68\x7a\x7b\xf7\xae\x4f\x44\xf0\x35\x8f\xe3\x4e\x27\x97\xdc\xac\xa1\x9b\x32\xf2\xd4\x7f\xcc\x1f\x58\x59\xe7\x34\x31\x31\x81\x4c\x5c\x82\x63\x76\xf0\xf0\x56\x57\x6f\x1c\x1e\x8e\x89\x14\xfa\xf9\xf1\x34\xfc\xb9\x7f\xa4\x7d\x0c\x25\x70\xe2\xf2\x99\xfb\x44\x52\x69\xd2\x58\xcc\x47\xee\x6b\x94\x3e\xa6\xe1\xb7\x29\x9c\xec\x60\x93\x9f\x37\x1e\x92\x4d\xc6\x51\xdd\x58\x52\xe7\xd4\xd6\x09\x95\xf0\xbb\xc6\xee\xcb\x3f\x08\xf9\x2e\x4c\xf9\xcc\x15\x54\xae\x15\x37\x2e\x55\xed\x95\xc2\xb6\x97\xe6\x6b\xa9\x6a\x25\xe2\xc8\x89\x2c\xff\x35\x25\x78\xa9\x50\xef\x9e\x61\x03\x18\x3f\x1d\x66\x58\x71\x0d\xdc\x0b\x39\x3c\x3c\x74\xbf\x79\x88\x73\xeb\x96\xb8\x3e\xc6\x36\x83\xd4\x18\xaa\xf4\x66\xdb\xb4\x12\xc5\x76\x1e\x43\x0d\x01\x3e\xdf\xfc\xec\x60\x36\x08\xc3\x74\xa6\x61\x45\x8f\xc8\x65\x74\xa4\x1d\xe9\xe8\x36\x64\x76\x56\xbb\x16\xf1\xb7\x3b\x2c\xec\xbc\xa9\xb3\xda\x8d\x78\x80\x41\x13\x57\x86\x5e\x4c\x0c\xec\xe1\xed\x3f\xe2\x0f\x2c\x15\x89\x33\x95\x88\xbe\x77\xa7\x48\x3f\x7f\xf3\x14\xed\xf0\x0e\x42\x95\xc0\x58\xc6\x3e\x3a\x0f\x54\xf4\x72\x47\xa1\x15\xba\x06\x6f\x4a\x9f\xe7\xbf\xf0\xd7\xcf\x9c\x06\xb1\x8b\x14\x5f\x0e\x66\x8e\xdf\xa9\xf2\x1a\xe9\x66\xc7\x0d\x0d\x1a\x54\xc2\xb5\x43\x9f\x1f\x5b\xb2\x93\x7c\x06\x37\x69\x68\x9f\x2f\x9e\xbd\xdc\x51\xdc\x20\xca\xce\x33\x73\x13\xee\x6c\x58\x5d\xf1\xa2\xb5\x5f\xf7\x3f\x2f\xf7\x09\xa2\x18\x06\x43\xb7\xf1\x90\xe6\xcf\xd7\x59\x90\xfd\xdd\xdd\xb8\x39\x47\xf9\xfa\x85\xa0\x01\xec\x7f\x6a\xe9\xbe\x8c\x57\x73\xf8\x8a\xb1\x43\x05\x85\x85\x53\x47\x92\x9f\x53\xe5\x63\x3d\x43\xdd\x37\xde\xc5\x62\x2e\xb2\x5b\x65\xf2\x38\xec\x0f\xde\x57\x54\x12\x6d\x9e\xe6\xa8\xb3\x2a\xfc\x5a\x3e\x5f\x24\x93\x3f\x5d\x8a\x1d\x75\xa3\xd1\x7a\x9d\x66\xe9\x05\xaa\x20\x86\xca\xab\xb9\x70\xa2\x4b\xff\xf9\x75\xc6\xdc\xb7\xbe\x96\x89\x47\x0d\xc3\xb0\x20\x3f\xd9\x4c\x7d\xde\x98\x0c\xbb\xdd\xe4\x40\x05\xf1\xe7\x7e\xd2\x9d\x7b\xb5\x8c\x24\x52\x2a\xd0\x2d\x28\x9c\x00\x41\x3e\x3f\x95\x4c\xfa\xba\x7a\xac\x1b\xba\x4a\xa3\x4b\x2f\x3d\xdf\xee\x14\x83\x61\x7a\x7f\x5b\xbd\x62\x1f\x1f\x1f\xfd\x58\xdc\x07\x3d\x7e\xf5\x74\x4a\xf4\xc7\xff\x3b\x3c\xbc\x29\xeb\x50\xb7\xe2\x1c\xb5\x19\x76\xd4\x6c\x30\xcc\x04\x86\x9d\x42\x67\x87\xf9\x64\xfd\x1d\x2e\xef\x09\x08\xfc\x4f\x4e\x68\x57\x90\x47\x68\xed\x3e\x7a\x5a\xba\x73\x30\x40\x0f\x14\xb2\xfb\x29\x5c\x32\x0c\x5b\x48\x6d\xd8\x1f\xf8\xf1\x60\x54\x2c\xca\x23\x86\x4d\xd9\x3e\x2f\x66\x20\x38\x60\x0f\x55\xca\xb6\x81\xec\x0c\xf2\x16\xa7\x29\x02\x06\xf4\x7a\x8b\x3f\xfa\x10\xe0\x6f\xbe\xd2\xab\xb1\x89\x76\xc1\x98\xdd\xf3\x14\xe8\xff\x33\x28\xdc\xf7\xd2\x5c\xbc\xd0\xd3\xd7\x37\x66\x3e\x2f\x32\x32\xd2\x4c\x68\xee\x35\xfa\xf8\x31\x32\x27\x23\x43\x7b\xa4\xf1\x61\xed\x6d\xe3\x76\xe9\xd6\xc5\x16\x6e\x6c\x4f\x4e\x0c\xaf\xce\xdf\x56\xb8\x5c\xcb\x32\x33\xf6\x25\x2c\x04\x52\xff\x48\xed\x9a\x78\x30\x6f\x18\x41\x87\x36\x61\x38\xe3\x3a\xc2\x0f\xc7\x58\xaf\xd9\xb2\xab\x10\xd4\x2d\xf4\xb2\x7b\x26\x62\x65\x7d\x1b\xf8\x78\x5c\x31\x23\x06\x22\xc6\xc7\xc7\x17\x15\x17\xb7\x6c\x30\x70\xfe\x5d\xb6\xda\x32\xbe\x5e\xd1\x49\xed\x51\x40\xf8\xe5\x5b\x35\xa4\x2d\xc5\xed\x5d\xac\xf7\x1b\x7f\xba\x6f\x49\x79\xaa\x2c\xb5\x7a\x1c\x57\xd7\xd7\x22\xde\x9f\xcd\xa8\x64\x08\x67\x0e\x2e\x0d\xb8\xb3\xe9\x48\x4e\x52\x52\x35\xc4\xc4\xc5\x2d\x05\xa9\x23\x13\xbf\xaa\xf5\x20\x91\xf8\x0e\x81\xf7\xb0\xdb\x24\x3e\xaa\x54\xa4\x91\x36\x25\x08\x0d\xbf\x97\xad\x3f\xfe\xf5\xc1\x58\xe6\x8e\xe2\x6b\x9c\xde\x99\xbd\x72\xd5\xca\x2a\x9d\x0a\xe6\xa4\x2d\x15\x3b\x96\xb5\xa7\xc7\xfb\xda\xc6\x39\xdb\x91\x6e\xc3\xac\x17\x4c\x0e\xfe\x2e\x4f\x5f\x9c\xe8\x68\x95\x01\x67\x40\x2b\x5d\xd5\xc5\x65\x3a\xb7\xf1\xb6\x16\xe6\x23\xb1\x69\x1f\x41\x4a\xa2\x98\xe9\x57\x68\xed\x97\xda\x41\xe3\xf1\x8e\x79\xff\xf4\xb3\x97\xec\xec\xec\x1e\x4e\xfa\x08\x6a\xf5\xde\x74\xdd\xde\x71\xb4\x15\x4a\xc9\xa7\xcf\x1e\x55\x94\x3b\x3c\x80\x8d\x63\x97\xa1\x2b\x26\x1d\x13\xd8\xbb\xde\x8c\xf8\x04\xc5\x6f\x63\xd6\x9d\x56\x23\x3f\x44\x38\xc7\x95\xe6\xec\x4c\x9a\x76\x5c\xa1\x71\x12\xea\x44\x5b\x08\x0f\x83\xb9\xea\x5b\xfc\xee\xd9\x09\x55\xf2\x2a\xbf\xd4\xf2\xdf\x3d\x72\x47\x3e\x99\xab\x48\x21\xd1\x8b\xa4\xcd\x47\xb7\x89\x19\x30\xc2\x1e\x0f\xc2\xce\x27\xc3\x1e\x92\x67\xe1\x70\x82\xb3\xce\x85\xb3\xe3\xd8\xd8\x58\x94\x7e\x1b\x77\x36\x30\xf4\x21\x67\x76\x56\xbb\xc0\x8b\x9a\xfa\xef\xf6\xd3\xe3\x2b\x25\x0a\x0f\x6f\xef\x8f\xe4\x2a\x0a\x7d\x0c\x22\x10\xc9\x07\xda\x9f\x44\x31\x0c\x65\xd3\xbb\x59\x9d\xae\x71\xb2\x6a\x56\x1d\x43\xce\x55\xb3\x01\x2e\x31\x79\x4c\x38\x36\xb9\x70\x01\x24\xc3\x28\x5c\xed\xca\x97\x4f\x44\x42\x4c\x6c\x7d\x6f\x92\x47\x54\xa2\x50\x4b\x57\x48\x56\x69\x7f\xc4\x3c\xef\x80\x28\xad\xb3\xa6\xe0\x9a\x4e\x53\x79\x7a\xcf\x9b\x02\x6f\xa8\x45\x2f\x24\xe8\x8d\x3f\x5d\x07\x53\x3a\x67\xb6\xca\x13\xea\xf9\xc9\x0c\x6b\x45\xea\xbe\x8c\xe6\xeb\x9b\x33\xe7\x53\x99\xae\x30\xea\xa1\xfc\xbd\xcd\x76\x6b\xef\x54\x3a\x69\x5b\xe1\x7c\x0f\x77\xf7\x9f\xc7\xc7\xdc\x65\xd3\x71\xea\x55\x6f\x94\x8a\x1e\x1e\x48\x18\xb8\x5e\xbd\x7a\x55\x3c\x2e\x63\x9a\xe1\xe4\x66\xb4\xb8\xfd\xb9\xfc\x6d\x83\xc1\x38\x39\x7e\xec\xc5\x7d\xbd\xbe\x81\xca\x2c\x14\x0e\xd7\xde\x10\xb5\x16\xfc\x6b\x25\x6c\x64\x4c\x3c\x6b\xc4\xf5\x26\x25\xb5\x92\x9e\x53\x1e\x51\x5a\xcd\x5e\x9e\x66\xb0\xf3\x4b\xef\x5d\x06\x1c\xd7\xe9\x90\x8a\x16\x99\x32\xd0\x51\xc2\xcd\x05\x6e\xb6\x6e\xc8\x29\xd5\xd9\xb2\xd9\x6d\xf1\x00\x74\xfd\xa2\x45\x7a\x8b\x6f\x9f\x0c\xbc\x11\x07\xf5\xaf\xac\x48\x16\x10\x91\x54\xc8\x70\x8c\x6f\x59\x0f\xe6\xe6\x34\x04\xd9\x15\xef\xea\x0b\x06\xb6\xaf\x1c\xc7\xd8\xf9\x98\xb4\x45\xff\xaf\xc3\xd1\xb1\x91\x68\x91\xbc\x79\x72\x8b\xa3\xa6\x7d\xbe\xfb\x14\x99\x3a\x71\x7a\xff\xfb\xb3\x9f\xaf\xef\xe0\xf1\xf1\x31\x2c\x70\xc5\x62\xf7\x5a\xe7\xa0\x9a\x51\x4d\xc2\xcd\x39\xfe\xe2\x3e\x04\x9f\x80\x20\x9e\x38\x3a\xe7\x75\x04\x7d\x25\x27\x55\xa9\xaf\xc6\x1f\x41\xf7\x67\xe4\x7b\x6a\xb1\xaa\x2a\xed\xa8\x13\x8e\x70\x53\x92\x9f\x28\x39\x15\x06\xf2\x24\x62\xbd\xd1\x19\x02\x89\x99\xcf\xce\xba\x9a\xd6\x42\xec\x69\xd0\x00\x39\x42\xfb\x0d\x51\x0b\x71\x49\x06\x1e\xd2\x8b\x57\xed\x46\x51\xf7\x10\x34\xb7\x56\x63\xfe\xfc\x82\x2f\x82\x0f\xc7\xf9\x41\x6c\x30\xde\x4c\x0b\x44\xed\x57\xaa\x48\xbe\xac\x4f\xb1\xd4\xc5\x85\xe6\xb5\x9b\x3d\x7d\xf7\xdd\xec\x19\x86\xd7\xda\x75\x54\xfa\x9a\x16\xb5\x3e\xc0\x30\xdf\xfe\xf5\xe9\x37\x5d\x4f\x77\x07\x46\x32\x4c\xa1\x0b\xf3\xb2\xe0\xac\x96\xba\x49\xa6\x8a\x92\xba\x03\xbd\x56\x26\xff\x83\xbe\xc9\x6e\xc5\xc9\x75\xf8\xa0\x16\x09\xe2\x7a\x9a\x30\xc2\x95\x47\x96\x7d\x38\x91\xec\x2d\xf4\x22\xf1\x79\x9e\xb2\xfc\x13\xc6\x97\x92\x6a\xe0\xb5\xa7\xf0\x38\x60\x14\x8b\xba\xe8\xe6\x70\x09\x2f\x6d\x44\x4b\x84\x79\x92\x80\x4e\x80\xf6\x68\xfa\xe8\x0a\xe7\x3d\x88\xd0\x75\x74\x91\xe7\xf5\xc9\x43\xc2\x1f\x0c\x2b\xbc\x57\xed\x21\x4c\xcc\xd7\xf8\x83\xd8\x0b\xd5\xb5\xe0\xd8\x79\x48\x0f\x5b\x65\x1d\xd7\x68\x80\xcc\x8a\xe8\x95\xaf\xef\xed\x6d\x36\x6b\xa8\xd1\xfa\xda\x5a\x67\xd8\x53\xe0\xd9\x4d\x73\xa1\x92\x55\xc7\x4e\x04\x1c\xdf\xaa\x16\xb5\x8c\x1d\xb7\xf1\x79\xd1\xbb\x67\x6f\x91\xc2\x4c\xfa\x2e\x6b\xdd\x6e\x57\xd2\x31\xa9\x15\xa1\x76\x59\x5d\x2d\x3c\xc2\xe4\x96\x80\xd8\x4f\xf0\x4a\x31\x95\xfe\x77\xe8\x2d\x6f\x42\xb1\xbf\x64\x67\xe3\x34\xd4\x54\xed\xf8\xe1\x46\xa0\xac\xfc\xdf\xee\x0d\x26\xfb\xf7\x8b\xf6\x76\xa9\x65\x8e\x3c\x15\x82\xde\x51\x3b\xb5\xf7\x15\xa5\xb5\xca\xea\xea\xf8\xa0\xd9\x9c\xa6\xb7\xab\x81\x1c\x11\x5c\x6b\x9d\xff\x2a\x01\xa3\x90\x52\xb6\x1c\x75\x16\x55\x3b\x81\xfd\x6a\x26\x6c\x92\x15\xf5\x11\x24\x92\x9c\x81\x21\xb9\xb4\xd9\x6f\xc3\xd4\xe1\x17\x46\x78\xf9\x6c\x62\xae\x9a\x57\x41\x64\xa4\x84\x1f\x98\x2b\xbb\xb6\xf1\x5d\x61\xd3\x39\xf2\x73\x15\xd4\x31\x9a\x33\x5a\x24\xba\x8e\x72\x7f\x70\x46\x87\x74\xe1\xee\xe1\x36\xbb\x89\xd4\xc4\xc9\x8d\x10\x63\x76\x38\x61\xf8\x22\x67\xdd\xfc\x63\x55\x76\xc9\xc5\x8e\x90\x8b\xd7\x04\x5f\xa1\xba\xdb\x4a\x94\x2f\x4d\xe2\x00\xba\xd3\x11\xe2\x2e\xd5\xd0\x69\xa4\x91\x29\x89\xb1\xf7\x53\x0b\xb1\x29\xed\xc9\x67\x3d\xfe\x11\x42\x79\x06\x8b\xaf\x01\x67\x5b\x7c\x2b\xa1\x51\x5a\x94\x0c\x3d\x82\xa4\xa7\xc4\xf9\xc1\x5c\xe3\x3c\x51\xf2\x4e\x6a\x55\xb3\x16\x7e\x76\xd9\x6e\x5d\xb7\x09\xc3\x17\x51\x6d\x75\x2d\xd8\xd7\x62\x68\xa6\x26\x0b\xd4\x34\x74\xf0\x82\xc9\xb8\xf7\x6a\xe4\x58\x49\x8a\x34\xc7\x34\x49\xf1\x7d\x84\xd0\x0b\x6c\x4b\xd8\xf3\xe5\x60\x3b\x41\xef\xa7\x19\xba\xfe\x31\x3b\xcc\x2f\xf8\xc3\xc3\xbf\xb2\x38\x20\xa2\x01\x55\x33\xcd\x0e\x9c\x36\xa6\x70\x63\x5d\xa8\x0d\xc8\x84\x8d\xdb\xc2\xb0\x46\x94\xd3\x30\xf9\x32\xfb\xc8\x2a\xea\x04\x75\x34\xb0\x3e\x79\x78\x8c\xb4\x08\x0b\xcb\x16\xbd\x7d\x80\x78\xec\x3c\x78\x9f\xdd\xa3\xba\xbb\x33\xeb\xa5\x53\x36\x9d\x4e\x5f\x45\x13\xe2\x62\xbd\x6c\xe4\x7c\x9f\x62\x05\x11\x3c\x91\x8b\x19\x31\x4f\x5e\x86\xb6\xca\x94\xec\xf9\xbe\xc5\x4f\x2d\xca\xf0\xb6\xa4\x95\x0a\xfe\x09\xb7\xeb\xa6\x8f\xb2\x69\xc8\x99\x45\xe1\xf1\xf8\xdb\x29\x4d\x54\x0e\x7d\xd4\xec\x5d\xdc\x4a\xab\xef\xbe\x4b\x28\x5c\x61\x90\x15\xde\x7d\x71\x75\xd5\xfc\xd8\x3e\xf9\xfa\x24\xa1\x74\x2d\xbb\x4a\x43\x89\x71\x9c\xee\x69\x52\x84\xeb\x6b\xab\x97\x8e\x07\x3e\xe5\xf9\x60\xbe\x8b\xe4\x8d\xd6\x6b\x02\xa9\x09\x0e\xf0\x4d\x8c\xbb\x1a\xeb\x9e\xa7\xbf\xbf\x52\x01\xd3\x45\x60\x67\x0b\x7a\xa5\x4f\x4a\xf5\x11\x42\xf4\x20\x4d\x99\x48\xb1\x30\xf9\xc3\xd6\xd2\xfe\xa4\xc9\x0d\x0a\xeb\xc5\x72\xf6\x61\x81\x48\x7c\xe8\xd3\x06\x1f\xc1\x71\x98\x5c\x55\x06\x61\x45\x71\x95\x46\x38\x22\xab\xd2\xc1\x95\x99\x4f\x78\xd2\xab\x97\xb7\xe3\x4a\xfc\x6f\x60\x1c\x66\xe8\xda\xfa\xca\x5c\x30\x0f\xcf\x51\x54\x54\xba\xe5\xf3\x44\x0e\x5d\xd8\x8d\x88\x21\x2b\x30\xd8\x9f\x8e\x01\xc3\xa3\x20\xcd\x46\x80\x16\x08\x5c\xcb\x36\x33\x4d\xeb\xa7\x89\xa0\xef\x27\xef\x5b\x5c\xe4\xd3\x24\xb9\x9f\xfb\xc5\xaa\xb1\x77\x49\xec\xeb\x77\xdd\xfc\xf5\xaa\x15\xcd\x54\x5b\x1f\x7a\x9b\x14\x4b\x70\x85\xbd\x89\x51\x9d\x97\x0d\x29\x17\x01\xba\xf6\x90\x0f\x39\x82\x1b\x0c\x75\x45\x71\xcb\x34\x65\x21\x83\xe6\x34\xb2\x1f\x4a\x2a\xcc\xe8\x20\x44\x0e\x24\x7c\xb9\xaa\x4a\x90\x2d\x44\xc6\x8f\x4b\x65\x8d\x22\xdd\xa2\x37\x36\xec\x23\xec\xac\x29\x72\xe5\x3a\xd6\x60\x7c\x98\x53\x07\x14\x9d\x81\x49\xd9\x61\x43\x74\x85\xb0\x84\xc6\x7c\x3f\xa1\x63\x8c\x2d\xa1\x20\x32\xb2\x7e\xf7\x4a\xbc\x9c\xe6\xa8\xe7\x46\x20\x69\xdb\x33\xe0\xbd\xcd\xb0\xc9\x81\xec\x55\xa5\x46\x88\x54\xb1\x76\x16\x33\x01\x9d\x54\x87\x1e\x41\xe3\xf2\x11\x7f\xae\xea\xd2\xab\x5a\xa6\x04\xc1\x00\x53\xcd\xe6\xbe\x37\x15\x0f\x9f\x9d\xfc\x50\xf8\x2b\x4d\x3b\x42\xe7\x4b\xcd\x4a\xd4\x55\x1f\x3d\x7e\xf6\xc5\xbe\x7a\xb8\xd9\xec\x26\xc7\xbf\x32\xb0\x79\xa3\xd0\x67\x52\xca\xf6\xcf\xdb\xf3\x57\xf1\xf3\xa6\xf4\xcd\xf6\x62\x86\x0b\xc5\x7d\x2b\x5a\x91\xd7\x77\x83\x3a\x83\xb1\x6c\x5c\x85\xf7\x5f\x47\x9c\x82\xf8\x45\x77\x2e\x37\x49\xbd\x34\xa6\x6a\x0f\x12\x6e\x3d\x07\xfd\x1f\x76\x3d\xa5\x79\x7e\xdb\xaf\x7c\xda\x7a\xe7\x77\x75\x7d\x7b\x3d\x3b\x74\x78\x78\xd8\x81\xee\x3f\x21\xa6\x6a\x35\x9c\xf5\x2e\x40\xd3\x2a\xc9\xa2\xb5\x60\x2a\x27\x2f\x36\x95\x76\xea\xa8\x92\x7c\xf6\x93\xae\x89\xaa\x15\xa0\x26\xa1\xad\x8e\x6f\x90\xd1\xfd\x63\xba\x5f\x61\x9e\xaa\xba\xbd\x6a\x24\x34\xcf\xd4\xef\x97\xff\x0f\xc5\x19\x1c\x90\x44\x31\x4a\x39\x2d\x3a\x4f\xd0\xf8\xff\xba\x00\x5e\x29\x03\x96\x3d\x4f\x4f\x4f\x78\xc1\x3e\x0b\x41\xfd\xe4\x56\xbc\x83\xc6\x4e\x2a\xac\xd8\x11\xc9\x18\x50\x9d\x88\x26\x2a\x2d\x12\x84\x74\x6c\xa6\x0c\x33\xc4\xb4\x7e\x72\x34\xba\xdf\x5d\xf4\xef\x83\x54\x12\xfa\xf1\xa1\xe4\xff\x03\x35\x40\xca\xbf\xe0\xf1\x93\x1f\x91\x52\x72\x70\xf7\x2b\x96\xab\x39\xe3\xe1\x2e\x08\x78\xf8\xf0\xe1\x16\xd1\x3e\x3f\xc0\xf3\x34\x06\x6b\x5f\x2d\x57\x6d\x3b\x0d\xdd\xe0\x9f\x3b\xff\x73\x6b\x1d\x55\x69\x59\x2e\x4a\x8c\x35\x54\x75\xc5\x6c\x31\xe7\xe0\xce\x3e\x71\x1c\xf8\x72\xb8\x0d\xa9\xbc\x9b\x57\x5d\x5b\x66\xb3\x15\x02\xcd\x72\xb9\x24\x49\xce\x7b\x79\xa6\xb1\x54\x95\x61\x31\xcb\x29\xab\x12\x6b\x1b\xb2\x7c\xc1\x60\xd8\xe7\xde\xbd\xbb\xe7\x1f\xb0\x0e\x36\xda\x64\x57\x17\xc5\x29\x7b\x77\xfc\xa1\x36\x35\xe4\x79\x49\x94\x68\x82\xe0\xf3\xb7\xdb\x33\xc6\xdc\xc8\x29\xe2\x75\x87\xc6\x97\x3d\x2f\xde\xfe\xb5\x97\x6e\x3c\x2d\xe9\x74\x23\xcf\x0c\xa8\x0c\x71\xf2\xf6\x87\x5b\x53\x1b\x9c\x83\xbd\xdd\x03\x4e\xce\x0e\x51\x4a\xd1\xd4\x95\xdf\xf2\xd8\x6e\x8f\x7d\xde\x3d\xbc\x4b\x46\xcd\x42\x90\x15\x05\xa6\x31\x1e\x94\x36\x8e\x53\x60\x0d\xd4\x55\x43\x5e\x34\x94\x65\x45\x63\x1a\x8a\x22\xa7\xac\x6b\xf6\x77\x76\xd8\x19\x77\x79\xd5\x43\xde\xbb\x5d\x35\xa6\xa6\x6a\x60\x7f\xef\x11\xcb\xd5\x29\x66\xd4\x20\xc3\x90\xaa\x32\xe4\xab\x9a\xc5\x7c\x45\xdd\x14\x18\x57\x11\x47\x21\xbf\xfb\xee\x9b\x5f\xa5\x2c\xac\x02\xe8\x06\x5f\x86\x07\xc1\xda\xab\xf5\xa6\x57\x13\xe0\x5e\xeb\xb5\xb1\x29\x7f\xaf\x73\x6d\x95\xda\xec\x0d\xaf\x81\x32\x4e\x02\xf2\xac\xa2\xae\x3d\x07\xf4\x6d\x84\xf2\xba\x36\x18\x6b\x09\xc3\x98\xfd\xdd\x03\xac\x31\xd4\x4d\x4d\x10\x44\x17\x3a\xc2\xdb\xf8\xac\x00\x4f\x4a\xd9\x1a\x20\x57\x1b\xb0\xab\xaa\x86\xa3\xc3\x09\x61\x10\x82\x55\x14\x45\x45\x59\x55\x1b\x85\x5a\x6b\xed\xe6\x57\xd5\xd4\x24\x51\xcc\xbd\xd1\x0e\x71\xac\x91\xea\x0a\xbc\x93\x92\xd3\xc9\x19\xcb\xbc\x22\x8e\x7a\x28\xa9\xb1\x46\x50\x37\x0d\xc6\xc0\x62\x5e\xb1\x5a\x2d\x70\xd4\xe8\x50\xf0\xe0\xee\x7d\x82\x60\x2b\xbf\xf3\xae\xd9\x5d\x10\x04\x37\x7a\x45\xce\x39\x87\x35\x0e\x1d\xbc\x0a\x25\x5a\xeb\x77\x12\x9c\x10\x42\xbc\x42\x42\xf7\xaa\xca\xa1\xf7\xcd\x2d\x1b\x92\xf4\xcd\x67\xa8\x2c\x1a\xd6\xc8\x16\x04\x11\xae\x7d\xe8\xc7\x71\xb4\x15\xf9\xfc\x9c\x33\xbc\x30\x8a\x70\x16\x70\x66\x03\x78\xce\x0a\xac\x91\x2c\x97\x15\x55\x55\x52\xd6\x15\xc6\x18\xac\x35\x9b\x27\x6a\x12\x27\x8c\x06\x43\xa4\x52\x9e\xf0\xaa\xda\xfa\xe5\xe2\x53\x5a\x4a\x16\xb3\x39\xcb\x65\xce\xde\xfe\x5d\xa6\x93\x19\x67\x93\xdc\x8b\x0d\x2c\x4a\xac\xa9\x31\xa6\x42\xa8\x9a\x3b\xfb\xbb\x24\xc9\xd6\x37\xe0\x7d\x01\xef\x36\xec\x03\xd7\x75\x83\x0a\xc2\x57\xb2\xa7\xb5\x3f\xed\x75\x7b\x78\x6b\x02\xfa\xc5\xaf\x5f\x67\x79\xbe\x54\x7d\x73\xa6\x58\x57\xde\xda\x51\x2a\xd9\x7a\x6c\x58\x9c\x80\x30\x0c\x3e\x4b\xca\xd2\x16\xf0\x2e\x96\x9b\x81\x97\xe2\x29\xb2\x9c\x38\x8e\x48\xd3\x10\x1d\x7a\xdf\xd5\x38\x08\x09\x83\x0e\x5d\xd1\xd9\x50\x45\x54\x3b\x19\xb5\xce\x62\x8d\xf7\x7f\x10\x08\xc2\x2b\xdc\xa3\x6c\x63\x98\xce\x56\x0c\x47\x3b\x0c\x87\x7d\xc2\x30\xe4\xec\xec\x94\x22\xaf\xa9\xab\x86\x5e\xbf\x83\x90\x92\x5e\x6f\x3b\xfe\xff\xb5\x80\xf7\xae\xaa\xd2\x9f\x3a\x84\x68\x7b\xb5\x85\xdf\x7b\x7d\x15\x0c\xeb\x6b\x3b\x83\xad\x55\x61\x56\xab\xd5\x25\x53\x28\xad\x25\xdd\xfe\xdb\xd7\x05\x97\x8b\x62\xf3\x9a\x9c\x75\xe8\x40\x12\x27\x01\x42\x74\x99\x4c\x4f\xaf\xe5\x5f\xbb\x8d\x5b\x0a\x78\x71\x12\x33\x1a\x8d\x29\x8a\x92\x38\x8e\x91\x4a\x12\x85\x21\x4d\xe5\xe7\x6b\xd6\xb5\x1b\x0c\x2d\x98\x35\xc6\x71\xae\xc7\xed\x31\x2e\x4e\x34\x3a\x52\x60\x2e\x00\x9e\x56\x4c\x4e\x26\x58\x14\xfd\xbe\x3f\x94\x69\x1a\x93\xa6\xf7\xc9\x56\x05\x42\x0a\xe2\x38\xdc\xea\x8b\x7d\x80\x58\x2e\x97\xb7\x60\xed\x49\xa0\x03\xc5\x72\x5e\x10\xc5\x9d\x4b\x5d\xb2\x35\xe1\xf8\x5d\xfc\x63\xd3\x34\xe5\xf8\xf8\xf8\x12\xe0\xad\x41\xef\xcd\x59\xa6\xa1\xae\x2d\x08\x41\xd3\x78\xb0\xeb\xf5\x63\xc2\x48\xa3\x83\x3e\x4f\x9f\x3e\xa5\xd7\xeb\x6d\x5d\xca\xae\xd3\x12\xbb\x8d\x2f\x5a\x49\xcf\x63\xf3\xab\x36\x1e\xb0\xa2\x48\xd3\x98\x86\xf9\x72\xc1\x72\xb5\xa0\x28\x72\x94\x14\x04\x4a\x5f\x71\x8c\x21\x4d\x2f\x4f\x66\x91\x92\xe5\x62\xc9\xe9\x64\xce\x60\x30\xd8\xac\xfc\x6c\x0e\x6b\x27\x26\x49\xa2\x2d\xd8\x7d\xa0\x58\xfb\xfd\xde\xf4\x0c\x2f\x68\x2d\x15\x57\xcb\xea\xf2\x43\xb7\x7d\xed\x6b\x32\xf1\x75\x32\xbc\x7e\xbf\xcf\xd9\x7b\x68\x2e\x16\xb9\xe7\xd6\x59\xeb\xda\x6d\xa0\x84\x30\xf2\xe7\x33\x0c\x43\xc6\xe3\x31\xcf\x9f\x3f\xdf\x1e\xaa\xcf\x2d\xc3\xb3\xd6\x72\x7a\x7a\xc2\x6a\xb5\xc2\xd4\x15\x69\x1a\xd3\xe9\xa4\xe8\x40\x51\x37\x35\x4e\x48\x82\x30\xc5\x59\x4b\x51\xe6\x9c\xcd\x9e\xd3\x4d\x53\x86\xfd\x01\x42\x48\x9a\x0b\x3c\x28\x1d\xc8\xf3\x61\x85\x10\xe4\x79\xce\xb3\x67\x47\xf4\x87\x3b\x97\xb8\x4f\xdb\xf8\x38\x51\x96\xe5\x87\xdb\xb0\x70\x6c\xe4\x94\x3e\xf8\xc3\x55\x49\xba\xfd\x88\xd5\xa2\xba\x24\xb7\x1e\x86\x3e\xd3\x7f\x17\xbd\xc3\xb5\xaf\xc4\xbb\x96\xf3\xae\x1d\xbc\xc5\x71\x40\xa7\x17\x5d\xa2\x2c\xad\x7d\x31\x8c\x31\x34\x4d\xf3\xc1\x94\xbb\xb7\x80\xf7\x1b\xdf\x1c\xe7\x8e\x4e\x8e\x30\x90\x74\xc7\x7b\x64\xab\xa5\x57\xac\x08\x3b\x18\x63\x69\x4c\xcd\x70\x3c\x46\xc9\x80\xba\xea\x51\x14\x03\xb2\x7c\xc5\xe1\xe9\x19\x81\x52\x0c\x7a\xbd\x76\xa1\xff\xa5\x03\xe5\x1c\x93\xd9\x02\x1d\x26\xdb\x5e\xc8\x27\x2a\x67\x3f\xa8\x60\x80\xe0\x2a\x66\xd1\x07\x8b\x30\xd2\x54\x95\x21\x5b\x96\x97\xfa\x6d\x4a\x29\x9a\xa6\x79\xab\x1a\xce\x79\x11\x21\xd9\xdb\xdb\xe7\xf0\xf0\xf8\x12\x57\xf3\xad\xa5\x70\x37\x22\x4e\x02\x94\x96\x57\x52\x57\xfc\x36\x46\xcc\x6c\x36\x63\x67\x67\x67\x7b\xc0\x6e\x73\x49\xfb\xfc\xf9\x73\x26\x93\x09\xbd\x5e\x8f\x5e\xaf\xc7\x6a\xb5\x62\x34\xe8\xd2\x89\x03\xa4\x10\x54\x75\x05\x42\x60\x8c\x23\x8a\x34\xb3\xe9\x31\x42\x36\x0c\xc7\x1d\xee\x1c\x8c\xc9\xb2\x19\xd6\x49\xd2\x6e\x9f\xf9\x6a\xc9\x7c\xb9\x20\x08\x83\xf3\x1b\x44\x4a\xce\xa6\x33\x16\xcb\xe2\xda\x6b\x42\xdb\xf8\x75\xf1\xe2\xc5\x8b\x0f\xbe\x61\xf1\x31\xaf\x9b\xdf\x69\x0d\xbc\xa2\x49\x75\x4e\x2d\x89\x22\xdf\xe2\xb8\xee\xe0\x02\x04\x65\x51\xb1\x7c\xc9\xae\xf1\xad\x59\x89\x96\x84\x91\x7e\x2d\x4f\x4f\x29\x45\x9a\xa6\x2c\x97\xcb\xed\xe1\xba\xcd\x80\xf7\xef\x7f\xff\x9b\x6e\xb7\xcb\xee\xee\x2e\x49\x92\x50\x55\x15\xf9\x6a\x41\x92\xa6\xe0\x1c\x16\x36\x32\xd6\xd6\x34\x74\xbb\xe9\x66\x21\x5d\x08\xf8\xf7\xbf\x7f\xe0\xd1\xa3\xbb\x40\x4e\x6f\x90\x62\xf0\xee\xf0\xf3\xd5\x1c\xda\x72\x6a\x36\x5f\x70\x74\x3c\xe3\x77\xbf\xfb\x6e\xeb\xee\xf4\x89\xe2\xe5\x49\xe5\xad\x28\x85\x02\x49\x14\x07\x97\x7a\x79\x69\x9a\x22\xa5\xbc\xd6\xfe\xaa\x73\x8e\xd9\x6c\x4a\xdd\x18\xc2\x30\xc1\x9a\x0f\x97\x92\x4a\x29\x89\xa2\x88\xba\xae\xb6\x87\xeb\x36\x02\x9e\x73\x8e\xbf\xff\xfd\xef\xdc\xbd\x7b\x97\x5e\xaf\x87\xd6\x9a\x3c\xcf\x59\x2c\x16\x7c\xf5\xd5\x3d\x70\xce\xf3\x9a\xac\xa3\xae\x1b\xea\xb2\xc2\x58\x47\x10\x68\xa2\x28\x24\x8e\x63\x8e\x8f\x8f\x49\xe3\x80\xfe\xa0\x43\xbf\xdf\xe5\xf1\xe3\x9f\x18\xf6\x13\xfe\xf4\x97\xef\x39\x39\x3d\xe3\xd9\x93\x67\x14\x8d\xe5\xe4\x6c\xce\xf7\x7f\xf8\xc3\xb6\xf7\xf1\x89\x62\xad\x8e\x72\x53\x32\x69\xe7\x5e\x61\x26\xbd\x36\xcb\x0b\x02\x85\x35\xd6\xab\x0e\x03\x41\xbb\x32\x78\x9d\x0c\xcf\x39\xc7\x6c\x3a\xe3\xd1\x83\x47\x80\xa4\xfa\xc0\x4b\xfe\x42\x48\x9c\xdb\x56\x27\xb7\x0e\xf0\x8c\x31\xfc\xf3\x9f\xff\xe4\xc1\x83\x07\x9b\xac\xee\xf0\xf0\x10\x63\x0c\x8b\xc5\x9c\x6e\xa7\xeb\x55\x4d\xa4\x44\x2b\xc9\x2a\xcb\x78\xfa\xcb\x0b\x74\xa0\xe9\x74\x12\xca\xa2\xe0\x87\x1f\x7e\xe4\xf0\xc5\x33\xc6\x3b\x43\x84\xb5\xdc\xb9\xb3\x4b\x1c\x29\xf6\xf7\xc6\x68\xe7\x5d\xa4\x64\x90\xf0\xe4\xc9\x2f\x7c\xf3\xcd\xb7\x37\x9e\x0f\xf6\x39\x45\x59\x96\x8c\xc7\xe3\x1b\xf3\x7a\x84\x78\x95\x7b\xfe\x3a\xc0\xf2\xf4\x10\xb3\x51\x1e\xd6\xed\x24\xff\xba\xd4\x14\x63\x0d\xa6\x81\x28\x4a\xa8\xca\x0f\x6b\xb0\xe3\xac\xc0\x39\xb1\x55\x4b\xb9\x6d\x80\xf7\xec\xd9\x33\xee\xdf\xbf\x4f\xa7\xd3\xa1\xaa\x2a\x1e\x3f\x7e\xcc\x9d\x3b\x77\x88\xa2\x88\xa2\x28\xc9\xf3\x1c\x94\xc0\x19\x43\xb7\x93\xd2\x49\x52\xba\x9d\x98\x7b\x77\x76\x41\x08\x3a\xbd\x1e\xa6\xa9\xf9\xf6\xdb\x47\x44\x5a\x83\x94\x1c\xbe\x38\xe2\xc1\x83\x03\x44\x5b\x06\x6b\x1d\x30\x18\x0c\xe9\x0f\x46\x2c\x16\xdb\xbe\xc7\xa7\x06\xbc\x9b\x28\x09\xf5\xb6\x84\x53\x08\x3f\xd9\x17\x78\x9a\x48\x9e\xd5\x28\xa5\x36\x2d\x94\xeb\x64\x88\x3a\xd0\xd4\xa6\x44\x29\x4d\x5d\x99\x6b\xef\xe1\x5e\x27\x4b\xad\x4b\xf3\x4e\x44\xe8\x2d\xe0\xdd\x80\x98\x4e\xa7\x04\x41\x40\x92\x24\x34\x4d\xc3\xf3\xe7\xcf\xf9\xee\xbb\xef\x36\x07\x66\xa3\x2d\x27\x35\x45\xd3\xf0\xfc\x68\x42\x94\x26\xec\xed\xed\x78\x4e\x5e\xdd\x70\x76\x3a\x61\x7f\x6f\x4c\x1c\x06\x6d\xbd\x62\x29\x8b\x9a\xb8\x95\x77\xaf\x8d\xe1\xe4\x74\xc2\xe1\xe1\x0b\xc6\xe3\x1d\x8e\x8f\x8f\xb7\x87\xe4\x13\x97\xb4\x37\x35\xa3\xb6\xd6\x72\xf8\x7c\xce\xd1\x8b\x05\xd3\xb3\x8c\x6c\xe5\x17\xfb\x5d\xcb\xd7\x0c\x02\x4d\x6f\x98\xf8\xb2\xb6\xf0\x99\xd4\xc5\x49\xed\xdb\x62\x34\x1c\xf2\xec\xf9\x53\xa2\x28\xc4\x18\x7b\xc9\xa3\xe2\xd7\x55\x45\x96\xb2\x6a\x5a\x51\xd1\xed\x59\xbe\x15\x80\x97\x65\x19\x87\x87\x87\xec\xee\xee\x22\xa5\xe4\xc9\x93\x27\x1c\x1c\x1c\x6c\x7a\x3d\x4a\x29\xf6\xf7\xf7\xf8\xe9\xf1\x53\xa6\xa7\xa7\xfc\xfb\xa7\x5f\x3c\xc8\xad\x65\xa2\x74\xc0\x2f\x87\x27\xad\xcc\x7b\xdf\xbf\x31\x21\xc8\xb2\x02\x15\xa8\xb6\x76\x71\x48\x04\x52\x08\xe2\x38\x26\x08\x02\x76\x76\x76\x78\xfc\xf8\xf1\xf6\x24\x7c\x42\x50\xb9\xa9\x80\x27\x84\x60\x38\x4a\x51\x52\x90\xe7\x35\x8b\x79\xc1\xe4\x34\x63\xd2\x82\x9f\x10\x90\x24\x01\xdd\x5e\x44\x59\x36\x14\x45\x43\xaf\xd7\x65\xb9\x5c\xfa\xca\xe3\x2d\xdf\x3b\x4d\x3b\x38\xd7\x50\x96\x05\x42\x48\xca\xf2\xd7\x97\x9f\xce\x39\xca\x96\x98\xdc\xef\xf5\xf8\xa8\xfc\x9c\x2d\xe0\x7d\xb8\x78\xfa\xf4\x29\xdf\x7c\xf3\x0d\x5a\x6b\x9e\x3c\x79\xc2\x68\x34\xda\x10\x35\xc1\x4f\xa2\xee\xdc\xb9\xc3\xee\xfe\x3d\x26\xf3\x92\x47\x8f\xbe\xa1\xdf\xeb\x10\x68\xef\x5b\x61\xeb\x8a\xd9\x6c\x81\xd2\x21\xa6\x25\x6a\xa2\x24\x4f\x9e\xbe\x60\x6f\x67\x84\x33\x0d\x56\x4a\xb2\x2c\xe7\x2f\x7f\xf9\x33\x3f\xff\xfc\xf3\xa6\xbc\x0a\x82\x80\xc5\x62\xb1\x3d\x0d\x1f\x39\xd6\xf2\x48\x37\x55\x34\x40\x08\xaf\x83\xb8\xb3\xdf\x65\x38\xf2\x32\x63\x3e\x2b\xb5\x2c\xe6\x05\xa7\xc7\x2b\x4c\xe3\x48\xbb\x11\xdd\x6e\x04\xce\xd1\xeb\xf5\xc9\xf3\x9c\x2c\xcb\xde\xfa\xfd\x95\x52\x7c\xf5\xd5\xd7\xcc\xe6\x27\x48\x21\x31\xb5\x7b\xc5\x7d\xec\x7d\xca\xd9\xc6\x18\xa4\x90\xc4\x71\x74\x59\x36\x6d\x1b\xaf\xc4\x8d\x18\x4d\x66\x59\xc6\xce\xce\x0e\x4a\x29\xf2\x3c\xc7\x18\x73\xe5\xb6\x83\x3f\x30\x8f\xce\xd3\xf8\x32\xa3\xb1\x5e\x0c\xe0\xd9\xb3\x23\x0e\x0e\xee\x23\xa4\xe4\xe8\xe4\x8c\x50\x4b\xb2\xac\x40\xc8\x80\xaa\x6e\xc8\xcf\x66\xcc\x66\x4b\xd2\x4e\x9f\x71\x18\xf2\x97\xbf\xfc\x85\x1f\x7f\xfc\x91\x3f\xfd\xe9\x4f\x0c\x06\x83\x0d\xd7\x6f\x1b\x1f\x17\xf0\xaa\xaa\xba\x15\x2a\x29\x49\x1a\x12\x46\x9a\xc5\xac\xa0\x2a\x4d\x2b\xef\x64\x38\x3d\x59\x92\x24\x01\x69\x27\x44\x69\xd5\x96\xba\x01\x75\x5d\xbd\x95\x80\xec\xd7\xcb\x7a\x1c\xbe\x38\xc2\xe1\x1f\xca\x45\xee\x3d\x2d\xde\x3f\x63\x76\x34\x95\x45\x48\xaf\x1a\x54\xd5\x25\xfd\x5e\x7f\x7b\xd8\x6e\x72\x86\x57\x55\x15\x51\x14\xa1\x94\x62\x32\x99\x5c\xcb\x0b\x56\x29\x49\x10\x44\x3c\x7f\x7e\xc6\x5f\xff\xf7\x47\x64\x10\xd3\x1f\x0c\x18\x0e\x87\x74\x3b\x03\x8a\xca\xb1\x7f\xe7\x3e\xdf\x7f\xff\x3d\xc6\x05\x28\x95\xf0\xe8\xeb\x6f\xb9\x7f\xff\xde\xe6\x90\xa6\x69\xba\x31\x63\xf1\x2e\xef\xe5\xf6\x44\x7c\x64\xc0\xd3\x5a\xdf\x1a\x72\xb7\x52\x92\xc1\x28\xa1\xdb\xf7\xf2\x50\xa2\xb5\xd0\xcc\xf3\x9a\xb3\x93\x15\xb3\x49\x86\x31\x8e\xbb\x77\xef\xd2\x34\xe6\x5a\x7b\xb5\x52\x4a\x76\x76\xc6\x58\xdb\xe0\x1c\x54\x65\xf3\xde\x59\x9e\x73\x5e\xcd\xa5\x31\xde\xc2\x31\xcf\x2a\xa6\xd3\x99\xb7\xff\xdc\xc6\xcd\xcd\xf0\x8a\xa2\xa0\xd7\xeb\x6d\xca\x9d\xeb\x72\xe2\xfa\xfd\x3e\xff\xf1\xe7\x3f\xbf\xf2\xe7\x3b\xbb\x3b\xec\xec\x9e\xaf\xd8\xec\xef\xef\x5d\x79\xf0\x3a\x9d\x0e\x8b\xc5\x82\xdd\xdd\x5d\xd2\x34\xa5\x2c\x4b\xa2\x28\xda\x9e\x8a\x8f\x14\x79\x9e\xd3\xe9\x74\x6e\xd5\x36\x8b\x10\x82\xb4\x13\x11\x46\x01\xab\x45\x41\x55\x19\xd6\xbb\x6c\x45\x51\x13\x84\x0a\x2d\x13\x94\xbc\xde\x4e\xad\x52\x8a\x9d\x9d\x31\xf3\x69\x41\xdd\xd8\x56\x64\xd4\x22\xe5\xfb\xf4\x35\xdd\xa5\xcd\x0f\xa9\x14\xcb\xb3\x25\x47\x87\x47\xdb\x15\xc9\x9b\x9a\xe1\xad\xcb\x1c\xad\xf5\x66\xbc\xff\x29\x6e\x08\x21\x04\x51\x14\xb1\x5a\xad\x90\x52\x22\x84\xb8\xb6\xf2\xc5\x36\xde\xff\x5a\xdf\xd6\xb6\x81\xd6\x92\xc1\x28\x65\x30\x4c\x88\x22\x85\x0e\xd4\x66\x89\xbf\xaa\x6a\xa4\x0c\x37\xce\x74\x6f\x2e\x41\x2d\xf3\xf9\x02\x87\x68\xfd\x87\xcf\x89\xcc\xef\x93\xe1\xd5\x65\xd3\x8a\x56\x39\xa2\x30\xf6\x3e\xb5\x67\x67\x5b\x3e\xde\x4d\x05\xbc\xe5\x72\xd9\x52\x4e\x24\xab\xd5\xea\x93\xb1\xf0\xd7\x3f\x73\x7d\x30\x54\xab\x82\xbc\x8d\x8f\x17\x9d\x4e\xe7\xd6\x93\xbc\xc3\x48\x6f\x80\x6f\x38\x4e\x49\x3b\x21\x52\x4a\xf2\x2c\x67\x36\x9f\x5d\x69\x2e\x75\x31\x8c\x31\x1c\x1f\x9f\xb4\x65\xac\xf7\x5e\xa9\xca\x86\xa6\x79\xf7\x61\xc3\xda\x48\x8a\x0b\xfe\x1a\x3b\x3b\x3b\x74\xbb\xdd\xad\x5c\xd4\x4d\x06\x3c\x29\x25\x4a\x29\xb2\x2c\xfb\xa4\x2b\x5e\x17\x6f\xbe\x75\x96\xb7\x8d\x8f\x97\xdd\xe5\x79\xfe\xc1\xc8\xb6\xbf\x75\x28\xe5\x95\x4b\x84\x10\x20\x20\x49\x52\x26\x67\x33\x8a\xa2\x7c\xeb\xe7\xb0\xf1\x63\x69\x1f\xbc\x55\x65\x36\x9a\x77\xef\x92\xdd\x15\x45\x7d\x01\xec\xfc\x79\xee\xf7\xbd\xc8\x86\x31\x66\x5b\xb1\xdc\x44\xc0\xab\xeb\x1a\xad\xbd\x13\xfb\x6c\x36\xfb\xe4\x19\xc0\xc5\x32\xfa\x73\xb9\x19\x6f\x62\xcc\x66\x33\xe6\xf3\xf9\xe7\x0a\xe7\x04\x3a\x20\x0a\x3b\x1c\x1d\x9e\xbc\xb5\x52\x30\xc6\x6e\x28\x2f\xeb\x67\x6c\x55\x36\x1b\x21\x8c\xeb\x3e\x40\x8a\xbc\xe6\xe2\xc0\x3b\x8a\x02\xba\xdd\x74\xa3\x24\x3d\x9b\xcd\xb6\x07\xef\xa6\x01\x5e\xd3\x34\xe7\x5e\xaf\xad\xb1\xcb\x36\xd3\xfa\xfc\xe2\xc5\x8b\x17\xf4\xfb\xfd\xcf\xf2\xda\x4a\x29\x68\x4c\xc3\x70\x30\x26\xcb\x2a\xbf\x0d\xf4\xc6\x76\x8a\xff\x8f\x10\x5e\xbd\x5b\x6b\xb9\x31\xe4\xbe\x76\xa2\x50\x99\x4d\x59\xec\xad\x24\x21\xed\x84\x08\x29\x08\x82\x80\x38\x8e\x39\x3d\x3d\x7d\x27\x81\xd2\x2d\xe0\x7d\x82\x08\x82\x60\x93\x65\xc5\x71\x8c\x31\xe6\x93\x65\x5a\x17\x49\xb0\xd7\x15\x71\xdc\xc6\xbb\x87\x31\x06\x63\xcc\x67\x3b\x01\x8f\xe3\x08\xad\x35\x45\x59\x10\x86\x21\xcf\x9f\xbf\xe0\x97\xa7\x4f\x99\x4d\xa7\xbc\xcc\x03\xb6\xd6\x1b\x49\x6d\xca\xd0\xc0\x6b\xdd\x59\x6b\xa9\xaa\x06\x63\xec\xb5\xce\x6d\x59\xd6\x17\xce\xab\x27\x4c\xaf\x65\xdf\x01\xf6\xf6\x3c\x33\xe1\xf8\xf8\x78\x5b\xb9\x5c\x08\xfd\xdb\x1f\x96\x98\xa2\x28\x36\x2e\xf4\xd7\x59\xc4\xfe\x50\x61\xad\xdd\xf4\x0c\x3f\xe5\xcf\xfd\xd2\x62\x3e\x9f\x93\xa6\xe9\xad\x7b\xa0\x58\xeb\x28\xca\x82\x3c\xcb\x18\x8f\xc7\xaf\xbc\x7e\x6b\x2d\x59\x96\x31\x99\xcc\x58\xcc\x16\x6d\x85\xe2\xa8\xca\x8a\x62\xb9\x60\x7a\x36\xe1\xe0\xbe\x64\xbc\x73\x4e\x04\x36\xd6\x9b\x69\xfb\xef\xe5\x90\xc2\xfb\xb1\x14\x79\x4d\x91\x37\x84\x61\x73\x2d\x22\xb2\x69\xce\x41\x4c\x0a\xf1\x8a\xa7\xad\xd6\x9a\x07\x0f\x1e\xf0\xfc\xf9\x73\x96\xcb\xe5\x96\x54\x7f\x53\x00\xaf\xdf\xef\xb3\x5a\xad\x68\x9a\x86\xe1\x70\xc8\x6c\x36\xfb\x24\xd9\xd6\xda\x23\x34\x8e\x63\xcf\x85\xba\x00\x7e\xdb\xf8\xb0\x31\x9d\x4e\xe9\x76\xbb\x37\x7a\xc3\xc2\x58\x4b\x59\x56\x94\x79\x4e\x5e\xe4\x34\x75\x4d\xd3\x54\x08\xbc\xcd\x67\x5d\x15\x8c\x77\xf7\x29\x8b\x82\xc5\xc2\x9b\x44\xd9\xa6\xc6\x59\x43\x51\x54\x98\xc6\xb6\xde\xb3\x02\xdd\x0e\x33\x9a\xba\xe6\xe4\xf4\x88\xd1\xb8\x7f\xae\xc6\xd2\xfa\x6f\x6c\x40\xd3\x79\xa3\xa0\x24\x0d\x99\x4f\x73\x2f\x56\xf0\x96\xf3\x6f\xad\xbb\x90\x09\x3a\x82\x50\x5d\xf2\xb9\x58\x47\xb7\xdb\x25\x8a\x22\x16\x8b\x05\x9d\x4e\xe7\x56\x6c\xb8\x7c\xf6\x80\xb7\x2e\x73\x9a\xa6\x21\x8e\xe3\x6b\x2b\x4f\x7c\x88\xec\x2e\xcf\x73\x7a\xbd\x1e\x4d\xd3\x50\xd7\xf5\xf6\x29\xf8\x91\x22\xcb\x32\x76\x77\x77\x6f\xec\xeb\xfb\xf9\xe7\xc7\x08\xe7\x4d\x70\x64\xab\x8f\x27\x05\x84\xd2\xf7\xe7\xa4\x94\xcc\xa7\x67\x9c\x9c\x9c\x12\x69\x85\x73\x16\xd1\x82\x96\x94\x02\xad\x14\xa2\xed\x0e\x89\xf6\xcf\x9c\x10\x68\x21\x58\x2e\xe6\x18\xf3\x92\xb9\x8e\xbb\xf4\xe4\x05\x20\xed\x04\x68\x2d\x51\x5a\xbe\x55\xab\x6a\x0d\x78\x1e\x14\x05\x71\xfa\xfa\x8c\x70\x7f\x7f\x9f\x9f\x7e\xfa\x69\x63\x91\xf0\xa5\xc7\x8d\x80\xfc\x4e\xa7\x83\xb5\x76\xe3\xf3\xf9\xa9\xf8\x70\xf3\xf9\x9c\x7e\xbf\x4f\x55\x55\x94\x65\x79\x0b\x7c\x52\x6f\x5f\xac\xaf\xe5\x4d\x52\x39\x7e\x39\xca\x6c\x89\xb0\x0d\x51\x20\x89\x02\x45\x14\x68\xc2\x20\x20\x0c\x83\x0d\x83\x20\x8e\x02\x9a\xaa\x44\x2b\xd0\xca\xfb\xd5\xea\x40\xa3\xb4\xda\x38\x99\x49\x21\x69\x8c\x65\x91\x65\x4c\xda\x4a\xc5\xd4\x35\x45\xbb\xb2\xe8\x9c\x23\xcb\x32\xd4\xa5\x4c\xab\xfd\xb7\xd2\x1b\x6b\x07\x81\xe2\x6d\x9f\xd2\xda\x8c\xdb\x5a\x4b\xb7\x17\x11\x45\xfa\x8d\x2d\xa3\xc1\x60\xc0\xc9\xc9\xc9\x96\xa6\x72\x53\x00\xcf\x2f\x5f\x7b\x1e\x52\x92\x24\x4c\x26\x93\x8f\x5e\xce\x3e\x7d\xfa\x74\x03\x70\x79\x9e\x7f\x58\x17\xad\x6d\x6c\x62\xb9\x5c\x12\x86\xe1\x8d\x21\x1c\xbb\x16\x84\xab\xaa\xde\x48\x92\xcd\x16\x4b\x4e\xa7\xf3\x4b\x0c\x81\xb5\xb2\xcb\xba\xda\xd0\x4a\xa3\xb5\xc2\x18\x2f\x6f\x25\x84\xf0\xc0\xe4\x7c\x36\x68\xad\x65\x95\x67\x58\xd1\xb0\xb7\xdb\x67\x3c\x18\x30\x5b\x2e\x51\x4a\x32\xdb\x38\xee\xb5\x16\x8d\x5a\x9f\x83\x9a\x78\xf7\x6a\x46\x29\xc9\xce\x7e\x97\xdd\xfd\x1e\x49\xfa\x76\x1f\x96\x83\x83\x03\x8a\xa2\x78\xab\x84\xd5\xb6\xa4\xfd\x44\xd1\xeb\xf5\xf8\xf1\xc7\x1f\xe9\xf5\x7a\xec\xed\xed\xf1\xb7\xbf\xfd\x8d\x6e\xb7\xfb\xd1\x32\xae\xd9\x6c\x46\x96\x65\xfc\xf1\x8f\x7f\xa4\x28\x0a\xe6\xf3\x39\x5f\x7f\xfd\xf5\x16\x9d\x3e\x12\xe0\xad\xcd\x6e\x3e\x56\x9c\x9d\x9d\x51\x16\x05\x49\x92\x30\x1c\x8d\x36\x7f\x5e\x37\x0d\xd9\x2a\xa3\x28\x0a\xaa\xaa\xc4\x34\x0d\xd6\x1a\xac\xa9\x7d\xeb\xc4\xfa\xd2\x74\xd4\x4b\x59\xe5\xa5\xd7\xea\xbb\xe2\x75\x3a\xe7\x50\x4a\x11\x85\x01\x65\xe5\xa7\xa3\x8b\x55\xbe\xd1\xf6\x4b\xe2\x88\xc1\x20\x45\xeb\xde\xa6\x7f\xd7\x4d\x05\x79\x95\xa3\xa5\xe0\xf8\xe8\x88\xfb\xf7\xef\xbf\x92\xd5\xe1\x2e\xfc\x7a\x87\xe4\x77\xed\xaf\xf1\x2e\xf1\xed\xb7\xdf\x30\x9b\xcd\x68\x9a\xce\x17\xdd\xab\xbe\x11\xef\x5c\x29\xc5\x68\x34\xe2\x5f\xff\xfa\x17\xdf\x7d\xf7\x1d\xdf\x7f\xff\x3d\xff\xf3\x3f\xff\xc3\x7f\xfe\xe7\x7f\x7e\x94\x12\xeb\xc5\x8b\x17\xfc\xfe\xf7\xbf\xa7\x69\x1a\x8e\x8f\x8f\x19\x8f\xc7\xdb\x86\xee\x47\x8a\xa2\x28\xde\xf9\xf3\x9d\x4c\xa6\x3c\x7f\xf6\x9c\xba\xaa\x31\xce\xd0\xeb\x77\xbd\xc7\x49\x7c\x75\x16\xfe\xec\xe9\x13\xfa\x9d\x88\x93\xd3\x63\x96\x59\xce\xd9\xe9\x09\x75\x55\x22\x70\xc4\x51\x48\x14\x78\x8b\x43\x9f\xac\x39\x24\x10\x6a\x09\x4e\x82\xf0\x53\x4e\xad\x14\x8b\x65\xc6\x78\xd8\xdf\x78\x54\x08\x21\xbc\x34\xbb\x94\x64\x65\xc5\x6c\x99\x31\x9b\x2f\xe9\x76\x12\xc6\x83\x1e\xdd\x4e\x8a\x90\x02\xdc\x5a\xd8\x54\x6e\x08\xec\x42\xc0\xc1\xde\x98\x67\x87\x27\x64\xf9\xe2\x8d\x19\xe7\x3b\xe2\xdd\xfb\x9d\xfb\x46\x22\x5d\x8a\xb3\x5f\x36\xf5\xea\xc6\x40\xfd\xde\xde\x1e\xd3\xe9\x94\xd9\x6c\xc6\x78\x3c\xe6\xab\xaf\xbe\xe2\xc7\x1f\x7f\xe4\x77\xbf\xfb\xdd\x07\xfb\x19\xd6\x5a\x7e\xfa\xe9\x27\xf6\xf7\xf7\xd1\x5a\xb3\x58\x2c\xbc\xca\xed\x15\xda\x7b\xdb\xf8\x30\x51\x55\xd5\x46\x7e\xeb\x6d\xd7\xa6\x58\x56\xac\xa6\x05\x4d\x63\xd8\xeb\xdd\x43\x49\xe9\xfb\x5e\x45\xc6\x8f\xff\xf7\x27\x92\x5e\xc4\x78\x67\x84\x90\x82\xba\xac\xa8\xca\x92\xb2\x2c\xc8\xf2\x8c\x6e\x12\xd0\x94\x25\xa7\x87\x4f\xe9\xa5\x29\x2a\xed\xb6\xe0\x63\xb1\x96\x0d\xd8\x5d\x7a\x15\xe2\x3c\x83\x8b\xc2\x80\xe9\x72\xc5\xfe\xce\x88\xda\x39\xaa\xba\xa1\xaa\x6a\xca\xaa\xa6\x28\x2b\xac\xb3\xf4\x3b\x09\x0f\xee\xee\x12\x06\xfa\x5c\x64\x16\x90\x4a\x52\x94\x25\x01\x1a\xdd\x96\xee\xce\x39\xc2\x40\x13\x86\x01\x79\x5e\x70\x36\x99\x30\x1a\x0e\x01\x81\xb5\xd6\xcf\x2d\x3e\x11\xf6\x58\xeb\x55\x91\xab\xba\xa1\x2c\x6b\xef\xcd\xf1\x85\x72\x4e\x6f\x54\x6e\xfb\xbb\xdf\xfd\x8e\x7f\xfd\xeb\x5f\xf4\xfb\x7d\x06\x83\x01\xc6\x18\xfe\xfe\xf7\xbf\xf3\x87\x3f\xfc\xe1\x83\x64\x76\xff\xf5\x5f\xff\xc5\x77\xdf\x7d\x47\xbf\xdf\xa7\x69\x1a\x26\x93\x09\xf7\xee\xdd\xdb\xa2\xd2\xc7\x3c\x60\x6d\xd3\xff\xb5\x19\x8e\x73\x2c\x96\x0b\xa6\xc7\x0b\x12\xdd\xf1\xe5\xa3\x56\x38\x1c\x4d\xbb\x88\x1f\xc7\x09\x49\x9c\x50\x37\x35\x87\xbf\x9c\x52\xd5\x33\x92\x28\x40\x08\xd0\x4a\x79\x00\x32\x96\x30\xf4\xfd\x2c\xa5\xd5\xb5\x9d\xc4\xda\x54\x8e\x30\xd4\x28\x21\x39\x3e\x9d\x32\x5f\xae\x48\xe2\x88\x34\x89\xe8\x76\x12\x86\x83\x2e\xaa\xed\xef\x59\x6b\xa9\x1b\xf3\xca\x7b\x10\x52\xbc\xe2\x7c\x66\xac\x65\x34\xec\x93\x65\x39\x2f\x5e\xbc\x60\x3c\x1a\x6d\x40\xf8\x1c\x73\x05\x1f\x1b\x7b\x8a\xbc\xc6\x5a\xc8\xf3\x15\x61\xe8\xe5\xae\xbe\x54\x8e\xfd\x8d\x02\xbc\xb5\x8c\xfb\xdf\xfe\xf6\x37\xfe\xf4\xa7\x3f\x31\x1e\x8f\xd1\x5a\xf3\xdf\xff\xfd\xdf\xfc\xe1\x0f\x7f\xb8\x24\xf9\xbe\x06\xb1\xf5\x42\x7a\x92\x24\xaf\xed\x4d\xac\x56\x2b\xfe\xf1\x8f\x7f\xf0\x1f\xff\xf1\x1f\x44\x51\x84\x31\x86\xc7\x8f\x1f\x73\xf7\xee\xdd\x2d\xf7\xee\x03\xc4\x45\x1a\x91\x73\x60\xad\x57\x08\x3e\x3b\x3b\xa3\x28\x0a\x96\x8b\x25\xcb\xe5\x8a\xe5\x72\x81\x35\x86\x2c\xcf\x10\xce\x91\x17\x05\xce\x5a\x9c\x0d\xf8\xf6\xe1\xef\x3d\x10\x5c\x71\x27\x3a\xe7\x99\x6b\x4a\x69\xf6\x47\x77\x79\x76\xdc\xd0\x98\x82\x24\x0e\x01\x41\xa8\x35\x8d\x31\x44\x5a\x31\xcf\x0a\x7a\x9d\xf8\xda\x40\x07\xd0\x54\x0d\xd3\x7c\xb9\xc9\xba\xbe\x7a\x70\x17\x29\x04\x8d\xb1\xac\x39\x24\x8d\x31\xd4\x4d\x43\x1a\xc7\x57\x82\xa9\x92\x92\xa2\x28\xd1\x9d\xa4\xe5\xe3\xf9\x7f\x17\x05\x1a\x29\x15\xab\xa5\x77\xc7\x4b\xd3\xce\x65\x16\x82\xf8\xb8\xa9\x9e\x73\x50\xd7\x0d\x0e\xb0\xce\x50\x37\xf5\x27\x2a\xa2\xb7\x80\x77\xad\xe8\xf5\x7a\xdc\xbf\x7f\x9f\x7f\xfe\xf3\x9f\x7c\xf3\xcd\x37\xf4\xfb\x7d\xfe\xf8\xc7\x3f\xf2\xf4\xe9\x53\x7a\xbd\x1e\x83\xc1\xc0\xaf\xf1\xb4\xc3\x86\x30\x0c\xa9\xeb\x9a\xe3\xe3\x63\xf6\xf6\xf6\xe8\xf5\x7a\x9b\x03\x99\xe7\x39\x87\x87\x87\x58\x6b\xf9\xcf\xff\xfc\xcf\x8d\x04\x54\x9e\xe7\x7c\xf5\xd5\x57\x5b\xb0\xfb\x40\x6d\x82\xd9\xe4\x94\xd3\x93\x63\x84\x90\x9e\xd3\xd8\x34\xe0\x7c\x1d\xa9\x94\xe4\xe8\xf9\xcf\x48\x21\x10\x52\xa0\xa4\x24\x0d\x24\x42\x48\xd2\x30\x65\xba\xa8\xf9\xe6\xe1\x1f\x68\x6c\x7d\xad\x9b\xb0\x31\x35\x0f\xef\x7c\xc5\xbf\x7e\xf9\x27\xce\x95\x24\x49\x84\x56\x9e\x0e\x12\xc4\x21\x75\xd3\x5c\xcb\x58\xdb\x3a\x47\x55\xd5\xd4\xb5\x41\x48\xe9\x1f\x98\x4a\xd0\xef\x77\xfd\xfa\xd7\x4b\x95\xaf\x14\x02\x6b\x2c\x45\x59\x11\x47\xe1\x25\xd0\x73\xce\x91\x44\x21\xa7\x93\x05\x71\x1c\x11\x5e\xc8\x68\xa5\x10\xf4\x7b\x1d\xce\x66\xab\xb6\x97\x66\x2e\xad\x84\xad\x7d\x71\x3f\x56\xd4\x55\x43\x53\x5b\x70\xa2\x75\x5f\xfb\xb2\x25\xd0\x6e\xe4\x1d\x3f\x1a\x8d\x08\x82\x80\x1f\x7e\xf8\x81\xfb\xf7\xef\xd3\xed\x76\xf9\xfa\xeb\xaf\x59\x2c\x16\xac\x56\x2b\xb4\xd6\x9c\x9d\x9d\xb1\xb3\xb3\xd3\xba\x41\xa5\x84\x61\xc8\x72\xb9\xa4\x69\x1a\xf2\x3c\xdf\xd0\x21\xf6\xf7\xf7\xe9\x76\xfd\x21\x2e\x8a\x82\xa3\x23\xaf\x06\xbb\x05\xbb\x0f\x96\x43\x60\x9a\x06\xd7\x54\x28\xad\x09\xb5\x20\x0a\xc2\x73\xa4\x70\x17\xbf\x92\x4b\x8e\xd7\x93\x45\xc6\xc1\xee\xb7\x18\x7b\x3d\x90\x5a\x7f\xd3\xaa\xa9\xb8\xb7\xf7\x80\x9f\x9e\xfd\x9d\x38\x0a\xbc\x3d\x61\xdd\xa0\xa4\x44\x0a\xe1\x57\xb7\xde\x94\x8d\xb6\xc3\x88\x30\x0c\x68\x0c\x34\xb5\x01\x67\x31\x46\x72\x36\x99\xb3\x33\xea\x5d\x92\x5d\x77\x6d\xf5\x11\x84\x01\xb3\xc5\x8a\x28\x0c\xae\x00\x50\x18\x0f\x7b\x4c\x67\x4b\x76\x77\x06\xc8\x76\x78\x61\x9d\x65\x67\xdc\xe7\x74\xba\xa0\xae\x6b\xda\x1f\x7f\x0e\xa4\x1f\x79\x58\x56\x14\x35\x8d\xb1\x68\xe9\xf9\x7d\x6b\x1f\x8e\x2d\xe0\xdd\xb0\xe8\x76\xbb\xfc\xf1\x8f\x7f\xe4\x6f\x7f\xfb\x1b\x51\x14\xf1\xf0\xe1\x43\xba\xdd\x2e\x75\x5d\x73\x72\x72\xc2\xce\xce\x0e\xab\xd5\x8a\xa3\xa3\x23\x3f\x49\xbb\xd0\x2b\xea\xf5\x7a\x1b\x8b\x47\xbf\x94\x5d\x71\x7a\x7a\x4a\x9e\xe7\x7c\xfd\xf5\xd7\xdb\x89\xec\x07\x0c\x21\x04\x16\xa8\x1b\x4b\x10\xca\x4b\xdc\xb5\x37\x59\x2b\x58\x6b\x89\x74\x87\x30\x88\x30\xf6\xdd\xb2\x0e\xe7\x1c\x5a\x05\x84\x41\x4a\xdd\x18\x02\xad\x37\xab\x56\x41\xa0\xa9\xeb\xc6\xf7\xf3\x2e\xa2\x68\x9b\xa1\x35\xc6\x12\xc7\x21\xcb\x55\x49\x5d\x1b\xe2\x28\x26\x8d\x74\xfb\x9a\x1d\x08\x5e\xeb\x31\x11\x28\x45\x1a\x85\xac\xb2\x9c\x6e\x27\xbd\x94\xe5\x59\x6b\xe9\xa5\x09\xd3\xd9\xd2\xff\xfc\x40\x6f\x4a\x4a\x85\x60\x7f\x67\xc0\x62\xb9\x24\x89\x3b\xde\x5e\xb4\x2d\xd3\x3f\x66\x76\x57\x95\x0d\x75\x69\xce\xaf\x83\xbc\xd9\x04\xf0\x2f\x1a\xf0\xd6\x37\xd3\x1f\xff\xf8\x47\x8e\x8e\x8e\xf8\xe9\xa7\x9f\x18\x8f\xc7\xbe\x69\x5c\xd7\x44\x51\x44\x96\x65\xec\xed\xed\xb1\xbf\xbf\x7f\xe9\x66\xa8\xeb\x9a\x3c\xcf\x37\x2b\x63\x8b\xc5\x82\x34\x4d\xf9\xf6\xdb\x6f\xb7\x08\xf5\xc1\xaf\x91\x44\xe9\x10\xde\xf1\x21\x62\x2d\x44\x61\x17\xeb\xde\xcf\x56\xd0\x5a\x43\xbf\x37\x64\x3a\x3f\x64\x6f\x14\x7a\x7b\x42\x07\x51\xe0\x5d\xea\xa2\x30\xb8\x84\xb7\x02\x6f\xc2\xae\xa4\xc2\x18\x88\x82\x98\x38\x5c\x4f\x71\xed\xa5\xa6\x57\x51\x36\x24\x71\xf0\x52\x6f\xd2\xef\xb7\x2a\xad\x29\x8a\xf2\xca\xf5\xc7\xaa\x69\x18\xf4\x3b\x14\x65\xb5\x01\xbc\x75\xff\xaf\xd7\x49\x59\xcc\x67\xa4\xfb\x1d\x8c\x69\x36\xd4\x95\x8f\x85\x3d\x5e\x70\xb5\x6a\xfb\x90\x3e\xb3\x73\xae\xde\x6c\x85\x6c\x01\xef\x06\xc7\xfe\xfe\x3e\xbb\xbb\xbb\x2c\x16\x0b\xa6\xd3\x29\xab\xd5\x8a\xc7\x8f\x1f\x6f\x80\x6f\x3e\x9f\x6f\x56\xd2\x3c\xc9\xb4\xc2\x18\x43\x9a\xa6\xf4\x7a\x3d\x1e\x3e\x7c\x78\xeb\xa5\xc5\x6f\xfa\x83\xe9\x5d\x42\x0a\x41\xd5\x18\x3a\x71\xf0\xab\x7c\xa3\xc3\x20\x44\x47\x31\x55\x4b\x06\x36\xd6\x12\x68\x45\x59\xb5\x4a\xc0\x2f\x81\x92\x96\x92\xbc\xac\x09\xb4\xe7\xdd\xad\x33\xb9\xcb\x95\xb7\x20\xcf\x2b\xa2\x50\x5d\x1a\x3e\x6c\xbe\x87\xf2\x65\x73\x59\xad\x7b\x79\x97\x41\x31\x8e\x42\xe6\xcb\xec\x0a\x01\x00\x47\x9e\x2d\x91\x4a\xa0\x75\x78\xe1\xfb\x7e\x1c\x00\x2a\xf2\x66\x63\x2f\xe9\x9c\x23\x0c\x25\xc6\x36\x04\x81\xde\x66\x78\xb7\x21\xa4\x94\xf4\xfb\x7d\xa4\x94\xec\xee\xee\x52\x55\x15\x87\x87\x87\x1b\xd7\xf7\x2c\xcb\x08\x82\x80\xe1\x70\xc8\xce\xce\x0e\xfd\xfe\xd6\x9b\xf3\x93\x1d\x22\xad\x5a\xd7\xad\xeb\xa1\x97\x90\x92\xaa\x6e\x18\x74\x34\xbf\x06\xf1\x9c\xb5\xec\xee\xec\x72\xf2\xfc\x09\x5a\x69\xca\xba\x26\x0e\x83\x36\x6b\x74\xaf\x64\x3c\x4a\x2b\xa8\x6a\x8c\x31\xbe\xa7\x25\xce\x33\xb0\x35\x40\x49\x21\x50\x4a\xb2\xca\x0a\xfa\xbd\xce\x2b\x59\x9e\x56\x1e\x08\x8b\xa2\x22\x8e\xa2\x57\x7e\x8e\x90\xa2\x05\xc4\x9a\x38\x8e\x36\xa0\x2b\x84\x00\x6b\x39\x3d\x3d\x23\x0c\x7d\x46\xaa\x94\xfc\x28\x19\x97\x31\x96\xaa\xac\xfd\x90\x42\x40\x14\x85\xcc\x16\x13\x92\x24\x26\x08\xc2\x2f\xfb\xac\xde\xa6\x17\x7b\xb1\x9c\x4d\xd3\x94\xe1\x70\xc8\xee\xee\x2e\x5a\x6b\x66\xb3\x19\x51\x14\x11\x45\x11\xd3\xe9\x94\xa6\x69\xb6\x83\x89\x4f\x97\xe3\x79\x60\x78\x47\xec\xfa\x75\x99\x86\xc0\x36\x96\x5e\xda\xa3\xac\x6b\x06\x49\x42\x5e\x94\x24\x51\xe4\xb3\x9a\x2b\xfa\x70\x7e\xa3\x42\x82\xf3\x13\x63\x63\x2c\x55\x5d\x23\x24\x28\x25\x50\x52\x10\x86\x5e\xc0\x62\x95\xad\x27\xc0\xea\x12\xe8\x09\x01\x41\xe0\xb3\xc8\xaa\x6e\x08\xb5\xc2\x5e\x04\x45\xeb\xe8\x77\x53\x4e\xa7\x0b\x0e\xe2\x68\xf3\x91\x08\x21\xe8\x77\x53\x9e\x3c\x79\xcc\xce\xe8\x6e\xab\xb8\xf2\xe1\x01\xcf\x39\x47\x91\xd5\x94\x45\x03\x02\x9c\x13\x08\x09\x27\x27\xc7\x3c\x7a\xf4\x80\x20\x08\xbe\xe8\x93\x7a\xeb\xba\xf7\xeb\xfd\xc5\xa6\x69\x2e\x49\x49\x19\x63\x36\x02\x04\xf3\xf9\x7c\x2b\xe8\xf9\xe9\xeb\xda\x77\xc2\x3b\x01\x97\x08\xb8\xef\x71\x6b\x83\xa4\xb5\xf7\xb4\x84\xa1\xa6\xaa\x9b\x56\xda\x49\x62\x8c\x7b\xa5\x41\x66\x9d\x43\x09\x89\xd2\x20\x94\x43\x48\x4b\x10\x0a\x86\xfd\x84\xf1\xb0\xcb\xa0\x9f\x92\x26\x51\x2b\x59\x26\x98\x2d\x56\xaf\xec\xd6\xae\xb7\x32\xbc\x21\x77\xf1\xca\x00\xcc\x73\x42\xfd\x20\xa6\xae\x9b\x4b\x62\x04\x41\xa0\x49\x23\xcd\x2a\xcb\x5a\xc2\xb1\x40\xaa\x0f\x0b\x78\x65\xd1\xb0\x5a\x55\xac\x8b\xf5\x40\x2b\x4e\x4f\x8e\xe9\x74\x3a\xa4\xe9\x56\x0d\xe8\x56\x01\xde\x5a\xa8\x73\x5d\x7e\xac\x7f\x01\x84\x61\xb8\x71\xb5\x5f\x7f\xcd\x36\x3e\x5d\xbb\x41\x4a\x79\x65\x56\xf5\xba\xeb\xa8\xb5\xa2\x31\xcd\x7b\xf7\xb0\xbc\x3c\xba\xa2\x69\x6a\xef\x20\x26\x25\xb6\x9d\x10\x2b\x29\x69\x4c\x73\x41\x91\x44\x6c\xd4\x4d\x74\xa0\x68\x1a\x43\x55\x95\x74\x3a\x11\xbb\xe3\x3e\x61\x18\x6c\xa6\xcb\xd6\x3a\x82\xd6\x91\xcc\x0f\x30\xaa\x4b\x99\xa8\x73\x1e\x50\xd7\x00\x5b\x37\xcd\x15\x4a\xc8\x8e\xf1\xb0\xc7\x64\xbe\x7c\xe9\xdf\x3a\x76\x46\x03\x94\x6c\xa9\x31\x4a\xa0\x3f\x20\x4d\xc4\x34\x96\x3c\xab\x2e\xdc\x1f\x50\x37\x35\xcb\xd5\x9c\xf1\x78\xf4\xc5\x67\x77\xb7\x0e\xf0\xd6\xf4\x93\xf5\xef\x9d\x73\x9b\x4c\x2e\x49\x92\x8d\x98\xe8\xd6\x08\xe8\x13\x5f\x17\x29\x91\xaa\x2d\xed\xc4\xf5\xcc\xa8\x43\xad\xa8\xeb\xf2\x3d\xf1\xce\xdf\xd0\x3a\x50\x1c\x1f\x9f\xd0\xed\xa4\x80\x97\x4d\xf2\xf2\x4d\x2d\x11\x59\x6b\x2f\xc6\xd9\x52\x93\x8a\xd2\x2b\xa6\x18\x63\xda\x5d\x58\x47\xd3\x98\x57\x36\x27\x1c\xd0\xed\x24\x7e\x9f\xf6\x0a\x43\x6b\xeb\x1c\x9d\x24\x46\x08\x58\xe5\xc5\x95\xd2\xef\xbd\x34\xa5\x28\xfd\xf0\xec\x62\x96\x27\xa5\xa0\xd7\x89\xb0\xd6\x93\xb2\x3f\x64\x32\x90\xe7\x35\x55\x65\x36\xf2\xf1\x4a\x2a\xe6\xf3\x09\xfd\x7e\x67\xab\xf5\x78\x5b\x01\x4f\x29\xbf\x27\x19\x86\x21\x83\xc1\x60\x33\x7d\xcd\xb2\x8c\xb2\x15\x5a\xfc\x94\x46\x40\xdb\x00\x25\x15\x4a\x79\x23\x9a\xeb\xe2\x97\x52\x92\xac\x5c\xa0\xa4\x82\x77\xbc\x56\x4a\x2a\xaa\xba\x44\x68\xc7\x62\x3e\xa5\xd7\x4d\x7d\xa9\xd9\xee\xd4\x06\x5a\x91\xe5\x05\x93\xd9\x9c\xd3\xe9\x92\x45\x56\x63\x45\x42\x18\x0d\xd1\xe1\x80\x28\x1e\xa2\xa4\x26\xcb\xbc\xc4\xd3\xcb\x18\xbd\xde\x9c\x10\x08\x8a\xb2\xba\x12\x5c\x94\x94\x44\x61\x88\x35\xce\x53\x62\xae\x48\x41\x87\xbd\x0e\xf3\x45\x76\xc5\x39\xb6\x58\x57\xd0\xeb\xc5\x1f\xec\x1a\x14\x79\x4d\xb6\xaa\x36\xef\x45\x49\x6f\x2a\x64\x6c\xcd\x78\x67\x9b\xdd\xdd\xda\x1e\xde\x5a\x15\x59\x29\x45\x1c\xc7\x9b\x1e\x4a\x59\x96\x1b\x77\xac\x20\x08\xb6\xe4\xe2\x4f\x18\x5a\x7b\xf5\xdf\x66\x23\x3b\x7e\x9d\xeb\x28\x30\xb6\x64\xb6\x98\x12\xe8\xe0\xda\xa0\x27\x10\x34\x8d\x65\x9e\x4f\x09\xc2\x80\xba\xae\x98\x2f\x33\xa6\x8b\x8c\xaa\x76\xcc\x16\x4b\x56\x59\x41\xd5\x58\x3a\xbd\xbb\x8c\x46\xf7\x19\x8d\xef\xd3\xeb\xed\x93\xa4\x63\x92\x64\xc4\x68\x74\x97\xc6\x78\x1a\x8b\x75\x57\xff\x68\xeb\x2c\xa3\x61\x8f\xaa\x6e\x98\x2f\x56\x97\x78\x75\xeb\x2c\x2f\x8a\x42\x6f\xd1\x78\x69\x5d\xcc\x47\x63\x2d\xfd\x5e\x87\xa2\xaa\x5e\x79\xf8\x4a\xe9\x58\x2d\xcf\x68\x4c\x8d\xb5\x8e\xd5\xa2\xa4\x2a\xdf\xbf\xe7\x5c\x96\x0d\xcb\xc5\x9a\x1b\xe8\xcb\xf7\xba\xa9\x38\x3d\x3b\xa2\xdf\xef\xd2\xeb\x6d\x19\x0b\x9b\xb3\x7a\xdb\x32\x3c\x29\x25\xab\xd5\x8a\x5e\xaf\x47\x51\x14\x58\x6b\x19\x0c\x06\x48\x29\x51\x4a\x51\xb4\x42\x90\x5b\xde\xdd\x27\xcc\xf0\xb4\x46\xeb\xa0\xcd\x96\x2e\xec\x4d\xbd\x02\x55\xe7\xd7\x51\x08\xd8\x19\x76\x39\x9b\x1e\x11\x47\x09\xa1\x0e\x30\xee\x7a\x16\x85\x45\xbd\xe2\xd1\x37\xf7\x39\x3c\x3c\xe2\xe1\xc3\x6f\x30\x46\xb4\x3a\x6f\x8e\xd5\x6a\x81\x94\x9a\x4e\x2f\x20\x4d\x06\x48\xe5\x1b\xf7\x2a\x90\x68\x7d\xbe\x52\x56\xd4\x43\x26\xc7\x2f\xbc\xdf\x84\x0a\xaf\xec\x11\x06\x5a\x13\x04\x9a\xac\x28\xe9\x54\x35\xba\xed\x13\x5e\xec\x43\x5a\x6b\x71\xd6\x5d\x69\xe4\x2e\x84\x20\x0e\x43\xea\xc6\x10\x06\x6a\xe3\x41\xa1\x01\x63\x72\x9e\x3c\x79\xca\xde\xee\x01\xa6\xf1\xa6\xda\x2a\x90\xc4\x71\x40\x9c\xbc\x3d\x1b\xb3\xd6\x51\x16\x35\x75\x65\x68\x6a\xe3\x7b\x8b\x72\x5d\x3a\x5b\xca\x32\xa3\x93\x76\x19\x0e\xc7\xdb\xf6\xce\x6d\x06\x3c\xa5\xd4\x46\x9b\xdf\xf7\x2d\xf2\x4d\xba\xae\xb5\x66\xb5\x5a\x11\x86\xe1\x36\xc3\xfb\x94\xd7\x05\xbf\xb2\xe4\x80\xa6\x6e\xda\x12\xcf\x67\x1b\xeb\x06\xba\x1f\x28\xb0\xd9\x2f\x75\x0e\x1a\x63\x69\x6c\xc3\xe1\xd9\x33\xee\xed\x3e\x6c\xb3\x77\xc3\x15\x35\x26\x42\x4a\x70\xd0\xd8\x9a\xbb\x0f\xf6\x99\xcc\x4e\x28\x0b\x4b\x37\xe9\x12\xb4\x40\x26\x05\xf4\x7a\x7d\x82\x50\x12\x84\xda\x53\x50\xd6\x00\x2b\xd7\x32\x4c\xfe\x7f\xef\xdf\x3b\xe0\xec\xe4\x88\xd5\xaa\xd8\xf0\xe9\x5e\xce\xf4\xd6\xf2\x53\x69\x12\x33\x9d\x2d\xd8\xdb\x19\x82\xb9\x6c\x8f\x28\xa5\xa4\x36\x0d\x91\x0b\x5e\xe1\x3a\x5b\x6b\xe9\x74\x92\xb6\x47\x28\xc9\xf3\x9a\xb2\xac\xa9\x1a\x43\xb7\x3f\x42\xa9\x80\x7f\xfe\xf0\x0f\xee\xdd\x7d\x44\xa0\x03\xaa\xd2\x50\x95\x0d\xab\x65\x49\x9c\x84\xc4\x89\xef\x41\xae\x7b\x72\xd6\x7a\x03\xee\x22\xaf\xa9\xaa\xa6\xdd\x4d\x3e\x7f\x10\x78\x9d\x3d\x87\x40\x10\x47\x1d\x40\x6c\xef\x83\xdb\x0c\x78\xe0\x5d\xce\x3a\x9d\x0e\xcf\x9e\x3d\xe3\xde\xbd\x7b\x64\x59\xc6\x0f\x3f\xfc\xc0\xc1\xc1\x01\x41\x10\x90\x65\xd9\x86\xa0\xbc\x8d\x4f\x17\xbd\x5e\x0f\x77\xe7\x2e\xa7\x67\x13\x54\x10\xd2\x49\x3b\x9b\x8c\x4f\xb6\x5c\x36\xad\x03\xbf\x30\xaf\x24\x4a\xe9\x96\xaa\xe1\xaf\xd9\x8b\x67\x4f\xd9\x1d\xdc\xa5\x9b\x76\xa9\xeb\x6a\x93\xed\x49\x21\x08\x74\x48\x56\xe4\x2c\xf3\x39\xbb\x07\x23\x9e\x1f\x3e\xa5\xc8\x2d\xa3\xe1\x2e\x9d\x6e\x84\x0e\x54\xeb\x2e\x76\x0e\x6c\x6f\xcb\x6a\xa4\x94\x8c\x77\x76\x38\x7a\xf1\x9c\x61\x3b\xe8\xb8\x8a\x48\x18\x47\x21\xd3\xd9\xd2\x97\xb7\x55\x83\xd6\x72\x03\x6a\xce\x59\xba\x9d\x84\xa3\x93\x29\x81\xd6\xc4\x51\xb8\xa1\xda\xf8\x6c\x4b\x22\xad\x20\x2b\x2a\x8e\x4e\x67\x38\xe3\x50\x5a\xa3\xa4\x26\x8e\x7a\x74\x7b\x09\xa6\xb1\x3c\x7f\xf1\x94\x5e\xaf\xcf\xce\x68\xdf\x83\xa3\x83\x22\x6b\x30\x8d\x6f\x17\x28\xed\x55\x7e\x8a\xbc\xa4\x2a\x2b\xea\xa6\x26\x0c\xe3\x0d\x10\x7a\x85\x9a\xca\xdb\x63\x08\x08\xc3\x98\x38\x89\x08\x23\x79\xc9\x9c\x7b\x1b\x20\x9c\x73\x33\xe0\x56\x15\xf9\xc6\x18\xca\xb2\x24\x8e\xfd\x45\x9f\xcf\xe7\x24\x49\xc2\xe1\xe1\x21\xe0\x4d\x4b\xb6\xa4\xe3\xdf\xe0\xba\x34\x0d\x47\xc7\xc7\xc4\x71\xcc\x70\x38\xbc\x24\x95\xfe\x72\xa6\x7e\x29\x8d\x72\x8e\xc5\x62\xc1\x5f\xff\xfa\xbf\xc4\x2a\x61\x7f\xf7\x2e\xa1\xf6\xa4\xdd\xba\x2e\x99\x2e\x26\x44\xa9\xe6\xc1\xa3\x07\xfc\xeb\x5f\x3f\x12\xea\x2e\xdd\xce\x80\x34\x8d\xe8\x0d\xe2\x96\x76\xf2\xee\xaf\x77\x3a\x9d\xf2\xfc\x97\x27\x18\xd3\x70\x67\x67\x88\x7b\x29\xcb\x5b\x7f\xcf\x67\x87\x67\xf4\x7b\xa9\x77\x32\x0b\xf4\xa5\xd2\x55\x2b\xc5\x74\xb1\xc4\x39\x3f\xd9\x55\x52\x20\x85\xa4\x28\x1b\xce\xa6\x0b\x66\x8b\x05\x4a\x29\xfa\xdd\x1e\xa1\xf6\x5b\x20\x4d\x63\x88\x3b\x03\xbe\xfe\xfa\x11\x42\x3a\xf2\xac\xe4\xf1\xe3\x9f\xb1\xc6\xd2\xef\x8f\x30\xc6\x53\x5d\x8a\x22\x6f\xf9\x7c\xde\x6f\x23\x08\x02\xba\x9d\x01\x71\x9c\xa2\x94\xde\x64\xbf\xee\x82\xbc\x9d\x4f\x62\x2d\x9d\x6e\x48\xa7\x9b\x6e\x0f\xe5\xe5\x98\xdf\x4a\xc0\x5b\xa7\xf0\xab\xd5\x8a\xaa\xaa\xe8\xf7\xfb\x9c\x9c\x9c\x60\x8c\x61\x7f\x7f\x7f\x3b\x91\xfa\x8d\xa2\xaa\x2a\x66\xb3\x19\xdd\x6e\xf7\xbd\x5c\xe0\x8a\xa2\xe0\xf9\xf3\xe7\x1c\x1f\x1f\xb7\x04\xde\x14\xad\x15\x49\x92\x20\x85\x64\x3e\x5f\xd2\xe9\x0c\xe9\xa4\x7d\xa4\x84\xd1\x4e\xfa\xab\x78\x6c\x65\x59\xf1\xcb\x93\x9f\x98\xcd\x66\xdc\xd9\x1b\xa3\xae\xd8\x7a\x10\x42\x90\xe5\x15\x67\xd3\x19\xe3\x61\x8f\x5e\x27\xa5\xb9\x20\xe0\x29\x84\xd7\xf8\x2b\xeb\x1a\x63\x2c\x79\x51\xb2\xca\x4a\x9a\xc6\x73\x41\x7b\x9d\x2e\x61\x10\xb4\xe0\xbf\xde\xaf\xb5\x54\xc6\xf2\xa7\x3f\xff\xf9\xd2\x43\x7c\xb9\xcc\x59\x2d\x4b\xea\xba\x6e\x33\x3d\x8b\xd6\x01\x5a\x85\x28\xa5\x37\xfe\x19\x5e\x10\xd5\x53\x5c\xa2\x38\x20\x8e\x35\xab\x56\x3b\xe3\x3b\x00\x00\x20\x00\x49\x44\x41\x54\x55\x45\x55\x36\x68\xad\xc8\xb2\x05\x2a\xb0\x5b\x35\xef\x2b\x00\xef\xd6\xa6\x41\x6b\xd2\xf1\x5a\x1d\x25\x8e\x63\xd2\x34\xdd\x82\xdd\x6f\x0c\x78\xbe\x74\x7d\xbf\x63\x15\xc7\x31\x5f\x7f\xfd\x35\x8f\x1e\x3d\xa2\xaa\x2a\xbf\x4d\x63\x1a\xbf\x39\xd1\x48\x3a\xe9\x18\xa5\x24\x3a\x50\x24\x49\x88\xd6\xbf\xae\x6d\x11\x45\x21\x45\x6d\xe8\x75\x12\xf2\xa2\xa0\x9b\xbe\x0a\xd2\x8e\x76\x57\x58\x04\x14\x65\x83\xd6\x25\x51\x18\xb6\x9c\x3a\x49\x63\x0c\x67\x67\x53\x16\xab\x1c\x25\x34\x61\x18\x92\x44\x29\x3a\x95\xed\xfa\x59\x85\x56\x92\x20\x54\x84\x81\x42\x2a\x3f\xbc\x59\x66\x05\xbf\xfc\xf2\x8c\xfb\xf7\xef\xe1\xac\x23\x5b\xd5\x14\x99\x45\xcb\x10\x15\x85\x9c\xab\x12\xb7\x2a\x2d\x4a\x10\xc7\x01\x41\xa8\x36\xa9\x9c\x6a\x09\xd2\xd6\x5a\xce\xb1\xda\xf7\x4b\x31\xe7\xfd\xbc\x6d\xdc\xe2\x1e\xde\xcb\xa5\x2d\x70\xee\x13\xba\x9d\x46\xfd\xa6\xb1\x5c\x2e\x37\xb4\xa0\x5f\xf3\x20\x53\x4a\x7d\x32\x9f\xe0\x7b\x07\xf7\xf8\xe1\x9f\x7f\xa3\xdf\x49\x5e\x57\x4a\xf8\x1d\xdb\xc0\x2b\xa3\x78\x0d\x3d\xc8\xf2\x92\xe9\x7c\x89\xb5\x8e\x28\x08\x19\xf7\xc6\xac\x8a\x8c\x30\x08\x10\x08\xff\xe7\x61\x40\x9d\x57\xf4\xfa\xd1\x46\x10\x74\xfd\x1e\xa3\x50\x33\x9d\x9e\x71\xef\xde\x3d\x6f\x4a\x54\x67\x34\xa6\x66\x30\x18\xb6\xc3\x12\x89\xd4\x5e\x99\x45\xaa\xb7\x9d\xed\xf6\xef\x9d\x7f\xbd\xb2\xfd\xff\x9d\xf5\x5e\x1b\xdb\x38\x8f\xcf\xa2\xb3\xef\x9c\xa3\xaa\xaa\x77\x33\x6e\xd9\xc6\x07\xbf\x06\x75\x5d\xdf\xba\x61\xd1\x70\x38\x40\xe9\x90\xbc\x28\x5f\x2b\xfc\x29\xa5\x20\x89\x03\xb4\x54\x38\xa7\x98\x4c\x57\x4c\x66\x19\x69\xd4\x61\x34\x18\x12\x85\x31\x0e\x47\x18\x04\xcc\x97\x4b\x94\x82\x4e\x37\x24\xed\x68\x82\x40\x5e\x02\xbb\x75\xda\xa8\x94\x22\x09\x25\xc7\xc7\x47\xfe\x67\x28\x41\x18\xc9\xb6\xf7\x16\x11\x25\x01\x41\xa0\x50\xfa\x7a\x5b\x43\x42\x0a\x5c\x3b\x74\x71\x88\x76\x22\xbe\xbd\x1f\x3e\x2b\xc0\x5b\xab\x19\x6f\x81\xee\xb7\x8f\x3c\xcf\xa9\xeb\x6a\xb3\xde\x77\x9b\xe2\xfb\xdf\xff\x9e\xb2\x36\x64\x57\xac\x89\xad\x23\x08\x3c\x07\xcf\x34\x16\x9c\xa2\x97\x74\x01\x41\x55\x36\x18\x63\x11\xc2\xd1\xeb\xc6\xec\xed\xf4\xc8\xca\x8c\x3c\xcf\x39\x3a\x99\x32\xec\xf7\xae\x28\x93\xfd\xa6\x46\x1c\x45\x98\xc6\x53\xac\xc2\x30\xda\x88\x62\xbc\xd7\x03\xc7\xfa\xf2\xf5\xa2\xfd\xe3\x76\xd9\xe8\x33\x2b\x69\xd7\xa0\xb7\x5d\x23\xfb\xed\xa3\xaa\xbc\x15\x60\xd0\x96\x7e\x9b\xf2\x0d\x41\xfb\x7f\xbc\x6c\x0a\x7b\x53\x3a\x10\x69\xa7\xcb\x70\x34\xe0\x6c\x3a\x7b\x45\x03\xcf\x97\xa0\x3e\x03\x73\xce\x50\x37\x96\x38\x0c\x31\xad\x33\x5b\x10\x2a\xc2\x50\xb5\x9c\x3f\x47\x10\x44\x24\x71\x44\x55\x37\xf4\xfb\x9d\xcd\x90\xe1\x6a\x90\xb2\x14\x79\x4e\x59\xd5\x68\xed\xa7\xbf\xef\xd3\x12\xf0\xaf\x4f\xb6\x15\xed\x79\xdf\xce\x5a\xf3\x39\xdc\xe2\x5b\xc0\x7b\xb9\xe7\xb3\x8d\xdf\xfa\xa1\x03\x02\x4f\x9c\x3d\x7c\x3e\x61\x30\xf0\x0b\xfb\x42\xb2\xe1\xc6\x29\x29\x51\x4a\x6c\x04\x32\x85\x94\x48\x79\xbe\x75\x21\xa4\x38\xff\xfd\x27\xbe\xa6\x42\x40\xa7\xd3\xa7\xc8\x72\x16\xab\x8c\x5e\x9a\x5c\xd6\xb8\x73\x10\x28\x49\xbf\x97\x78\xcf\xda\x54\x63\xad\x24\x08\xd4\x86\x6c\x7c\x0e\x6a\x5e\x74\x33\x0c\xf4\x2b\xea\x31\xa2\x95\xd0\x72\xd6\x7a\xe1\x02\x0b\x9d\x6e\x9f\x28\x0c\x68\x9a\x66\xa3\xda\xfd\xbe\xef\xe1\x55\x1e\xe1\xf6\xde\xf8\xec\x00\x6f\x1b\x37\xa0\x2f\x22\x61\x30\x4c\x29\xab\x21\xab\x65\x81\x92\xd0\xb4\x3b\xaa\xc6\x58\x30\xd0\xd0\x9a\xc9\xbc\xc2\xc9\x6b\xbf\x87\x10\x48\x25\x09\x02\xbf\x25\xa1\x74\x0b\x90\x9f\x08\xfc\x46\xa3\x31\x27\x47\x87\xe4\x79\xf9\xda\x69\xad\xd2\x92\x38\xf2\x56\x90\x49\x1c\xb4\x99\xec\x55\xa9\x9b\x2f\x5b\x2f\xaa\xa4\xac\xab\x91\xc6\x58\x8c\x53\x44\x71\x07\xad\x9a\x8d\x1f\x8b\xd6\x9a\xba\xae\x29\xcb\xf2\xbd\x94\x4d\xce\x85\x44\x1d\x42\x48\x84\xd8\xae\x56\x5e\xf9\x39\x7d\x1e\x19\xc6\xfb\x3f\x19\xb7\xf1\xe1\xa2\xd3\xe9\x21\x50\x2c\x96\x0b\xef\xed\xda\xfe\x5a\x8b\x3a\x34\xa6\xc6\x34\x0d\xd6\x1a\x1c\x76\x8d\x0c\xd0\x36\xd9\x9b\xda\x92\xad\x6a\x26\x67\x39\xb3\xb3\x9c\xb2\x68\xb0\xd6\x61\x8d\x97\x72\xba\xf8\x6b\xad\x5f\xe7\x6c\xfb\x6b\xb3\xba\xc6\x7b\xb5\x38\xd2\x34\x21\x4a\x52\xea\xa6\xa1\x6c\x3d\x32\x2e\x61\x98\x73\x5e\x31\x59\xab\xd6\xcc\xfa\xf5\x40\x2c\xdb\x9d\xd9\xc6\x18\xaa\xba\xa6\xaa\x0d\x45\x65\x38\x9d\x16\x94\x4d\xc8\x68\xfc\x08\x67\x63\x56\x8b\x82\x3c\x3f\x57\x53\x59\xaf\x4d\xbe\x6b\x4f\x5a\xb4\xb2\xf4\x5e\x16\x4d\xb6\x6b\x76\x72\x9b\xe1\x7d\xae\x25\xed\xb6\x8f\x77\x43\x00\x2f\x4d\x58\x75\x42\x0e\x8f\x8e\x19\xeb\x7d\x94\xd2\x34\x8d\x07\x39\x27\x1c\xd6\x18\xa2\x38\xf0\x80\xe0\x04\xc6\xb8\x35\x93\xa2\x05\x0a\xbf\x82\x26\x95\xa2\x31\x92\x3c\xf7\x66\xd9\xe2\x42\x13\x70\xad\x14\x2c\xda\x72\x78\x3d\x88\x14\x92\xb6\x69\xef\x5e\xca\x78\x2e\x64\x92\x2d\x88\xad\xcb\xe6\xf3\xff\xf5\xbf\xff\xf6\xdb\xdf\xf1\xdf\xff\xf5\xff\xb1\x58\x66\xc4\x3b\xc3\x2b\xcf\x94\x6c\xbf\xfe\xa2\xce\xdd\xfa\x1c\xfa\xf2\xd6\x51\x54\x35\x45\x51\x79\x16\x9c\x08\x10\x2a\x40\xa9\x88\xf1\x4e\x4a\x14\x84\x14\x79\x8e\x92\x82\x20\x8c\x38\x9b\x4c\x36\x4a\xc4\xc3\xe1\x90\x9f\x7f\xfe\x79\xb3\xa9\xf2\x2e\x11\x04\x1a\xa5\x60\xb1\x58\x20\x04\xf4\x7a\x9d\x57\xd4\x9a\xb7\xb1\x2d\x69\xb7\xf1\x41\x9f\x3e\xbe\xec\xd3\x5a\xd0\x1f\xc4\x84\x61\xd2\xca\xf0\xb7\x60\xa1\x04\x61\xa4\x30\x4d\x43\x55\x57\xd4\x55\x85\xb1\x96\xaa\xac\x70\xce\xe2\x84\xa4\xb1\x0d\xca\x2a\x9c\x94\x34\x26\xa0\x6e\x68\xfd\x64\x1d\xce\xb6\x5b\x06\x17\x12\xa0\xb5\xa8\xc0\xda\x44\x48\x2b\xed\xe5\xe6\xdd\xda\xe4\xba\x6d\xe2\x8b\x16\x0c\x5b\x8d\x52\xa5\x3c\x78\x22\x41\x08\x2f\xc6\xa9\x75\x48\x9c\xf4\xc9\x56\x33\xea\x81\x41\x2b\xf9\x8a\x89\x8f\x52\x12\x65\x24\x75\x63\x36\x86\xdc\x42\xf8\xd2\xbd\xac\x6b\xac\x81\xbc\x74\x38\x17\xd2\xeb\x0f\x49\xe3\xce\xe6\x75\x58\xe7\x36\xc3\x0e\x21\x24\x5a\x05\x14\xc5\x72\xf3\xfd\xd7\x5e\x2d\x6b\x02\xf7\xdb\xca\x79\x07\x58\x63\xc8\x32\x3f\x15\x2e\x8a\x92\xba\xa9\x49\xe2\x1e\x41\x18\xfa\xcf\x66\x1b\x9f\x0f\xe0\xad\x4b\xa6\x8b\x86\xdb\x6b\x43\xee\xed\x30\xe3\xb7\x89\xa6\x69\x08\xc3\x90\x20\xd4\x44\xb1\x22\xe2\xd5\x5e\x92\x56\xea\x8d\xf4\x95\x66\xa3\x4a\x6c\xb1\xc6\x60\xad\x2f\x89\xd7\xe5\x71\x59\x56\x18\xe3\x0d\x9d\xac\xb1\x14\x65\x89\x56\x01\xc6\x34\xad\x2c\x98\x00\x27\x51\x3a\xd8\x80\xe0\x5a\x47\xd1\xb5\x4a\x2d\x42\x4a\xa4\x90\x97\x2c\x03\x92\xa4\xc3\x68\xb4\x4f\x9e\xcd\x59\xad\x72\x46\xc3\xae\xf7\xc6\xb8\xd4\x2b\xf3\xbc\x3a\x0f\x5c\x01\x75\xdd\x50\x37\x96\xda\x08\x9c\x0d\x70\x28\xe2\x24\xf0\xee\x66\x02\x56\xf9\x02\x6b\x0c\x52\xaa\xcd\x99\x54\x4a\x22\xdb\x5d\x58\x5f\x7a\x9e\xc7\xfe\xfe\x3e\x79\x9e\x6f\x40\x0f\xa0\xae\x1b\xbc\x9a\x8b\xdb\x9c\xf9\xba\xae\x71\xd6\x62\x4c\xb3\x99\xf2\x76\x3a\x1d\xee\xdd\x1b\x62\x1a\x36\x03\xa3\x6d\x7c\x66\x80\xb7\x2e\x2d\x9a\xa6\x21\xcb\x32\x8a\xa2\x60\x38\x1c\x6e\x57\xcc\x7e\xa3\x58\x53\x2b\x7e\x4d\x8b\x41\x6b\xfd\xc6\xf5\xb4\x35\x18\xd4\x75\x4d\xd3\x34\x94\xad\x2a\xf1\xba\x57\xe8\xb3\x38\xaf\x56\xb2\x76\x53\xf3\xd9\xce\xda\x5d\xcd\x21\xa4\x42\x49\x85\x6b\xf7\x55\xa5\xf2\x3a\x79\x51\x14\x91\xe7\x63\xb2\xe5\x8c\xa6\x49\x36\xde\xb4\x42\xb0\xc9\x14\xa5\x54\x94\x79\xc3\xc2\x94\x08\x19\x82\x88\x08\xc2\x88\x34\xee\x80\x80\xa2\x58\x51\x56\x25\xaa\x05\xb9\x75\x7f\x79\x03\x78\x5a\x12\x45\xd2\x7b\x27\x47\xc9\x4b\xa5\x69\xb0\xf9\x99\x67\x67\x67\x94\x65\x4e\x18\x68\xbc\xcb\xa3\xdb\x74\xe5\x3c\xe0\x7a\xea\x49\x1c\xc7\x97\xf6\x66\xb7\xba\x19\x5f\x40\x49\xbb\x7e\xc2\x2d\x16\x8b\x5f\xbd\xde\xb4\x8d\x5f\xf7\x10\x0a\x82\xe0\xa3\x0a\xb0\xae\xaf\x6d\x18\x86\x97\x80\xe4\x75\x75\x9f\x6d\x1b\x85\x97\x97\x1d\xce\x6d\x25\x37\x3d\x41\xe9\x19\x6c\x07\xf7\xee\xf1\xc3\x3f\x66\xcc\x16\x2b\x76\x47\x03\x9f\x55\x39\x47\xdd\x34\xe4\x79\xdd\xfa\x51\x44\xa8\x20\x25\x08\x13\x02\xed\xfb\x92\x6b\x9d\xbf\x38\xee\x90\x24\x9d\x73\xad\xba\x4b\x45\xa8\x07\x4e\xd9\xfe\xcc\x20\x78\xf5\x16\x5c\x7f\x76\xdd\x5e\x8f\x6c\x39\x63\xb0\xd3\x47\x4b\xcf\xb3\x53\x2d\xa5\x47\x78\x04\x66\x99\x97\x9c\x9c\xcc\xb7\x07\xef\x4b\x03\x3c\x29\x25\x51\x14\x91\x65\xd9\xf6\xaa\xfe\xc6\x19\x5e\x10\x04\x1f\x55\x9e\xeb\x9d\xda\x15\x82\x76\xb1\x5e\xbc\xfa\x17\xaf\x89\x34\x4d\xe9\x0f\xc7\x64\x8b\x29\x79\x59\x52\x14\x25\xd6\x2a\x10\x01\x52\xa5\x5e\x6b\x2e\x8c\xd1\x3a\xdc\x4c\x8b\x6b\x63\x2f\x91\xab\xdf\x94\xe0\x3a\x1c\x65\x69\xa9\x4a\x8b\x73\xc5\xeb\x5f\x47\x92\x30\xde\x19\x13\x6a\xdd\x4e\x7e\x2f\x7d\xd0\x2d\xe5\xcf\x51\x6c\xcf\xfc\x97\x07\x78\xeb\x9b\x6d\x23\xad\xb3\x8d\x6d\xfc\x8a\xb8\x7b\xf7\x1e\xff\x7b\x72\x82\x75\x25\x83\xfe\x2e\x08\x89\x94\x5e\xca\x7e\xbd\x2f\xbc\xee\xa7\xe1\x20\x8a\x35\x49\x1a\x52\xe6\x35\x65\xf5\x76\x8a\x94\x68\x07\x2b\x65\x51\xbd\xf1\xeb\x3a\x69\x17\x41\xfd\xc6\x07\xbd\x65\xcb\x50\xf8\x22\x01\x6f\x4b\x51\xb9\xad\x0f\x2a\xa8\xca\x9a\xd5\xa2\x22\x4e\x34\x71\x1a\xfe\xe6\x0d\xf7\x38\x8e\xf8\xee\xfb\xff\xc3\x6a\xd1\x78\x2d\xba\x56\x00\x60\x3d\x38\x58\x4b\x36\x25\x49\x48\x18\x69\x74\xa0\x36\x72\x4d\xd5\x24\x7b\xeb\x1e\xeb\x9a\x2b\x97\x95\x15\x6f\x72\xb7\x7c\x71\x78\xc4\xce\x4e\x8f\xf8\x35\x19\xb3\x12\x12\x29\x7c\x5f\x73\x5d\xe2\x6f\xe3\x0b\x01\xbc\x6d\xdc\x9c\x07\xcf\xbb\x94\x9d\x42\xac\xa5\xcc\x05\xf3\x79\x41\x51\x36\x24\x49\x40\x9c\x84\xbf\xe9\xbe\x6d\xaf\xd7\xa3\x29\x57\xd4\x8d\xd9\x10\x8f\xa3\xd8\x83\xdb\x1a\xe0\xd6\xd2\xf2\xeb\x08\x42\xff\x77\xf5\x75\xb3\xbc\x96\x58\xad\xf4\xd5\x6f\x74\xb1\x5c\x30\x1e\x77\xdf\xf8\xd9\x29\xed\xcd\xab\xb6\x80\xf7\x05\x01\x9e\x31\x66\x5b\xce\xde\x10\xb0\xab\xeb\x1a\x63\xcc\x3b\xf5\xf1\x94\x96\x74\xfb\x5e\xb2\x3f\xcb\x2a\x4c\x63\x69\x6a\x4b\xa7\x17\xfd\x86\xd9\x9e\x23\xed\x85\x2c\xe7\x05\x4a\xf9\xd7\xa7\x94\x7c\xeb\xbe\x6f\x1c\xeb\x8d\x93\xd8\x9b\x80\xca\x57\x24\x7e\xaa\xad\x5e\xf3\x59\xe9\x96\x06\xf3\xa6\xcf\x3b\x0c\x03\xf2\xbc\xa0\xdf\xdf\xda\x31\x7e\x11\x80\x27\x84\xa0\xaa\x2a\x7e\xf9\xe5\x17\x7a\xbd\xde\xad\x94\x28\xfa\x5c\x42\x4a\x49\x59\x7a\x99\xf2\x77\x1d\x5c\x28\x25\xe9\xf4\x22\xbf\xad\x90\xd7\xe4\x59\x45\x5d\x1b\xaf\x0f\x17\xeb\xdf\xe4\x5c\x45\x91\x26\xdc\xed\xb6\xef\xed\x7a\xc0\x1b\xc5\x01\xab\x65\x79\x0d\x79\x26\xb1\x59\x89\x7b\xdd\x43\xbc\x31\xe6\x8d\x0b\x62\x52\x08\xe2\x28\xa4\x28\x8b\xed\xe1\xbb\xce\xf9\xfc\x5c\xde\xc8\xba\xaf\xd2\xe9\x74\xb6\x9e\xb4\xbf\x31\xe0\xc5\x71\xfc\xde\xd7\x40\x29\x49\x6f\x90\x78\xe0\x03\xea\xda\x30\x9b\xe6\x4c\xcf\x32\xea\xaa\xf9\xe4\xef\xc7\x7b\x21\x8b\x77\xca\x32\xa5\x14\x24\x9d\xf0\xda\xbd\x64\xf7\x9a\xa1\x43\x55\xd5\x6f\xfd\x1e\x42\x08\xa2\x40\x53\x96\xe5\xf6\xf0\x7d\x29\x80\xe7\x9c\xa3\xd3\xe9\xf0\xf0\xa1\xf7\x36\x7d\x5f\x11\xc5\x6d\x7c\x18\x80\xf8\xb5\x83\x23\x29\x05\xdd\x5e\x4c\x6f\x70\x6e\x45\x58\x95\x0d\x67\xa7\x19\xf3\x59\x7e\x2b\xda\x16\xe9\x5b\x07\x2f\x5e\x57\xca\x5a\x03\xaf\x51\x26\xb6\xd6\x10\x84\xfa\x8d\x32\xed\x02\xbf\xb9\xd2\xd4\xf5\xf6\xf0\x7d\x49\x19\xde\xfa\x66\x5b\x2e\x97\x5b\xc0\xfb\x8d\x63\xad\xed\xf6\x21\x40\x63\x38\x4a\xbc\xb8\x66\x3b\x08\xc9\x57\x35\x93\x93\x8c\xaa\x6c\x6e\xb4\xa2\xaf\x5f\x55\x0b\x5e\x2f\xfe\xe9\x2e\x20\xd6\x6b\xf0\xac\x28\x0a\xe2\x38\x46\xbe\xe9\x36\x15\x20\x94\xda\xf6\xae\xbf\x44\xc0\x5b\x1f\xb4\x6d\x7c\x3e\x11\x46\x9a\xe1\xb8\x43\x14\xa9\x76\x25\x4c\x60\x8c\x65\x3a\xc9\x59\xcc\xf3\x56\x1a\xea\x86\x66\x79\xdd\xe8\x4a\xc5\x12\x21\x40\x49\x2f\x06\x2a\xdf\xb0\xf3\x9a\xe7\x05\x81\x56\xaf\x4c\xaa\x9d\x92\x97\x10\xd3\x6f\x88\x6c\xa9\x58\x5f\x24\xe0\x6d\x9f\x74\xbf\x6d\xc4\x71\x8c\xd6\xfa\x83\x5e\x07\xa9\x04\x83\x51\x4a\xda\x0d\x2f\xc9\x3c\xe5\x59\xcd\xd1\x8b\x05\xab\x45\x81\x35\xee\x8d\x03\x80\xdf\xe4\xe6\x92\x82\xf0\xaa\x61\x8b\x10\x84\xb1\x6a\xbf\x46\xbe\x16\xf0\x56\xd9\x8a\x38\x0c\x5e\x31\x2e\xff\xd7\x8f\x8f\x2f\x2d\xcc\x4a\x29\x89\x42\xbd\xd5\x84\xfc\x12\x4b\xda\xb5\xd2\xc6\x36\x6e\x7f\x49\xfb\x72\x74\x7b\x31\xfd\x61\x42\x10\xc8\xcd\xf5\x16\x52\xb0\x5a\x56\x9c\x1c\x2f\x99\x4f\x73\x8a\xbc\xc6\x9a\x9b\x83\x7a\x49\x1a\xbc\xca\x25\x6c\x39\x7d\x49\x1a\xa2\xb5\x46\xa9\xab\x27\xd0\x75\x59\xe1\xe5\xad\x36\xc8\x46\x51\x96\xcc\x17\x2b\x9f\x1a\x5e\x38\xf7\x42\x0a\x8a\x62\x3b\xb8\xf8\xa2\x00\xef\x3a\x1a\x62\xdb\xf8\xb8\xb1\x56\xed\xfd\x58\x7d\xd4\x28\xd2\x8c\x76\x3a\x24\x69\xe0\xfb\x57\x42\xb0\x5c\xcd\x68\x9a\x9a\x3c\xaf\x59\xcc\x0a\xa6\x93\x8c\xba\x32\xaf\xb5\x5d\xfc\x94\x11\x04\x8a\x28\xd2\x97\x96\x6b\xd7\xaa\xcc\x49\x7a\xbe\xc1\x71\x75\x39\x1f\xd2\x6e\xae\xad\xff\x65\x0b\x70\xf2\x15\xf0\x8c\xa3\x88\x72\x0b\x78\x5f\x5e\x49\xbb\x8d\xdf\xfa\x06\x0f\x36\x0e\x5c\xeb\x87\xd0\x9b\x1f\x52\x9c\xcb\xb5\xbf\xf4\xeb\x4d\xf5\x69\xaf\x1f\x33\x1c\x26\x84\xa1\x42\x2a\xc1\xf1\xc9\x33\xb2\x7c\x4e\x63\x2a\xca\xb2\x62\x72\x96\x51\xe4\x37\x63\x72\xd9\xe9\x46\x97\x3a\x6c\xce\x41\xdd\xd8\x75\x85\xfa\xda\x18\x0c\x86\x4c\xe6\x4b\xcc\x85\x6c\xf9\xaa\xaf\x57\x42\x90\x76\x92\x56\x36\x7f\x1b\x6f\x8a\xed\x6a\xd9\x36\x3e\xec\x13\xb4\x95\x2e\x5a\xcb\x74\x0d\x87\xc3\xcb\x00\x68\x01\xd6\xd4\x15\xaf\xf1\xe6\xd6\x28\xd0\x1a\x0c\xae\xe5\xd2\x69\xdd\xcc\xa4\x58\x5b\x3d\x7a\x49\xa5\xf5\x8d\x1f\x46\x9a\x30\xd2\x74\xba\xf7\xb1\xf6\x1e\xab\xd5\x9c\x55\xb6\x44\x89\x80\xe1\xb8\xe7\xb3\xfd\x37\x2d\xaa\x7e\xaa\xac\x57\x4b\x92\x34\x24\xcf\x2a\xff\xde\x70\x17\x86\x2d\xaf\x7f\x6d\x77\xee\xec\xf3\xd7\xff\x39\x22\x2b\x0a\x7a\x69\xfa\xda\xaf\x94\x42\x30\xea\x77\x71\x62\x2b\x89\xb6\x05\xbc\x6d\x7c\xf2\x92\x76\x6f\x6f\x8f\x67\xcf\x9e\x71\x72\x72\x42\x51\x14\x28\xa5\x37\xdc\x3c\xd3\x00\x4e\x60\x8d\xd7\xf8\xd8\xe8\xd2\x09\x50\x52\x23\xfc\x5f\xb7\xb2\xed\x6b\x63\x1a\x90\x4a\xa1\x95\x6a\xa5\xd8\x15\x52\x29\x94\x12\x28\xad\x90\x52\x10\x04\x8a\xd1\x78\xc8\x68\x3c\xc4\x5a\xbb\x51\x34\xb9\x29\xd1\xe9\x86\x94\x45\x4b\x24\x6e\xdf\x1f\x4e\x10\x04\xfa\x8d\xaf\xf5\xe0\xde\x7d\x5e\xbc\x78\x41\xe7\xdb\x14\xd5\x9a\x1d\x71\xd5\x44\xd6\xf1\x46\xbe\xde\x36\xb6\x80\xb7\x8d\x8f\x14\xc3\xe1\x10\x21\x04\x7f\xff\xdb\xdf\x79\xf6\xe4\x99\xcf\xb0\xdc\x79\x66\x76\xd5\x66\x81\x75\x6e\x43\xe1\xb0\x6d\x49\xab\x94\x44\x0a\x85\x75\x16\x29\x3c\xc8\x39\xe7\xbc\x44\x93\xf0\x0e\x5d\x5a\x6b\x94\x94\x04\x51\x80\xd2\x02\xad\x24\x4a\x6b\xa4\x14\x9b\xcc\xd2\x6f\x4b\x48\x94\x52\x1b\x3f\x09\x29\x24\xb2\x55\x37\xb9\x48\x89\x5b\x67\xa9\x1f\xfe\x41\x20\xe9\xf5\x13\x56\x8b\x0a\xd3\xba\xb6\x29\xfd\x76\x67\xb1\xf1\x78\xc4\xe9\xc9\x09\x67\x93\x29\x7b\xbb\x63\xb4\x52\xa4\x49\xfc\x0a\xe8\x59\x1c\x55\x51\x10\x26\xc1\x1b\x77\x6f\xb7\x80\xf7\x19\xc5\x76\x60\x71\x93\x32\x9a\x2e\x51\x1c\xa1\x51\x68\x1d\x60\x5d\xdb\x7d\xbf\x80\x2e\x97\x14\x88\xd7\x7d\xbb\x36\x5d\x59\x97\xb9\xeb\x0c\xd0\xe1\x5a\xed\x39\x87\xa9\x0b\xea\xb6\xaf\x65\x37\xbd\x3e\x81\x75\x6e\x03\x6c\x52\x0a\xbf\x90\xef\xc0\xe1\xb3\xcb\x38\x8a\x5b\x4f\x0a\x85\xd2\x9a\x30\x08\x08\x42\x85\xc3\x7a\xff\x5c\xfc\xfe\xea\x5a\x0e\xde\xbf\x04\x8b\xc3\xa1\xd4\x5a\x12\xde\xb1\xc9\xb1\xdc\xb9\x4a\xf2\x3a\x4b\xbd\x98\xe9\x6a\xad\x2f\x29\xc7\x38\x07\x79\xbb\x67\xdc\x34\x05\x69\x27\x26\x8e\x93\xb7\x7e\x96\x5f\x7f\xfb\x0d\x7f\xfd\xef\xff\xa2\xdf\xef\x13\x05\x01\x77\xee\xec\xc0\x45\x26\x82\x10\x14\x65\xcd\xd1\xd1\x84\xaf\xbf\xd9\x0a\x08\x7c\xf6\x80\xb7\x7e\x8a\xbf\x69\xe2\xb5\x8d\x4f\xfc\xf0\x61\xed\xdf\x20\x08\x02\xe9\x31\x69\x2d\x79\x7e\x49\x6a\xbd\xfd\xaf\x13\xad\x88\xef\x05\x7f\xd9\x35\xd8\xad\xd1\x45\x09\x5e\xfe\x97\x08\xff\x93\x2e\x96\x7a\xeb\xdf\x3b\xeb\x33\xca\xa2\xac\x69\x4c\x83\x74\x72\x03\xb6\x6b\xbb\x47\x1d\x78\x12\x6f\x63\x3c\x28\x36\x8d\x79\x69\xc0\xe0\x70\xce\x6e\x32\x43\x9c\xc3\x72\x61\x74\x2a\x24\x6e\xbd\x4a\x77\x11\xf0\x5a\xe0\xf5\x0e\x6a\x1e\xa8\xbd\x2c\xbb\x2f\xe7\x2d\x96\xf1\xce\x37\x0c\x06\x83\xb7\x7e\x96\x81\xd6\x8c\x46\x43\x26\x67\x53\xee\x1e\xdc\xa1\x2a\x2a\x6c\xc7\x6e\x32\x39\x63\x2d\xf3\xd9\x02\x15\x6c\x45\x33\x3e\x6b\xc0\x5b\xf7\x78\xa4\x94\x64\x59\xc6\xe1\xe1\x21\xd6\xda\xad\x78\xc0\x8d\xe8\xe5\xf9\xeb\xe2\x6f\x7c\x81\x73\x2f\x97\x6f\xee\x8a\x72\xee\x02\x60\xad\xc1\xcf\xb2\x71\x1a\x5b\x03\xa5\x6d\x09\xc6\xd6\x3a\x9c\xb5\xd8\x97\xb3\xfb\x56\x6b\x5d\x4a\x3f\x1c\xa9\x4d\xe3\xcf\x49\xfb\x9a\x36\x04\x65\x07\xc6\xb8\xd6\x63\x42\xe1\x80\x28\xd4\x2f\xbd\xba\x2b\x40\xd6\x5d\xa6\x8a\x38\x77\x45\x91\xee\x2e\x98\x83\xdb\xf3\xfd\xb1\x50\x2b\x9c\x14\x38\x15\xb2\xb3\xb3\x73\xad\xcf\xd2\x18\x43\xbf\x97\x72\x7a\x36\x05\x21\x98\x2f\x96\x74\x7b\x5d\x92\x28\x04\x01\x4d\x63\x58\xae\x72\xbe\xf9\xf6\xbb\xed\xc1\xfb\x9c\x01\x6f\x9d\xd5\xa9\xff\xc7\xde\x7b\x76\x39\x92\x64\x57\x82\xd7\x94\x6b\x00\x21\x52\x55\x65\x09\x56\x93\xd3\xbb\xe4\x90\x5f\x38\xf3\xff\x7f\xc1\xce\x07\x9e\xe5\xd9\x3d\x33\xac\x66\x4f\x97\xca\xaa\x14\x11\x01\xe5\xca\xc4\x9b\x0f\xe6\x40\x20\x14\x34\x42\xda\xeb\x8e\xea\xce\x8a\x04\xe0\xee\x30\xbb\x76\x9f\xba\x4f\x08\x44\x91\x2f\xe2\x3c\x3a\x3a\x0a\xf2\x50\x8f\x05\xf4\xa4\x84\x75\x8b\x60\x45\xb7\x02\xdc\x35\x6a\x78\x99\xa1\x05\xef\x0a\xa7\xd8\x15\x90\xa1\x85\xa1\x3c\xce\x11\x8c\xb5\xb0\x8e\x6e\xad\xc3\x24\x72\xf3\x24\x86\xb5\xce\x8f\x45\xa4\xcb\xcf\x77\xf6\x1a\xbc\xb1\xc5\x58\xa3\x7f\xfd\xd5\x2b\x66\xf3\x9c\xc3\x75\x3e\x7b\xe5\xa3\xe7\x7f\x60\x97\xbb\x8c\x00\x29\x39\x18\x73\x28\x9b\x76\x03\x0f\x06\x18\x8d\xa7\x7e\x50\x90\xd5\x48\x92\x04\x5a\x6b\xa4\x89\x97\xd2\x6a\x9a\x16\x71\x92\x85\x83\xfe\xb9\x03\x9e\x10\x02\x55\x55\xe1\xd7\x5f\x7f\x45\x9a\xa6\x38\x3d\x3d\x3d\xe8\xf0\x98\x60\x1b\x2e\x2e\x21\xe0\x36\xed\x7a\xa1\x9b\x85\xb6\x37\x0f\x3a\xcc\xa7\x76\x09\xe2\x90\x8a\xc1\xd9\x99\x7e\x1c\x75\x05\xc7\x5d\x5c\x6f\x2e\xc7\xae\x60\x9d\x85\xec\x96\x3c\xe7\x5e\x62\x9d\xcf\x62\x70\xdd\xe1\xc9\x2e\x3d\xd5\x2b\x6e\xf8\x25\x9b\x63\x5d\x8c\xf1\x2a\xdb\x73\x6e\x81\xd1\xcd\x18\x1f\x01\x98\xbb\xbf\xfe\x7a\xad\x75\x20\xd8\x8d\x3a\x51\xa4\x14\x18\x0e\x27\x38\x3d\x19\x40\xb7\x06\x79\x96\xa0\xed\xd4\x51\xac\x73\x18\x8e\xa6\x78\xf5\xfa\x5d\x58\x70\xcf\x1d\xf0\x8a\xa2\x00\x11\xa1\xaa\x2a\xf4\x7a\xbd\x00\x76\x8f\x6d\x71\x49\x89\x56\xeb\x83\xc4\x55\x69\xe1\x1f\x8c\x31\x08\x09\x70\x21\x21\xc9\x4b\xa6\xfb\xd1\x8a\x0c\xad\x26\x44\x71\x84\x7e\xbf\x8f\x6a\x3a\x46\x12\xc9\x4b\x06\xc9\x2e\x9d\x56\x9a\x93\x32\x76\x87\xbb\xbd\x88\xc6\xec\x4a\x66\x97\x16\xe2\x7d\x33\x46\x76\x35\x09\xd3\xb9\xd8\xdd\x68\x46\xad\x2f\x8b\xab\xd7\x4d\xb4\x39\xe7\x10\x27\x11\x18\xe7\x10\x4a\xa2\x9e\x56\x73\xc0\x13\x42\x20\x4d\xd3\xb0\xe0\x5e\x42\x0c\xaf\xdf\xef\xa3\xd7\xeb\x85\x0c\xed\x23\xb4\x24\x49\x50\x4d\x26\x07\x6f\xf9\x5b\xc4\x15\x0f\x7e\x0c\x02\xbc\x9b\x15\x2b\xd1\x18\x8b\xc1\xa0\x80\x6e\x2a\x58\x67\x10\x47\x91\xcf\xee\x5e\xe3\x91\x57\xdd\xee\x75\xc5\x3b\xaf\x85\x58\xd0\xd5\x11\xde\x51\x6e\xe2\x87\xf7\xb0\xf9\xc0\xf2\x75\x0f\x69\x6b\x2d\xf2\x2c\xc5\x97\xcf\x5f\xf0\xf6\xdd\x6b\x8c\x86\x93\xf9\xe7\xab\x28\x84\x70\xd6\xc6\x8c\xa7\x7e\x03\xb3\x1a\xab\x00\x78\x8f\xcf\xb2\x3c\xef\x4a\x3c\x1e\xa8\x64\x88\xb1\x79\xb1\x72\x9e\xe7\x88\x93\x18\x4d\xdb\x1e\x16\x7c\xd7\xbc\x2e\x1f\x7b\x5b\xbf\xf7\xb5\xd5\x16\x51\x12\x03\x0c\xf8\xb7\x7f\xfb\xff\x11\x25\x11\x40\x04\xdd\x6a\xb8\x50\x98\xf0\x32\x18\x5e\xb0\xc7\x6d\x83\xc1\x00\xd5\x64\x82\x7a\x3a\x7d\x98\x72\xa1\xae\xb1\x5e\x76\x35\x71\x51\x92\x60\x32\x1e\x3f\x78\xe9\xd2\x2c\xd9\xb6\x89\xaa\x4f\x14\x29\x30\xeb\xf0\xee\xab\x77\x38\x39\x1a\x40\x49\x09\x07\xa0\xaa\x1b\x48\x95\x85\xc5\xf6\x52\x18\x5e\xb0\x47\xcc\xbe\xe1\xe3\xac\xec\x81\xda\xbc\xa8\x63\x53\xb3\x52\x94\x3c\xcf\xa1\xa4\x84\x31\xe6\xc1\x3d\x02\xe7\x1c\xea\x0d\x18\x1e\x39\x82\x71\x0e\x1f\x7e\xfb\x1d\x4a\x4a\xef\x16\x03\xa8\xaa\x06\x91\x0a\x3d\xb4\x01\xf0\x82\x3d\x0a\x4b\xd2\xf4\x41\xfb\x5a\x67\x02\x02\xc6\x18\xe4\x59\x06\x26\x04\x5a\x63\xf0\x90\x70\x37\x0b\xc3\x6c\x92\xc1\x3e\x7d\xfd\x0a\xe3\x71\x89\xe1\x70\x8c\xc9\xb4\x04\x84\x07\xf1\xb6\x69\x91\xa6\x81\xe1\x05\xc0\x0b\xf6\x28\x4c\x45\x11\xd4\x03\x31\x10\xea\x5a\xcd\x62\xa5\xe0\x8c\x41\x92\x24\x50\x51\xf4\x28\x04\x62\x37\x8d\xe1\xbd\xff\xfa\x3d\x86\xe3\x31\x9c\x23\x8c\xa7\xa5\xef\xf0\x00\x41\x1b\x0b\x29\x43\xfd\x5d\x00\xbc\x60\x8f\xc6\xb2\x07\xcc\xa2\xcf\xe4\xa6\x66\x55\x6f\x71\xec\x8b\x75\xdd\x03\xc6\xf1\x58\x17\x57\xb4\x1b\xd4\xe2\x45\x91\x42\xac\x52\x1c\x0f\x0a\x7c\xfa\x74\x06\x30\x06\x6b\x1c\x1c\x42\xb2\x2e\x00\x5e\xb0\x47\x65\x0f\x5a\x1f\xc9\x18\xac\x73\x73\x05\xe6\xa2\x28\xc0\xa5\x84\x7d\xa0\x38\xde\xbc\xef\x1b\xd8\x78\xb4\xe2\xd1\xc9\x09\xa2\x38\x41\xdb\x6a\xe8\xa6\x41\x35\x2d\x91\x65\x79\x58\x60\x9b\xac\xc5\xf0\x08\x82\x1d\xda\xb4\x31\x30\xc6\x3c\x58\xeb\x93\x5b\x00\xbc\x2c\xcb\xc0\x38\x87\x71\xee\x41\x17\xff\x2c\x91\xb2\x89\xe5\x79\x8e\x2c\xcb\xc0\x55\x82\xbf\xfe\xf5\x27\xb4\xda\xe2\xef\x7e\x08\xfd\xb3\x01\xf0\x82\x3d\x2a\x13\x42\x78\xbd\xbb\x47\x70\x2d\x71\x1c\xfb\x38\x5e\xf3\xb0\xf3\x1f\x18\xe7\xd0\x5b\x0c\xcf\x66\x8c\xe1\xe4\xf8\x04\x75\xd5\x40\x48\x81\xa2\x08\x0c\x2f\x00\x5e\xb0\x47\xe7\xd2\x3e\xe4\xf8\x4c\x22\x9a\x7f\x3e\x63\x0c\x69\x92\x60\xdc\x34\x0f\x56\x8f\xc7\x18\x03\x07\x60\x76\x78\x26\x5f\x7f\xfd\x55\x58\x58\xdb\x30\xeb\xf0\x08\x82\x1d\xda\xa2\x38\x06\x3d\x64\xdd\xdb\x02\xe0\x01\x40\xbf\xdf\x9f\xbb\xba\x0f\x96\x4c\xe9\x46\x8a\x06\x0b\x80\x17\xec\xb9\xb9\xb4\x0f\x3c\x5f\x62\xa6\x82\x3c\xb3\xa2\xd7\x03\xe3\xfc\xc1\x87\xb6\x07\xb1\xda\x00\x78\xc1\x9e\xb1\x5b\xfb\x50\x1b\xfc\xba\x72\x09\x67\x0c\xbd\xa2\x78\x50\x20\xe6\x1d\xe0\x86\xa1\xf1\x01\xf0\x82\x3d\x47\xc0\x8b\xa2\x07\x05\xbc\xeb\xee\xe3\xf1\xf1\xb1\x8f\xed\x3d\x10\xe0\x6c\xd3\x4f\x1b\x2c\x00\x5e\xb0\x27\x62\x69\x9a\xa2\xd5\xfa\xde\x63\x66\x6c\xe6\x3a\x5e\x03\xdb\x24\xcb\xd0\x5a\xeb\x87\xf6\x3c\x40\x1c\x6f\x26\x13\x15\xe2\x78\x01\xf0\x82\x3d\x43\xcb\xd2\x14\xf5\x81\xa5\x99\x6e\x65\x77\x4b\x5c\xca\xbc\x28\xe6\x52\x4d\x0f\xc5\xf0\x02\xe0\xdd\xb3\xa7\x11\x1e\xc1\x7e\xcc\x39\x42\x53\x6b\x58\x43\x60\x1c\x88\x22\x09\x15\x85\x1e\xc7\x99\xe5\x79\x8e\xb6\x6d\xef\xfd\x73\xd9\x82\x5b\x7b\x5d\x88\xb4\xe8\xf5\xd0\x56\xd5\xc1\x05\x4a\x97\x5d\x57\xbb\x45\x2d\x5e\xb0\x00\x78\x0f\x6a\xd6\x3a\x8c\x2e\x2a\x18\xe3\xe6\x9e\x53\x2d\x34\x92\x54\x21\xcb\xa3\x20\x4e\x0a\xcc\x87\x60\x5b\x6b\xe7\xd2\x46\xf7\xc5\xf0\x08\x97\xb5\x78\x8b\xd9\xda\x28\x8a\xae\x4d\xde\xb9\x57\x8a\xe7\x19\x5e\x00\xbc\xe0\xd2\x3e\x35\x66\x37\x3c\xab\xa0\x5b\x7b\x25\x4c\x64\x8c\x43\x39\x6d\xd1\xd4\xc1\x65\x99\x59\x7f\x30\x40\x5d\xd7\xf7\x0e\x32\x77\x7d\x5a\x9c\x24\x30\xce\x5d\x19\x96\x7d\xaf\xd7\x44\x04\x1d\x5c\xda\x00\x78\x4f\xc9\x74\x6b\xa0\xb5\xbd\xb1\x89\x67\x43\xc1\xc7\xa3\x1a\x75\x15\x4e\x71\x00\x78\xfd\xfa\xf5\x46\xa2\x97\xfb\x23\x53\xec\x56\x90\x55\xdd\x18\x49\x3c\x44\xf6\x38\xc4\xf0\x02\xe0\x3d\x49\xc0\xd3\x6e\x09\x61\x61\x5e\xbf\x6c\x54\x07\xa6\x07\x2f\xf9\xee\x6e\x4c\xf3\xba\x07\x16\xde\x95\x9f\xdc\xf6\xb9\x59\x9e\xa3\xba\x67\x10\x9e\xc5\x0c\xfd\x9c\xd9\x50\x96\x12\x00\xef\x09\x99\x10\xcb\xe3\x51\x8c\xb1\x79\x42\xc3\x59\xf7\xc2\x9f\x95\x40\x92\xa6\xa0\xfb\xee\x70\xe8\x26\x84\xdd\xd6\x59\xf1\xee\xdd\x3b\x4c\xcb\x12\x78\x80\xc4\x05\x42\x1d\xde\xbd\xdb\x93\x4e\x5a\x18\x6d\x51\x95\x1a\xba\x35\x20\x00\x51\x2c\x91\x24\xea\x5e\xb3\xa3\xeb\x92\x15\x63\x1c\x9c\x23\xf0\x17\x9c\xb8\x65\x8c\x21\xcb\x73\xb8\xa6\xb9\xd7\x2e\x07\xc6\xd8\x9d\x9f\x97\x26\x09\xc0\xb9\x97\xaf\xba\x67\xdd\xbe\xfb\x4c\xde\x04\x7b\xe2\x0c\x4f\xb7\x16\x17\xe7\x15\xaa\xb2\x85\xb1\x04\x6b\x1d\xaa\xb2\xc5\xc5\x79\x89\x2f\x9f\x26\xd0\xed\x3d\x9c\x9c\xe4\x41\x77\x15\x33\x60\x8c\x41\x6b\xeb\x63\x7d\x2f\x7c\x85\xc7\x71\x0c\x47\x74\xaf\x3a\xbd\xb3\x61\x3e\x77\xd9\xeb\x57\xaf\xd0\xdc\x73\xb6\x74\xa6\xc4\x6c\x43\x0c\x2f\x00\xde\x2a\x73\x8e\x50\x96\x2d\x8c\x59\x4c\x16\xf8\x71\xf2\x44\x80\xb5\x84\x2f\x9f\x26\xa8\x2b\x7d\xd0\x78\xb4\x75\x04\xdd\xd8\xb5\x77\x9d\xd6\xf6\x41\xa5\xc5\x1f\x83\xe5\x79\xee\x0b\x90\xef\xb9\x8f\x95\x88\xee\x3c\x6b\xde\xbc\x7d\xbb\x91\xdc\xfa\xbe\xdc\x59\xde\xad\xdd\x20\x22\x10\x00\x6f\x39\xd0\x18\x8b\xba\xd2\x4b\xa7\x61\x71\xc1\x31\x1e\xd5\x3b\x2b\x62\xf8\xae\xa4\x2e\xd0\x7e\x6d\x5d\x36\xb5\x86\x71\x6b\x02\x1e\xf3\x40\x4c\x01\xf0\x50\xd6\xf5\xfd\x4e\x32\x23\x82\x35\xe6\xce\xd8\x61\x92\x24\x0f\x22\x6e\x40\x00\x8c\x31\x21\x8e\x77\x8f\xf6\x24\x63\x78\xb3\x42\xd2\x55\xae\xa4\x35\x0e\x75\x65\xba\xe2\xdf\xf5\x99\xc0\x0c\xe8\x9c\x23\xb4\xb5\x86\xd6\xbe\xc6\x2e\x4e\x24\x92\x54\x75\x05\xb4\xbe\xd8\x98\x8b\xf5\x36\x2e\x63\x0c\xba\x31\xb0\xc6\x41\x88\x97\x9b\x2b\x52\x4a\x81\x0b\x81\xb6\x6d\x21\x84\xb8\x1f\x90\xe9\xc6\x22\xde\x79\x38\x72\x8e\x28\x8e\xef\xbd\x3c\x85\x5d\x5b\x73\xc1\x02\xe0\xdd\xb1\x50\x18\x38\x67\x1d\xf4\xb1\xa5\x0b\xb9\xae\x34\xd2\x4c\x2d\x05\x47\xcf\xe0\xba\xd9\x07\xad\x45\xd3\x18\xb4\xad\x85\xb3\x74\x65\x65\xd6\xb5\x46\x14\x4b\x08\xc1\x30\x19\x37\x60\x7c\xb3\x48\x94\xeb\x40\xf4\xa5\xdb\xeb\xd7\xaf\xf1\xe5\x8f\x3f\xf0\xe6\xf5\xeb\x7b\x61\x37\xbc\x3b\x70\x96\x99\x50\x0a\xae\x69\xee\x3d\x53\x3b\x93\x88\x52\x61\x98\x76\x70\x69\x97\x1d\x8d\x6b\x2d\x4c\xe6\x0b\x83\x9b\x5a\xdf\x0a\x72\xd6\x3a\xb4\xad\x41\x39\x6d\x31\xbc\x28\xf1\xe5\xd3\x14\xe7\xe7\x15\xea\xca\x80\x08\x60\x9c\xf9\x9f\xee\xb3\x22\xe5\x27\xbe\x6b\x6d\xd1\x36\x9b\x4f\xbd\xba\xa3\xfe\xf5\xc5\xd9\xfb\xaf\xbf\xc6\x78\x3a\xbd\x57\x66\x63\x3b\x65\x94\x3b\x5d\xed\x2c\x43\x7b\xcf\xe2\x06\x9c\x73\x5f\x8b\xe7\x5c\x58\x14\x01\xf0\x96\x5c\x34\x63\x90\x82\x83\xdc\x3a\x8b\x8a\xa1\x2a\x2f\x01\x8f\x1c\xc1\x68\x8b\x72\xda\xe2\xe2\xac\xc4\xc5\x59\x85\xc9\xb8\x41\xdb\xf8\x6c\xab\x90\xfc\x1a\x73\xf3\x9b\x52\x4a\x8e\xde\x51\x02\x30\x60\x3a\x69\x36\xf6\x7e\x88\x08\x42\xf0\x17\xed\xce\xce\x2c\x8a\x22\xf4\x7a\xbd\x7b\x15\x13\xb0\xce\x2d\x4d\x4c\x0c\x8e\x8e\x30\xbd\xef\xd8\x62\x70\x69\x83\x4b\xbb\x8e\x09\xc1\x91\xa4\x0a\xed\x3a\xa5\x27\x5d\xbc\xcd\x18\x07\x6b\x1c\x74\x6b\x51\x55\x1a\xc6\x58\x08\xe1\x33\xbb\x6c\x31\x5b\x46\x33\xe9\x1e\x0f\x96\x9c\x73\x3f\x1d\xaa\x17\x83\x73\x86\xe9\xa4\x59\x99\x30\xb9\x6d\x41\x33\x30\x64\x59\x04\x21\x03\xe0\x01\xc0\xdb\x77\xef\x70\xf1\xf9\x33\x7a\xbd\xde\xe1\x37\x7c\x37\x34\x67\x19\x77\x8b\xa3\x08\x8c\xf3\xb9\x3e\xde\x7d\x80\x10\xef\xea\x03\x43\xd2\x22\x00\xde\x4a\x57\x35\x4a\x24\xa2\x5a\xa2\x6d\x57\xbb\x96\x44\xc0\x78\x58\x41\x6b\x37\x1f\xdc\x22\x24\x9f\x67\x5d\x7d\x02\x04\x90\x82\x43\xc8\xee\x47\x70\x48\xe9\x7f\x66\x89\x09\xad\x2d\xa6\x93\x76\x7d\xb0\x23\x02\x3a\x36\x9a\x64\x0a\xc9\x8a\x58\xe2\x4b\xb2\xc1\xd1\x11\x3e\x7f\xfc\x78\x3f\xcb\x85\xb1\x1b\x83\x7c\x6e\xb3\xd3\x57\xaf\x30\x3e\x3f\x47\xaf\xd7\xbb\x9f\x04\x46\x17\xe3\x08\x2e\x6d\x00\xbc\x95\x8c\x89\x1c\x75\x0c\x6d\x3d\xd3\xda\x02\x58\xc8\xd6\x2d\xe4\x23\x54\x2c\xa1\x14\x47\x14\x2b\x28\xc5\x6f\x05\xa5\x99\x10\xc0\x9a\x57\x08\xc6\x18\xe2\x34\x82\x10\x0c\x71\x24\x21\x83\x36\xde\x0d\x46\xc5\x39\x07\xc3\xe1\x6b\xb1\x19\x7c\xf9\xc7\x2a\x65\x92\xaf\xde\xbd\xc3\xa7\xdf\x7f\x47\xbf\x28\xee\xa5\x3e\x7c\xae\x98\x12\x24\xa2\x02\xe0\xdd\x88\xc1\x58\x07\x67\x09\x5a\x1b\x58\x4b\x30\xc6\xad\xd5\xe5\x70\x6d\x79\xdd\x00\x26\x30\xef\x6a\xc6\xc9\xf2\x47\x51\x57\x1a\x46\xaf\x79\x12\x77\x25\x2c\xfd\x41\xb2\xd1\x3d\x3a\xeb\xe6\x25\x30\x1d\x29\x81\x10\x0c\xfc\x19\xc6\xfe\xa4\x94\x60\xe2\x9e\x0f\x81\x15\xac\x2d\x8e\x63\x08\xa5\xee\x2f\xa6\xf6\x00\xb2\x54\x01\xf0\x1e\x91\x39\xe7\xd0\xd6\x16\xd6\x3a\x10\x7c\x90\x5f\x2a\x01\xdd\x1a\xb4\x8d\x85\x73\x04\x63\x2e\x75\xe7\xfc\x5a\x61\x1b\xae\xf9\xc5\xfa\x3d\x06\x90\x07\xd3\x55\xd7\x55\x55\x1a\xab\xca\x60\x2e\x37\xb3\x40\x96\x47\x1b\x5d\x97\xd1\x16\x93\x71\x03\x63\xdc\xbc\x91\x9d\x88\xc0\x05\x07\x17\x6c\x1e\xb7\x54\xea\x79\x30\x45\xce\x39\xd2\x34\x45\x59\xd7\xc8\xd2\xf4\xb0\x6e\xdd\x0c\x58\xd6\x00\x97\x5e\xaf\x07\x6d\x0c\xd4\x81\x81\x6f\x71\x1d\x06\x89\xa8\x17\x08\x78\x6d\x63\x50\x96\xad\x17\xd2\x74\xbe\x0d\x88\x31\x06\x29\x79\xa7\x24\x4c\x73\x80\xdb\xee\x50\xf4\xb5\x76\x52\xf2\x0e\xe0\xd8\xdc\xb3\x35\x66\x79\xd0\x78\x3a\x69\x61\x3a\x97\x78\x25\xb5\x03\x43\x9a\x2b\xc8\x0d\x80\x89\x1c\x61\x78\x5e\xc1\xd8\x85\xc1\xd0\xdd\x66\x73\xda\x82\x3a\x8f\x47\xb7\x16\x45\x2f\x46\x14\x3f\x7d\xa1\x6a\xc6\x18\x8e\x8e\x8e\xf0\x97\xff\xf8\x0f\xf4\xf2\xfc\x70\x80\xd7\x01\x8b\xb5\x76\x2d\x75\xe1\xa3\xa3\x23\xfc\xfe\xeb\xaf\xf7\x56\x17\xc7\xba\x03\x35\xd8\x3d\x1d\xb4\x8f\xe1\x22\x8c\x71\x98\x8c\x1b\x34\xb5\xaf\x7f\x5b\xa4\xfa\x1e\x68\x66\x75\x77\xdb\x20\x1d\xc1\x59\x07\xce\x19\x8a\x22\x46\x92\x29\x5c\x5d\x5f\x04\x6b\xef\x3e\xc9\xab\x69\xdb\x09\x78\xb2\xb5\x96\x6f\x9a\xfa\x6e\x8c\x4d\xac\x9c\xb6\xd0\xc6\xdd\xee\xde\x74\xcf\x82\x31\x06\xdd\x5a\x4c\x27\x1d\x0b\x7c\x06\x36\x38\x3a\x82\xee\x5a\xab\x0e\xed\xda\x59\x6b\xd7\x62\x52\xfd\x7e\x1f\xfa\x9e\xb2\xa6\x6c\xe1\xbb\x0d\xf6\x82\x18\x5e\x39\x69\x60\xf4\xdd\x1b\x7e\x17\xb7\x81\x88\x50\xf4\x63\xc4\xb1\x84\x54\x02\xd3\x71\x73\xd5\x35\xa5\x8e\x4d\xdd\xe2\xad\x36\xb5\x2f\x4a\x5e\xd7\xb3\x11\x82\x21\xef\x25\x6b\x2d\xe0\xa6\xd6\xa8\x6b\x0d\x72\x3e\xa1\xc2\xd7\xe8\xda\x60\x9c\x41\x6b\x07\xdd\x1a\x48\x19\x3d\xfd\xc5\x27\x84\xcf\x8c\x4e\x26\x07\x2b\x4f\xa1\x0d\x81\x45\x29\x05\x79\x9f\x5d\x0f\x9d\x56\x5f\xb0\x17\xc2\xf0\xac\xf5\xb5\x71\xb4\xc7\xbc\x98\xdf\x38\x84\x28\x16\x38\x39\xcd\x91\x17\x31\x54\x24\xc1\x18\x43\x53\xaf\xd7\x21\x61\x8c\xc5\x74\xdc\xc0\xac\x29\xda\xc9\x00\xe4\x5d\xad\xde\x6d\x36\x8b\x3f\x56\xd3\x16\xe7\x5f\x4a\x8c\x86\x5e\x05\xb9\x6d\xcd\x46\x15\x10\x3e\x43\xfd\x3c\x16\x1f\x63\x0c\x5f\x7f\xfd\x35\x26\x65\x79\x50\x96\xc3\x80\xb9\x32\xc9\xca\x0d\xc1\x39\xb2\x3c\xf7\xee\xef\x3d\x30\xaf\xc0\xf0\x5e\x18\xc3\x33\xda\x79\x7d\xb4\xbd\x7c\xe7\x04\x67\x09\x49\xaa\x7c\x80\x3f\x12\x37\xb2\x9b\xc6\xb8\xb5\x7a\x60\xab\xa9\x86\x36\xeb\xba\x5a\x84\x28\x56\x48\x12\x75\xfd\xf0\x46\xdb\x1a\x34\xb5\x81\x35\x3e\xe9\xe2\x66\x8a\x29\x5b\x24\x5c\x66\xaf\x60\xcf\x24\x61\xcb\x18\x43\x9e\xe7\xe0\x42\x1c\x3c\x69\xe1\x9c\x5b\x3b\x39\xd0\xef\xf7\xf1\xf1\xd7\x5f\xd1\x8b\xa2\x83\xca\x79\xcd\x34\xf1\x78\x00\xbc\x97\x03\x78\x9c\xb3\x6e\x3a\x3c\xb0\x8b\x2a\x24\x91\x2f\x1c\x2e\xfa\x11\x92\x58\x75\x3d\xb0\xd7\xd9\xa4\x4f\x5c\xac\xfa\x98\xb6\xf1\xcc\x6b\x2d\xa8\x23\x02\xe7\x0c\xbd\x41\x7c\xc9\xe6\xac\x43\x59\x6a\x54\x65\x3b\xff\x3c\xda\x83\x9b\x4e\x44\x50\x4a\x6c\x94\x10\x79\xf4\x2e\x06\xe7\x28\x8a\x02\x75\xd3\x20\x89\xe3\x83\xb8\xb5\x6c\x21\xbc\xb1\x8e\x1d\x0d\x06\xf8\xe5\x6f\x7f\xbb\x0f\xc4\x07\x11\x85\x2c\xed\x4b\x02\x3c\xa9\x38\x18\xe7\x20\x67\x77\x54\xc1\xf5\x88\x29\xa5\x00\xbf\xa3\x20\x59\x1b\x8b\x75\x4a\x4b\xb4\xb6\x3e\x91\xb1\x06\x30\x25\x89\x42\x7f\x90\x80\xc8\xc7\xfc\xea\x5a\xa3\xad\x4d\xc7\x5a\x2f\x33\xc1\xfb\x72\xd5\x45\xd7\xfd\xf1\x5c\x8c\x31\x86\xa3\xe3\x63\x7c\xfc\xf0\x01\x69\x92\x1c\xa6\x14\xa4\x93\x87\x5a\xd7\x75\x8c\xa2\x08\x5c\x88\x7b\x93\x8b\x0a\x59\xda\x17\x04\x78\x8c\x31\x08\xc1\xb0\xeb\x21\xc7\x18\x83\xb1\x0e\xd3\x71\x0d\x71\x94\xde\x5e\xa8\xeb\xee\xa2\x77\x6c\xc1\xe5\xf5\x4a\x28\xeb\xe8\xed\x31\xc6\xa0\x22\x81\xe1\xb0\x82\xd1\xae\x2b\xa7\xf1\x80\xba\xff\xb8\x8c\x67\x92\x51\x24\x9e\x55\xcc\x67\xe6\xd6\x6a\x63\x0e\x2a\xfb\xbe\x29\x74\xf5\xfa\x7d\x34\x75\x8d\xe8\x40\xac\xf3\xca\xb5\x39\xdf\xf2\xf8\x10\xc2\x05\x2f\xcd\x1e\xc5\x13\x4e\x33\xb5\x51\x9b\xd8\xb2\xcd\xd3\x36\xd6\xbb\x92\xb7\xe8\xce\xf9\x93\x94\xdd\xd8\x08\x44\x34\x57\xd2\x68\x1b\x3b\x57\x4e\x59\x87\x71\x8d\x47\xf5\xbc\x28\x9a\xae\x81\xe7\x3e\x77\x2b\x11\x90\xa4\xd1\xc6\x25\x2f\x4f\x01\xf0\x92\x38\x86\x8a\xa2\x83\x65\x2b\xb7\x69\xe1\x3a\x3e\x3e\xc6\x68\x3a\x3d\xf8\xb0\x21\x02\x60\xee\x18\x21\x19\xec\x99\x02\x5e\x9c\x28\x44\x91\xdc\x0b\x54\x30\xce\x30\x1e\x35\x68\xda\x9b\x94\xd1\x18\xba\x91\x45\x9d\xe1\x9a\x73\x84\xe9\xb4\xc1\x74\xb2\x99\x08\xe4\x7e\xd9\x96\xcf\x2e\x53\x37\xe4\x46\x70\x86\x28\x16\x48\x32\x89\xc1\x71\x8a\x5e\x3f\x79\x96\x19\x3d\x21\x25\x7a\xfd\x3e\xca\xaa\x3a\x4c\x00\x7f\x56\x96\xb2\xc1\x4b\x8e\x8e\x8e\xd0\x6a\x7d\x6f\x40\x14\x00\xef\x85\xb8\xb4\x97\x2e\x44\x82\xa6\x9e\x60\xdd\xf6\xad\xa5\x1b\x48\x30\x8c\x2f\x6a\x88\x13\x7e\x65\x64\xe3\xac\x88\xf9\xfa\xf9\x6f\xad\xc3\xc5\x59\x35\x57\x52\xb9\x9f\x91\x5a\xbe\xbc\x84\xba\x8f\xe3\x9c\x41\x70\x01\x19\x09\x48\xc9\xa1\x94\x80\x90\x7c\xad\xfa\xbc\x27\x0f\x78\x42\xe0\xf8\xe8\x08\x7f\xf9\xfc\x19\xfd\x5e\xef\x20\xc3\xa9\xd9\x86\xea\xab\x8c\x31\x0c\x06\x03\x54\x75\x8d\xf8\x80\x6e\x2d\x07\xe0\xba\xa2\x68\x29\x65\x40\xa4\x97\x02\x78\x8c\x33\x0c\x8e\x53\x5c\x5c\x54\xb7\x06\x5c\xae\x75\x5c\xad\x74\x62\x1c\xf9\xc9\x66\x3d\x99\x80\x73\xff\xe7\xbb\xe4\xd5\xfd\x7b\xde\xdf\x20\x66\xc6\xfc\x26\x8f\x63\x81\x38\x55\xe0\x8c\xdf\x99\x68\x79\x09\xc6\x18\xf3\x03\xba\xbb\xf2\x91\x43\x29\xa8\x68\xad\x37\x02\x96\xb7\x6f\xdf\xe2\x97\x9f\x7e\xf2\xd9\xe3\x7d\xb3\xf9\xd9\xec\x14\xa0\x5b\x9b\x21\x71\xf1\xa2\x00\x0f\xf0\x83\xb4\x07\x83\xc4\x0f\xd7\xd6\x6e\x7e\xaa\x72\xce\x10\xc7\x12\x8c\xfb\xbe\xd6\x75\x16\x1e\x63\x0c\x4d\xa5\x91\x24\x0a\x51\x2c\x60\x8d\xbb\x35\xae\xb7\xbb\x0b\x7a\x9d\x94\x76\xe2\xa1\x8c\x41\x28\x3e\x1f\xcf\x38\x03\x6a\x72\x84\xb4\x88\x50\xf4\x92\x20\xf7\xbe\xb8\x10\xa5\x44\xbf\xd7\xc3\xa4\x2c\xd1\xcb\xf3\xbd\x8f\x4d\x64\x8c\xcd\x93\x03\xeb\xfe\xfd\xbc\x28\xe6\x02\xb2\x44\xae\x13\x74\xb8\x71\xb6\x6e\xbc\x5c\x2e\xef\x59\x20\x8a\x14\xb4\xb5\xd0\x3a\x94\xa6\xbc\x38\xc0\x03\xba\x78\x5e\xac\xbc\x54\x92\xf1\x2d\x57\x52\x0a\x70\xce\xe6\x5d\x06\xe5\xb4\x5d\x6f\x80\x0e\x03\xc6\xa3\x1a\x69\x16\xf9\x44\xc6\xde\xa9\x09\xc0\xc8\x03\xdb\xac\xee\x4f\x45\x02\x71\x2c\xe7\x6a\xc9\x4d\x6d\x60\x47\x35\x6c\xb7\x53\x18\xef\xa6\x97\x65\xee\x59\x95\x97\xec\x6a\x4a\x29\x1c\x1d\x1f\xe3\x6f\x7f\xfd\x2b\x7a\x79\x3e\x8f\x63\x62\x7b\x3c\x59\x20\x53\x74\xe9\xd6\x6e\x08\xc2\x49\x9a\xa0\x6a\xbd\xb0\x83\xd1\xe6\xc6\x10\x71\x42\x97\xf0\x5a\xe9\x7a\xf8\x75\x3c\xfb\x6b\x71\xac\xf0\xdd\xab\x77\x28\x8a\x0c\x1f\xfe\xf8\x82\x36\x68\xe2\xbd\x4c\xc0\x9b\xbb\x7c\x9d\xf2\xf0\xf5\x53\x37\xcd\x55\xb7\xf8\xec\x1a\xee\x2d\x83\x73\x84\xf1\x70\xfd\x71\x8a\xb3\x72\x14\xd1\xb9\xc1\x74\xf7\x2e\x42\x9a\x45\x2b\x25\x9b\x9c\x73\x37\xaa\xf5\x8d\x71\x70\xd6\x01\x01\xf0\xae\x7c\xb7\x59\x96\x01\x9c\xa1\xd5\x2d\xb6\xf1\x6b\xe7\xc0\xc6\xf9\xd5\xd7\xb2\x4b\x60\xda\xc4\x75\x14\x42\xa0\xe8\x15\xf8\xe6\x9b\x77\xdd\x07\xd8\xdb\xbe\x60\x68\x6d\xfc\xfb\x2e\x01\x54\x06\x20\x4a\xe2\xc5\x18\x0e\x40\x0e\xba\x6e\x3a\xf6\x19\xfa\x69\x5f\x2c\xe0\x2d\x3f\x75\x05\x06\x47\x29\xea\xca\x77\x32\x98\xbb\x54\x46\x16\x6c\x2d\xb0\xeb\x54\x37\x67\xc9\x82\x38\x96\xa8\x4a\x7d\xb7\x84\x3c\xf3\xc3\x81\x18\xb0\x5c\xa3\x8e\x6e\xff\x28\x63\x1c\x54\x44\xa1\x8f\xf2\x1a\xcb\x7b\xf3\xfa\x35\x92\x98\xa3\xdf\xdf\x5c\x4c\xc0\x5a\x07\xeb\x2c\xae\xe7\x63\x39\xe7\x88\x12\x85\xf1\xa4\x82\xd6\x1a\x49\xb2\xbe\x30\x2b\x63\x1c\xd3\xd1\x10\x79\x9e\x03\xd7\x41\x6d\xbe\x66\xd4\xa5\x62\xeb\x32\x33\x6e\x01\xc8\xed\x1c\x54\x09\x0e\xcd\x3d\x0e\x34\x0a\x80\xf7\xc4\x8c\x73\x86\x2c\x8f\xc0\xbb\x12\x14\x22\x87\xad\x53\xab\x5d\x44\x40\x66\xf2\x00\x00\x20\x00\x49\x44\x41\x54\xef\x97\x94\xc2\x0f\xda\xce\xd4\xbc\x68\xb9\xa9\x57\xc7\x55\xa6\x93\x16\x49\x7a\xb7\xfe\x1d\xdd\x71\xdc\xb7\x8d\xe9\x86\x7a\x87\x45\x38\x0f\x67\xc4\x31\xfa\x83\x3e\x4c\x5b\x21\x2f\x72\xc0\x6e\x11\xc7\x63\xb7\x50\x43\x2e\x20\xa5\x44\xd3\xda\x8d\x7a\x63\x3d\xeb\x2c\xf0\xf9\xf3\x27\xe4\xfd\x01\x60\x9b\xdb\xd7\xcf\x46\xea\x0f\xb7\x2d\x07\x86\x94\x7b\xd5\x5b\x14\xde\xf7\xf5\xd0\x9d\x12\x43\xb7\x80\xd4\xd0\x43\x77\x4b\x4b\xd7\xd0\xdd\xdd\x8d\x48\x37\x08\x23\x1d\xd2\x48\x2a\x9d\x03\x48\x8b\x74\x33\x94\x74\x77\xbc\xcf\xe7\xfb\x17\xbc\xbf\x9b\x7d\x75\xce\xb3\xd7\x5e\x3b\xd6\x3e\x17\x47\x23\xc3\x43\x7d\xf1\xa2\x74\xc6\x10\xe3\x7b\xac\xeb\x1c\x02\x9f\x25\x9e\x16\x46\x69\xa5\xf4\x1b\x8b\xd4\x7a\x3c\x1d\x72\xcf\xc7\x2c\xf4\x56\xf9\x36\x14\xd0\xb7\xd6\xb1\xbb\x0d\x8e\xb7\xba\x7f\xed\x42\x11\x26\xd6\x16\xd2\x2c\x1b\x88\x60\xa4\x34\xd5\x50\xe8\xd1\xfb\x7c\xae\xd7\xfb\x52\xa4\x5a\x62\xc7\x0e\x12\x69\x25\x6d\x28\xde\xb0\x6f\xe8\xd7\x40\x70\x27\xd9\x1d\x46\x26\x24\x7c\x6b\xcd\x61\x92\x20\x17\x26\x29\xb8\xe7\x42\xd5\x25\x62\x76\xd4\xfb\xd5\x75\x26\xce\x42\x68\x61\x94\x81\x9e\xaf\x25\x79\x68\x51\x0d\x72\x75\xdb\xde\x95\x77\x30\x27\x80\xba\x63\xd7\x25\xab\xec\x5b\xde\xa1\x46\x12\xcf\xfc\x6c\xf0\xa3\x73\xa9\x69\xc5\x87\xb2\x0c\x9f\x70\x9e\x10\xda\xb2\x99\x70\x56\x59\x90\x49\x1b\x8d\x6c\x99\x37\x56\xa9\xc2\x9a\x52\x4a\x56\x5e\x82\x55\xd0\xd2\xf2\x84\xfa\xde\x2c\xae\xc4\x1b\xd0\x09\xf0\x6b\xf3\xc3\x6b\xe7\xbf\x5a\xcc\xf5\xcc\x1b\xea\x05\x86\xf1\xc5\xe3\x0f\x11\x6a\xee\xf0\xf6\xb4\xb7\x00\x93\x1d\x24\x3a\x74\x45\x36\xe8\x05\xa6\x0e\xcd\x9d\xf5\x96\x84\x22\x7e\xa2\x9c\x73\x66\xe6\xfe\xcb\xcd\x1e\xd2\xc8\x74\x16\x14\x3d\xf8\xc7\x90\xcf\x8d\xeb\xbd\xe4\x33\xbb\x15\x64\xcc\x3b\x51\x92\xf8\x19\x4f\x4e\x13\x19\x94\x74\x15\x4f\xa0\x66\xd6\x85\xe8\xd5\x21\xd2\x44\x2f\x31\x96\xe3\xde\xea\xfe\x2b\x1e\x1f\xf1\x6c\xbd\x6b\x80\xa6\x20\x64\xb8\xe0\xf1\xe5\x05\x89\xda\x32\x45\xba\x77\xb1\xf7\x16\x69\xd3\xc4\x12\xd7\x7a\xc9\x67\xd9\x03\x37\xc1\x55\xd7\x46\x52\x76\x24\x1e\xe8\xcf\x75\x0a\x2c\x8e\x71\x09\xde\xa8\xe1\x16\x9f\x3e\xf1\xde\x6b\x75\x7c\xee\x19\x13\xfb\x86\x73\xd3\xfd\xea\x08\x03\x2e\xcf\xf7\x1b\x44\xa4\xa8\x13\x9d\xc7\x6a\x08\xa2\xca\x1b\x35\xd7\x46\xbb\x9c\x74\xbe\x77\x9f\x37\x33\xbe\x4e\x4f\x6c\x97\xdb\x06\x47\xa4\x60\x16\xf4\x18\x6f\x5e\xdf\x54\xbf\xb4\xa7\x46\x25\xf2\xf6\x34\x1d\x5f\x64\xab\x03\x70\x67\x8e\x56\xbe\x7d\xa6\xeb\x24\x00\xb8\xba\xf0\x92\x08\xd0\xec\x35\x59\x21\x69\x32\x2f\x84\xac\x6c\xd0\x01\xd2\xdd\x91\xd6\x0c\x37\x1a\x4e\x82\x96\x46\xeb\x3e\x10\x8b\x7b\x79\x91\x6e\x3e\xd0\x5b\x7d\xce\xfb\x42\x8c\x6a\x96\xc1\x9e\xaf\xef\x1f\xc7\x8f\x5b\x2b\x60\xe7\x88\x55\xc2\xe1\x34\x1c\x57\xdf\xbc\xcb\x2d\xad\xe3\x30\xf8\x45\x16\xea\xf4\xde\x2f\xc5\x56\x08\x56\x82\xf5\x69\x96\xf5\x0f\x23\x82\x4f\xa2\x2b\x81\x14\xe6\xd4\x41\x1b\x01\xcb\x26\xb4\xe4\xe0\x66\x46\x80\xa3\xb8\x6b\xac\xc7\x97\xd0\x82\x2e\x86\x14\x80\x47\x45\x6b\x0c\xcd\xf4\x0c\xfe\x75\x74\xec\xf8\xe3\xfb\x27\x37\x54\x32\x25\xf4\x2d\x15\x79\x12\x3c\x55\x18\xb0\xca\x5e\x44\x4f\xe1\xeb\xaf\xdc\x27\x5a\xfc\x6e\x9d\x04\x2e\xa3\xa9\x6c\xd5\xa6\x85\x90\xfd\xea\xfb\x8b\x03\xb8\x9f\x2c\x72\x2d\xd5\xb2\xda\x95\xab\x00\x76\xb0\xcf\x96\xa0\x01\x37\x52\xdf\x67\x8c\x62\xbf\xa6\xe9\x01\xc8\xb4\x82\x81\x9a\x9b\x3b\xb7\xec\xa3\x4e\x23\x8d\xbe\xb9\x13\x27\x72\x3b\xce\x26\x93\x3d\xa6\x33\x76\xb1\xe2\x49\xd7\x59\x22\x16\x02\x1d\xe2\x26\x4a\x2f\x92\x86\x9a\xa1\xbe\xaf\xf6\xae\x15\x6c\xa7\x78\x91\x99\xc6\xa6\xbf\x40\xda\x4d\x42\xa4\xb8\x53\x48\xe1\x16\x62\xe8\xdd\xfc\xec\x1b\xd5\x61\x9d\xb5\xc6\x90\xbc\xd6\x9c\x28\x35\x0b\x04\xab\x2c\x2c\x52\xce\x4b\xda\xd3\xa4\x6c\x10\x4d\x0b\xed\x8f\x34\x62\xdf\x78\x79\x85\x31\xf6\xf9\x40\x2a\x4b\x23\x78\x61\x34\xc6\x4f\xb2\x9d\x9c\x6a\x4e\x09\xe1\x2d\xa7\x4b\x38\xc6\x78\x61\x6d\x08\x25\xc0\x64\x72\xbe\xc4\x6e\x54\x9f\xee\xae\x8f\xca\x49\x9d\x79\x33\x87\xd1\xa0\xc5\x88\xb1\x39\x55\xba\x8e\x44\x2d\x2a\x2b\x85\x55\x93\xd5\x8f\x6c\xa6\x07\x64\xeb\x70\x56\xc9\x3a\x65\x89\xac\x3f\x42\x6f\xd8\xb6\xde\x8e\x0b\x4b\x97\xed\xe3\xbe\x2b\xb7\xbb\x2c\x71\x45\x2b\x23\x78\x43\xeb\x9f\xe3\xd0\xf7\x4d\x02\x8b\x57\x0e\xb1\xf6\x8c\x0b\xb9\x49\xf0\x29\x41\x36\xa1\x19\x50\x90\x6b\x5e\xf3\x3c\x6a\x6f\xe0\x97\x30\xdb\xda\x81\x83\xe2\x08\x97\x7a\xdd\xd6\x77\xa9\x68\xed\x52\xc7\x37\x57\x75\x13\xb5\xb4\x97\xee\x25\x2f\xd7\x13\x9c\x8a\xcd\xb3\x1e\x90\xa4\xf4\x0e\xac\xd8\x60\xfd\x78\x04\xaf\xc0\xa3\x54\x52\x92\x45\x13\x42\xc7\x6c\x5b\x55\xd9\x86\x18\x0d\x1d\x8e\x65\x9b\x2c\xbf\x42\xb6\x65\xf4\x05\x14\x42\x70\x4f\x82\xd1\x3a\x46\x98\xac\xd1\xd1\x8a\x12\x6b\xf0\x97\x38\x4f\x8d\x40\x40\xe6\xd2\x9b\x13\xfc\x0f\x0f\x2a\xbe\x25\xcb\x11\xc9\x30\x1a\x38\x27\xe3\x3a\x7a\x46\xb6\xaf\x36\xd8\x3d\x6c\xa1\x15\x15\x6e\xbe\x86\xb3\x7a\x11\x1e\x42\x1f\x91\xd6\x3d\x4e\xa7\xf6\x37\x51\x7b\x5a\xe8\x30\xe7\x8b\x2f\x96\x0f\xbf\x2d\x55\x5c\x08\xe2\x3e\xe5\xa1\x83\xf7\x9a\xae\xe9\xa0\xca\xcf\xbe\x5d\xe3\xf8\x95\xd6\x38\xcf\xbb\xfd\x3f\xc2\xfb\x85\x46\x66\xb6\x76\x09\x79\x0b\x94\xeb\x07\x64\xa7\x40\x5e\x52\x6c\x5a\x09\x5f\xab\xac\xc5\x2f\xc2\xe6\xc8\xb0\x60\x0e\xf3\xd9\xfb\xa5\xae\xb6\x48\xea\x15\xe1\x27\x96\x32\xd9\x15\x72\xb7\xd3\x94\xd2\x7f\x2e\x35\x71\x08\x6a\x46\x29\x48\xb2\xcd\x2f\x00\xc6\x5a\x9c\x91\x83\x3a\x3d\xdf\xd0\x0d\x8a\x82\xa6\x29\x80\x22\x17\xee\xb9\x8d\xc1\x27\x18\xd9\x1f\xd1\xdc\x64\x13\xe8\x5c\xb5\x89\xb9\xd7\x24\x6f\x7b\xa3\x53\x43\x3d\x23\x69\xea\x5b\x27\xbb\xb6\x09\xda\x90\xc7\x2d\x53\x99\x7e\x6d\x40\x7f\x56\x13\x9e\x6f\xef\xa2\xea\xdc\x1c\xec\x6b\x6b\x7c\xcf\xaf\xa4\x2e\x8e\x5f\x2c\xfb\xe8\xfe\x04\x74\x5c\xdb\x14\xc0\x26\x74\xa7\xa4\x27\xa1\x1e\xdf\xa1\x03\x74\x64\xce\x56\xb6\x1a\x12\xe9\x5e\xfd\xf8\x46\x27\xf7\x20\xbe\xc5\xa5\xe8\x9d\x34\x19\xb7\x6e\xfe\xab\x3b\x03\xd3\x2f\xc9\x66\x93\x17\xb7\x6b\x46\x85\xce\x77\xf0\x79\x42\x29\xe5\x2f\x07\xbd\x0c\x74\x91\x62\x5a\xbd\x6c\x71\xc0\x1c\xbc\xc9\x6e\x8a\x8c\xdc\xe5\xc2\x0f\x46\x7c\x89\x6b\xc9\x1f\xdc\xf4\x4e\xd3\xac\x53\xcf\x66\x89\x29\x5d\x12\xb1\x1d\xb2\xaa\x89\xd7\x3f\xe9\xbd\x6f\xda\xb9\x53\xb5\x2c\x2a\x7e\x09\x22\xde\x69\x77\x72\xa8\xd6\x54\xbb\xe9\x6c\xb2\x0a\x79\x27\x18\x57\x69\x7f\xbb\x11\x7d\xff\x35\xe7\xee\x43\xbd\xa7\x64\x03\xd3\xf1\xfc\x49\x4a\xb4\xae\x66\x94\x83\x70\xc4\x3c\xb9\xc3\x02\xab\xc5\xaa\xed\xe8\x5a\x16\x75\x98\xd6\xef\x05\x78\xf0\x2b\x95\xeb\x46\xd7\xca\x51\xee\xbe\x49\xad\x4d\xa1\x1f\x17\xfe\x79\xb1\xfe\x7a\x7b\xe5\x32\x2c\xe0\xfd\xd0\x26\x3c\xd0\x44\xee\x4d\xbc\x37\x69\xc4\x17\xb2\x33\x64\x6d\xdb\x8b\xfd\x31\xdb\xf9\x6f\xa6\x32\xeb\xb7\x8e\xfe\xfb\x35\xd1\x54\xde\x98\xf6\x3f\x2c\x01\x17\xd4\xc6\xba\xfd\x43\x06\x5f\x7c\x04\xa6\x4a\x9e\x34\xce\x04\x66\x33\x81\xab\x50\x25\xf7\xfa\xfd\xe1\x5f\x53\x6e\xd5\xfa\xb4\x71\x3b\x01\x53\x99\x59\x53\xef\xd6\x75\x98\x7e\xb2\x60\x62\x4f\x4d\x81\x58\x55\x28\xf1\x50\x45\x48\x5f\x61\x3f\x22\x18\xba\xf9\x01\x0a\x9a\xc8\xf4\xa8\x3c\x27\xaf\x69\x1f\x65\x53\x22\x18\xe2\x3e\xe1\xe3\xfb\x7d\x09\xf3\xa5\x3a\xf8\xad\x14\x7f\xf1\xbd\x9b\xd0\xe8\x92\xd1\x5a\x02\x2b\xc9\x33\xa6\x33\x36\xd5\x26\xfb\xe3\x84\x37\xb6\x87\x7d\x28\x3b\xc1\x36\xbb\xa1\x18\x51\x9e\xdd\x83\xb4\x0d\x36\x6c\xb1\xeb\xef\x36\xd9\x09\x42\x7b\x68\x46\xbf\x03\x5a\xa5\x1f\xda\x66\xb7\xa8\xd5\xbc\x29\x53\xfb\x22\xe5\xa2\x4b\xd8\x0e\x6f\xbc\xcb\x52\xed\xc3\x86\xeb\xd7\x8c\x0b\x4f\x87\xf0\x76\x97\xf0\x12\x13\xd2\x74\x2e\x79\x5b\x97\xa7\xa7\xc4\x62\xa0\x5c\xfd\xe7\xc6\x9a\x14\xdb\xa5\x10\x20\x19\x01\x32\x9c\xa5\x11\xd9\x38\x2e\x4c\x91\x8b\xf8\x1c\xdd\xb4\xf6\x27\xb4\xd5\x31\xde\xd7\x85\x44\x90\x20\x56\xfb\x0b\x63\xd7\x57\x64\xfb\x2a\xb5\x0c\x88\x81\x02\x5a\x03\x09\x1b\x1c\x5a\x9b\x59\xc9\x07\xab\xb3\x8a\x6f\x43\x4c\xba\x78\xe9\xd1\xf2\xd8\x60\xa0\xdc\xb8\xc9\x8c\xcb\xbb\xf6\xd2\x33\x42\x03\xf9\xde\x53\xd2\x45\x9d\xd5\x7a\xbb\xdc\xfd\x69\xe3\xbc\xd8\x55\xb4\x58\x91\xd2\xd4\x2c\x4d\x29\xfb\x8a\xd6\x49\x8f\x76\xfb\x49\x66\x14\x1c\x69\x85\x38\x78\xcb\xdd\xff\x61\x31\x1d\x59\xd4\x96\xf2\x50\xeb\x2c\x18\xfc\x63\x13\xdb\x80\x80\x41\xa9\xc4\x88\x2c\x93\xc1\x0c\xd2\xf1\xb1\x6f\x5e\xa8\x7f\xd3\x6e\x82\x7a\xc6\x3f\x68\xef\x34\x73\x5c\x78\x28\x53\x15\xa1\x1e\xda\x9c\xa3\x16\xdc\x2f\x8f\x1e\x03\x4f\x77\xe2\x87\xaf\x5e\x55\x36\x3b\xa8\x8d\xec\x76\xae\x9f\xe0\x89\xb7\x15\xd5\xcc\x36\xea\x93\x9d\x14\xc6\xc2\x84\xd0\x48\x09\xbd\xbb\x5c\x11\x10\x92\xaa\xaa\x0a\x55\x23\x5f\x37\x17\x6d\xc3\x23\xa9\x06\xa9\x4f\xd2\xe7\x96\x4f\xc5\xca\xa5\x64\xc1\xc1\xda\xb4\x13\xf4\x67\x0e\xeb\x5a\x9f\x1c\x13\x8d\x1e\x96\xa4\x41\xb2\x17\x01\x73\xe6\x4e\x1c\x1f\xaf\xab\x38\xf2\x7e\xd5\xd7\x39\x5e\x5c\xb2\xff\x11\xea\xb7\xd3\x37\x23\x03\x7a\x99\x99\x7f\x46\x22\xe8\x4c\xd2\x91\x83\x24\x9c\xad\x3b\x2a\x47\x29\x3e\x3c\x0c\x77\x88\xd2\xb0\xee\x06\xd1\x4e\xd7\x4d\x9d\x5c\x2e\x11\x00\xd1\xe7\x73\x22\x71\x18\xf0\x6e\x47\xc8\xf2\xf1\x83\xde\xfe\x76\xb3\x21\xd8\x0b\x47\x13\xd1\x09\x95\x6e\x36\x64\xd1\x22\x47\x66\x84\x1c\xa8\xad\x70\x4e\x3d\x7f\x60\x6a\x38\xa5\x1b\x07\x46\xa6\x20\x42\x6c\xa2\x55\x3a\x0c\x86\x87\x9c\x04\x06\x44\x1f\x96\xc3\xf7\x0e\x8a\xa5\x4d\x07\xd7\x32\xda\x06\x84\x15\x9a\x41\x7c\xcf\x6e\x47\x92\x61\x35\xff\xca\x63\x2e\x4c\xf0\x51\xb5\xd0\xd1\xdf\xf7\x2e\xde\x26\x95\x1c\xfc\xd6\x36\x74\xc6\x3f\x9b\x46\xf0\x30\xf8\xab\x06\xa9\x7b\x97\x43\x8b\xe6\x58\x9b\x9d\x80\x77\xdb\x08\x50\xff\x70\xaa\x22\x73\xab\x9f\x33\x06\x04\x51\xce\x4f\xd7\x9e\x90\x6f\x88\xe0\x4a\x23\xc3\x66\x54\x33\x8b\xbb\x04\x2f\xcd\xb6\x27\xd7\x28\x12\xdb\x7f\xfd\xb2\x98\x78\x68\x6e\x42\x46\x3e\x9e\xd3\x4f\xe1\x8f\xda\x92\x8b\xee\x8c\x4d\xd9\xbd\xe8\x43\xf3\x9f\xd5\x22\x1f\xf6\xa1\x4e\xac\x2c\x46\x6f\xbf\xa8\x75\x09\x52\x70\xfd\xe6\x8e\x6a\x89\x93\xb3\x66\x5a\x04\xf3\x0d\x43\x3f\x68\x95\xab\xf9\x3b\x5f\x77\x3b\xae\x53\xc0\xb4\x05\xdc\x77\x43\xbf\xb2\x37\xab\x51\x19\x03\xae\xbc\x01\x07\x38\x75\x05\x8c\x9e\x79\x6b\x85\xa7\xa2\x80\xcd\xad\x0d\xf3\xb5\x4c\x47\xdd\x06\xc7\xb6\xde\xdb\x16\x3a\xb0\x2b\xd4\x4d\x25\xdc\x16\xd0\xad\xeb\xcc\x67\xab\x04\x73\x1e\x17\x6e\xb2\x39\x36\x93\x5d\x93\x55\x11\xd4\xd6\x66\x99\xb0\x1f\x9c\x1b\x0d\x03\xb6\x2c\x65\x41\x62\x1b\x71\x95\x90\xa3\xa0\x3b\x98\x30\xd4\x0c\x5b\x53\xf0\xe6\x96\x22\xc6\x13\x78\xa4\x31\xcf\xe1\x16\x2c\xbc\x8c\xba\x07\x1d\x68\xae\x9a\x6e\x25\x87\xac\x3d\x2e\xba\x55\x19\x36\xd8\x0e\x9e\xdb\xd4\x59\xce\xe6\x74\x09\xcc\x73\x05\xd6\xdf\xe4\x6d\x2f\x95\x8f\x34\xc4\x6d\x22\x00\x6a\x7c\x24\x9b\x5f\x57\x6c\xfc\x91\x9d\x9d\x98\xe5\xa6\x5c\xc2\x9c\x0c\x1c\x80\x27\x1a\xd7\x70\xb6\xa5\x95\xaf\xed\x57\x35\xc9\xf3\x78\xc2\x7a\xdd\x99\x4e\x53\x7b\x3e\xc7\x78\x3a\x5a\x45\x83\xd2\x58\xeb\xce\x1f\x38\x1c\x7f\x28\x1e\x2a\x87\x8d\xab\x15\xf6\xff\x92\xb0\x3a\x2c\x5f\x62\x95\x54\x02\xe4\xca\x23\x21\x33\xda\x23\xf6\x09\xd5\x70\x4f\x9b\x8b\x9f\x8c\xa1\x74\xb2\x90\x40\xd2\x87\x7d\x74\xf8\x6c\x3f\x3b\xba\x12\xf6\xf5\xa1\x44\x72\x8f\xc1\x4d\x96\xf5\x4b\xf3\x06\xd3\x19\x15\xf2\x15\x80\xc9\xb9\x46\x26\xa1\x93\x62\xd5\x5f\xd5\x0c\xd1\x47\x67\x04\x50\x61\x3f\xfd\x2d\x6c\x2e\xf8\xdd\x9d\x52\x48\xb9\x4b\xb7\xa9\x54\x8d\x7b\xff\x75\x58\xc4\x7f\xc3\x2d\xe4\x76\x61\x5d\x3e\x2b\xde\xab\xce\x88\xf2\x46\x3e\x3a\x86\xa2\x50\xc8\x60\x35\x6a\x63\x2b\xd1\xeb\x66\xbf\xe2\xd3\x82\xd1\x90\x64\x74\xd4\x52\xf7\x51\xee\x49\xc4\x79\x2c\xc3\x32\x30\x77\xff\xf6\x3a\x20\xfd\xa8\xe2\x79\x69\x07\x20\xd9\x44\x10\x9a\xb8\x36\xdd\x8a\xf5\x1b\xbb\xe9\x3b\x42\x3e\x02\x3a\x49\xb1\xfc\xb4\x94\x4a\xbf\x4a\x0b\xcd\xa1\xe1\xf5\xd2\x5c\x8b\x47\x1d\xfc\x76\xcc\xe2\xc5\x9a\x5d\xe6\xc8\x4f\xcd\xb1\xb5\x3f\x0b\x32\x73\xe6\xa6\x9e\x0f\xc1\x8d\xbd\xf9\xa1\x12\x82\xd3\x7c\x64\x38\x60\x10\x91\xd0\xa9\x01\x88\x2c\xe8\x90\x32\x29\xfe\xec\x2e\xd1\xe4\x9b\xca\xda\xf8\xab\x75\x33\x6d\xb1\xb4\xe4\x1b\x20\xd9\x13\xd7\x96\xa3\xaa\x2a\x9a\x17\x51\xde\x08\x28\x92\x27\xf6\x87\x58\xd0\x31\xda\x34\xd4\x5b\xb5\x14\xbf\x33\xcf\x52\x0b\x9e\xbc\x21\xb1\xb9\x73\x8d\xb3\x0d\x5e\xbe\xcf\x4d\xe3\xeb\x73\x1b\x01\x71\xa8\x5c\x3f\x75\x55\xe7\x06\x53\x21\x95\xa1\x14\xa1\xd2\x5f\x86\x46\x73\xe2\x15\xb3\x5c\x70\xeb\x3b\x1f\x1f\x9b\x3d\x06\x72\xd3\xa9\x3c\x59\x20\x24\x73\xd3\x9e\x0d\x6d\x46\x58\xf2\xb8\xdc\xee\x2d\x9f\x16\x4b\x08\xb2\x21\x2d\x5d\x8b\xeb\x86\x52\x46\xfe\xe8\x4e\xf1\x4b\x0f\x6b\xe7\x77\xb1\xb6\xe8\xed\x50\xdf\xd3\x42\xd8\xc5\x85\xcc\xc0\xa4\x29\x3a\xa6\x27\x1a\xf1\xf7\x2d\xc3\x76\x28\xbb\x10\x22\x73\x59\x93\xbc\x2e\xd4\x8b\x77\x7f\xcf\xec\xb4\x72\x7d\x27\xd6\x8a\x88\x2d\x82\x3a\x68\xd4\x6c\x8e\x4c\x14\xec\x1f\x8b\xd4\x38\x46\xd6\xc4\xba\x50\x15\x7b\xdd\x10\x5c\x71\x12\xa5\x6c\xc1\x87\xb9\x52\x3e\xc0\xaa\xf5\xea\x05\x71\x71\x6c\xec\x31\x27\x99\xe4\xd3\x09\x5f\x4a\xfa\x76\xc3\x4e\x4e\x4d\xab\xab\x4e\xf6\x32\xc1\xfa\x0a\xd9\xf4\x0d\xbc\x31\xc3\x64\x51\x09\x45\x19\x83\xa5\x1f\xf6\x37\x7a\x32\x30\x9d\x57\x24\x1d\x91\xca\xac\x24\x2b\x90\x16\x4b\xea\xdd\xae\x99\x45\x4d\xcc\x31\x2d\x3e\xeb\xbf\xb3\x94\xcc\xad\xf2\x57\x1a\x12\x68\xad\x6c\xc4\x80\x63\x6b\x5d\x64\xd2\xaa\xec\x0c\xab\xbb\x58\xa8\x5a\x3e\x97\x2f\xcb\xac\xba\x6b\x4a\x17\xb8\x39\xc3\xf8\x10\x60\xc2\x7f\x33\xbb\x51\xd4\xb2\xc3\x39\x9c\x45\xf1\x6e\xdb\xf5\x46\x7e\x9e\xcf\xc2\x16\x77\xa5\x86\x84\x3e\x67\x64\x47\xa0\x1f\x85\x64\xbb\x13\x49\x29\x7f\xf1\x3d\x33\x35\x07\x77\x4a\xae\x08\x18\x05\xbd\x21\x6a\xb1\xf2\x79\xc3\x97\xd6\x8e\x67\x3c\x62\x2e\x7e\xf6\x59\xad\x16\xdb\x63\x27\xdc\xb0\x7b\xad\x6b\x8b\x3e\xd1\xf5\x1a\x48\x2a\xf9\x93\xc1\x19\xa5\xb3\x98\x58\xaa\x09\xe7\x28\x91\x23\xbb\xc0\xbd\x57\xfd\x08\xa8\xf6\x24\x45\x0b\xea\x1a\xd7\xf4\x8a\xa3\xbe\x6e\xe9\x9d\xc7\x9f\x7a\xf2\xca\x5b\x26\x9e\xbe\x73\xf0\xcd\x81\x8d\xea\xeb\xdc\x66\xfb\xb5\x79\x49\xf1\xbb\x68\x8c\x12\x16\x7e\x4c\x8a\x19\xa5\xce\xc2\xea\x32\xe5\xb4\xe2\xd4\xbe\x6f\xf2\xd0\xc2\x48\x33\xe7\xed\x55\x6f\xed\x38\x1e\x5f\x28\x0d\x6a\x40\xc0\xeb\x3e\xb2\xa2\xba\xa3\xda\x7c\xb3\xfc\x5d\x10\xcc\xdc\x30\x4b\xdc\x68\x2c\xc8\x57\x63\x16\x88\x4f\x22\x86\x2e\xad\x29\xbb\x56\x66\x1a\x82\x4b\x8a\xfd\x11\xdd\xeb\x10\x17\x40\x70\xc9\x17\xcd\x8f\xea\xbd\x86\x6c\x15\x50\x19\x36\x0c\x1c\xd8\x64\x94\x71\x4d\xa1\x9d\x20\x8a\xc2\xff\x16\x87\x61\xf4\x7b\x3b\xcb\x1d\x97\x0e\x98\xc6\x27\xfd\xc6\x12\x9d\x91\x37\xf7\xc8\x0d\xf0\x2e\x13\x7c\xcf\x2e\x7d\x39\x49\x5c\x5e\x76\xe7\x20\x40\x39\x32\xde\x7b\xda\x72\x77\xf9\x5b\x51\x09\xd6\x2b\x84\xc6\xd1\xc5\xbc\xc1\x9c\x40\xa5\xa2\x4d\xf9\xab\xce\x65\xa2\xa7\x52\xdd\x6a\x5a\xf4\x7d\xab\x4b\x4f\xcb\x0e\x62\x04\x56\x2f\x41\x9c\xa8\xd4\x8a\x80\xd1\x5c\xc0\xed\x93\x98\x1b\x1d\x77\xfe\xc7\x7d\xbb\xa3\x68\xc2\xba\x26\xfb\xfa\x98\x26\xfd\x86\x91\x9f\x77\x5a\xc8\x77\xe4\xc1\x3a\x73\xf7\xde\x64\x0a\x26\xac\xea\xb1\xf0\x2e\xfd\xde\x2b\xe6\x4e\x34\x2c\x4b\x4a\xf9\x81\x43\xb5\x06\x3a\x85\xd8\x0e\xba\x15\x27\x7b\xee\x9a\x8d\x58\x77\xf6\x19\x09\x65\x82\x61\x8b\x17\xed\xfc\x87\x2f\x76\x79\x59\x02\xc6\xd9\x25\x66\xe4\xe9\x46\x57\x5a\xd0\x13\x65\x95\xd3\x70\xeb\xa9\xdf\x7a\x8d\xec\xed\x24\x79\xc6\x0a\xbc\xae\x6d\xeb\x6f\x2c\xca\xd3\xb9\x4b\xbc\x3d\x19\x6b\x05\x89\xc3\x1f\x3d\x5b\xfc\xbf\x50\x0b\x5d\xc7\x51\xee\xef\xdb\x36\xcc\x3b\x05\xa9\x2a\xb3\xca\x6a\x26\xb4\xf2\xd3\x1e\xd5\xc7\x73\xfb\x44\x8e\x22\xfc\xe4\xb6\xca\xeb\x5b\x58\x53\x3a\x35\x22\x1a\x6b\xbc\xf0\xd4\xa7\x2d\xa4\x8f\x2e\xb7\x97\x16\x5b\x57\x41\x15\x3d\x53\x36\x48\x98\x64\x03\xfb\x06\x32\x47\x79\xe2\x2a\x7f\xf1\x4f\xb3\x9c\x43\xcd\xe7\xcd\x32\x68\xf5\x88\xa4\x12\xd2\x66\x59\x3a\x90\xf2\x64\x6f\x35\x66\xdf\x2a\xc8\xfb\x95\x7d\x0a\xa9\x35\xe9\x46\x96\x75\x8b\x61\xd7\x66\x09\xe0\x4e\x72\x21\x60\xc6\xb7\xf0\xd7\xe4\xcf\x8b\xbc\xbf\x4d\xc9\xb6\xae\xcc\x2e\x29\xe0\xee\x8c\x76\xdc\x81\xfa\xdb\x73\x2d\xdf\xdf\xa6\xec\x91\x1d\x7a\xc4\x79\x08\xaf\x1d\x3e\x06\x74\x51\x03\xf7\xed\x5a\x6e\x6b\x1d\xe3\x4a\x0e\xe4\xd8\x84\x7c\x05\xe9\x88\x22\x3c\xaf\xe3\x28\xdd\x6b\xda\xf5\xc0\x94\x78\x8f\x76\x2b\xa8\xaa\xef\x56\x97\x49\xb1\xfc\x76\xd0\xd3\xcd\x71\x8e\x4e\x7f\xd7\x05\xf8\x97\x16\xd3\xc8\xfc\xe6\xb7\xb0\x76\x22\x60\xb1\xe7\x64\xed\xa1\x3b\x59\xc2\x3f\xbf\x5f\xc6\xec\xdf\x2b\x52\x79\xd7\xde\x7c\x3c\x6a\x1f\x03\xd7\x13\x8a\x0b\x89\x60\x4f\x76\x39\x09\x32\x10\xaa\x4b\xf5\x3e\xb1\x16\xd1\xe8\x64\xd1\x8a\x4d\xb3\x33\x8d\x6f\xf3\xfb\xea\xce\xe8\xfb\x7b\xbd\x27\xf4\x0d\x57\xe2\x75\x57\xc1\x0c\xaf\xad\xa8\xcc\x1e\x95\x1e\x75\xcd\x5b\x25\x2c\x0c\x4c\xa8\x3f\x77\xa9\xb2\x36\xc4\x0b\x62\xfc\x21\x9d\xef\xcd\x11\x36\x6f\x31\x3d\x36\x23\x90\xc8\xe5\x84\x7b\x7b\x09\x48\x8e\x75\x73\x37\x2d\xb2\x3f\xd6\x42\x1e\xbb\xf4\xfb\x42\xb2\xca\x1f\xd3\xba\x69\x81\xdf\xb0\xc5\x8e\x80\x48\xea\xe4\x99\xd8\x8d\xf3\xdc\xee\xbd\x04\x13\x0d\x6f\xd8\x8b\xc9\x8e\x90\x91\xb3\xc8\xaf\x31\x78\xa6\x84\x05\xbd\x35\x6a\x51\x2a\x91\x6c\xed\xe8\xc4\x80\x35\xaf\x02\xba\x3a\xa4\xac\xd7\xcc\xf7\x3a\x84\xd6\xe5\x34\x7b\x85\xa6\x6a\x96\xfa\x19\x12\xb7\xa9\x50\x0f\x81\xf1\xb3\x0b\xf5\x5b\x50\x19\x1d\x1d\x8a\xa6\x4e\x70\x12\xc0\x02\x74\xca\xa3\x3d\x76\x7f\xf9\xfd\xc3\x19\xcf\xef\xe6\xcd\xf5\xc6\x31\x65\xab\xef\x2c\xd2\x59\x43\x20\xc3\x0d\x47\x9e\xc3\xb2\x45\x38\xe7\x2d\xdd\xe6\x76\x4d\xc4\xfc\x9e\x1a\xea\x1e\x25\xdd\x18\x03\xd7\x43\xf3\xd0\xd3\x0a\xbf\x8e\xf2\x88\x50\xbd\x08\xb3\x10\xea\xf0\xbf\xbd\x32\x56\x93\xb6\xa1\xdf\x41\xf4\xdc\x40\x67\x82\x5a\x6e\x78\xff\xf2\x0d\x3c\x18\xbb\xbb\x9c\x5b\x8d\xf1\xb8\xa5\x7f\xed\x6b\x04\x5f\xc1\x30\xd9\xd2\x32\x37\x37\xac\xf3\xcf\x67\x93\x72\x1a\x45\x64\x05\x41\x46\x45\xc1\xc6\x92\x3e\x6e\xd8\xbe\x25\xd8\xb6\xaf\x0c\xb1\x7d\x3a\x26\x6e\xf0\x56\x5f\xd9\xf4\xf1\x75\xe5\xce\x95\x9b\xd2\xb4\x70\x81\x4d\xe0\xa8\x70\x8b\x72\x30\x27\xfb\x6f\x01\x31\x31\x6f\x4c\x31\x8d\xa5\x9f\xe6\xc6\xaf\x3d\xdd\x60\xd9\xd4\xb2\x42\x4e\x25\x06\x59\x0d\xa9\x10\x6e\x6c\x57\x1e\x97\xf6\x07\x6c\x5f\xb6\x84\xc1\x1f\x92\x59\x95\xb5\x8f\xbf\xb9\x1c\x2f\xea\xba\xf5\x59\x4f\xe3\xf5\xf7\x03\xfc\xa3\x5e\x35\x63\x16\xce\x30\x3b\xfd\x17\x8c\xb0\x12\xb2\xfc\x66\xd8\x5e\xe9\x6b\xed\xe6\x0c\x91\x29\x5a\xfb\xba\x2c\x38\xed\x99\x90\xef\x4c\xde\xa5\x86\x69\x21\x6a\x7d\xf1\xb7\xb0\x7b\xff\xbd\x61\xf4\xe9\x8f\x70\xa8\xfd\x42\xba\x81\x3c\x10\x3b\xa9\xc3\x07\x14\x11\x07\x4e\x52\xd7\x1a\x31\xf9\xdb\x40\x86\xd4\x0c\x3b\xd2\x1b\x66\xa9\x98\xad\x25\x44\x91\x33\x85\x08\xd7\x6a\x38\x8a\x91\xbe\x8b\x7d\xf7\x25\xe4\xcc\xa7\x8c\xbc\xcd\x81\xdf\xeb\xc6\x0f\x7e\x81\xee\xbc\x82\x1a\x15\xc0\x1a\x26\xf8\x8d\x2f\x47\x2d\xdb\x5b\xef\x9e\x6e\xca\xbd\xd8\x05\xf9\x4f\xf6\x30\xfc\xe0\xb7\xf4\xf5\xe9\x89\x74\x30\x91\xad\xe9\xad\xc9\x85\x5a\xff\xe7\x14\x1b\x82\x6d\xd9\xc7\x00\xd0\x71\x63\xcf\xe2\x23\x4b\x63\x92\xa3\xcb\xf1\xf2\x29\xa5\xad\x13\x7c\xd4\x4e\xf6\xe7\xd6\xe3\xfa\x6d\xb7\x74\x43\x59\xf6\xc7\x8e\x72\xfb\x0e\x99\x11\xcd\xf8\x9a\xd8\x20\xa7\x0b\x46\x39\xa2\x93\xf0\x97\xe9\x9a\xbc\x81\xfe\x9c\x09\xb8\x1f\x33\xf3\x30\x50\x55\x39\x81\x79\x57\x3a\x9d\x9d\x6e\x94\x49\xcd\xcc\x7a\x84\x07\x83\x49\x28\x4d\xb6\x78\x21\x59\x93\x08\xd3\x9f\xab\x7d\x45\x86\x56\x3a\x7f\xff\xfb\x6f\x56\x2f\x40\x67\xf6\xd6\x9f\xac\x59\x92\xd9\xcc\x5a\x2b\x71\x91\xec\x5d\x41\x93\xa5\x25\xc0\x3f\x6f\xc1\x6b\x8b\xf4\x20\x22\x95\x52\x03\xe4\xda\xe0\x31\xdd\x0c\xc5\x1d\xc7\x24\x76\x63\xeb\xe2\xbc\x99\x76\xca\x92\x45\x43\xec\x64\xbb\x77\xff\xbc\x72\x79\xb9\x7d\x54\x6a\x52\x0e\x0e\xdf\xda\xe6\x23\xb8\x71\x1d\xd9\x9b\x5b\xf3\x09\xe4\xff\xe0\x41\xff\x79\xdd\x8f\x10\xa0\xef\x74\x40\xec\xa8\x48\x7e\x8a\x4c\x54\x1f\x92\x47\x96\x70\x42\x1b\xea\xef\x49\xf2\x86\x66\xd0\x0d\x1d\x07\xdd\x3b\x09\x3c\x6d\x12\x8a\x16\xce\x06\x9a\xc9\xad\x4e\x76\xaf\x4e\xfa\x03\xe4\xb8\x6e\xa7\x5e\x60\x03\x66\x8d\x2c\xd1\x92\x59\x4c\x39\x59\xda\x44\x5d\xeb\xeb\xc3\x94\xc8\xbd\x81\xb2\xba\x0b\xe4\x70\xf3\xae\xa0\x30\xdc\x87\x80\x0e\xbe\xe4\x53\x08\x12\xa1\xa4\xa7\x67\x7c\x53\xf5\x37\x84\x26\xe1\x46\x83\x79\xf6\x38\xc6\xcb\x7e\xc1\xa2\x7d\x7f\xe4\xb2\x67\x08\x34\x18\x67\xd4\xd4\x97\x62\x42\x7f\xae\x31\x71\xbf\x66\x45\xd1\x38\xdd\xf7\xe5\xc7\xa4\x30\xf8\xf2\x42\x62\xed\x26\x90\x1c\xde\x1e\xb1\x6f\xc0\xf6\xb7\x93\xa6\xea\xdd\xf3\x41\x3e\x83\xb7\x5c\xd3\x37\xfd\xf0\x23\x9e\x44\xb6\x39\x4b\xbb\xf7\xf4\x0d\x70\x2b\x01\x98\x79\x0b\x42\xab\xdd\xbd\xd9\x49\xae\xcd\xfd\xe5\x2e\x56\x5c\xf1\xe2\x7d\xcb\x46\xe8\x15\x71\x95\x03\xf6\x11\xe5\x8e\xb7\x9b\xe1\x31\x62\x51\x0b\x3e\x91\x1b\xc9\xd9\x7a\x27\x8c\xab\x27\xb8\xff\xe2\x82\x35\x8d\x11\x57\xf1\x86\x12\x41\xbd\xcd\x87\x69\x71\x42\x59\xa3\x71\xdb\xa5\x4d\x52\xb9\x80\xb6\x61\xef\xd0\x21\x9e\xc1\x4c\xbd\x75\x11\x2a\x41\x61\xc0\xc2\xa4\x90\x65\xd6\x5d\x91\x42\x59\xb9\x7a\x06\x23\x4c\x7b\x02\x48\xfc\x2a\x3b\xdf\x27\x56\x3e\x70\xd0\x1b\xb8\xb1\x9d\xfb\xb7\x54\x1b\x5f\x2c\xed\xfe\x37\x53\x5e\xa7\xb5\x21\x06\xf2\x2d\x6f\x98\xeb\xa1\xb3\xb9\xf4\x9d\x6c\xd7\x6d\xf9\xc2\xdb\xb1\x30\x68\xc7\xb1\x98\xb4\x6f\x98\xa9\xc4\xaf\xed\xab\xb1\x3b\xa5\xa5\xa1\xe0\x48\xa3\xd7\x2f\xf6\x59\x98\x0b\x47\xd1\x06\xd7\xb9\x44\xe8\x33\xc6\x46\xf0\xdd\x1a\xcc\x9a\x53\xda\x8d\xc8\x1f\xf5\x58\xe5\xdb\x8a\xbe\x55\x17\x25\xec\x99\xc4\xc6\x58\x74\x1f\xb8\xee\xa7\x5f\x6f\xc5\x01\xf5\x24\x92\xeb\xe4\x71\x4f\xd9\x9d\x21\x16\x96\xda\x41\xb0\x25\x38\x06\x5a\x7e\xcb\x1b\x4d\xd9\xc3\x74\xf7\xd0\x7f\xce\x56\xf6\x03\x99\xed\x22\x12\xf6\xd5\x68\x48\xe4\x5b\x1b\x2c\x20\xe1\x4b\x9c\x59\xf8\x69\xfa\x13\xe3\x5d\x56\x4d\x5e\x2d\x4b\xfa\xdc\xef\x35\x07\xb3\xac\x59\x19\xc4\x94\xdb\xdf\x6d\x44\x4b\x46\x15\x8f\xcc\xcc\xaf\x78\x83\xf1\x73\x7c\x4f\xee\x96\xaf\xfa\x53\xca\x09\x96\x5b\x31\x76\x59\xa7\x08\xce\xa1\x0c\x40\xc7\xec\x53\x33\x54\x5a\x25\xa2\x62\xab\x9b\x94\x74\xb0\xa6\x78\x8e\xb8\x2f\x5e\xf0\xe6\x93\x4d\xe7\x54\xd3\x10\xbb\x44\xe7\x4a\xaf\xd0\x11\xc0\xa6\xd6\xa4\xf9\xda\x37\xaf\xb9\x70\x02\xf0\xd6\x98\x7f\xda\xdd\x82\xb2\x64\x0a\x6a\x61\xc1\x04\x5a\xde\x09\xef\x5b\xdd\xba\xfd\xbc\x96\x99\xab\x48\xff\x07\xe3\x72\x35\x8a\x9c\xf1\x6b\x4c\x95\x7d\x53\x6e\x9b\x37\x84\x52\xe2\x81\xc8\x71\x90\xc6\x02\xb3\xb0\x5d\xd7\xb3\x47\x4f\x8d\x33\x94\xf6\x78\x8d\x8f\x9c\xa8\x27\xc9\x2e\x4d\xb1\xe7\x32\x8d\xac\xfc\xbd\xd8\x34\x94\x47\x35\xaf\xd3\x02\x3b\x54\xe9\xe6\x91\xa0\xd6\x15\xed\x47\x19\xa1\x05\x93\xa3\xea\x81\x08\xac\x65\x8c\x23\xc2\xf8\x54\xe5\x53\xcd\x1b\x63\xdc\x94\xc9\xad\xe7\x92\x54\x31\x25\x4a\xbe\x90\xb3\x7f\x20\x77\xdc\xae\x62\xc9\xd9\xbf\x08\xe7\xb7\xff\xac\xc5\xc8\x77\xac\xb1\x95\x8a\x35\xb2\x4e\xf8\xef\x3e\x0b\xaf\x85\x46\x17\x17\x38\x0d\xaa\xa6\xc9\x4a\xa1\x9f\x36\xd5\xaa\xb4\x83\x16\xc5\x08\xf3\x6f\x86\x41\x9c\x08\xa2\xfb\xf9\x19\x79\x03\x3e\x19\xfe\x3d\x97\xa6\xd2\xfd\x7f\x5e\xeb\xf1\xdd\xc0\x42\xe2\xfd\xd9\xf1\x13\xb6\x98\x6e\x9c\xc2\xc4\x7b\x62\x88\xdc\x08\xc6\x4a\x8a\xb8\x07\xad\x27\x7b\x57\x3c\xc8\x81\x9c\xd8\x78\xdd\xef\x68\x95\xfe\x06\x59\x6d\x39\xcd\x32\x1b\x34\x73\x2f\x9a\x34\x22\xfe\xf0\x19\x5b\xea\x12\x75\x1b\x5d\xb0\xfc\xae\x22\xab\xe6\xed\x68\x9e\x10\xb8\x88\x33\x1d\x8d\x19\x54\x70\x1c\x37\x93\x35\xce\x86\x70\xf6\x99\x0e\x4b\xdf\xf1\xb6\x1a\x7f\xb6\xc5\x7b\xd7\x60\x5b\x4e\xac\xcd\xa2\x21\x70\x9e\xc9\x0a\x30\x42\x37\xa6\xfd\x57\x95\x6a\xdb\x6f\x2a\x71\x6b\x62\x55\x43\xc2\x5c\xe5\x1e\x07\xa7\xc0\x2e\xe7\xc6\x4b\x1c\x4d\x85\x30\xda\x61\xd9\xe7\x82\xda\x87\x79\x25\x31\x4b\x31\x68\x45\x0d\x81\x1c\x0f\xe0\x37\xf9\xd0\xef\x4c\x07\xcc\x39\x10\xd6\x02\xca\x68\xe7\x11\x8f\xfb\x98\x85\x55\x40\x0c\x91\x94\xed\x7b\xc2\xce\xd0\xed\xa1\x92\xae\xdc\xb2\x0a\xca\x12\x76\x76\x67\xa3\x44\x47\xa0\x83\xbb\xf3\x07\x27\xec\xe2\xf4\x30\xdd\x29\x9c\xf3\xe7\x88\x3d\xc5\xa6\xef\x6c\xe8\x36\x77\x34\x66\x3d\x68\x1a\xa4\x11\x5c\x38\x6e\x81\xee\x11\x1d\x2b\xff\x6c\xc8\xfd\xee\xae\xec\xec\xe6\x3d\x84\xb9\xd6\x52\xe3\xa6\x6c\x4f\x78\x4e\x6d\x5f\xe6\x33\x4d\x31\x25\x9f\xe8\x59\x34\x29\x8b\xf8\xa9\xe0\x4b\x9e\xf6\x71\x29\x88\xf3\x4e\x15\x89\x68\xd2\x68\x7e\x4f\xd6\x16\xf6\x94\x32\x97\x18\x11\x62\xda\xbd\x1d\x56\xc8\x53\x82\xa6\x04\x43\x84\x27\x54\xef\x7e\x8f\x10\xa4\x5d\x9f\xb2\x37\xcf\x4c\x4e\xd5\xaf\x41\x18\xea\xd1\xb0\x9b\x41\x03\xdb\x84\x6a\x99\xdb\xdd\x0b\xba\xf7\xf4\xc7\x2b\x36\x9e\x35\x48\x36\x4f\x8f\xc2\x0c\x65\xa0\x4a\xe6\xcf\x86\x5e\x22\xb5\x1d\xc1\xcd\xd3\x6b\xb7\x2f\x5d\xc2\x54\x92\x51\x2e\xf1\xfb\x38\x7e\x53\x4b\x7f\x3e\x15\xd9\x63\x2f\x3f\x56\x61\x26\x78\xe2\x2a\x91\x73\xa0\x88\xd6\x72\x24\xfc\x38\x72\xfe\x78\xd9\x12\x6a\x6a\x96\xbc\xb6\x08\x8d\xa7\xf8\xe4\xf5\x63\x88\x25\xcb\x73\xef\x7d\x14\xcd\xc4\xc8\xc3\xda\x1a\xd1\x11\x2c\xeb\x36\x1a\x49\xeb\xc3\xc1\xfc\xb1\xd9\xee\x57\x80\x1b\x2a\xd3\x90\x0f\x15\x5d\xc2\x49\x8f\xf1\xde\x25\x51\x14\x37\xaa\x56\x65\x83\x3f\xe2\xa6\x98\x98\x25\xd4\x1c\xa4\x08\x22\xe0\xf0\xb2\xdc\x1c\xc1\xa2\xb3\xfa\x45\xba\xdc\x89\x35\x9e\xd0\x6a\xe7\xd0\xd5\x2a\x72\x14\x9b\x62\x83\x39\xfb\x85\xc6\x54\x2c\xd0\x68\x7d\x22\x27\x53\x59\x12\xfd\x34\xb4\x94\x2b\xfb\x42\x36\x62\x22\x5a\x8c\x86\x62\x2a\x09\x0e\xd4\x59\xeb\xa5\xea\x47\x82\x60\xcf\x03\xf5\x1d\x69\x77\x6d\x8c\x22\x79\x93\x22\x77\x88\xc1\xc3\x56\x3c\xd1\x09\x28\x98\xac\x32\xe5\x02\x80\xf5\x59\x3e\xce\x9f\xae\xec\x53\x25\x42\x9b\xee\x3f\x56\xcb\x78\x60\xce\x19\xdf\xac\xcf\xb5\xe4\xa4\xdd\x3e\x65\xa2\x46\x16\x00\xca\x2d\x10\x66\x70\x42\x50\x57\xf8\x83\x1a\x6c\x02\x97\xba\x2d\xfc\x52\x2f\x07\x02\xa1\x48\xb7\x95\xc4\x6d\xd3\x90\x2e\x55\xf9\x4f\x8a\x18\x87\xa6\x1d\xae\x1a\xf4\xa0\x0c\xeb\x2f\xc5\x0b\x58\x14\xcc\x80\xf5\x77\xa8\x62\x93\x80\xc9\x79\x42\xd8\xf5\x9f\xb3\x53\xdd\x97\xee\x0e\xfc\xf7\x6a\x8c\xe0\x7d\x8d\xd4\xe4\x81\x89\x18\xc7\x33\x5f\x99\x3a\x34\xdc\xa7\x71\x08\x0f\x67\x27\x5f\x56\xc6\x22\x57\xad\x85\xe9\x90\x1f\x96\xb6\xf2\x5a\x95\x62\x13\xde\x7c\x65\x4b\x0c\x86\x0e\xeb\xd3\x92\xe7\x16\x81\x28\x8e\x67\x56\x3b\x59\xf3\xd1\x80\xc6\x7a\xbc\x6d\xa0\x8d\x3d\xa5\x8b\xc4\x5f\x3b\x14\xa4\x65\xcb\x69\x31\x55\x6d\xec\xcf\xf0\xc8\x9f\x2d\x2b\x3d\x4c\x91\xfc\x68\x39\x04\xe3\x41\xab\x02\xfa\x80\x4e\x52\x6b\x98\x70\x73\xad\x49\xa0\x8c\x72\x9f\x2b\x55\x7b\x53\xa8\x91\x6b\xe4\x8a\x18\xea\xf5\x95\xe8\xc0\xc3\x10\x7a\xf2\x97\x6b\x74\xd6\x76\xf7\x44\xb4\x06\xfc\x56\xac\xb0\xbb\xb4\x71\xc3\xcf\x0e\xcb\xa6\x5f\xc7\xda\x31\x23\xd3\x3e\x8a\x55\x01\xee\xc4\xab\x3f\x24\xa1\x22\x61\x0f\xc3\x71\x3e\x73\x51\x91\x98\x64\x7c\xb2\x33\x3c\x61\x5a\x95\xb1\xa0\x03\x98\x36\x50\x2d\x7e\xf3\xb1\x73\x68\x4a\xbd\x74\x86\xae\xaa\x63\x0c\x7c\x0c\xb7\x26\xfe\x29\xdc\xd1\x43\xde\xc2\xa7\x5a\xd4\x82\xd4\xca\x42\x68\x6a\xbd\x92\xe0\x88\xfc\x8c\xdc\xd4\xee\x82\x3b\xbd\x4f\xc1\x0e\x58\xc7\xb2\xf4\xf5\xae\x15\xa4\xa7\xd6\x5a\xcb\x16\x89\xff\x25\x7d\xd9\xb8\x48\x6c\xe9\xb4\x4e\xd5\xc8\xa5\xad\x5c\x5c\x95\x27\xd6\xd1\x82\xdc\x24\xe7\xf8\xb5\x87\x3a\xba\xb1\xee\x8e\x99\x80\x98\x1d\xb0\x1e\x8f\x2c\x0a\x9f\x16\xb0\x71\xbe\xcb\xa0\x9c\x48\x27\xbe\x1b\x38\x6f\x48\xdb\x22\x20\xf8\xb9\x19\x88\x23\x47\x2c\x87\x67\x6d\x70\x46\xd5\x25\x44\x4a\xb1\xb0\x89\x20\x49\x24\x67\xd7\x12\xcd\x2f\x3c\xb8\x8a\xb8\x89\xb2\xe2\xf0\x26\x24\xbd\x92\xd3\xc2\x76\x3f\x4a\xd0\x68\x4c\xd7\xad\xe0\x0d\xdc\xb0\x55\x61\xfb\x2d\x93\xb3\xf0\x63\x05\xbe\xdf\x1d\xf2\x82\xa9\x3a\x7b\x7c\x67\x5a\x83\x32\xe3\xf3\xd5\x89\x0e\xb9\x14\xdf\xee\x90\x5c\x6a\x91\x11\x60\xda\xc0\x51\xe3\xcc\xf1\x9e\xad\xa2\xcd\xc4\xda\xc8\x3e\xa4\x07\xc9\x6c\x2f\x1b\x96\xd5\x11\xb7\xd8\xb4\x3c\x01\x01\x49\xce\xb6\x3e\xb1\x21\x99\x63\xcb\x53\xc4\x72\x28\xb8\xf3\xc0\x9e\xc7\x13\xed\x51\x3e\x87\xf9\x21\x9c\x47\x38\xef\xfd\x36\xfc\xec\xb1\xec\x04\x1a\xcb\x4a\xd9\xd3\xac\xf8\x1e\x34\x15\x6f\x27\x17\x4c\x63\x89\xed\x32\x95\xd9\xa4\x49\xd4\x47\x70\x8b\xe6\x07\x2d\x72\xe6\xbf\xc7\x5d\xa3\xd0\x24\x32\x17\xcd\x7f\x24\x84\x6e\xce\xa3\xf6\x62\xb5\x72\xfa\x10\x78\x39\x06\xad\xad\xa1\x8e\xb3\x28\xcd\x95\x9c\xb4\x30\xb6\x0b\x9f\x07\xf9\x4a\xc3\x68\x7f\x2e\x32\x0c\xc0\x73\x74\x89\xff\xf0\xad\x3c\x72\xc4\x15\x4b\xce\x3a\x98\x25\x93\x2a\x9a\x02\x3a\x21\x8e\xe9\xfc\x19\xbb\x8f\x4b\xd2\x1e\x21\xeb\xc3\xc1\xd6\x36\xae\x48\x05\xde\x73\x46\xb7\x0f\x5f\xa1\x58\x3f\x83\xc5\xdc\x60\xee\x3e\xe2\xd2\xf9\xe5\x46\x3d\x13\xa3\xa3\xdc\x35\x0c\xfa\x1d\x1b\x1e\x64\xf3\xc1\xc3\x7d\x88\x9b\x62\x2a\xb3\xb5\xe1\x2e\x0b\x99\x61\x97\x62\x4d\x72\x5c\xfe\x60\x9f\xb6\x18\xb5\x6a\xef\xa7\x64\x27\xee\xc9\xe0\x0b\xee\x93\x2d\x54\x40\xc2\xf0\xbb\x1d\xb9\xee\x9b\x8e\x52\xfb\xc3\xa5\x5f\xf3\x81\x5d\xea\x88\xed\xe2\x87\x0d\x82\x88\x9b\x62\x82\x3e\xfb\xe8\xb3\x4a\x1d\xb7\xba\x90\x04\x3a\xae\x6d\xb4\xcc\x5e\xdb\x6f\x21\xeb\x7f\xa8\x94\x16\x03\x13\xc9\x2d\x29\x77\x56\x4f\x2f\x78\x50\x7b\xc9\xec\x6a\x53\xf7\x87\xd4\x5c\x89\x2b\xd1\xdb\x67\x02\x51\x7c\x2d\x8d\x63\x31\x50\x7b\x03\xcb\x8f\x27\x17\xeb\x6c\x7d\xbe\x69\x26\x49\xea\xfa\x8d\xde\x7c\xe6\xc3\xf3\xab\xfe\x92\x35\xf3\x64\xcd\xaa\xf5\x41\xc2\xcb\x56\xcf\x66\x2c\xac\xdc\xbe\xef\xa7\x24\xd1\xc9\x7c\x91\x87\x97\x57\x94\x7c\x9e\x58\x1c\x3a\x88\xcf\x1b\xeb\xd7\xde\x79\x05\x04\xe1\x3c\x3f\x23\x33\xdd\xa6\x36\xfa\x84\x92\x4e\x95\xf8\xcf\xf1\xd6\xf0\x1d\xf7\x1e\x1a\x5e\xed\x16\xff\xe9\xdc\x27\x22\x73\x1e\xd6\x2e\xce\x75\xb5\xfb\x7e\x10\x47\x70\xbf\x85\x5e\xa1\xb0\xb0\xb8\x74\x87\x7a\x2f\x43\xc4\x3c\xaa\x96\x9c\xb9\x27\x72\x6f\xa0\xcd\x71\x5d\x8b\x5d\xee\xdc\x71\x52\x6c\x21\xa3\x42\xae\x42\xb2\x45\x2e\xc5\x40\x00\x79\x20\xec\xd8\x77\x44\x35\xbf\x0c\x4c\x93\x24\x95\x70\x76\xdb\x9d\x50\xf4\x81\xad\x53\x58\x6c\xed\xe1\x5c\x71\x16\x7b\x7e\xf9\x65\xad\xec\x4a\x26\xaf\x98\x05\x60\x0a\xe8\xb4\xb5\xd1\x99\xab\x5b\x77\xdb\x19\x66\xdc\xeb\x02\x2a\x7f\x61\xf6\x0e\x7d\x9a\x8f\x53\xf9\x43\x94\xae\x84\xd1\x8a\xe6\x57\x79\x18\xc0\x15\xd7\xaf\xc9\x4c\xdb\xde\x66\xc4\xb4\x0a\x8f\x6a\xdf\x77\x95\xba\xb8\x4f\xcd\xf1\xc4\x75\xb6\x5c\x30\x78\x1b\xe1\x89\x06\x0b\x1d\xeb\xd9\xd6\xca\x98\xd8\x6f\xd4\xb5\xd2\xb3\xf6\xa3\xa0\x91\x06\xfd\x9b\xb4\x5a\x7c\x4b\xa1\x60\x62\x4a\xf0\x76\x33\xac\xf7\xa9\xd4\xa3\xa4\x87\x81\x59\x5a\x02\xa9\x4a\x36\x29\xeb\xd5\xd4\x6f\x07\x3a\xbf\x9e\x95\xa1\xa2\x0c\x55\x02\xfc\xa6\xc6\x13\xdc\x1b\xe6\x6b\x9d\xfa\xa7\x2e\xd1\xfd\x53\x29\xbd\x90\x52\x18\xb0\xb9\x3e\x48\xe0\xa6\x21\xe8\xd4\xa6\x5a\x13\x34\x8a\xa2\xa6\x0e\x58\xad\xf6\x1a\x44\xe0\x8f\x23\x51\xb5\x27\x8e\x66\x05\x24\xba\xd9\xbc\x29\xd3\xdb\xe7\x5a\xf0\xe5\x6f\xda\x4d\xdf\x79\x72\xb6\xae\xa1\x5b\x88\xcc\x84\xad\xd4\x48\x00\xc3\x7e\xbd\x64\x94\x8f\xff\xb5\x8b\x40\xe9\x0d\xd4\xd5\xad\x67\xe1\x7b\x4f\x2f\x9b\x2a\x99\x25\x87\x26\x71\x42\x45\x50\xf3\xef\x70\xaf\x1d\xf5\xcb\xe8\x4f\x29\x93\x7f\x22\xbd\x89\xe6\xba\xf4\x4d\xd8\x17\xb8\x74\x8b\x42\x40\xca\xad\x6d\x8b\xa8\x12\x38\x42\x6b\x95\xf4\xfb\x5e\x6e\xc4\x70\xe7\x52\xc1\x9f\xe3\x60\x38\xf5\x96\x6f\x97\x19\xac\xb0\x05\x61\x05\xde\xd2\xe5\x85\xa5\x7e\x91\xda\xd0\x51\xf5\xc7\x17\x11\x95\x40\x4a\x39\xa9\x38\x5b\x72\x55\x46\x30\x1a\x9f\xf2\x03\xe1\xc2\x2d\x75\x64\x0b\xb2\xa3\xed\xeb\xdc\x36\x5e\x83\x5a\xc6\xe8\x84\x69\x8f\x42\xe0\xd5\x1e\xbf\x7c\x09\x15\xaa\x27\x6f\x2f\x39\x16\x6c\x68\x5d\xb2\x6c\x8e\x64\xb6\x11\xf1\x64\xcb\x8a\xfe\x3e\x40\xa6\xf2\x13\x70\x06\x08\x38\xfb\x47\x21\xd4\x3f\xb4\x74\x7d\x3a\x24\xaa\xa2\x45\x74\x81\x83\x2f\x5c\x7a\xf3\x34\x2a\x1f\xdc\x89\x67\xdd\x65\x1a\xde\xee\xdc\xa6\xfe\xc7\x23\xa9\xd5\x9e\x6e\xf0\x15\x10\xed\x99\x64\x26\x11\x84\x7c\xf2\xec\x83\xec\xe1\xb5\xab\x8f\x41\x02\x38\x53\x32\xd2\x39\x2c\xcb\xc7\x23\xa5\x8c\x2c\x21\x52\x0a\xe8\xd1\xde\xff\xb5\x09\x0e\xce\xa4\x8b\xbb\x5b\x2a\xec\x79\x73\xc1\x9a\x14\x02\xef\x37\xac\x8c\x74\xbd\x43\x90\xb6\x00\x53\x33\x28\xdd\x32\x57\x33\x7d\x8a\x9a\xb1\xc3\x6c\x3b\xea\xf2\x71\x00\x49\xa3\x83\x48\x5a\x1d\x00\x9d\xb6\x90\xd9\x46\x13\x4c\xbf\xae\x90\x04\x96\x90\x1c\x0d\xb6\x7d\x4a\x85\xe1\xaf\x34\xc0\x53\x3b\xae\xde\x7d\x5c\xda\x70\x6a\xb9\x51\x9f\xb0\x05\xe7\xf0\xad\xef\xb2\xb9\xe5\x81\x96\x7e\x35\x96\x49\xbb\x5e\xed\x46\x01\x99\x0f\xc7\x2f\x9a\x6f\x23\xc3\x28\x91\x28\xbd\x81\xbf\x6d\x12\x8c\x30\x01\x72\x5c\xed\x6d\xe6\xa2\x63\xec\x5c\x16\xd0\xee\x51\x1a\x6c\x3f\xbd\xf6\x8d\x2e\xea\x43\x9b\x78\x12\xde\x49\x80\x2d\x68\x99\x77\x13\xa1\xf7\xe2\x82\x9b\x33\x26\xfd\x1b\xdc\x6f\x52\xd8\x6d\x52\x3a\x5f\x00\xb0\xce\xeb\x77\x3f\xbe\x50\xb1\x66\xe3\xce\x9e\xba\xd6\x14\x70\xc1\x93\xae\xb5\x79\xcf\x28\x13\x9a\x22\x89\xde\x4a\xbc\x62\x79\xc0\xd3\x19\xd8\xca\xa8\xaa\xd9\x66\x94\x11\x0e\x8a\x69\x9d\x87\x40\x2d\x37\x34\x1b\x67\xd3\xb5\xa1\x66\xd8\xbe\x9c\x85\x9e\xb2\x40\xf5\x72\x34\x7f\x17\x84\xf3\x88\xb5\xb3\x01\x52\x4a\x4d\x76\x97\x95\xcf\x23\x97\xc3\x4b\xca\x19\xa5\x98\xc3\x15\x42\x69\xb2\xce\xa6\x3e\x7d\x39\xd9\x5f\x96\x79\x4d\xbe\x14\xa3\x3b\x77\x44\xab\xac\x64\x06\x03\xd7\x4e\xbb\x58\xda\x59\x3c\x12\x98\xcb\xf4\x3c\x97\xe6\xdb\xfd\xe9\x6c\xd6\xfc\x07\xff\x1b\x1f\x3e\x63\x81\xdc\xf4\x4c\xb4\x60\xa4\x7d\x6c\x2d\x8f\x1d\x7f\xaf\x7a\xb2\xa5\x44\x52\x1c\x5b\x20\x65\xc5\x01\x4d\x19\xd6\x07\xea\x23\x84\x98\xb9\xe6\x3b\x92\x26\xb0\x1a\xc4\xb0\xeb\x61\x15\x9b\x3e\x96\xf3\xdf\x0f\xd7\x70\x55\x56\xa6\xd2\x04\x0d\x9d\x0e\x75\x5d\xc7\x16\x8c\xa1\xe1\xa7\x47\xd3\x7e\x06\x3f\x7d\x6d\x65\x88\x1b\x54\x9a\x02\x0b\x9f\x96\xf1\x33\xbe\x3a\x9c\x52\x59\x2b\x9a\x25\xeb\xfb\x6f\x92\xdd\xe6\x9b\xc2\xbe\x86\x8c\x44\x4a\x0b\xb8\x32\xd3\x8c\x5d\x97\x10\x16\x7d\x04\x11\x21\xf4\x22\xd2\x58\x8f\x52\x01\x98\x58\x31\x32\x6b\xf5\x44\x6f\x35\x3f\x07\x32\xba\xec\x1f\x0f\x3f\x40\xe9\x0d\x3c\x84\x81\x65\x88\xc4\x26\xea\x2d\x74\x19\x58\x98\x14\xb4\x9f\x52\xd3\x0c\x57\x92\x86\x7e\x38\xde\x4b\xa7\x68\x9d\x06\xcf\x62\x4e\x9a\x27\x44\x23\xf5\x0a\xca\xa2\xd0\xf7\x0a\x63\x59\x8e\xaf\x23\x94\x6d\x9c\x59\x0a\x7f\x05\xb8\xaa\xb7\x55\xbf\x33\x86\x86\x8c\x46\x68\x53\x18\x2f\x43\x92\x61\xdb\xb2\x45\xa1\x19\x16\x6e\xab\x73\xd8\x3e\xfc\x56\xf1\x12\x50\xcf\xd2\xa5\x57\x11\x54\xf0\x3e\x2d\x08\xec\xd8\x26\x89\x72\xe8\xb7\xff\x4a\x66\xda\x23\x21\x69\xe0\x2c\x51\xe1\x23\x70\x17\x3b\x42\x4a\x8b\x56\x21\x00\x30\x6d\x18\x86\xe9\x92\xc9\xe4\x2b\x74\xf3\xca\x62\xa0\x4f\xd2\xa1\x28\xa4\x8d\x44\xce\x11\x79\x4c\xd2\x95\x45\xb8\x90\x43\x06\x02\x5a\x84\x80\x27\xfe\x55\xb9\x68\x9d\xd4\x87\xed\xd1\x0c\x6d\xb3\x57\xcd\xf3\xf7\x33\xc9\x7d\x11\x2a\x14\xc7\x04\xf9\xc5\x11\x0c\x67\x43\xb1\xda\x18\x8e\x9d\x88\x09\x9f\xb6\x0b\x2d\xca\xdc\xa1\x10\xbe\xaf\xf4\x13\xbc\xbd\x8b\x37\x66\x23\xc0\x58\x40\xed\xc9\xab\x60\x76\x86\xdb\xb7\xb0\x8a\x45\x8b\x75\x8e\x89\x57\x19\xce\x9f\x4d\xe1\x5b\x28\x81\xd7\x15\x95\x44\xb1\xef\x0a\x16\xc1\xcd\x3c\x9e\xf9\xfb\xa6\xb2\x61\x3f\x12\x63\xc9\x91\x36\x47\xfc\x7d\xfd\xce\x9c\xb2\x19\xe2\x2c\x0f\x38\x3a\x24\x39\x95\x6c\x59\x44\x71\xc3\x48\xd9\x17\xf9\x7b\xd9\xc6\xc8\xf3\x50\x2f\xd0\x43\xfe\x2c\xd2\xc9\xd3\x7a\x4c\xe2\xe9\x45\x47\x01\x00\x14\x2a\x4a\xb2\x52\x9f\xf0\x15\xb8\x0f\xab\x96\x3e\xc0\x41\xef\xca\xcc\xd9\xc7\xcb\x70\xfe\xbb\x10\xc1\xa4\x84\x6d\xc9\x1e\x36\x21\x4f\xee\x31\xcf\xca\xec\x6b\xb0\x1c\x6c\x94\x31\x68\x77\x18\x00\x5f\xdc\x66\xa2\x0f\xf4\xd9\xc7\x2a\x41\x3a\x40\xe9\xc5\x22\xc1\xfe\xe6\x10\x87\xdf\x70\xe2\x73\x6a\xb9\x72\x18\x09\x50\xce\x2d\xcf\xc9\x9a\xd3\x4d\x9f\xb3\x59\xa3\x89\x88\x57\xeb\x17\x97\xbd\x44\xdc\x44\xc1\x12\x40\xcb\x1e\x6f\xaa\xc4\xd9\x8e\x0e\x21\x41\xeb\x13\x12\x46\x1f\x50\xd9\x46\x9e\xe5\x30\x76\x06\x71\xe5\xfc\x06\x46\x7a\xa3\x7e\x8c\x3a\x13\x04\x98\x36\x40\xb0\xb2\xf6\x51\xca\xe7\x6c\x4e\x4b\x9a\x48\x34\x89\x68\xc6\x4d\x12\xe0\xc0\x74\xfb\x2f\xbf\x74\x5f\x63\x60\xbd\x9b\x35\x58\xdc\x9d\x00\xbf\xbe\x08\x93\x16\xf6\x29\x10\xb5\x3a\x60\x72\x01\x46\xa2\x80\xcc\xc4\x54\xd3\x82\xd6\xd7\x09\x4e\xea\xec\x70\x4a\xe8\xa9\xc3\x01\xae\x27\x10\xb9\xe4\xf8\xe1\x92\x00\xb8\x16\x3d\x05\x61\xc0\x34\xb0\xfc\x5c\x73\x5e\x40\x92\xe7\xa6\x88\x62\x41\x7d\x46\x8a\x56\x51\xcd\x21\xce\x10\x0e\x10\x7a\x33\x10\x2d\x8d\xe7\x0c\xe8\x84\xf4\xb9\x78\xd3\xac\x94\xab\x88\xd7\x60\xe0\x27\x05\x41\x13\xa8\x62\xa5\xdd\x27\x09\x86\xb9\x63\x4a\x2e\xe4\xc7\xaf\xfe\x1c\x60\x82\xd0\xb0\xfe\x23\x04\xba\xab\x44\xd6\xe7\x42\x8b\xea\x50\x2b\x43\x34\xd4\x30\x49\x79\xdc\x9e\x66\x45\x32\x6c\xb9\xf8\x21\x69\xb8\xe1\xce\xde\x15\xe1\x3c\x02\x25\x10\xf5\x7d\xa6\x74\x4b\xd1\xaa\x48\x64\x01\x95\x14\x5a\x3f\x51\x19\x11\x27\x27\x6c\x01\x7a\x30\x11\x26\xfa\x77\xeb\x3f\xb2\x8e\x51\x41\x3b\x72\x49\xff\x9a\x97\xd9\x0f\xe6\x70\xc6\x84\x88\x0a\xaf\x9f\x82\x81\x25\x08\x1e\x2b\x9f\x2a\x24\x47\xa7\x2f\xa9\x01\x5c\xfa\x0d\x46\x80\xfa\x8d\xa3\xbe\xe6\x23\x66\x4e\xa8\x67\x6c\xe9\x65\x98\x2f\x6d\x5c\x36\x23\x46\x82\x77\x43\x00\x6f\xdb\x83\x96\x8f\xb8\xec\xf9\x7f\x8c\xfa\xc4\x63\xd0\x69\x0a\x9e\x2c\x63\x27\xa3\x17\x8a\x28\xb0\x9e\x0c\x07\x74\x51\x43\x5b\x40\xf3\x2e\x83\xa2\xc1\x7b\x40\x91\x51\x15\x14\xac\x30\xd2\x63\x32\x5e\x51\x3a\x64\x51\xd1\x69\xd4\x7a\xa4\x55\x84\x0a\xf9\x41\x77\x6c\x85\xa8\x56\xe7\xb6\x76\x66\x47\x1c\x68\xd3\x16\xd6\xff\x2a\xc3\x3b\x86\x59\xd4\xcc\x68\x41\x8c\x29\x62\x13\xd1\xcd\xab\xc1\x05\x95\x5e\x9a\x90\xd9\x4e\xb0\x86\x80\x51\xc1\x73\xd5\x46\xf4\x67\xcb\x7f\x91\x75\xa0\x9c\x83\xcf\x84\x99\xe7\xcb\x7c\xa9\x58\xa8\xb4\x64\xbb\x0e\x6e\xa6\x9d\x58\x9c\x24\xb3\xfd\x48\x0d\xc7\xfe\x07\xa7\xcd\xa8\xfa\x06\xf5\x8a\xc3\xc6\xf2\x3d\x5e\x46\x33\x24\xa1\x88\x05\xb3\x39\x00\x29\xa6\x6d\x5a\x0d\xd6\x7b\x2b\x8e\xf6\xdf\x6a\x05\x99\x1a\xcc\x88\x2b\x5c\xf1\xe1\x20\x08\x5f\xf2\xe2\x87\x3d\x48\x55\xaf\xfe\x61\x97\xd3\x76\xa5\x45\x56\x06\x18\xd0\x18\x10\x49\x8b\x7e\x9d\xf9\xf6\x3f\x5f\xb6\x31\x15\xc5\x23\xd5\xe5\xb1\xc4\xb5\xc6\x58\xa5\x93\x42\x1d\x77\x08\x5b\xf1\x59\xb6\xfe\x15\xbf\xda\xde\x6f\xcf\x38\x41\x84\xc2\x3d\x09\x2c\xfc\xfe\x77\x12\xe9\x3c\x43\x5a\x87\x7f\xed\xd2\x14\xc7\x22\x9b\xfa\x64\x0b\xa0\x8e\xbe\xd5\xe7\x8d\xf4\xef\x1d\xbd\x97\x58\x37\xd1\x20\xd2\xff\x90\xa1\x6d\x4e\xfc\x55\x9a\x37\xd7\x76\x0a\x3f\xa5\x9a\xae\xfc\x36\x31\xb9\x56\xba\x79\x86\x29\x10\x03\x95\xfb\x5f\xee\x4d\xfe\x46\xc8\x50\x60\x41\x12\xa5\x37\xd4\x02\xbe\x8c\x50\x65\x6c\x71\xc3\xba\xaa\x1a\x3d\x8f\xba\x21\xaf\x32\xb2\x20\x58\x14\xd7\x7f\x1d\x0e\x69\x6b\x62\x2d\x23\x5a\x6e\x34\x64\x6d\x1f\x5d\x64\xa2\xa5\x22\x3c\x07\xdf\x0d\xf8\x8d\x2c\xb7\xf8\x52\x1d\xbd\x2a\xc9\xa2\xe9\x78\x62\xfc\x47\x65\x3a\xaa\x68\x38\xfb\x04\xc8\xb8\x2c\x64\xfc\x6b\x41\xb6\x88\xa5\xe6\x32\x25\xf6\x35\x78\x7f\xd3\xb8\xb0\x2e\xaf\x85\xe9\xc0\x1e\x37\xc0\x4b\x03\xf4\x3f\xd2\xdf\x09\xb0\xa2\x3c\x46\x22\xc8\xa7\x8f\x7a\x64\x67\x71\xbe\xe9\x61\xba\x43\xb2\xe7\x99\xb5\x39\x9b\x86\x1d\x83\x48\x10\x68\xd1\xe8\x4a\x69\x04\x88\xd8\xff\xe7\x39\x5c\x25\xf7\xbd\xdb\x3c\xc7\x63\x8c\x6f\x7b\x6e\xf7\x75\x64\xc9\xdc\x63\xe1\x02\xb1\x82\x66\xf6\x47\x03\xcb\x15\x02\xd0\xd1\xcb\x26\x54\xe6\xbc\x41\x07\xe9\xbf\x50\x99\x0f\xdc\x41\xef\x01\x1d\xf4\xb7\x73\x5f\xf0\x55\x58\x35\x17\xe6\xcc\xc9\x4c\x4b\x41\x94\xaa\x0b\x2b\x3e\xe3\x48\x6b\xcf\x84\x91\x0a\x14\xff\x55\x58\x99\x8b\x73\x3d\x5a\x53\x12\x2a\xd5\xd5\x89\xd1\xe7\x53\x1b\x0d\xcd\xa8\xc6\x55\xe4\x4f\x5c\x9a\x6a\x8a\x04\xcb\xff\x65\x33\xb0\xd2\xc7\xc7\x27\x66\xcb\xcd\x8f\xe9\x74\x0f\x2a\x33\xcb\xe7\x36\x29\xf5\x1b\xdd\x75\xbd\x3b\x3a\x63\xfb\xf7\xc8\x80\x91\x79\x99\xa5\x1f\xfc\x62\xa7\x70\x01\x3a\xc8\x5d\xc3\xff\x93\x2d\xde\xc3\xa4\x1b\x42\xd2\x06\x97\xde\xde\xde\xc6\x0e\x7d\x98\xea\x81\xf0\x7c\x8d\xe2\x0a\xcd\x8e\x04\x89\x46\x40\xd4\x67\x42\x5e\x1f\x6c\x01\xbd\x78\x12\xcf\x61\x21\x3d\x78\xf4\xfd\x7e\xa2\xf2\x3a\x3a\x76\xcb\x24\xed\xce\x74\x28\x48\xfb\x21\x1f\xc8\x80\x03\x9a\x3d\x9f\x4d\xf3\x4d\x2e\xf0\x84\x6c\x21\xd8\x9b\x09\x03\x07\xa8\x7f\x55\xb9\x57\xda\x54\x2b\xb4\x33\xac\xff\x49\xe4\x26\xd0\x2c\x81\xab\xbd\xf7\xb0\x13\x05\xa6\x97\x25\xdb\x38\xfe\xb0\x4b\xa5\x31\xa5\x68\x15\x56\xe2\x54\xb5\x0f\xce\xfc\x23\x58\xef\xf4\x8f\x6c\xaf\x1b\xc8\xa6\x01\x70\x2d\xaa\x80\x1c\x39\x80\x96\x57\xc2\x1d\x12\x6f\x0f\x53\xf7\x6d\xa1\x4f\x82\xcf\xb3\x12\x2f\xef\xd2\x18\xfb\xb2\xb9\xcc\xce\x07\xf0\x24\xc2\x62\x0b\x5b\x62\x3c\x77\x88\x95\x63\x21\xb5\x32\x73\x7f\x35\xaa\xe4\x08\xe5\xa8\x0e\x08\x32\xae\x39\xcc\x94\x59\x41\xda\xbe\xef\xfa\x3b\x9b\x33\xf0\x2d\x5c\x17\xbe\x31\x62\x24\x25\x25\x6d\x52\x9a\x0b\xd7\xa6\x30\xff\x50\x10\x99\x9c\xb6\x4b\x6c\xba\xe2\x7e\x54\xa8\xb0\x63\x43\xe2\xfd\xda\x6f\x10\xb9\x21\x11\xd2\xd7\xec\x05\xd0\x66\x89\x43\x84\x7a\x3e\x9f\xb8\x86\x0e\x48\xbc\xc2\x25\x62\x86\xf0\x8d\x97\x21\x53\x7d\xb1\xe8\xf3\xcb\x0f\xc9\x05\xef\xf7\xc8\x96\xb8\x7a\x9e\x23\xf0\x68\xc4\xc7\xdf\xa8\x2c\xb1\xdf\x1e\xeb\xdf\xe2\x42\xdf\xee\x42\x3f\x73\xa3\x4e\x0a\x1d\x70\xce\x2e\xf3\xe1\x84\x3c\x9d\xe8\x81\x00\x91\x7d\x00\x66\xe6\x61\x00\xb0\xca\xc0\x00\x1d\x09\x80\xca\x19\x24\x05\x22\xa5\xd4\x31\x28\xb2\x37\x6c\x76\x8e\xa7\x09\x7d\xfe\x41\x71\xdc\xd4\x11\x7c\x7f\x6e\xce\x69\x74\x83\xf7\xb6\x4b\x73\x3e\x3b\x15\xba\x69\xf1\x09\x31\xa1\x0f\x18\xdf\x74\x45\xb0\x5d\x2a\x99\x6f\xad\x58\x8c\x38\x80\x85\x24\x1d\x27\xf1\x32\xf0\x16\x7d\xd6\xf3\x12\xf8\xfc\xd0\xf3\xbc\xc1\x12\x67\xf1\xea\x24\xad\x6a\x0a\xf1\xeb\xcb\xff\x42\x66\x79\x7e\x01\x57\xd0\x77\x4c\x2d\xb7\x17\x74\xd4\xa5\x57\xd5\xc6\x27\xc8\xd0\x02\x26\xca\x5f\x25\x4e\x01\x07\x24\xde\xae\x24\xe0\x15\x05\x21\xd2\x94\xc3\x76\xb9\xa8\x03\x5b\xf8\xd6\x06\xc5\x2c\x98\x0d\x75\xdf\x81\x10\xb7\xf9\xa2\x1f\xa8\x30\x7d\x7d\xdb\xad\x84\x68\x4f\x12\x6b\x7e\xb9\x59\x07\x7c\x82\x90\x87\x5d\xc1\xa0\xbb\x35\xc1\xfb\xc7\xa3\xfa\x8b\xb6\x9e\x17\x1c\x89\xe7\xf3\x01\xb5\xf1\xa4\x58\x8d\x05\x7e\x18\x9c\xbb\x91\x7d\x60\xf4\x82\x15\x8a\x70\x98\x35\xad\x1f\x5e\xe5\x40\xa7\x33\x8f\xc0\x83\x3f\xc4\x0d\x84\x4f\xcf\x9b\xf3\xe5\xf6\x82\x13\x45\x29\x0a\xed\xc9\x33\xac\x31\x1c\x31\x6c\x6d\x6d\xc1\x62\x62\x38\xf1\x4c\x00\xd3\x86\x0a\x97\x04\x6e\x20\x5c\x84\x5a\x4d\x5d\x9d\x9b\x3a\x99\xfb\xeb\x47\x10\x75\xf2\x16\x74\x60\x66\x86\xa0\x98\xa5\xdf\x0d\x0c\x1d\x5e\xfe\xc3\x27\x97\xc6\x08\xc4\xfd\x0c\x82\x6d\x5e\x5f\x13\x31\x33\x33\xdf\xdf\x5f\x30\x80\xf7\x59\xc6\x08\xbd\x4d\x4a\x6a\x3e\xf0\x79\x47\x57\xd5\x98\xd7\xc8\xad\x35\xd3\x5a\x68\x7c\x90\x26\x24\xbd\xaf\x7f\x7b\xa6\x56\xdd\xc5\x8a\x2a\x61\xe9\x9f\x92\x78\x4b\x18\xb5\x8a\x28\x66\x69\x6d\xcf\x8e\x8f\x8f\xff\xe5\xf8\xfc\x6f\x96\x19\x9d\xb3\xd0\x48\xb2\x0a\x5d\x4b\xfe\xfe\xe5\x2e\x99\x25\x8e\x52\x41\x21\xf2\xc1\x71\x5b\x96\x3e\x8e\x21\x61\x98\x61\x50\x33\xe9\x62\x71\xaa\x89\xa3\x9a\x30\x72\x1d\xa3\xd1\x0a\x40\xf5\xe8\x80\xb4\x89\x12\xf8\x1e\x4b\x4b\xb9\xd3\xff\x0a\xb5\xe7\xe5\x96\x82\x93\x93\xd3\xcd\xc3\x23\xa2\x0f\x02\xb2\x0d\x6d\x5a\x6e\x3e\xeb\x79\x7b\xfd\x7d\xb4\xde\x13\x8a\x07\xb8\x3f\xac\xae\xef\x15\x52\x30\x59\xc8\xf9\xf8\x7e\xa4\x11\x5b\x36\x6a\xd4\x2a\x02\xac\xe6\x16\xf0\x48\x0e\xc1\x56\x22\x64\x48\x2a\xa6\x28\x90\xf8\x96\xa0\xa1\x66\xf8\x5d\x71\xfb\xa8\xbe\xa7\x3f\x9b\xcb\xac\x15\x55\xb4\x37\xd9\xce\xd8\xca\xd2\x5b\x07\xfe\xec\x84\x89\x1b\x9e\x5e\xd2\xa6\xc8\x8b\x50\xc9\xd9\x46\xb7\x2f\x99\x5b\xaa\xf8\x07\x3d\xac\xd7\x4b\x38\x5a\x80\xfc\x6c\x23\x34\x72\x46\x2e\x52\x57\xcf\xef\xee\x76\xa5\x41\xa3\xb5\x19\x7f\x00\xeb\xf6\x80\x5d\x55\xbb\x33\xf0\x0e\xb7\x64\x06\xdd\xda\xc0\x14\x41\xa6\xbb\x14\xbe\xf9\xf2\x74\x77\xb5\xc1\x56\x76\x7e\x38\xc7\x06\xb4\xdc\x73\xef\x62\x1f\x03\x01\xaf\xb9\x0f\xee\x5c\x50\x44\x00\x1a\x5a\x10\x61\x7b\x0d\xb5\x3c\x21\x7d\x17\xc7\xe1\xe1\xe1\xfb\xfb\x0b\x78\xe6\xb8\x43\xda\x7b\xbf\xa7\x39\xd6\x4f\x83\x9a\x3a\x38\x2e\x14\x5d\xa9\xa4\x64\xa5\x5a\xb1\x16\xe1\xf5\xdd\x21\x91\x6d\xae\x1b\x16\xa2\x55\x9b\xd0\xe8\x38\xa0\x7c\xc9\x45\xe6\x3e\x21\x3b\xa8\x13\xa2\xbe\x7a\x29\xaa\x60\x90\xf5\xf3\xe7\x4f\x49\xca\x61\x0a\xdb\x8f\xd1\xf8\x60\xf1\x5b\xf7\x1f\xed\x75\x5b\x50\x99\x34\x46\xc7\xce\x24\xa9\x23\x83\x5f\x98\x1b\x95\xb2\xd1\x9e\x49\x82\xae\xeb\x9b\xf0\xe1\x64\xbf\xbb\x53\xaa\x62\x5c\x2b\x21\xb4\xc7\x48\x22\xef\xda\x8e\xf9\x56\xc7\x1a\x90\xce\xb7\x40\x3c\x00\xe2\x24\x81\x97\xe5\x0c\xc5\xa0\x9d\x6a\xe8\xe9\x87\x29\x80\x38\x11\x23\x5a\x0c\xe9\xb1\x10\xa8\x8b\x5d\x6e\x96\x2f\x47\xad\x42\xa4\x6b\x8a\x5d\x46\xf8\xeb\xc7\xf3\xad\x01\x57\x20\x2e\xd7\xe9\x64\xaf\xcf\x66\x91\x11\x56\xc1\xd0\x5a\x2d\x1f\x53\x1c\xfd\x5f\xef\xc4\x82\xd0\x57\xb2\x92\x99\x03\x42\x7d\x26\x05\xd3\x41\x8d\xd4\x9f\x37\x84\x24\xdc\x09\xdc\x85\x82\x8a\xf8\x43\x98\xae\x1b\xdd\x03\x4f\x77\x67\xa9\xfb\x42\x30\x38\x81\x78\xf0\x23\x18\xad\x17\xf2\xdf\x36\x65\xb1\x07\xbd\x52\x8a\x4b\xd5\x13\x66\x8d\x5b\x37\x1c\x77\xfa\x3a\xe4\x8e\xcd\xca\xf4\xdb\xfe\x4b\x25\x5d\xd0\x33\x9c\x37\xb7\x7c\xa8\x5a\xaf\xde\xe5\xe7\x88\x16\xa6\xe2\xd6\x26\x3a\x4b\x05\xc7\x20\xee\xce\x97\xd8\xf3\xa7\xb3\x1e\xf8\x44\x9e\xe0\xf7\x2a\x07\xc7\xbb\x1c\xee\x18\x96\x2c\xbd\x1c\xcb\x9e\x0d\x05\x1d\x7e\xbb\x3c\xd4\xf4\x51\x74\xff\x7c\xa9\x4a\x16\xf6\x42\x80\x5f\x5f\x7e\x9a\xc1\xfe\xf9\xc3\xac\x6d\xd0\x6b\x50\x52\xfd\x7a\x70\x24\xf8\xac\xcf\x01\x95\xf9\x9f\xee\x9d\xeb\xee\x93\x01\xda\x9a\xa1\xbe\x0a\x44\x91\xf6\x67\x64\x4b\x98\x0b\xba\x33\x3c\xa5\x34\x08\xb7\x79\x59\xff\xaf\x73\x62\xfc\x0c\xba\xb3\x76\x51\x3d\xf8\x8f\x9f\x66\xb6\x8b\x67\xe1\xe1\x4d\x00\x20\x88\xc7\x5a\x02\x0d\x51\xaa\x8d\xc4\x50\x24\x45\x1b\x5f\x34\x3c\xcd\xc5\x55\xee\xcf\xff\xb4\x50\xe1\x1d\x14\x7e\xd2\x21\x38\x12\x8f\xa8\xba\x56\x6c\xaf\xcc\x68\x1f\x6a\x32\x31\xfe\xeb\xdf\x7c\x71\x1d\x7f\x42\x2b\x00\x7c\x58\x05\x1b\x3a\xc7\x42\x8f\x52\x64\xb2\x33\x8a\x97\x78\xbd\xa7\x4a\x10\x61\x29\x7b\x9e\x3e\x0a\xc0\x5c\x70\x28\x74\x49\x0d\xa3\x55\x0a\x0b\x87\xef\x17\x84\xc2\x7c\x37\x5c\xb2\xc9\xbc\x27\xa5\x15\x7b\x26\xa0\xde\x76\xe4\x48\x9c\xe0\x6d\xbf\xab\xda\x85\x56\x92\xdd\xe6\xf0\x39\x5c\xe3\xd8\xd3\x10\xec\xf5\xae\x40\xa4\x49\xa4\x4d\x94\x5e\xac\xdb\x9d\x04\xcf\x24\x51\x2e\xfc\x61\x0c\xf1\x90\x67\x24\xa5\xf7\xb4\x4c\xc3\x07\xc5\xf2\x69\x2c\xb2\x3f\x8c\xeb\xfe\x29\x49\x6a\x3b\x7e\x84\xe1\x8c\x20\x48\xbb\x19\x63\xf7\x40\xc0\xe2\x0a\xb9\x56\x29\xd2\x74\x6a\xc8\x3f\x21\x29\x5a\x52\xe8\x49\x7c\x63\xdc\xe4\xb8\x9a\x92\x32\x1f\x20\x56\xc8\x8c\xfb\xb4\x7b\x91\xab\x3d\x6f\x8f\x58\xdd\xfe\x6f\x13\x30\xec\x2b\xae\xd0\x07\x75\xa2\x25\x40\x04\x9b\x31\x34\x93\x96\x1e\xac\x32\x12\x77\x62\xac\xee\x48\xb5\x0c\x0b\x43\x07\x9a\x04\x49\xcf\xa8\xb8\x07\x05\xc5\x31\x2a\x0d\xf4\x63\x84\x91\x1e\x57\x1a\x9a\x2c\xfc\x37\x04\xb6\xc1\x28\x2c\xfd\x0f\x4b\x44\x30\xb0\xfe\x09\x2d\xd3\xe7\x94\x20\x8c\x12\x0f\x60\xb2\x63\x0a\x12\x94\xf7\x1e\x3e\x4b\x83\x2f\xbd\x75\x53\x5d\x9f\x52\xc6\xba\xfd\x49\x92\x55\x7c\xca\x05\x93\x6e\xda\x2e\xf2\xe7\xd7\xe2\x58\x46\x20\xb0\x9c\xa3\x8f\xe0\x41\x57\x8f\xf3\x5c\x95\x6b\x68\x8a\x46\x7c\xf3\xe9\xce\xac\xde\xfb\x05\x86\x1f\xf2\x7c\x21\x41\xeb\xbf\xdd\x06\xc4\x38\x68\x8d\x66\x88\x48\x0b\xca\x8f\x5e\x7b\x06\x9d\x37\x72\xd5\xe4\xe9\x3c\xed\x4d\x1c\x6d\xfe\x76\x79\xc4\x1e\x60\x40\xbf\xcd\xa9\xa9\xfa\xf0\x7c\x39\x86\x46\xe3\x2a\x13\xd7\xce\x03\x82\x85\x73\xa3\x3a\xb3\xa3\x87\x71\xfb\x88\x2b\xf8\x78\x69\x16\x7d\x58\x49\x5a\x23\x38\x54\x1e\x20\x2f\x08\xb7\x4e\xf0\xd5\x1e\xa5\x5c\xef\x0a\x8c\xee\x73\x8f\x11\x55\xc7\x97\x23\x28\xcd\xc0\x97\x2e\x74\x32\x7c\xbe\x2d\x78\xc3\x12\x6a\x82\x98\x94\x72\x26\x0f\x19\xf7\x8d\x0a\xf7\x2b\x49\x28\xf0\x53\xd7\x06\xe3\x25\x20\x9c\xad\x77\x0d\x6d\x82\x87\x9b\x45\x87\x97\x1e\x68\xc4\x71\x47\x16\x9f\x4d\xb3\x90\xca\x65\x0c\x5a\xaf\xf2\xcd\xcf\xb2\x02\x7b\xe1\xd0\x22\x67\x47\xe8\xb7\x60\x8c\xf4\xf1\xff\x24\xfc\xb7\xae\x2e\x71\xc8\xf3\x45\x7d\xac\x4e\x12\xdf\x64\xdd\xcf\x5f\x35\xe9\x2f\xce\x0e\x89\x33\x72\x89\x57\x4d\x84\x03\x57\x98\xaa\x96\x20\x6a\x7e\x16\x66\x0e\xa0\xa5\x9d\x51\xfd\x77\x7a\x70\x24\x11\x66\x64\x1f\x46\x6e\xb9\xf4\x5a\x02\x9d\x8b\xd2\xaf\x90\xfb\x5d\xd2\x4e\x35\xe2\x62\x94\xf2\x8c\x2a\x95\x1f\xaa\x1e\x61\x56\xd8\xac\x0c\xcd\x8e\x88\xd8\xfb\xd8\x91\x52\x34\x15\x15\xc3\xf7\xa7\x4f\xae\x00\x37\x68\x41\x68\x04\x11\x61\x97\x54\x44\x95\xd3\xcc\xdf\x00\x84\x1d\xfe\xc9\xa9\xdf\x75\x44\xa8\xd3\xcb\x16\x3c\x35\x45\x46\x9f\x3d\xaa\xdc\x6f\xfd\x31\x1d\x85\x7d\xd8\x90\x73\x5b\x61\x05\x6f\xcf\x78\x57\xed\xdd\x90\xbe\x32\x9c\x5b\x59\x27\x9e\xaf\x9b\x63\xd9\x5c\xdf\xec\x2a\xae\xbf\xe1\xce\x62\x54\x55\x1d\xef\x0d\x21\x02\xe8\xa6\x02\x35\x3e\xc8\x9b\xd8\x87\x01\x9a\x0a\x73\xfa\x7b\x41\x5f\xff\x7e\x0b\x7a\x2f\xc5\x1a\xde\x4e\xb5\x05\x1f\xe6\x1a\x49\xc3\xf6\xcf\x2f\x71\x76\x9d\xac\xfe\xbe\x42\x12\xbc\x95\x72\xf9\xeb\x4f\xa0\xc2\x0c\xc9\x22\x09\x81\x64\xd4\x47\x8c\x66\xd5\x78\xc5\xba\x75\x9e\x1a\x6d\x0b\xf3\x84\x22\x76\xc7\x1c\x5d\x86\xa9\x80\x86\xf9\xc2\x5c\x61\xe0\xd1\x34\xcd\xbd\x16\x85\x23\xd5\xb2\x61\x49\x25\x3f\xd4\x1b\x48\x59\xd1\x00\x0b\x07\x7c\xac\x98\x10\x95\x0b\xf2\x11\x18\xda\x64\x41\x91\x4c\xd1\x42\x5c\x8d\x8e\x80\x03\xfc\xfa\xa8\x3b\x0c\x1c\x2f\xfe\xeb\xeb\xe3\x63\x6c\xe7\x15\x7a\x55\xed\xce\xe0\x27\x12\xff\x7c\x86\x38\x7a\xa0\xa5\x86\x0e\xf1\x61\xbb\xbc\xce\xff\x7e\x63\x73\x29\xd2\x13\x44\x96\x7c\x1d\xfb\x2d\xac\xd7\x16\xb5\xc3\x5a\xa5\x35\x40\x20\xe5\x86\x5a\x6b\xf4\xfc\xb2\xf1\x46\x95\xc6\x88\x60\xd9\xe6\xb8\x82\x33\x35\x45\x20\x82\x24\x8f\xd8\xac\x7f\x80\x35\xde\x97\x15\x5b\x98\x18\x0d\x98\x6e\xc5\x49\xb1\xa5\x58\x3b\x5b\x5a\xb2\x85\x9a\x04\xf5\x6d\x14\x40\xb7\x0f\x18\x87\xcf\x6b\x64\x89\x30\x18\x2b\x23\x5c\x38\x38\x27\xbb\x58\x58\xf6\x29\x0d\x3b\x26\xa3\xdb\x36\x13\xfa\xec\xd0\xf0\xa5\xd0\x11\x10\xcd\xbd\x4c\xfd\x6f\x80\x04\xe6\x3c\xf9\x16\xd3\x34\x51\x28\xc4\x00\xae\x45\x4b\xde\x7d\x4a\xf1\xe0\xc7\x28\xb3\xd0\x17\x62\x77\x4a\x4b\x10\x70\x53\x3d\xf4\xf1\x5d\x23\xbb\x11\x44\x8e\xda\xbd\x98\x45\x9a\x48\x0e\x88\xdd\xf9\x61\xe4\x7c\x1b\xb1\xe0\xf5\x1e\xa9\x6b\x9d\xed\xbb\xe2\x96\x85\x5f\x52\x31\x30\xcc\x13\x77\x1f\xdd\x9e\x7c\xab\x82\x26\x54\x9a\xd0\x76\xe4\xca\xf2\x3a\x9e\xc1\x6d\x4b\x23\x93\xa9\xd1\xb5\xdb\x80\xfc\x6f\x81\x31\x94\x21\x1d\x37\x61\x6d\x15\x95\xf7\x99\x01\xb0\x8c\xff\xf3\x3c\x8e\xe9\x60\x79\x6e\xbe\xc5\xb5\xe7\x85\x64\x23\xe4\x89\xa3\xd9\x85\xfb\xdb\x47\x73\xf6\x94\x3b\xc6\xc3\xdf\x1d\x7b\xaf\x91\xe8\x09\xee\x6e\xf2\x76\x14\x48\xf8\x03\xcd\x50\xda\x38\xc2\x41\xf7\x04\xbc\x9e\xb7\x28\xbe\x09\xf7\x80\x4b\x4c\xe7\xea\xbe\xa8\x5e\x3f\xe3\xe8\xe8\xff\x21\x6e\x4a\x2e\x10\xdf\xaa\xd1\xa6\x7c\xc8\x0e\x7d\x20\x65\x69\x11\x96\x31\x10\xc4\x11\x7f\x7d\x15\x19\xbf\xa7\xac\xb7\x08\x9f\x55\x84\xac\x0a\xa4\xd8\xc4\xe6\xdb\xbb\xdd\x96\x0d\xce\xfc\xd3\x53\x2c\x46\x2e\xe8\x0e\xea\xdd\x78\x59\xc3\x03\x8b\x8b\xc3\xa6\x24\xde\xe2\x0a\x42\x5e\xb1\x92\x92\x02\xbf\xe7\xd9\xb0\x2e\x92\x1d\x15\x8e\xd0\x12\x6e\x57\x89\x74\x1c\x64\xa5\x0d\x33\x58\x62\x28\x43\xaa\x19\x11\x2c\xb1\x0b\x24\x42\x51\x2d\xbb\x17\xbf\x5e\x21\x3b\x5b\x6a\x2e\xa0\x2e\x3b\x7c\x64\x26\xc5\x97\x96\x42\x0f\xe7\x9b\x30\xe7\x40\x70\xd3\x48\x95\x34\xf8\x86\x04\x96\xff\x00\xc6\x0c\x8e\x49\xee\x49\x76\xf7\x7a\xc4\x01\x76\xba\x1d\x14\xed\x1c\x14\x34\x45\x97\xca\x35\x44\x2e\xbd\xbd\x6e\x84\x0e\xd5\x4b\xbc\x44\xb9\xf6\x3c\xe1\x9e\xf5\x3c\xc0\x86\xf3\xeb\xc8\x08\xfc\x7d\xe7\x84\xc0\x6b\xdb\xc7\xb3\xcc\x41\x8f\x63\x6f\x83\x6c\x22\x43\x05\x49\x81\x07\xa4\x64\x23\xf6\xa5\x27\x28\x01\x1f\x07\x4a\x83\xa1\x9d\x42\x7d\x9f\xf1\x42\xa3\xbd\x39\x38\x39\x27\xdd\x03\x02\x30\x27\x85\xba\xdf\x5e\x8d\x9e\x67\x43\x5f\x78\xd3\x18\x61\x2b\x6d\xae\xd9\x1d\xb9\x03\x74\xc8\x04\xc8\x46\x9a\xf3\x52\x61\xee\xc8\x8e\x18\xed\x6b\x2e\x2e\xb5\xd9\x95\xbf\xcd\x85\xed\x33\xaa\x78\x72\x3e\xc6\x00\x0b\xde\x62\xd6\x69\xa3\x35\x70\xed\x39\x67\x5a\xf3\x5b\xc8\x2c\x35\x88\x30\x42\xee\x67\xef\x20\x8d\xd1\xad\xfc\xa6\x37\x9e\x41\x05\x9e\x9f\x0a\xde\xe2\x92\x0b\x42\x2e\x90\x42\x22\xce\xdd\x21\x7e\x7d\xa7\xeb\x3f\xf9\x7c\xff\xb8\x0d\x64\xae\xb6\x05\xdf\x9f\x08\x5e\x7b\x7d\xa9\x57\xe3\x79\xde\x7d\x7b\x0a\xa5\x8b\x17\x4f\x52\x57\x4b\xdd\x7f\xb0\xb6\x15\x25\x28\xdf\x25\x3a\xb1\x4a\xb0\xd4\x63\xc8\x44\x14\x53\x00\x93\x59\x01\x84\xcb\x2c\xb0\x3a\x25\xfe\x95\xd4\x38\x3e\xcf\x83\x82\xae\xd6\x02\x19\xdd\x13\xfe\x53\xe7\xd0\xe7\xe4\x37\xb4\xd0\xc7\xd9\x0d\xb3\x87\x55\x96\xa4\xf3\xd3\x6a\x5f\xb6\xfa\xfa\x4a\x36\xa2\x38\x2e\x2b\x17\xfb\xd7\x04\x1b\xf5\x91\x99\x71\xa6\xbb\x13\x63\xd1\x25\x4e\x36\x87\x04\x29\xa2\xa1\xe9\x5a\xa9\x52\x74\x6c\x6d\xc6\x39\x9a\xfb\xc3\x1d\x20\x43\x25\x48\x91\xf0\xfa\x29\x38\xe8\xf1\x1e\xc5\xb0\x0d\x32\x1e\x8e\xc5\x15\x7d\x33\xd7\xd1\xa6\x98\xcb\x0d\x44\xf8\x91\x72\xff\xca\xd8\xaf\x69\xb0\xa5\xe7\xdc\x7a\xe4\xfb\xdf\x13\x75\x14\x42\xb2\x86\x5c\xe3\x2f\xf1\x78\xec\x9b\x5d\x39\xf1\x60\xc3\x04\x98\x24\x84\x5e\x0d\x38\xd5\x78\x87\x31\xb8\x6d\xc5\x43\x5c\xb5\x8f\x17\x87\xe4\xe1\xdc\x76\x01\xe1\xa6\x8c\xf8\xac\xd1\xa1\x01\x6f\xe4\xb3\x8d\x83\x07\xc9\x05\x17\x4b\x42\x1a\x0b\xcc\xe5\xc0\x14\xc5\xf0\x36\x7b\xec\xaf\x8c\xa6\xfd\x26\xc6\x5d\xea\x30\x60\xcc\x2c\xa6\x2d\xe3\xc5\x09\x9e\x04\x6d\x12\x97\xd4\x7e\xcf\x4b\xdb\x5b\x77\x97\xa3\x65\x62\xbd\x7d\x13\xee\xa4\x50\xf3\x7c\x3f\xc1\xb4\x61\x04\x9c\xb2\xec\x3d\xd0\x82\x96\x73\x2b\x27\xec\x13\x62\x9a\xb3\x04\x3c\x82\x5b\x52\x11\xb1\x2a\x79\xc4\x29\x7a\xdb\x2a\x2c\x55\x20\x36\x90\x3b\xe9\xb1\x7b\x23\x4b\x25\xa6\x2a\x97\x5e\xcd\x82\x85\xf8\xe1\x2c\x11\xe0\x4c\x45\x0b\x44\xc7\x06\xe1\xfe\xc8\xd8\x5c\x46\xc9\x16\x00\xac\xc3\x01\xc5\x6c\xc5\x40\x2f\xef\xf9\x83\x6d\x1a\x74\xdf\x67\xc4\xee\x57\xac\xde\xce\xcf\x7f\x85\x22\xf8\xff\x72\x5c\x4e\xce\xf1\x50\x77\xc6\x06\xf9\x23\x6d\xe2\x05\x2f\x38\x23\xd3\x23\xfe\x1a\xba\x33\x41\xff\x88\xe0\x86\x3b\x0d\x2b\x5e\x50\xa7\x45\x39\x8b\xb3\xcc\xfe\x31\xd0\x71\x5a\xd0\xfe\x91\x21\x92\xa9\x9f\xa6\xe7\x39\x41\xef\x0f\xbd\x96\xfb\xdd\x43\x94\xa8\x52\xea\xc6\x1c\x22\xbf\xa7\x63\xbd\x18\x0a\x0c\xf8\xde\x3b\x11\x8b\xa5\xf3\xe5\xf6\x89\x0d\xd2\xf6\xea\x2b\xb3\xd6\xd5\xfe\x90\xdc\x42\xd2\x9e\xbb\x5d\xb1\x05\x4c\x64\x00\xd1\xb9\x07\x79\x44\xc0\xd3\xdf\x5d\xa7\x23\x6b\x29\x7f\x64\x26\x57\x75\xee\x35\xd0\x3b\xae\x76\x4c\x60\x36\x6b\xb5\xf0\x6d\x32\xf8\x85\xe1\xeb\xc7\x84\x6e\x5c\x46\xab\xa0\x08\x96\xe1\xa5\xb2\xd8\x61\x37\x74\x4c\x1d\xe4\xfe\xfd\x8f\x48\x57\xcf\xad\xb5\xd7\x0f\x3c\x38\xe4\x86\xbe\x24\x20\x99\x31\xde\x23\x8d\x80\xba\x45\x19\xf2\xf8\x60\xfb\xbc\xc6\xbf\xbb\x7f\xb3\x70\xb4\xd6\x4b\x09\xe0\x5a\xc4\xf1\x9c\xef\x13\x4e\x46\x9e\x17\x02\xab\x64\x27\x54\x39\xaa\x78\xc8\xba\x51\xd1\x4e\x84\x0d\x4f\x00\x1a\x90\xbe\x18\x99\xa8\x9a\x3d\xee\x8e\x2e\xf3\x8b\x1a\xd7\xa8\xe6\x65\x5a\xfb\x41\xac\xda\x5c\xae\xf3\xd8\xee\x2f\xa7\xa0\x48\xf2\x22\x1f\xe5\xf9\xa3\x36\x69\x8b\xa3\x5b\x39\x7c\x30\x52\x65\x0b\x27\x87\x8c\xb1\xcf\x7d\xcb\x7e\x4d\xd7\xca\x55\x73\xa4\x31\x4a\x97\x51\x60\xd3\x7f\xe9\x4e\xd3\xf3\x99\x2f\xff\xa3\x88\x2d\x59\xc2\x2f\xee\xb8\x79\xff\xa2\x37\x92\xe6\x67\x59\x58\x5d\xe5\xc0\xf9\x2c\x94\x1e\x54\x90\x08\xa1\xec\x0c\x89\xaf\xef\x7e\x7b\xb7\x7d\x7f\x7b\xb7\x3b\x7d\x35\xe0\x70\xfb\xcd\x65\x16\xc6\x4f\x9e\xe8\xe6\xe5\x15\x15\x1f\x8f\x85\xee\x71\xe5\xde\x0c\xc1\x26\x40\xd2\x17\xeb\x1f\xca\x96\x28\xfc\x16\x89\x70\x7e\x33\xca\x4d\xcf\xe9\x76\x3b\xfb\x16\x01\xd8\xfa\x96\x16\x1b\x58\x69\x86\xb7\x76\x44\x69\x00\xa5\xa4\xb1\xd4\xaf\x15\x2c\x99\x37\xec\x11\xaf\xec\x28\xe8\xe8\xc6\x9a\xaf\x65\x98\xbc\x37\x4e\x4f\x9e\x58\x5e\xd2\x07\x74\x92\x8e\xec\xa0\xcb\x8b\x00\x1d\x67\x04\x48\xe4\x8d\xee\xfd\x3a\x6c\xb5\xbc\x90\xb4\x5c\x5e\xe3\x8f\xfa\x93\x20\x07\x86\xb9\xd4\x2e\x72\x2d\xb5\x28\xa9\x5d\x1e\x28\x5e\x4f\xbb\x1b\x5f\xd4\xa0\x9e\x7c\xb5\xe1\x75\x8e\x3b\x50\x29\x37\x27\xaa\x4f\xb6\xe7\x9f\x47\xa2\x11\x92\x7a\x5e\x49\x22\xd5\x2c\xec\xbb\x02\xed\x5f\x40\xa7\x6a\x60\xbe\x29\x4f\x2b\x83\x98\x62\x96\xd6\x1c\x55\x77\xff\x7d\x77\x22\x86\x31\x57\x35\xcc\xab\xf1\x95\x89\x74\x2e\xa8\xa7\x22\x53\x7b\xe4\x28\x8d\x72\xbd\x69\x57\x7f\x85\x7a\x41\x8a\x3e\x8e\x05\x72\x6b\x0a\x8b\xa6\x66\xc2\x82\x92\xc7\x83\xc1\x3c\xe2\xfc\xa6\xd5\xf4\x00\x3d\xf1\xdd\xf8\x5a\x91\x00\xae\xf6\x70\xea\x0e\x8a\x6c\xf5\x0c\xdf\x04\xce\x74\xbe\x49\xfa\x11\x30\x44\xdb\x91\x8b\x59\xae\x09\xcc\xc4\x61\xee\x87\x1d\xe1\x09\x3a\xed\xd1\xa6\x13\x02\xdb\x6a\xa4\x86\x69\xd1\x03\xd6\x55\x78\x2c\x29\x5f\xd5\x03\xf7\x97\xf3\xc0\x8d\x51\x5b\x6d\x8f\x16\xd8\xc8\x69\x54\xf6\xa5\x3a\x44\xf6\xde\xaf\x6f\x67\xb9\xea\x42\xb6\x3e\xb4\xdc\xdd\xd7\x65\xb7\x32\x1d\x14\x32\x8a\xb8\xbf\xc6\xa3\xd6\x6a\x15\xa4\x47\x63\x11\xcc\xd1\x7a\xea\x02\xaa\xdb\x1f\x9f\x42\xf3\xae\xe5\x74\x3f\x09\x1f\x7a\x46\xdd\xf8\x76\x61\x94\x2b\x5e\x18\x24\x86\xcc\xe1\x14\xfd\x21\x4f\x65\xf3\x72\x0f\xdb\x02\x95\x47\x39\x6c\xec\xf7\xd9\x1e\xd0\x22\x98\xc4\x82\xc6\xbc\xbf\x32\xdf\x69\xfd\x06\x4a\xf7\x82\x4a\x5f\xac\xf2\xca\xa5\x66\xf9\xbe\x1f\x91\xfb\xfa\xfa\xda\xb6\xf4\x75\xba\xc9\x2a\x0e\xa5\xf3\xc9\x42\xba\x4c\x89\xdd\xc7\x42\xbe\x7b\x22\x98\x1c\x11\x0c\xff\x35\x71\x8c\x3e\xda\x20\xf9\x2e\x6f\xd6\x7c\xd4\xee\x6e\xb6\xca\xb5\xb8\x2a\x22\xea\x11\x15\x47\x30\x58\xf9\xd5\x40\xd3\xc5\x4b\xb7\xd2\x9a\xbe\xe0\xde\x7d\xcd\x0d\x42\x8b\x88\x4e\x8b\xfc\xc7\x2f\xac\xef\xf1\xce\xc8\x98\xc3\xa1\xd9\xe4\xb3\x39\x30\x6e\x93\x9b\xb7\x33\xf9\xc0\x30\x3b\xb7\x01\x6e\x1e\x79\x2b\x14\x6a\x62\x53\xea\xe0\x01\xc8\x41\x02\x51\x3b\x8a\xd5\x37\x74\x56\xdd\xaa\x9c\x5e\x12\xa6\xa4\xfd\x48\x10\x3c\xbb\xa8\x25\xd6\x8c\x23\x21\x36\xc9\x98\x62\x89\xb8\x53\xbe\x2a\xc9\xb5\x07\x93\xe2\x71\x7a\xe4\x7e\xa2\x92\xa8\xd1\x0e\x53\x33\x0e\x1c\x56\x9f\x35\x2a\xfb\x67\xfb\xdd\x26\x4e\x2d\xa6\xb7\x70\x08\xa7\xf9\xe2\xfc\x05\xf7\x18\x75\xb0\x1b\xcd\x7d\xf0\xa3\xe3\x27\xc3\x32\xb9\x17\xd9\xa9\x90\x90\xbd\xd8\x24\x0c\x87\xee\x30\x7a\xe9\x84\x8b\xcd\x62\x1c\xba\x42\x7b\x72\x2f\x13\xbd\x3f\x4d\x9d\x2d\xf0\x20\xf1\xe1\xb5\xb5\xb5\xf8\xbc\x17\x68\xe0\x3b\x8d\x0f\x72\x0e\xc1\xfb\xd3\x2d\x54\x9d\x34\x1a\x3a\x4d\x5a\xf7\xa3\xdd\xf6\xb7\x0f\x97\x6e\x61\x45\x77\x0d\x3e\x12\x89\x4c\xb0\xd8\x21\x8b\xf0\xc7\xe4\xb7\xcd\x1f\xf6\xd3\x67\x3e\x90\x85\x48\x8b\x30\xb5\x32\x74\xfd\x32\x0d\xfb\x88\xf7\x88\x91\x04\xf4\x03\xbc\x9b\x85\xbd\xcb\xb4\x0e\x48\x62\x79\xf0\x5b\x07\x83\x6e\xdf\xb1\x5d\xc2\xe0\x0d\x9a\x58\x9d\x15\x6c\xbb\x5c\x04\xc3\x19\x91\x49\x04\x55\x9e\x14\xa2\x5a\x11\xd2\x86\x87\x99\xcc\x0c\x28\xb3\xe9\xd8\x5f\x8b\xd1\xfa\x39\x4c\x0d\xf9\x3e\xc3\xc4\x87\xe2\x80\xd9\x73\x5d\x82\x4e\x75\x98\x2e\x6a\xb5\x63\x74\x91\xef\x2e\x9e\x38\x71\x67\x27\x11\x2b\x2c\x18\x38\xa0\x40\xa7\x4a\x2d\xc2\x37\x11\xda\xf4\xcf\x4c\x94\x57\x94\xfb\xb7\x24\x20\xa3\x5c\x89\xdb\x82\x3c\xbc\x74\x0a\xdf\x62\xd0\xd6\x59\x59\x1d\xd0\x3c\xf7\xfd\x53\x6f\x00\xff\x40\x50\x08\xce\xbe\xeb\xb4\x2f\xc7\x2a\x1c\xc9\x78\xa3\x48\x21\x6a\x97\x31\x81\x1b\xac\xc1\xa9\xfb\x51\x58\x26\x36\x6c\x5d\xa1\xb9\xbd\xff\x03\x4f\xeb\x0a\x47\x4d\x0b\x9c\x90\xf7\x73\xdd\xd1\xef\xc8\xd8\x06\x7d\x76\x50\xde\x04\xa8\x60\x6a\x83\xc3\xe3\x73\xb7\x9e\xe4\x8a\x38\x04\x88\x2a\x2a\xca\x37\x6d\x48\x37\xc8\x66\xcc\x81\x43\xaf\x30\x9d\x03\xa3\xa9\x48\x83\xd9\x7d\x9a\x0b\xb9\x0f\xe3\x2e\x8f\x6d\x44\x6c\x30\xe5\x0c\xdf\xf5\x30\xee\xdb\x4a\x1f\xc6\x8a\x99\x8c\xc8\x7f\x5b\x1f\xd7\xc0\xfe\x6b\x38\x90\x30\x31\x33\x1c\xe1\x54\xcb\xf1\x14\x0c\xaa\xd0\x21\x5f\x57\xb0\x7b\xf1\x5c\x76\xfc\x4a\x16\x5e\x90\xf7\xf6\x11\x6d\x48\x64\x9f\xec\x98\xfe\x71\x7e\x40\xff\xa5\x3c\xbc\x82\xde\x2f\xdb\xad\x3f\x21\x82\x9b\x8b\xe2\x40\x5b\xe2\x0c\x7c\x28\x12\xe4\x42\xd4\x09\xc6\xa4\xfe\x86\x2f\x6a\x33\xf8\x25\x05\x06\xd4\x6e\x69\x65\x71\x08\x39\xd9\xf7\x29\xe2\x41\x92\x67\x03\x3a\x2a\xbb\xe6\xf1\x93\xfb\x5c\x4f\x06\x25\xb8\x45\x46\xde\x51\x73\xfb\xf5\xe2\x94\xb8\x0d\x33\x87\xd7\xd0\xf5\xb7\xd4\x42\x6a\xc3\x8b\xf9\xe8\x4a\xd1\xc5\x3c\x0d\x02\x7c\x4e\x9b\x53\x9e\xdb\xed\x33\x72\x26\x6d\x81\xd5\xab\x04\x1d\xad\x43\x75\x4b\x7d\x38\xb8\xa9\x96\xb9\xec\xc7\xb3\xd2\xc1\x65\x18\x47\x47\xc4\x7b\x37\x84\xb4\x30\xd6\x2b\x69\xb6\x88\x5a\x6c\x27\xeb\xc2\x44\xc4\x61\xab\x40\xd1\x6c\xad\x77\x29\xa8\x04\x39\xb6\x2e\xd4\x70\x38\x53\x79\xc4\x91\x49\xef\x40\xab\x4c\x02\xcd\x46\x55\x1a\x4a\x5e\xbe\x8a\x60\x7e\x7c\x0a\x06\x48\xb7\x2f\x67\xcc\x60\x43\x72\xbc\x13\xa1\x81\x84\xb5\x43\xb4\x91\x8b\xef\xa4\x28\x3e\x95\xd9\xf7\xcf\x4e\x11\x36\x67\xef\xcd\x96\x52\xd9\xed\x42\x60\x78\xef\x26\xf9\x5f\xcf\x78\x2e\xb3\xee\x67\xad\xb3\xf7\x0a\xc1\x93\xbf\x78\x14\x70\x15\x65\x90\x6a\xf8\x99\x94\x3f\x29\x5b\x2e\x1c\x4f\xfb\x50\x49\xa3\xc7\xd2\x25\x9d\x88\x21\x04\x9f\xe1\x4b\x7f\x8c\x46\xab\xed\xeb\x2b\xcd\x07\x5b\x6a\xce\xdd\x6e\x06\xab\x63\xe4\xb7\x3a\x50\x4e\x7d\x95\xad\xa8\xc3\x69\x2e\xa2\x5f\xa4\x51\x5d\xcf\x6b\xad\xdb\x5f\x7c\xb5\x9b\xf1\x7d\x1d\xe8\x41\xfa\x05\xb9\xd0\xfa\x9b\x53\x7d\xf7\x24\x0a\x26\x76\xcd\x28\x26\xa2\x35\x56\xfb\x61\xc9\x16\xe1\xfb\xcb\x67\x6b\x6b\xc8\xdb\x0a\x53\xfd\xdd\x26\x10\x88\xb7\x82\x1b\x7c\x16\x6a\xf0\xd4\x2b\x5e\xa6\x5e\xc0\x2a\x0f\xab\xe1\xf9\x9e\x8d\x29\x21\x10\x8a\x88\x1d\xf4\xf2\x78\xf3\x4d\x2e\x72\x7f\xe8\xe4\xe9\xee\x0c\x04\x2b\x3c\x12\xf6\x3b\x33\xaf\x1d\x72\x12\x90\xd1\xd1\x29\x43\x97\xb1\xf4\x1a\xc1\xc6\x0e\x0e\x23\xaf\x2e\x09\x96\x28\xa5\x2b\x4e\xb7\x54\x2a\xce\x78\x4f\x57\xdd\xa9\x53\xa2\x5e\xde\xf5\xc7\x56\x77\xe6\xa1\x41\x4b\x3d\xce\xed\x6a\x85\x92\xf6\xf5\xfc\xaa\x61\xcd\x65\xec\xbd\xd4\xba\xc2\xf3\xd9\xeb\x45\x85\xac\x8f\x4e\xd9\xec\xa5\xe0\x8b\x47\x11\x83\xed\xfb\x4f\xec\xc0\xf6\xc1\x4c\x5a\x23\x25\x7b\xae\x0c\xaf\xbe\xfc\xab\x44\x3a\x3b\x99\x9f\x86\x0b\xe8\xa1\xa7\xf2\x34\xec\x7a\x49\xee\xdf\x53\x12\xda\x9c\x89\x47\x3e\x9f\xc9\x49\x72\xff\x53\xd2\xd4\xec\xaa\xfa\xf3\xdd\x06\x75\x9e\x5c\x54\x5c\x3c\x0b\xa7\xa1\xa3\x4e\x45\x73\x16\x6c\x0d\x96\xc8\x97\x6f\xe6\x2e\xeb\x84\x4f\x99\x03\xb5\x88\x48\x18\x62\xcc\x25\x10\x72\x84\x30\x14\x75\x74\xbe\x5f\x61\x26\x5e\x6c\x18\x56\xd8\x0f\xd1\x81\x3a\xf1\x0e\x9e\xc2\x36\x67\xd5\xac\x7f\x1d\xa2\x93\x1b\xb1\xdf\x37\x4c\xa5\xa6\xb2\x23\x71\xce\x4c\x1c\x74\x72\xc3\x57\xa9\x29\x72\x9f\xb7\x83\xe3\x46\xbe\x9d\xcd\x78\xdb\xff\x9d\x0b\x8c\x9e\x12\xfa\xbc\xe6\x82\x76\x16\xbc\x64\x07\x45\xff\xfe\x21\xb2\xdb\xd7\xc0\x21\x29\x4d\x5d\x5c\x13\x49\x44\x79\x3c\xf9\x9a\x95\xf4\x7d\xcd\xdc\x9b\x20\x13\x93\xb0\xf0\xad\x4a\x77\x1d\x63\xff\xd3\xfe\x26\x4c\x73\x73\xf6\x3a\x22\xa9\x7b\x28\xa8\x1c\xed\x4a\xf6\xfc\xe0\x3c\x6e\x44\xb5\x61\x56\xe4\xe9\x53\x61\xba\x42\xd6\xf3\xaf\x55\xc0\x31\x94\xad\x1a\x77\xb9\x95\x43\xb5\x77\xe9\xea\x87\x80\x2a\x0e\x37\x2c\x63\xa3\x49\x2f\x93\x2d\x9f\x55\x47\x6b\xf7\x01\x9e\x27\x1d\xb7\x27\x4a\xdd\xea\x7c\xd9\x24\x39\x8a\xe9\x67\x43\xa6\x1b\xb0\xc1\x27\xfb\x89\x22\xf3\x43\xa9\x78\xbe\x5d\x43\x48\x19\x55\x5f\x6f\xcd\xbc\x7e\xed\x3e\x24\xe9\xe0\x89\xba\x63\x0d\x57\x75\x76\xf3\xf6\xf6\x56\xb5\xff\xee\x64\x45\xd0\xae\xf6\x87\x61\xed\x6f\xd3\x8e\x87\x9c\xc8\x64\x20\x6a\x28\xd1\x53\x45\x11\x5a\xc5\xed\xd7\x9d\x11\xcb\xa6\xe7\x57\x8a\xad\x60\x51\x45\xeb\xa3\x5f\x5d\xdf\x31\xab\x4b\x9b\xfb\x37\x18\xc5\xf6\xe9\xc3\x0c\x90\x65\xfc\x84\xd7\x59\x48\x89\xed\x8e\xd7\x44\xf4\x95\xa4\xf7\xef\x3c\x87\x72\x74\x7f\xee\x2c\x44\xe3\x8f\x75\xe3\x97\xb2\x3c\x68\x96\x48\xaa\x47\x3f\x41\xd0\xd7\x03\x93\xc9\x9e\xc4\x58\x2e\x85\x14\xc5\xcd\x93\x9f\x9e\x9e\xfe\x54\x35\x1a\x73\xaa\x0c\xe6\x61\xf1\x2a\x57\xd2\x9f\xae\x07\x3a\x09\x50\x38\x09\x50\xac\xae\xae\x42\x5a\x0c\xfb\x80\x9e\x97\xc6\xd0\xb8\xf5\xaf\x53\xe7\x4c\x6e\x9f\x58\x28\xa6\x86\xca\x6e\xa6\xbf\x6c\x62\xf4\xb3\xc9\xb6\xfd\x54\x29\x08\xc2\xd9\xef\x57\x7e\x25\x63\x86\x1f\x36\xdd\x4f\xd8\xf0\xda\xd5\x6e\x41\x65\xd0\x8b\xf4\x11\x69\x96\x02\xae\xb8\x64\x91\xd5\xf1\xc5\x8d\xa2\x2a\x52\x97\xf8\x8a\xbf\x89\x33\x6b\x6b\x8a\xcb\x9f\x97\xda\xd1\xa1\x17\x0c\xfe\xf8\xd9\x75\x1d\x1a\x95\x2c\xb5\x6d\x29\x85\x2f\x7b\x8e\xfc\x7f\x34\x7d\xef\xfe\xbf\x8c\x5f\xe6\xe6\x97\x03\x8c\x88\xf3\xfa\x69\x11\x24\x48\x7c\x33\x83\x6d\xca\x15\x1d\x7a\xbf\x04\x11\x0e\xe4\x89\xd4\x42\x51\x27\x48\x71\xff\x6d\x08\x00\x00\x00\x94\xe4\xd4\x64\xeb\xa4\x21\x11\xff\x2f\x00\x00\xff\xff\xa9\x7b\xa4\xe2\x12\xfc\x00\x00")
 
 func wwwArazuki_sakuPngBytes() ([]byte, error) {
@@ -978,7 +1001,7 @@ func fileInitnodeTxt() (*asset, error) {
 	return a, nil
 }
 
-var _fileMessageEnTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x56\x51\x6f\xe4\xb8\x0d\x7e\xd7\xaf\x20\x36\xe8\x36\x0b\x74\x9d\x34\xdd\x7b\xe9\xaa\x2a\x32\x59\xef\x6e\x70\xb9\x49\x30\x9e\xc3\x76\x51\x14\x86\xc6\xa6\x6d\x35\xb2\xe8\x93\xe4\x38\xbe\x5f\x5f\x50\xf6\x4c\x82\x3b\xe0\x1e\xfa\x90\x50\x22\x29\x91\x32\x3f\x7e\x9c\x33\x71\x06\x3f\x61\x08\xba\x45\x68\x8c\x45\x68\xc8\x43\xee\x5a\x6b\x42\x27\xce\xe0\x86\x86\xd9\x9b\xb6\x8b\x70\x5e\xbd\x83\xab\xcb\xcb\x1f\xde\x5f\x5d\xfe\xf5\x07\x08\x9d\x71\x5f\xf2\x7d\x18\xe1\xc1\xd3\x7f\xb1\x8a\x99\x38\x13\xc2\x6a\xd7\x4a\x85\x4e\x88\x33\xe8\xd1\x8d\x70\xd0\x5e\x44\x1a\xa4\xda\xdf\x3f\x08\x87\x93\x54\xdb\xfc\x9b\x30\xae\xc6\x67\xa9\x6e\xb7\x9f\xf2\x7f\x89\xaa\xd3\xae\xc5\x20\xd5\xcd\xd7\xeb\xed\x97\xbc\x10\x1e\x2b\x74\x51\xaa\x5d\x7e\x93\x6f\xf7\x22\xa0\xf6\x55\x27\x55\x91\x5f\xef\x6e\xbe\x8a\x9e\xd7\x57\x37\x5f\xdf\x6f\x76\xf7\xdf\x8a\x7c\x27\x7c\x08\x52\xed\x8a\x82\x63\xd6\x18\x2a\x6f\x86\x68\xc8\x09\x5e\x97\xc7\x48\x2c\x80\x1a\xd0\x55\x87\x35\x6c\x36\x05\x9c\x07\xf2\x11\x6b\x38\xcc\xf0\x84\x96\x2a\x13\xe7\x77\xd9\x72\xe8\x94\xd1\x1f\x1f\x8b\xa6\xc7\x10\x75\x3f\x1c\xcf\x9d\x12\x4f\xd2\xce\x30\x0e\xb5\x8e\xcb\xc1\xd5\xe5\xf4\x98\x24\xa1\xf1\xd4\x43\x75\xba\x7d\x75\x42\xef\xc9\x4b\xb5\x27\x08\xfa\x09\x41\x3b\x72\x73\x6f\xe2\x9c\xc1\x7e\xf4\x0e\xa8\x69\x52\x91\x2a\x72\x01\xab\x31\x9a\x27\x84\x81\x42\x3c\x66\x4f\x7d\xbf\xa6\xa1\x03\x39\x88\x04\x1e\x7b\x7a\x42\x38\x37\x0d\xcc\x34\x42\x40\x57\xb3\x9a\x62\x87\x1e\x1c\xd5\x18\xde\x9d\xf2\x73\xb5\x54\x2f\x61\x8c\x0f\x31\x5d\x9e\x22\x3a\x9c\xd2\x47\x98\x3a\x74\xe9\xa6\x49\xbb\xc8\x37\xa5\x3c\x67\x1a\xfd\xab\x64\xb9\x1e\x91\x06\x18\x74\x8b\xc2\x52\x4b\x52\x9d\x40\x23\x5e\x15\x4a\xaa\x87\xab\x87\xf5\x1c\x8d\x81\x03\x88\x60\x22\x4a\x75\xdf\x34\xa6\x32\xda\x42\x61\x22\x8a\x10\x75\x1c\x83\x54\x45\x92\x42\xb7\x1e\x71\x79\xe8\xf5\x71\x29\xa2\x89\x16\xa5\xda\xb3\x58\x71\xf4\x52\xcd\xa5\x2c\x70\xb3\xec\x85\xb6\x56\xaa\x6b\x6b\x19\x51\x65\xa5\x23\xb6\xe4\x4d\xc2\xe1\x69\x9d\x1e\x7d\x55\x75\x30\x06\xf4\xa0\x5b\x74\x31\xf0\xb3\x12\xaa\x44\x63\x6c\x44\x2f\xd5\xe7\x24\x85\xc7\x16\x9f\x07\x0e\xd3\xe6\xcf\x83\x88\xba\x95\x6a\xaf\x5b\x11\xa2\x37\xdc\x15\x45\x92\xac\x2f\xf9\xf5\x8c\xae\x61\x8c\x10\x75\x1b\x20\x0c\xd6\xc4\x68\x5c\xcb\xb8\x0a\x83\xae\x30\x83\x4f\x04\x8e\x22\x87\x86\xb7\x36\x7e\xfc\x0b\xbc\x6d\xf9\xbf\x76\x35\xbc\xd5\xfd\xf0\x31\x13\xa1\xa3\x89\x3f\x2a\x4d\x9c\x14\x57\x49\x2c\xf5\x2b\x7e\x5f\x60\xe1\x74\x8f\x52\x6d\x75\x8f\xa2\xd7\xc6\x4a\x95\xbf\x67\x29\x82\x69\x9d\x8e\xa3\x47\xa9\x8a\xe3\x52\xe8\x18\x35\xc3\xf4\x3a\x49\x11\xc6\xa6\x31\xcf\x52\x15\x49\x8a\x15\x9f\x39\x0b\x30\xee\xa5\x11\xc4\x09\x7b\x37\xcb\x42\x70\x52\x52\x3d\xdc\x17\xfb\xb4\x2c\x0f\x54\xcf\x52\x3d\x30\xa0\x22\x3e\x47\xce\x5b\xd7\xbd\xe1\x6e\xb5\x25\xd3\x8f\x54\x9f\xf2\xbb\x7c\x9f\x27\x18\xb0\xd2\x63\x45\xbe\x3e\xa9\xaf\x77\xfb\xdb\x9b\xbb\x5c\x2c\x90\x96\x6a\x91\xa2\xd2\xae\x42\x2b\xd5\x22\x57\xce\x28\x1d\x4e\xeb\xa5\x6b\xbf\x25\xe0\xf6\xfa\x11\x8f\x50\x16\x95\x47\xcd\x58\x5b\x64\xc2\x6c\xe7\x51\xd7\xe2\x04\x48\xa9\x4e\x4b\x61\x75\x88\xa5\xf6\xd1\x54\x7c\xe9\x17\xe2\x8f\x1c\x3b\x04\xd6\xc3\xaa\xcf\x98\xf0\x4a\x6a\x4a\x06\x3e\x77\xf1\xc0\x0c\x12\x3b\x13\x52\x2b\x64\xe2\x40\x31\x52\xff\xe2\xb1\x49\xfb\xdf\x38\xa5\x48\x8b\x9d\xab\xcf\x7f\xac\x62\x0e\xfd\x8d\xda\xe1\x24\xc8\xd6\xab\x96\x6c\xcd\x38\xe1\x3f\x81\xb5\x89\x65\xc2\x61\x5e\x9b\x05\x69\xc2\x2f\x9d\x10\x44\x98\x5d\x55\x32\xff\x94\x0e\xe3\x44\xfe\x51\xaa\x62\x76\xd5\xf1\x15\x61\xe1\xa6\xd5\x26\x9e\x4c\x8d\xc4\xc4\x24\xd5\x77\x6e\xf3\x83\xa7\x89\x7b\xa2\x26\x0c\x09\xa6\x61\x1c\x06\xf2\x31\x7d\x8d\xe4\xcc\xe1\xb2\x85\x93\x2d\x46\x7c\x55\xcb\xf2\x17\xa9\x3e\x51\xe2\x8f\xc5\x06\x0d\x59\x4b\x13\xc3\x7f\x8d\x7e\x1e\xde\xfd\xf3\x04\x89\x3f\xf2\xdf\x6c\x8a\x73\x64\xe7\x99\xdf\xf5\x3d\x4f\x53\x20\xe1\x53\x38\x3a\x61\xc7\x11\x84\xb1\xea\x8e\xb7\xb3\x69\x81\xc5\xd1\xc0\x48\x70\xa3\xb5\x2f\xb5\xdd\x8e\xd6\xc2\xf5\xd1\x9f\x4d\x2b\xb7\x24\xc3\x42\x30\x07\x5d\x1f\xb5\x1b\x5d\x2f\xca\x0c\xbe\xd3\x08\x95\x76\x7f\x5e\x5a\xf7\xcd\xc5\xbf\xff\x93\x2a\xd5\xc6\x8f\x6f\x12\x9f\x68\x48\x67\xb2\xf5\xd6\x79\x38\x5d\x3a\x0f\x28\x0e\xa6\x5d\x73\xdb\x13\xc1\xc1\xb4\x69\x28\x8b\x0f\x97\x7f\x93\xea\x33\xf9\x83\xa9\x6b\x74\xbc\x5d\x5b\x89\xa3\xd5\xc4\xd1\x3a\xe6\xe0\x01\x7d\x6f\x42\x30\x0b\xef\xeb\xaa\xc2\x10\x16\x58\xfd\xbc\xbb\xcd\xe0\xd6\x85\xa8\xad\x05\xa9\xa1\xf3\xd8\xfc\xe3\x4d\x17\xe3\xf0\xf7\x8b\x8b\x69\x9a\x32\x26\xe7\x16\x63\x18\x33\xe3\x1a\xba\x78\xf3\xc2\xd6\xf2\x42\xab\x4c\x7c\xb8\xfc\x20\xd5\x96\x22\x7c\xa6\xd1\xd5\xbc\x5d\x53\xd8\x77\x08\x1e\x7f\x19\x31\xf0\xac\xfb\x79\x77\x0b\x93\x5e\x40\xd1\xb0\x27\x70\x2e\x9c\x41\x40\xff\x84\x3e\x83\xbd\x9f\xc1\xea\x88\x1e\x12\x7d\xfc\xff\x19\x39\x2a\x6b\x1d\xf5\xf2\xf5\xb5\x6f\x47\xa6\x9c\xc0\xb7\x6e\x09\xd8\x92\x89\x30\xe8\x7e\x85\x6c\x9a\x62\x96\xe8\x31\x80\x35\x8f\x08\x9a\x89\xb6\xcf\x56\xde\x3e\x0e\xdd\x1d\xb6\xa3\xd5\x1e\xf0\x79\xf0\x98\x3e\x64\x58\xf0\x94\x09\xec\x87\x38\x97\xd6\x30\xa3\x6d\x89\x31\x83\x01\x66\x8c\x19\x7c\xd3\x26\x82\x86\x06\x27\xe8\x8d\x1b\x23\x86\x44\xd3\x95\x35\xd5\x23\xfc\x29\xa4\x36\x58\xc6\x97\xb0\xc6\x3d\x62\x5d\x26\x4e\x96\xea\x2e\xed\x60\x9b\x18\xfa\xd1\xd1\xe4\x8e\x96\x1f\x79\xb3\x1a\x18\x01\x41\xaa\x14\x50\x2c\x98\x0e\x52\xad\xe0\x0c\x22\xfd\x7e\x28\x83\xf9\x15\x79\x76\x55\x1d\x42\x61\x7e\x45\x11\xd0\x36\xe9\x36\x66\x3f\xdb\xa4\x31\x90\x7e\x97\x99\x50\x89\x96\xa8\x4d\x14\x76\x7f\xff\xe5\x2e\x17\xd6\xf4\x26\x4a\x95\x84\xe8\x0f\x52\xfd\xb4\x11\x8f\x07\xa9\x7e\xdc\xf0\x98\xa4\xaa\xec\xb1\x97\x2a\x2d\xd3\x0f\x9a\x1e\x7b\xf2\xb3\x88\x14\xb5\x7d\xe5\x90\xf6\xf0\x3b\xb7\x8a\x9c\xc3\x8a\x67\x7d\x79\x1c\xe2\x2f\x2a\xc1\xb4\x71\x99\x98\x9b\x21\x93\xaa\x54\x8f\x98\xc6\x97\xc3\xf7\x93\x9e\xe1\x95\xb3\x47\xab\x67\xac\xa5\x1a\x03\xb7\x7f\xda\xae\xc0\x12\x34\xa0\x63\x53\xc3\xcd\xf4\xea\x4c\x6d\xc2\xba\x63\xeb\xeb\x9d\xf8\x5f\x00\x00\x00\xff\xff\x8b\x55\xca\x25\xfd\x0a\x00\x00")
+var _fileMessageEnTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x57\x5f\x6f\xdc\xb8\x11\x7f\xe7\xa7\x18\xd8\x48\x9a\x00\xb6\xe2\x73\x73\x7d\xc8\xb1\x2c\xbc\xf6\xe6\x0f\xce\x59\xbb\xbb\x1b\xa4\x41\x51\x08\x5c\x72\x24\xb1\xa6\x48\x85\xa4\x2c\xeb\x3e\x7d\x31\x94\x76\xd7\xb8\x2b\xf2\x70\x0f\xf6\x70\xfe\x88\x33\xe4\xfc\x66\x86\x7b\xca\x4e\xe1\x33\xc6\x28\x6b\x84\xca\x58\x84\xca\x07\x58\xba\xda\x9a\xd8\xb0\x53\xb8\xf6\xdd\x18\x4c\xdd\x24\x78\xa5\x5e\xc3\xe5\xc5\xc5\xcf\xe7\x97\x17\x3f\xfd\x0c\xb1\x31\xee\xc3\x72\x1b\x7b\xb8\x0f\xfe\xbf\xa8\x52\xc1\x4e\x19\xb3\xd2\xd5\x5c\xa0\x63\xec\x14\x5a\x74\x3d\xec\x64\x60\xc9\x77\x5c\x6c\xef\xee\x99\xc3\x81\x8b\xd5\xf2\x2b\x33\x4e\xe3\x13\x17\x9f\x56\x37\xcb\x7f\x31\xd5\x48\x57\x63\xe4\xe2\xfa\xe3\xd5\xea\xc3\x72\xc3\x02\x2a\x74\x89\x8b\xf5\xf2\x7a\xb9\xda\xb2\x88\x32\xa8\x86\x8b\xcd\xf2\x6a\x7d\xfd\x91\xb5\xaa\xe1\xe2\xf2\xfa\xe3\xf9\x62\x7d\xf7\x75\xb3\x5c\xb3\x10\x23\x17\xeb\xcd\x86\xb1\x53\xd0\x18\x55\x30\x5d\x32\xde\x31\x8d\x51\x95\x7b\x4f\xe4\x10\x7c\x05\x52\x35\xa8\x61\xb1\xd8\xc0\xab\xe8\x43\x42\x0d\xbb\x11\x1e\xd1\x7a\x65\xd2\xf8\xba\x98\x3e\x3a\x44\xf4\xe3\xcf\x92\x69\x31\x26\xd9\x76\xfb\xef\x0e\x81\x67\x6a\x47\xe8\x3b\x2d\xc9\x78\xb1\xd8\xcc\x26\x87\xc3\x64\x0a\x55\xf0\x2d\xa8\xc3\xee\xb3\x11\x86\xe0\x03\x17\x5b\x0f\x51\x3e\x22\x48\xe7\xdd\xd8\x9a\x34\x16\xb0\xed\x83\x03\x5f\x55\x39\x49\xca\xbb\x88\xaa\x4f\xe6\x11\xa1\xf3\x31\xcd\x5f\x2b\xdf\xb6\x73\x18\x32\x7a\x07\xc9\x43\xc0\xd6\x3f\x22\xbc\x32\x15\x8c\xbe\x87\x88\x4e\x93\xd8\xa7\x06\x03\x38\xaf\x31\xee\x8f\x40\x2a\x2e\x8e\x6e\x4c\x88\x29\x6f\x9e\x3d\x3a\x1c\xf2\xdd\x0d\x0d\xba\xbc\xd3\x20\x5d\xa2\x9d\x72\x9c\xa3\xef\xc3\xb3\x60\x09\x03\xc9\x77\xd0\xc9\x1a\x99\xf5\xb5\xe7\xe2\x00\x9a\xec\x6c\x4e\x14\x17\xf7\x97\xf7\xf3\x77\xbe\x8f\xe4\x80\x45\x93\x90\x8b\xbb\xaa\x32\xca\x48\x0b\x1b\x93\x90\xc5\x24\x53\x1f\xb9\xd8\x64\xca\x64\x1d\x10\xa7\x83\x5e\xed\x97\x2c\x99\x64\x91\x8b\x2d\x11\x36\xa5\xe3\x98\xcd\x75\xe6\xe1\x7a\xe2\x99\xb4\x96\x8b\x2b\x6b\x59\xab\x9a\x52\xc9\x84\xb5\x0f\x86\xec\xae\x0f\xeb\x7c\xe8\x4b\xd5\x40\x1f\x31\x80\xac\xd1\xa5\x48\xc7\xca\xa8\x62\x95\xb1\x09\x03\x17\xef\x33\x65\x01\x6b\x7c\xea\xc8\x4d\xbd\x7c\xea\xd8\xf7\x1e\xc3\xc8\xc5\x3f\x89\xcc\x18\x2e\x1b\xb4\x1d\x17\x5f\x7d\xd0\x11\x64\x40\xb8\x5a\xdd\x9c\xa3\x2e\xe0\x4b\x44\xb8\x5b\x9f\xc1\x49\xd7\x04\x19\xf1\xe4\x0c\x52\x13\x50\xea\x77\xf9\x3c\x67\x90\x64\xfd\x2e\xc9\xfa\x0c\xa2\x71\x0a\xdf\x5d\x5e\x5c\xfc\xed\xfc\xe2\xa7\xf3\x8b\x4b\x90\x4e\x43\xef\x92\xb1\xcf\x84\x05\x4b\xb2\xe6\x62\x2b\x6b\x16\x53\x30\x54\x91\x9b\x4c\x49\x5e\xd2\xcd\x13\xb2\xbb\x3e\xd1\xbe\x11\x62\x67\x4d\x4a\xc6\xd5\x54\x0a\xb1\x93\x0a\x0b\xb8\xf1\xe0\x7c\xa2\x63\xc3\x4b\x9b\x7e\x39\x83\x97\x35\xfd\x27\x6f\x2f\x65\xdb\xfd\x52\xb0\xd8\xf8\x81\x12\xea\x07\xba\x10\x42\x08\x9b\xb0\xb3\xf9\x23\xb8\x98\x93\x2d\x72\xb1\x92\x2d\xb2\x56\x1a\xcb\xc5\xf2\x9c\x28\x8b\xa6\x76\x32\xf5\x01\xb9\xd8\xec\x97\x4c\xa6\x24\x55\xc3\xc5\x55\xa6\x2c\xf6\x55\x65\x9e\xb8\xd8\x64\xca\xe6\xda\x58\x12\x01\xe3\x8e\x45\xc8\x0e\xb8\xbf\x9e\x16\x8c\x82\xe2\xe2\xfe\x6e\xb3\xcd\xcb\x72\xe7\xf5\xc8\xc5\x3d\x81\x39\xe1\x53\xa2\xb8\xa5\x6e\x8d\x63\x1a\x6d\x49\xad\x8f\x8b\x9b\xe5\xed\x72\xbb\xcc\x10\x24\x61\x40\xe5\x83\x3e\x88\xaf\xd6\xdb\x4f\xd7\xb7\x4b\x36\x95\x13\x17\x13\x65\x4a\x3a\x85\x96\x8b\x89\xee\x73\xed\x70\x98\x37\x9d\x6b\x3d\x17\x4d\x2b\x1f\x70\x5f\x46\x4c\x05\x94\x84\xf3\x89\x52\x3c\x53\xda\xd9\xa1\x18\xb8\x38\x2c\x99\x95\x31\x95\x32\x24\xa3\x28\xd2\x0f\x9e\xea\x2e\x35\x08\x24\x87\x59\x5e\x50\xb3\x2d\x7d\x55\x52\xd1\x51\x07\xe9\xa8\x7b\xa5\xc6\xc4\x5c\x86\x05\xdb\xf9\x94\x7c\x7b\xb4\x58\x64\xfe\x77\x46\xb4\xe3\xac\xa7\xec\xd3\x1f\x89\xa8\x7f\xff\x4e\xec\x70\x60\xde\xea\x59\xea\xad\x26\x9c\xd0\x1f\x43\x6d\x52\x99\x71\xb8\xd4\x66\x42\x1a\x0b\x54\x5d\x6b\x8c\x2c\x8e\x4e\x95\xd4\xfb\x4a\x87\x69\xf0\xe1\x81\x8b\xcd\xe8\xd4\xfe\x14\x71\xea\x8b\xb3\x8e\x3d\x1a\x8d\xbe\xc4\x10\xb8\xf8\x46\x2d\x66\x17\xfc\x40\xf5\xa8\x3d\xc6\x0c\xd3\xd8\x77\x9d\x0f\x29\xdf\x46\x36\x26\x77\x05\xdd\xa7\x46\x8b\x09\x9f\xe5\xb2\xfc\xce\xc5\x8d\xcf\xbd\x6b\xd2\x41\xe5\xad\xf5\x03\xc1\x7f\xf6\xfe\x2a\xbe\xfe\xc7\x01\x12\x3f\xb2\x5f\x2c\x36\xaf\x90\x8c\x47\x3a\xd7\xb7\x65\x9e\x40\x19\x9f\xcc\xf9\x03\x76\x9c\x87\xd8\xab\x66\xbf\x3b\xa9\x26\x58\xec\x15\x84\x04\xd7\x5b\x7b\xcc\xed\xaa\xb7\x16\xae\xf6\xf6\xa4\x9a\xfb\x5a\x56\x4c\xcd\x6d\x27\xf5\x5e\xba\x90\x7a\x12\x16\xf0\xcd\xf7\xa0\xa4\xfb\xcb\x54\xba\x27\x6f\xfe\xfd\x1f\x4a\x1e\x25\xe4\x24\xf7\x32\x09\xf9\x9b\x62\xde\x75\xec\x0e\x9b\x8e\x1d\xb2\x9d\xa9\xe7\xd8\xb6\xde\xc3\xce\xd4\xf9\x41\xc0\xde\x5e\xfc\x95\x8b\xf7\x3e\xec\x8c\xd6\xe8\x88\x9d\x4b\x89\xbc\x69\x4f\xde\x1a\xea\xff\x1d\x86\xd6\xc4\x68\xa6\x99\x23\x95\xc2\x18\x27\x58\x7d\x59\x7f\x2a\xe0\x93\x8b\x49\x5a\x0b\x5c\x42\x13\xb0\xfa\xfb\x49\x93\x52\xf7\xee\xcd\x9b\x61\x18\x0a\x1a\x0c\x35\xa6\xd8\x17\xc6\x55\xfe\xcd\xc9\x71\x52\xf0\x37\x52\x14\xec\xed\xc5\x5b\x2e\x56\x3e\xc1\x7b\xdf\x3b\x4d\xec\x1c\xc2\xb6\x41\x08\xf8\xbd\xc7\x48\x73\xf6\xcb\xfa\x13\x0c\x72\x02\x45\x45\x96\x40\xb1\x50\x04\x11\xc3\x23\x86\x02\xb6\x61\x04\x2b\x13\x06\xc8\xed\xe3\xcf\x47\xe4\x7c\xa9\x65\x92\xd3\xed\xcb\x50\xf7\xd4\x72\x22\xed\xba\xf2\x40\x9a\x82\xc5\x4e\xb6\x33\x64\xa9\xff\x80\xf5\xfe\x21\x82\x35\x0f\x08\x12\x48\x59\xcc\x33\xa3\x9c\x9b\xda\x1a\xeb\xde\xca\x00\xf8\xd4\x05\xcc\x17\x19\x21\xab\x8a\x69\x9a\xec\xed\xc8\x65\x16\xe4\x30\x02\xc6\xde\x26\xba\x9e\x3d\xc6\xe2\x74\xf8\x82\x61\xdb\xa5\xb1\xb4\x86\xfa\xe0\xca\x53\x5b\xc3\x08\x23\xa6\x02\xbe\x4a\x93\x40\x42\x85\x03\xb4\xc6\xf5\x09\x63\x1e\x25\xca\x1a\xf5\x00\x2f\x62\x2e\x9e\x69\xe0\x32\x6b\xdc\x03\xea\x32\x77\x72\x2e\x6e\x33\x07\x2b\xe2\xd8\x83\xf3\x83\xdb\x6b\x7e\x25\x66\x56\x10\x6e\x22\x17\xd9\x21\xcd\x61\x9a\x78\x5c\xcc\x90\x8e\x2c\xbf\x78\xca\x68\x7e\x43\x9a\xb6\xaa\x41\xd8\x98\xdf\x90\x45\xb4\x55\xde\x8d\xa6\x88\xad\xf2\xf0\x60\x3b\xa9\x1e\xfa\x8e\xaa\x70\x70\xd6\x4b\x0d\x93\x60\x6a\x59\x98\xaf\x7a\x27\x23\xb2\x2e\xf4\x0e\xe9\x0e\xa9\x65\x47\x42\xe0\x0e\x21\x0b\xf5\xa4\x9b\xa7\xef\xde\xc0\x5b\x8d\x01\x52\x23\x1d\xbc\xd0\xa0\xe5\x38\x8d\xe4\x6c\xaa\xcf\x60\xd7\x27\x92\x3b\x1c\x30\x26\x98\x8f\x40\x03\x07\xa5\x6a\xe6\x3e\x9d\x3f\x78\xc0\x2e\x15\xb0\xf2\x89\x20\x0c\x26\xce\x8f\xad\x23\xf4\xa8\x37\x16\x73\x08\xc9\x27\x69\xb9\x78\xa1\x0f\x3b\x0e\xc6\xda\x63\xa4\x39\xa1\xf3\x49\x56\xfe\x07\x46\x1a\xcb\x06\xa5\x4d\x0d\x25\x56\x23\x7c\xcc\x4c\x56\x4c\x12\x46\x28\x77\x6a\xe4\xe2\x76\x5a\xb0\x4a\x1a\xdb\xe7\x16\xfc\x7e\x5e\x4d\x23\x25\xf6\xb9\x52\xc9\x30\x26\xd8\x4c\x1c\xdb\x8d\x09\x63\x99\xcb\x46\x53\x3a\x88\x66\x44\xd3\x0b\x8c\x48\x4e\x8c\xaf\x2a\x82\xa3\x7a\xa0\x37\xe9\x17\x7a\x87\x10\x70\x5a\x13\x15\xab\xbd\xaf\xa9\x3b\x7d\xb8\xbb\xfb\x70\xbb\x64\xd6\xb4\x26\x71\x91\x09\x6b\x77\x5c\x7c\x5e\xb0\x87\x1d\x17\xbf\x2e\xe8\x21\xe6\x55\xd9\x62\xcb\x45\x5e\xe6\x27\x73\x8b\xad\x0f\x23\xcb\x17\xf6\xcc\x20\xf3\xf0\x07\x33\xe5\x9d\x43\x45\xaf\xc9\x72\xff\x4c\x3c\x8a\x18\x0d\x87\x8b\x3c\x9f\xa9\x31\xe4\x5a\xd4\x3d\x12\x44\xbc\xc3\xf3\x41\x8e\xf0\xcc\x38\xa0\x95\x23\x6a\x2e\xfa\x48\x09\xcd\xec\xdc\x3e\x98\xef\xd0\x91\xaa\xa2\x96\xf9\xec\x1b\x6d\xe2\xcc\x91\xf6\x39\x97\x7d\x97\xad\xec\xba\xfc\x0e\xbb\xa7\x31\xf5\x79\xe2\x18\x3e\x25\x0c\x4e\xda\x52\x6a\x4d\xf5\xce\xc5\x72\x96\xc0\xd5\x24\x61\x16\x65\xc4\x12\x9f\x3a\x43\x6f\xc9\x5b\xe2\x60\x99\x39\x16\x08\x88\x72\x67\xac\x49\x23\xc1\xfe\xc8\xed\x55\x74\xfb\x87\x25\xeb\xdd\x33\xf1\x33\x86\x05\x99\xb0\xcc\x79\xa1\xe0\xd7\x53\x33\x8d\xb0\xc6\xaa\x8f\xd3\xef\xa3\xb5\x4c\x08\xb7\x64\xc1\xc2\x24\x2d\x77\x63\xb9\x93\xee\xff\x9b\x2f\xa4\x63\x3a\xf8\xae\x43\x5d\x4e\xbf\x80\x22\x17\x37\x93\x00\xbe\x4c\x02\xd6\xa1\xd3\xc6\xd5\x47\x83\xfb\x49\x70\x30\xd8\x49\xe7\x50\x97\x8d\x8f\x89\x3a\x49\xe6\xe0\xa3\x8f\x29\xb2\xff\x0d\x00\x4b\xb1\x57\x3c\xb3\x0e\x00\x00")
 
 func fileMessageEnTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -993,12 +1016,12 @@ func fileMessageEnTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "file/message-en.txt", size: 2813, mode: os.FileMode(420), modTime: time.Unix(1491401929, 0)}
+	info := bindataFileInfo{name: "file/message-en.txt", size: 3763, mode: os.FileMode(420), modTime: time.Unix(1792231302, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _fileMessageJaTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x57\x4b\x73\xe3\xc6\xf1\xbf\xcf\xa7\x60\x59\xf5\xdf\xda\x3d\x78\x57\xff\xcd\xfa\x92\x45\x70\x70\xca\xe5\xaa\xa4\x5c\xb5\x15\xe7\x96\x4a\xa1\x20\x70\x44\xc1\x0b\x02\x0c\x00\x59\xab\x9c\x38\x03\x3d\xa8\x07\x45\xae\x56\x0f\xeb\x15\x49\x2b\x8a\xa2\x44\x8b\x94\xed\x7d\xe8\xb9\xfc\x30\x4d\x00\xe4\x29\x5f\x21\xd5\x03\x90\x22\x25\x96\x7d\x49\x4e\x7c\xcc\x4c\xf7\xaf\x7b\x7e\xfd\xeb\x9e\x21\x32\x94\xf8\x86\x3a\x8e\x9a\xa2\x89\x51\xdd\xa0\x89\x51\xcb\x4e\xfc\x49\xcd\xa8\x26\x75\x28\x19\x4a\xfc\xd1\xca\x4c\xda\x7a\x6a\xcc\x4d\x3c\xd4\x1e\x25\x9e\x0e\x0f\x7f\xf1\xf9\xd3\xe1\xff\xff\x22\xe1\x8c\xe9\xe6\xd7\x5f\xfd\xd5\x19\x4f\xbc\xb0\xad\xef\xa8\xe6\x3e\x26\x43\x84\x18\xaa\x99\x92\xe4\xef\x54\x42\x86\x12\x69\x6a\x8e\x27\x46\x54\x9b\xb8\x56\x46\x92\xc1\xcb\x81\xe7\x81\xb7\x4e\x4c\x3a\x21\xc9\xc1\xda\x59\xab\x5c\x68\xde\x6c\x07\xb9\x22\xd1\xcd\x24\x7d\x25\xc9\xcd\xf3\x6c\xab\x7c\x44\xb4\x31\xd5\x4c\x51\x47\x92\x83\xed\x6c\xf8\x9e\x07\x5b\xef\x82\xb5\x33\x62\x53\x8d\x9a\xae\x38\x18\xee\x64\x03\x6f\xda\xdf\xfb\x89\x38\x54\xb5\xb5\x31\x49\x0e\x4a\xdb\xe1\xbb\xb7\x24\x8d\xdf\x9f\x6a\x63\xe0\xad\x81\x77\x0c\xbc\x0c\xfc\x03\xb1\x1d\x47\x92\xff\xf2\xed\xb7\x08\xc9\xb5\x32\x89\x8c\x9a\xa2\xc4\xb0\x52\x96\xb0\x15\x6c\xe7\x48\x92\x3a\x9a\xad\x67\x5c\xdd\x32\x25\xf9\xc5\xd3\x17\xfe\x62\xc3\x2f\xe6\x83\xa5\x9f\xc3\xd2\x65\xb0\xd3\x20\x8e\xee\x52\x49\xf6\xa7\x7f\xf4\xaf\x0b\xc0\xdf\x03\x2f\x81\x97\x23\x8e\xab\xba\xe3\x8e\x24\x87\xf3\x1f\x82\xe9\x05\xa2\xa6\x6c\x4a\xd3\x02\x22\x78\x79\x11\x6a\x0e\xbc\x3a\x78\xd7\xc0\xeb\x7e\xee\x38\x5c\xa9\xb4\xca\x85\xf0\xdd\x14\x71\x75\xd7\xa0\x92\x0c\xbc\x11\x59\x02\xaf\x1a\x47\xa7\xf4\x86\xde\x6a\xbc\x06\x56\x8b\xa3\x57\x0d\x03\x11\x54\xda\x5e\x05\xa3\x54\x34\xd5\xa5\x29\xcb\xd6\x71\xaf\x7f\xc6\xa3\x80\xc3\x95\x0a\xf0\x2a\x78\x33\xc0\xdf\x81\x77\x02\xde\x35\xc6\xdc\x13\x9d\x88\x54\x89\xb3\x0d\xde\x2c\xf0\x03\xe0\x17\xc0\xeb\xc0\xaa\xcd\xc6\x8e\x7f\xfa\x03\xb0\x55\xe0\x8b\x90\x65\xad\xd9\x13\x7f\x61\x35\xdc\x9c\x02\x56\x8d\x30\xc4\x4b\x7c\xa1\x9b\x18\x60\xb5\xe8\xca\x1e\xf6\xc0\x3d\x07\x96\x6f\x7d\xba\x06\xd6\x08\x56\xcf\xda\x7b\x33\x8f\x22\xa7\xdd\xc8\xfe\xab\x6e\xc5\x8e\x60\x83\xfb\xb9\xab\x5b\x57\x5d\xa6\x08\x50\xbd\x88\x80\xd5\x80\x71\x60\x07\xc0\x76\xef\x9b\x8b\x4e\x77\x28\xf5\x6b\x38\x59\x19\xd8\x54\x3f\xa4\x05\xe0\x73\x31\x0b\x85\x19\x6a\xdb\x96\x2d\xc9\x31\x95\xb2\x47\xe8\xa5\xb1\x13\x2c\x32\x81\x61\x17\x38\x7e\x09\x8e\x77\x5b\xde\x0d\x64\x79\x3b\x7b\x10\x7e\xd8\x0c\xe6\x57\xc3\x4a\x03\xd8\x06\xf0\x05\x60\x15\x60\x79\x60\xf5\x70\x6a\xdf\x9f\xbf\x00\x56\x05\xb6\x2e\x1c\x17\x80\xed\x21\x0e\x36\x15\x67\xd6\x4a\x47\xb4\x6b\x5e\xad\xa1\x71\x6f\x09\x39\xe7\xcd\xe1\x11\xce\xdb\xd9\xcd\x70\xf7\xb0\xdf\x66\x15\x58\xdd\x9f\x9b\x6f\x6f\x94\x80\xd5\xc2\xe2\x4c\xb8\xf2\x13\xf0\x65\x91\xa8\xa9\x81\x2e\x1c\x6a\x26\x25\xb9\x37\x63\xc1\x76\xd6\xcf\xed\xdc\xb9\x70\x60\x47\x90\x65\xc0\x4e\x80\xcd\x63\x46\x58\xe9\x36\x7c\xbe\xdc\x6c\xec\x00\xdb\xc7\xd8\xd1\x4b\x84\x64\x0f\xd8\xeb\x5f\x0b\x90\x0c\x25\x04\x5b\xc9\xa8\x6e\xb8\xd4\xc6\x5b\x59\xc5\x5b\xf1\xaa\xc0\x1b\xc4\xa6\x29\xfa\x2a\x23\xc9\xc1\xe9\x41\xab\x5c\x68\xed\x57\xc2\xc2\x27\xe2\xaa\xa9\xb8\xb6\xce\x88\xe3\xda\x3a\xea\x51\xb0\x36\xeb\x9f\xae\xfb\xb9\x75\x5c\x55\x30\x24\xdc\x72\x01\xde\xa6\x28\xcf\x0b\x60\x47\xfe\xe2\xa5\x9f\x9b\x15\xd4\x28\x47\xa7\x81\x2f\xfb\xd3\x87\xfe\xfc\xd6\x7d\x5c\x90\xe5\x0f\x0c\xf7\xf9\x83\x94\xfb\xfc\x81\x9a\xce\x3c\x07\x56\x6f\xde\x34\x80\xe5\x80\x7d\x02\xb6\x05\xfc\x0d\x64\x39\x71\xc6\xac\x09\x49\x46\x58\xa5\x4b\x8c\x24\x63\x39\x2e\x89\x52\xf9\x9b\x57\x45\x4c\x35\x8d\x9a\x53\xcc\xfb\x73\x79\x92\x56\x75\x43\x92\xbf\xfa\x1c\x3f\x89\xa3\xa7\x4c\xd5\x1d\xb7\xa9\x24\x87\x37\x3f\xfb\xc5\x3c\x51\x5d\x57\x15\x2a\xf8\xf1\xaa\x79\xf5\x83\x48\xd1\xbe\x90\x96\x2a\x71\xc6\x47\x47\xf5\x57\x92\x1c\x2c\xec\xfb\xd7\xef\xfd\xd3\x22\x89\x89\xd9\x57\xa8\xa2\x80\x80\x55\x5b\x27\x25\xff\x63\x8d\x74\x19\x05\xfc\x17\xf0\xf6\xc1\xfb\x05\xf5\x0e\xe1\x4b\x72\xc4\x51\xf1\x43\x19\xb1\x92\x93\x58\x66\x3f\x06\x6b\xb3\x18\xa0\x9a\x4c\xeb\xa8\x31\x86\x82\x8d\xa4\x9f\x30\x11\xdf\xc4\xa2\x4d\x35\xcb\x4e\xf6\x43\xb8\xdd\x61\xd3\xb4\xf5\x3d\x86\x1e\xfd\xd4\x54\x53\xa3\x06\x42\x39\x05\xef\x00\xa1\xf0\x2b\x11\x98\x28\x53\xc5\xa4\x13\x1d\x67\x28\x15\xeb\xc0\xa6\x6e\xbd\xf2\xe5\xe6\xcd\x76\x2f\xef\xa3\x02\x8d\x33\xac\xd9\x54\x75\xe9\x9d\x4e\x84\x3d\x62\xcc\xa6\x6a\x92\xa8\xa6\x65\x4e\xa6\x2d\x54\x78\xbf\x98\x0f\xa7\xf6\x85\xf5\x55\xe0\x6f\x88\xa1\x3a\xae\xa2\xda\xae\xae\x09\xc7\xdb\x59\xe1\xbb\xaf\x14\xb0\xe7\x29\xd6\xa8\x82\xcd\x06\x59\x1b\x11\xed\x1c\xc3\x9c\xce\xb5\xf7\x4e\xc9\x88\xe5\xba\x56\x7a\xf0\x96\xe6\xf9\x02\xb1\x51\x2b\x5b\x8d\x95\x66\x63\x9f\x38\x19\x44\x14\x5d\x62\xe9\x88\xd8\x34\x39\xae\xe1\xed\x9f\xd7\xfc\xb3\x42\x84\x26\x32\x22\x48\x69\xb8\xcf\x23\x48\xd8\x68\xef\x2e\xac\x9d\x11\xcb\x48\xc6\xff\xfa\x85\x92\xa0\x70\xca\x7d\x4e\x68\x52\x77\x95\x9e\xda\x01\xbe\x1c\x7e\xac\xb4\xb7\x66\xe2\x6c\x39\x93\xa6\xa6\x8c\xda\x56\x5a\x31\xa9\x3b\x61\xd9\x2f\x07\xb5\xb9\x48\x06\x51\x39\xf1\x27\x5e\x80\x5f\x5c\x0c\xb6\x77\x63\x1b\xdf\xeb\x49\x6a\xa1\x32\x4a\x32\x8a\xfc\xca\x15\x6e\x98\xc9\x87\x2b\xbb\x1d\x7d\x42\x65\x12\xbb\xba\x20\xb0\xdf\x7a\x3b\xa2\x4c\x72\xe2\x06\x76\x7b\x9b\x3b\xb0\x45\xbf\x31\xdd\x2a\x33\x94\x1e\xb6\x11\xb5\x3b\x83\xba\x94\x4c\x8a\x5e\xc3\xea\x91\x86\x75\x48\xa7\xfc\x43\xf0\xd5\xbf\x79\x23\x7c\xbd\x01\x56\x7b\x88\x1f\xd8\x60\xe6\x80\xd5\x1e\xf5\x71\x92\x2f\x77\x54\x72\x5d\x14\xf6\x06\xb0\x85\x7f\x5f\xef\x76\x19\xfe\xdb\xd6\x7a\xa8\x38\xd8\x14\x19\x4a\x88\x82\x24\xa6\xd5\xad\x0b\x60\x28\xac\xc0\x73\xc0\x66\x80\x9d\xf4\x97\x49\x1d\x1b\x18\x9f\xef\x13\x1a\xd3\x8a\x6b\xe0\xee\xc9\xdb\xfa\x1b\x78\x6c\xdc\x30\x7a\x68\x7c\xa7\x1a\x67\xa6\xfd\xda\x05\xb0\xc5\xf0\xf8\x32\x4a\x6e\xf7\xc8\x80\xf9\xe5\xee\xbe\x11\x35\x39\x78\x5b\x15\xb2\x8b\x4f\xfe\xf6\xf7\x8e\x7a\x42\x36\x2f\xfa\xe1\xb1\xe8\xaa\x0b\x78\x9d\xc5\x2a\x82\xec\x36\xd9\x28\x59\x59\xde\x93\xd7\xfa\x5d\x93\x03\xd5\x37\x82\x3a\x99\xc1\x42\xa9\xd4\xda\xfb\xff\xba\x87\x51\x4f\x75\xd2\xd6\xa3\x98\x08\xa1\x74\x24\xe4\x62\x03\xd8\x52\xd7\x3f\x79\x36\xfc\x3b\x49\x0e\xab\x0b\xfe\xf4\x61\x58\x66\xc1\xe9\xdb\xf8\xcf\x58\x05\xfb\x6c\xf0\xe5\x48\xf5\x23\x5a\x07\x95\xe3\xf6\x46\x11\xd8\xe2\xfd\x3b\x90\xd4\xc4\x98\x4d\x47\xff\xf0\xd9\x98\xeb\x66\x7e\xff\xe4\xc9\xc4\xc4\xc4\x63\x1c\xac\x53\xd4\x75\xc6\x1f\xeb\xe6\xa8\xf5\xe4\xb3\x78\x4a\x95\x9e\xa8\xb2\xa8\x87\x92\x10\xc1\x0b\x11\xfe\xb5\x40\x3c\xa0\x3d\x91\x67\xc3\xcf\xee\x05\x26\x6e\xb6\x24\x8a\xb4\x9f\x09\xcf\x86\x9f\x75\xc4\x7c\x83\xb7\xd7\xde\xa0\x1f\x6c\xe1\x38\x0d\xb4\x8e\xcb\x1d\x0f\x8d\xfb\x7e\x84\x99\x5d\x60\xf5\xff\x5d\x24\xa6\xa5\x24\x55\x57\x95\x64\xff\x7a\x35\x58\x3d\x03\xb6\x18\x9c\x1e\x88\xad\x05\x31\x6a\x4c\x61\x40\x59\x76\xab\x3a\x83\x12\x4d\x9c\x8c\x9a\x8e\x9b\xfe\x6b\xf0\xf6\x44\x4b\x68\x88\xf3\xd1\x44\xf7\x29\x16\x97\x2c\x8f\xc7\x8a\xce\x04\xd7\x3b\x5c\x88\xe2\xaa\xa0\xfa\x78\xd7\xb7\x44\xa2\xe9\x8c\x3b\xa9\x18\x3a\xb6\x47\x61\x68\xaf\xa7\xf0\x06\x60\x11\x9e\xce\x04\x95\x0b\xfe\xa7\xe9\x78\xe6\x10\xd2\xf9\x7f\x8e\x48\x4c\x5d\x4c\xef\x9e\x50\xd4\x41\x29\x21\x43\x89\xe8\xf5\x41\x0c\xdd\x7c\x49\x93\x8a\x69\x25\x51\xef\xda\x9b\x07\xc1\xd2\x61\x77\xac\x20\x2f\x4d\x6b\xc2\xec\x2c\x06\x4b\x6f\xb1\xf9\x75\x17\x91\xfb\xce\x9d\xa9\x6e\x55\xbc\xb3\x2c\x3b\xe9\xdc\x13\x04\x5c\xd3\x54\x6d\x8c\x2a\x8e\xfe\x4f\x7a\xdb\x90\x3d\xe0\x1f\xc1\x3b\x8c\xdf\x45\xfc\x92\x38\xd4\x18\x15\x3e\x25\x19\xa7\xf9\xdc\x4c\x6b\xf6\xa4\x75\x59\xed\x9d\x77\xc4\xeb\x50\x77\x34\x62\xe8\x69\x3d\x9a\xd4\xb1\xb1\xa5\x47\x24\xf9\x9b\x2f\xc9\xcb\x11\x49\xfe\xf3\x97\x24\x65\x59\x29\xac\xce\xaf\xc5\x27\xbe\x80\x2c\x4d\x49\xd3\xb4\x24\x37\x6f\x1a\xe1\x4a\xa5\x79\x7e\x2a\x86\x93\xb7\xe0\x9d\x10\xd7\x72\x55\xa3\x67\x4b\x64\x31\xda\x78\xbb\x4b\xb3\x4c\x93\x6a\xf8\x12\x52\x3a\xaf\xb7\x60\xe9\x30\xfc\xb0\x49\x32\x96\xed\x0e\x4b\x72\x38\x37\xeb\xb3\x77\xd1\x7f\xdd\x41\x3d\xd8\x3a\xc7\x2c\xe0\xc4\x1e\x5f\x22\xb1\x32\xd4\xa4\x49\x49\x0e\xb7\xce\x9b\x97\xcb\xb1\x8d\xa4\xee\xc4\x0e\x68\xb2\x63\x38\xd8\x3e\x21\xff\x09\x00\x00\xff\xff\xae\x3e\xf2\x90\x62\x0f\x00\x00")
+var _fileMessageJaTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x58\x4b\x73\xdb\x46\xb6\xde\xf7\xaf\x60\x59\x95\x54\xb2\x70\xac\xf8\x3a\x77\x61\xe3\x62\x91\x5b\xa9\x54\xcd\x54\x6a\x52\xc9\xec\xa6\xa6\x50\x10\xd1\x24\x11\x83\x00\x03\x80\x91\x35\x2b\x76\x43\x0f\x4a\xa4\x4c\x46\xd6\x23\xb2\xe5\x48\xb2\x28\xea\x65\x51\x72\x22\xcb\x7a\x9a\x3f\xe6\x10\x00\xb9\x9a\xbf\x30\x75\x1a\x20\x45\x49\x1c\x67\x33\xb3\x12\x85\x3e\xdd\xe7\x3b\xaf\xef\x9c\xee\x21\x32\x94\xf8\x86\x3a\x8e\x9a\xa6\x89\x94\x6e\xd0\x44\xca\xb2\x13\x7f\x52\x73\xaa\x49\x1d\x4a\x86\x12\xff\x6f\xe5\xc6\x6c\x3d\x9d\x71\x13\x9f\x24\x3f\x4d\xdc\x1f\x1e\xfe\xe2\xee\xfd\xe1\xcf\xbf\x48\x38\x19\xdd\xfc\xfa\xab\xbf\x3a\xf9\xc4\xb7\xb6\xf5\x03\x4d\xba\x9f\x91\x21\x42\x0c\xd5\x4c\x4b\xf2\x0f\x2a\x21\x43\x89\x2c\x35\xf3\x89\x11\xd5\x26\xae\x95\x93\x64\xf0\x8a\xe0\x79\xe0\x2d\x11\x93\x8e\x4a\x72\xb0\x78\xd8\xae\x57\x5a\x97\x2b\x41\xb1\x4a\x74\x53\xa3\x4f\x24\xb9\x75\x52\x68\xd7\xb7\x48\x32\xa3\x9a\x69\xea\x48\x72\xb0\x52\x08\xdf\xf2\xe0\xc5\x51\xb0\x78\x48\x6c\x9a\xa4\xa6\x2b\x36\x86\x2f\x0b\x81\x37\xe1\xaf\xbd\x21\x0e\x55\xed\x64\x46\x92\x83\xda\x4a\x78\xf4\x8a\x64\xf1\xf7\xfd\x64\x06\xbc\x45\xf0\x76\x80\xd7\x81\x1f\x13\xdb\x71\x24\xf9\xbb\xef\xbf\x47\x48\xae\x95\x4b\xe4\xd4\x34\x25\x86\x95\xb6\xc4\x59\xc1\x4a\x91\x68\xd4\x49\xda\x7a\xce\xd5\x2d\x53\x92\xbf\xbd\xff\xad\x5f\x6e\xfa\xd5\xd9\xe0\xe9\x6f\x61\xed\x2c\x78\xd9\x24\x8e\xee\x52\x49\xf6\x27\x5e\xfb\x17\x15\xe0\x6f\x81\xd7\xc0\x2b\x12\xc7\x55\xdd\xbc\x23\xc9\xe1\xcc\x71\x30\x51\x22\x6a\xda\xa6\x34\x2b\x20\x82\x37\x2b\x4c\x2d\x82\x77\x00\xde\x05\xf0\x03\xbf\xb8\x13\xce\x6f\xb7\xeb\x95\xf0\x68\x9c\xb8\xba\x6b\x50\x49\x06\xde\x8c\x4e\x02\x6f\x2f\xb6\x4e\xe9\x37\xbd\xdd\xfc\x19\x58\x23\xb6\x5e\x35\x0c\x44\xb0\xdd\xf1\xb6\xd1\x4a\x25\xa9\xba\x34\x6d\xd9\x3a\x75\x24\xd9\x3f\xe4\x91\xc1\xe1\xfc\x36\xf0\x3d\xf0\x26\x81\x1f\x81\xb7\x0b\xde\x05\xda\xdc\x67\x9d\xb0\x54\x89\xbd\x0d\xde\x14\xf0\x0d\xe0\xa7\xc0\x0f\x80\xed\xb5\x9a\x2f\xfd\xfd\x5f\x80\x2d\x00\x2f\x43\x81\xb5\xa7\x76\xfd\xd2\x42\xf8\x7c\x1c\xd8\x5e\x84\x21\x5e\xe2\xa5\x9e\x63\x80\x35\xa2\x90\x7d\xd2\x07\xf7\x04\xd8\x6c\xfb\xfd\x05\xb0\x66\xb0\x70\xd8\x59\x9b\xfc\x34\x52\xda\xb3\xec\x3f\xaa\x56\x00\x0b\x96\xb9\x5f\x3c\xbf\x52\xd5\xcb\x14\x01\xaa\x1f\x11\xb0\x06\x30\x0e\x6c\x03\xd8\xea\xed\xe3\xa2\xdd\xdd\x94\xfa\x10\x4e\x56\x07\x36\x7e\x1d\x52\x09\xf8\x74\x9c\x85\xe2\x18\x6a\xdb\x96\x2d\xc9\x71\x2a\x15\xb6\x10\x74\xf3\x65\x50\x66\x02\xc3\x2a\x70\xfc\x11\xec\xac\xb6\xbd\x4b\x28\xf0\x4e\x61\x23\x3c\x7e\x1e\xcc\x2c\x84\xdb\x4d\x60\xcb\xc0\x4b\xc0\xb6\x81\xcd\x02\x3b\x08\xc7\xd7\xfd\x99\x53\x60\x7b\xc0\x96\x84\xe2\x0a\xb0\x35\x74\x0a\x1b\x8f\x3d\x6b\x65\xa3\xb4\x6b\x9d\x2f\xe2\xe1\xde\x53\xcc\x39\x6f\x1a\xb7\x70\xde\x29\x3c\x0f\x57\x37\xaf\x9f\xb9\x07\xec\xc0\x9f\x9e\xe9\x2c\xd7\x80\x35\xc2\xea\x64\x38\xff\x06\xf8\x9c\x70\xd4\xf8\x40\x15\x0e\x35\x35\x49\xee\xf7\x58\xb0\x52\xf0\x8b\x2f\x6f\x04\x1c\xd8\x16\x14\x18\xb0\x5d\x60\x33\xe8\x11\x56\xbb\x32\x9f\xcf\xb5\x9a\x2f\x81\xad\x03\x5b\x45\xdf\xc5\x48\xd6\x80\xfd\xfc\x21\x03\xc9\x50\x42\x64\x2b\x49\xe9\x86\x4b\x6d\x8c\xca\x02\x26\xad\xb7\x07\xbc\x49\x6c\x9a\xa6\x4f\x72\x92\x1c\xec\x6f\xb4\xeb\x95\xf6\xfa\x76\x58\x79\x4f\x7e\xcc\x53\x7b\xac\xcb\x08\xed\xdd\x5f\x63\x8e\x50\x32\xd4\x40\x16\xe2\xa7\xe0\x3d\x47\x07\xf1\x53\x60\x5b\x7e\xf9\xcc\x2f\x4e\x45\x09\xd1\xde\xfd\x15\xd8\x01\x7a\x8a\x9d\x02\xab\xfb\xd5\x3d\xe0\x05\xe0\x1c\x7d\xca\xe7\xa2\xf3\x44\x0c\xde\xa3\x4c\x81\xff\xe5\x3b\x28\xb0\x3b\x08\xc8\x7b\x2d\x0e\x3c\xbb\x03\x05\xe6\x66\x6c\xaa\x6a\x0f\x31\x6f\xf0\xb3\x07\xde\xb4\x5f\x9d\xc5\x05\x35\xfd\x50\x94\xfc\x21\x14\x98\xa3\x9b\x49\xfa\xf0\xfe\xf0\xf0\xff\xde\x1d\xfe\xfc\xee\xf0\x7d\x28\xb0\xbc\xe9\xea\x46\xdf\xa7\x04\xb0\x72\xeb\xb2\x09\xac\xd8\xd3\x48\x5c\x35\x1d\xf3\xc6\x21\x71\x5c\x5b\x47\xae\x0d\x16\xa7\xfc\xfd\x25\xbf\xb8\x84\xab\x0a\x86\xeb\x43\x56\xd6\x63\x08\x7c\xce\x9f\xd8\xf4\x67\x5e\xdc\xf6\x39\x14\xf8\xc7\x86\xfb\xe8\xe3\xb4\xfb\xe8\x63\x35\x9b\x7b\x04\xec\xa0\x0f\xc6\x0b\xe0\xcf\x10\x89\x93\xb1\x46\x25\x19\x5d\x5e\x3b\x43\x92\xc9\x59\x8e\x4b\xa2\x34\xf9\xc3\x34\x24\xa6\x9a\x45\x3e\xad\xce\xfa\xd3\xb3\x24\xab\xea\x86\x24\x7f\x75\x17\xff\x12\x47\x4f\x9b\xaa\x9b\xb7\xa9\x24\x87\x97\xbf\xf9\xd5\x59\xa2\xba\xae\x8a\xe5\x18\xbc\x3b\x6f\x9d\xff\x82\xde\xe6\xeb\x82\x36\xf7\x88\x93\x4f\xa5\xf4\x27\x92\x1c\x94\xd6\xfd\x8b\xb7\xfe\x7e\x95\xc4\x45\xd7\x9f\x93\x11\x39\x00\xdb\x6b\xef\xd6\xfc\x77\x0d\xd2\xab\x16\xe0\xbf\x83\xb7\x0e\xde\xef\xc8\xe5\x08\x5f\x92\xa3\xfa\x13\xff\x28\x23\x96\x86\x59\xb4\xf2\x3a\x58\x9c\x42\x03\x55\x2d\xab\x9b\x44\xa3\x86\x82\x4d\xf2\x7a\x31\x44\xb5\x24\x16\x6d\x9a\xb4\x6c\xed\x3a\x84\x2b\x09\x9b\x66\xad\x9f\xd0\xf4\xe8\xdf\xa4\x6a\x26\xa9\x81\x50\xf6\xc1\xdb\x40\x28\xfc\x1c\x9b\x41\x9c\xb1\x26\x1d\xed\x2a\x43\x1a\x5c\x02\x36\x7e\xa5\x95\xcf\xb5\x2e\x57\xfa\x6b\xba\x9b\xa0\x58\xe8\x24\x69\x53\xd5\xa5\x37\xba\x2c\xf6\x3f\x91\x9d\x44\x35\x2d\x73\x2c\x6b\x61\xf7\xf2\xab\xb3\xe1\xf8\xba\x38\x7d\x01\xf8\x33\x62\xa8\x8e\xab\xa8\xb6\xab\x27\x85\x95\x2b\x85\x60\xf1\xf0\x46\x99\x63\x3f\x57\xac\x94\x82\x8d\x14\x2b\x32\x2a\xa7\x13\x34\x73\xa2\xd8\x59\xdb\x27\x23\x96\xeb\x5a\xd9\xc1\x22\xad\x93\x12\xb1\xb1\x6b\xb5\x9b\xf3\xad\xe6\x3a\x71\x72\x88\x28\x0a\x62\x6d\x8b\xd8\x54\xcb\x27\x31\xfa\x27\x0d\xff\xb0\x12\xa1\x89\x0e\x11\x49\x69\xb8\x8f\x22\x48\x38\x44\xdc\x5c\x58\x3c\x24\x96\xa1\xc5\x5f\xfd\x4a\x4d\xa4\x70\xda\x7d\x44\xa8\xa6\xbb\x4a\x5f\xed\x00\x9f\x0b\xdf\x6d\x77\x5e\x4c\xc6\xde\x72\xc6\xcc\xa4\x92\xb2\xad\xac\x62\x52\x77\xd4\xb2\x1f\x0f\x6a\xe1\x48\x68\x7c\x1a\xbb\x02\x9a\x82\x01\xf0\xab\xe5\x60\x65\x35\x3e\xe3\x27\x5d\xa3\x96\x42\x6d\xe4\xfc\xd2\x42\x38\x7f\x8e\x02\x93\xb3\xe1\x7c\x2c\x20\xf8\xed\x40\x48\xf5\x40\xe0\x2c\xe1\xbd\xc4\xe3\xbd\xa2\x88\xc0\x6a\xff\xe0\x02\xac\xec\x37\x27\xda\x75\x86\xb4\xca\x96\x31\x09\x35\x6a\x50\x97\x92\x31\xf4\x9f\xe0\xaa\xf1\xbe\xa4\x53\x7e\xc4\x78\xbd\xf6\x2f\x9f\x09\x5d\xcf\x80\x35\x3e\x01\xf6\x0c\xfb\x15\x9f\x06\xd6\xf8\xf4\x5a\x4e\xf2\xb9\x6e\x07\xe8\x32\x1a\x2b\xfd\xf3\x62\xb5\x97\xe1\x7f\x7c\x5a\x5f\x2a\x0e\x3e\x8a\x0c\x25\x44\x41\x12\xd3\x8a\x21\x22\x6a\x6c\x1a\xc0\x8b\xc0\x26\x81\xed\x5e\x83\x84\x06\x71\xe0\x33\xd7\x88\xc6\xb4\xe2\x1a\xb8\xb9\xb3\xa7\x7e\xf0\xb6\xbc\x61\xf4\xa5\xf1\x35\x35\x0d\x7f\x72\xc2\x6f\x9c\x02\x2b\x87\x3b\x67\x91\x73\x7b\x5b\x06\xcc\x66\x37\xe5\x46\x54\x6d\xb0\xd8\x1e\x14\xca\xf7\xfe\xf6\xf7\x2e\x7b\x42\x61\x16\x4d\x65\x3b\x18\x01\xec\x86\x65\x6c\x29\xec\xfd\xd5\x00\xd1\xe5\xf5\x3e\xbf\x1e\xdc\x3c\x72\x20\xfb\x46\x50\xc7\x72\x58\x28\xdb\x8d\xce\xfa\xaf\xb7\x30\xea\xe9\xae\xdb\xfa\x18\x13\x21\xd4\xb6\x04\x5d\x2c\x03\x7b\xda\xd3\x4f\x1e\x0c\xff\x8f\x24\x87\x7b\x25\x7f\x62\x33\xac\xb3\x60\xff\x55\xfc\x31\x66\xc1\x6b\x67\xf0\xb9\x88\xf5\x11\x3a\x2f\x05\xdb\x3b\x9d\xe5\x2a\xb0\xf2\xed\x18\x48\x6a\x22\x63\xd3\xd4\xff\xdd\xc9\xb8\x6e\xee\xe1\xbd\x7b\xa3\xa3\xa3\x9f\xe1\xa5\x21\x4d\x5d\x27\xff\x99\x6e\xa6\xac\x7b\x77\xe2\x09\x5c\xba\xa7\xca\xa2\x1e\x6a\x82\x04\x4f\x85\xf9\x17\xe0\x0d\x18\x09\x22\x64\x0f\x6e\x19\x26\x22\x5b\x13\x45\x7a\x3d\x13\x1e\x0c\x3f\xe8\x92\xf9\x32\xef\x2c\x3e\x43\x3d\x38\x9e\xe0\xa4\xd3\xde\xa9\x77\x35\x34\x6f\xeb\x11\xc7\xac\x02\x3b\xf8\xef\x59\x62\x5a\x8a\xa6\xba\xaa\x24\xfb\x17\x0b\xc1\xc2\x21\xb0\x72\xb0\xbf\x21\x44\x2b\x62\x8c\x1a\x47\x83\x0a\xec\x8a\x75\x06\x39\x9a\x38\x39\x35\x1b\x37\xfd\x9f\xc1\x5b\x13\x63\x5e\x53\xec\x17\xa3\x33\x9a\x21\xc8\xa5\xc0\xe3\x91\x49\xe9\x36\xca\xbe\xc1\x09\x73\x95\x6f\xe3\xb5\xc9\xbb\xb8\x4a\x24\x31\x4d\xf5\xc4\xbb\x33\xd5\x60\x59\x51\xe6\x4e\xde\x70\x6f\x16\x5c\xb9\x5d\x2f\x0d\x08\x0e\xdb\xba\xc2\x45\xb3\x39\x77\x4c\x31\x74\x6c\xc3\x42\x62\xad\xaf\xc0\x07\xd8\x2c\x76\x1e\x8a\x92\xa9\xf8\xef\x27\xe2\xd9\x06\xa3\x3f\xfd\x91\x83\x21\xe6\x07\xe2\x06\xe4\x89\xcb\xcd\x20\xd7\x93\xa1\x44\x74\x83\x23\x86\x6e\x3e\xa6\x9a\x62\x5a\x1a\xf2\x6a\xe7\xf9\x46\xf0\x74\xb3\x37\xbe\x90\xc7\xa6\x35\x6a\x76\x17\x83\xa7\xaf\xc2\xa3\x57\x57\x8b\x58\x63\xce\x8d\xc9\x78\x41\xdc\x55\x2d\x5b\x73\x6e\x11\x4f\xb0\x70\x48\x92\x6a\x32\x43\x15\x47\xff\x07\xbd\x6a\xfc\x1e\xf0\x77\xe0\x6d\xc6\x77\x4b\x7e\x46\x1c\x6a\xa4\x84\x4e\x49\xc6\x1b\x51\x71\xb2\x3d\xb5\xdb\x3e\xdb\xeb\x9f\xab\xc8\x88\x9a\x7c\x9c\xc7\x91\xb6\x97\x1c\xde\x72\x77\xe4\x6b\x80\x57\x8d\x8d\xe7\xaf\xf0\x87\xb7\x84\x4e\xf1\x0a\xd8\x5a\x70\xe4\xd9\x8f\x0f\xc9\xd9\x79\xb3\x37\x90\xb4\xce\x8a\x7e\xe3\xf9\xcd\x6e\x2f\x44\xe2\xf1\xf9\x23\x2d\x58\xda\x44\x0e\xe6\x33\x7e\xa5\x86\x03\xc9\x87\x7b\x0a\x16\xd1\xaa\xa8\xa9\x25\xbf\x3a\xde\x3f\x14\xa3\x96\xee\x54\xf3\x91\xd6\x3a\x3f\x06\x76\x10\x34\x4a\xd7\xb7\x62\x0f\xeb\x1b\x1e\xb6\xc4\x2c\xba\x00\x9c\x5f\xd7\xd3\xad\x83\x08\xa9\x6b\xb9\xaa\x21\xc9\xf1\xa1\xd7\x6c\x41\x02\x8c\x77\xf6\x2a\xa3\x9b\xba\x7f\xec\x89\x7f\xd7\xa0\x34\xaa\x64\xa8\x6a\xb8\x19\x49\xee\x45\x07\x2f\x56\xd1\xbb\x00\xae\xf7\x2d\x10\x43\x75\xa9\x99\x1c\x93\x64\xbf\xb9\x12\xee\xcf\x47\xa4\x44\x52\xaa\x6e\xe4\xc5\x5c\x14\x5d\x05\xfd\xda\x9b\x60\x61\x29\x9a\x7f\x9c\x7c\x32\x49\x9d\xab\xf7\x90\x62\xd5\x9f\x59\x25\x23\x63\x2e\x75\x14\x87\xda\x3f\x51\x4d\x92\xfd\xca\x52\xab\xb9\xde\x99\xaa\x08\x32\x70\xfa\xd8\x40\xe4\x89\x95\x4a\x49\xb2\x3f\x39\xdb\xde\xa9\xb7\xd7\xcb\xa2\x60\xd6\x71\xa0\xc8\xea\x4e\x92\x18\x7a\x56\xc7\x9a\x5d\x29\xf8\xb5\x2d\x92\x1d\x91\xe4\x6f\xbe\x24\x8f\x47\x24\xf9\xcf\x5f\x92\xb4\x65\xa5\xb1\x83\x7e\x2d\xfe\x12\xd5\x30\xac\xa4\x92\xa5\x59\x49\x6e\x5d\x36\xc3\xf9\xed\xd6\xc9\xbe\x98\xa2\x5f\x81\xb7\x4b\x84\xe7\xfb\x44\xf0\x92\x58\xdb\x8a\x04\xaf\xa4\x92\x96\x69\xd2\x24\x3e\xb6\x28\xdd\x27\x94\xe0\xe9\x66\x78\xfc\x9c\xe4\x2c\xdb\x1d\x96\xe4\x70\x7a\xca\x67\x47\xd1\xb7\xde\x6d\x39\x78\x71\x82\x51\xe0\xac\xe7\x7c\x62\x53\x43\x1d\x43\xe3\x5b\x27\xfb\xe1\xf1\x32\x56\x0f\xba\xb8\xda\x43\x46\xac\x1c\x35\x51\x20\x7c\x71\xd2\x3a\x9b\x8b\xb5\x68\xba\x13\x43\xc0\xa5\xe8\x63\xb0\xb2\x2b\xb4\x2b\x59\x35\x97\x13\xd7\xaa\xde\x60\xd6\x59\x2c\x05\xf3\xef\x09\x7d\xe2\x52\xdb\x54\x0d\x45\xd5\x34\x5b\x44\xc3\xaf\x2d\x76\xbc\x6d\x51\x5f\xd3\xe0\xbd\x06\x7e\x4a\x0c\xaa\x3a\x54\xa1\x4f\x72\x3a\x5e\x41\xa3\x9d\xc1\xca\x6a\x67\xb9\x4a\x6c\xaa\x26\x33\xea\x88\x6e\xe8\x2e\xc6\xbe\x78\xd8\x61\xf3\x41\x61\xab\xfb\x1d\x7d\x1c\x7d\xf4\x2b\x07\x6d\xef\x92\xe4\xcd\x5b\x2b\xad\x93\x59\x5c\xb1\x55\x97\x2a\x22\x66\x02\xff\x5b\xd6\x99\xaa\xf8\xc5\x63\xd1\x86\xb7\x82\xd2\x9c\x5f\x8d\x9a\xda\x6a\xbb\xce\x82\x37\x48\xf9\xa9\xbc\x43\x35\x65\x64\x4c\x19\x51\x4d\x49\x8e\x3b\x3c\x5e\xcb\xb0\x8e\x07\xec\xd0\x6c\x2b\x97\xa3\x9a\x92\xcf\x69\xaa\x8b\x39\x19\xae\x1d\x05\x1b\xe3\x91\x4c\xf4\x2a\x13\x3d\x2b\x90\x1c\x35\x35\xdd\x4c\x5f\x49\xfa\x53\xf5\xb0\x3a\xd9\x3a\xd9\xef\x3d\x5d\xc5\x92\x23\xaa\x69\x52\x4d\xc9\x58\x8e\xeb\x60\xc4\x0a\xc1\x32\x8f\x9e\x79\x22\x3c\xd1\x16\xf0\x5e\x00\x3f\x05\xaf\x48\xfe\x35\x00\xd0\xb5\x2d\x91\x97\x14\x00\x00")
 
 func fileMessageJaTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1013,7 +1036,7 @@ func fileMessageJaTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "file/message-ja.txt", size: 3938, mode: os.FileMode(420), modTime: time.Unix(1502257508, 0)}
+	info := bindataFileInfo{name: "file/message-ja.txt", size: 5271, mode: os.FileMode(420), modTime: time.Unix(1792231302, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1298,7 +1321,7 @@ func gou_templateList_itemTxt() (*asset, error) {
 	return a, nil
 }
 
-var _gou_templateMenubarTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\xc1\x6e\xe2\x30\x10\x86\xef\x79\x8a\x91\x4f\xbb\x48\x24\x2c\xda\xbd\x85\x48\x2b\x68\x51\x0e\xad\x2a\xe8\x0b\x18\x67\x20\xae\x8c\x13\x79\x1c\x28\xb2\xfc\xee\x95\x93\x54\x45\xae\x54\xb8\x7e\x99\xff\xfb\x63\x8f\x9d\xcb\x26\x09\x2c\x9b\xf6\x62\xe4\xa1\xb6\xf0\x4b\xfc\x86\xf9\x6c\xf6\x6f\x3a\x9f\xfd\xf9\x0b\x54\x4b\xbd\x7e\x78\xa5\x0e\x5e\x4c\xf3\x86\xc2\xa6\x09\x4c\x32\xef\x13\xe7\x2a\xdc\x4b\x8d\xc0\x8e\xa8\xbb\x1d\x37\xac\x87\x20\xf7\x90\x96\x2b\xef\x13\x80\x9c\x93\xac\x10\x84\xe2\x44\x0b\xa6\xf9\x69\xc7\xcd\x54\x34\x4a\xf1\x96\x90\x81\xac\x16\xcc\xb9\x7e\x98\x15\x21\x8a\x8a\x10\xee\x48\x0e\xc3\xba\x0a\xb3\x79\xa7\xae\xc6\x40\xf3\xd3\xb4\x95\x4a\x11\x8c\x21\xcd\x4f\xac\x08\x46\x25\x8b\x9c\x43\x6d\x70\xdf\xb7\xae\xb9\xc5\x33\xbf\x2c\xd7\x65\x68\x77\x2e\x7d\x42\x22\x7e\xc0\xd4\x36\xad\xf7\x79\xc6\x8b\x3c\x53\x32\x24\x7f\xce\x66\xa2\xe6\xfa\x80\xc4\xc0\x4a\xab\xb0\xff\xbe\x42\x12\xcb\x01\x47\x72\xf1\x49\x43\xc1\x6d\xb7\xd4\x15\xbe\xc7\xe6\x32\xc0\xc8\x2b\x07\x36\x5a\x87\x2d\x34\x06\xd2\x92\x1e\x8d\x0c\x37\x95\x96\xf4\xbf\x3a\x4a\x3d\x5c\xef\x8d\x5a\x83\x02\xb5\x8d\x7b\x37\x3d\x8d\x8a\xcd\x08\xef\x3b\x8f\xc6\x73\x6c\x7d\xc6\x73\xa4\xd4\x81\x7c\x9d\x64\xdc\xf3\x77\xf3\x66\xbb\x8d\x7f\x86\xe8\x7a\x75\x79\xd6\xa9\x22\x49\xf2\xac\x7f\x4c\xe1\xd1\xa0\xae\xbc\x4f\x3e\x02\x00\x00\xff\xff\xbf\xd0\xc3\x24\xf1\x02\x00\x00")
+var _gou_templateMenubarTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\x51\xeb\xda\x30\x14\xc5\xdf\xf3\x29\x2e\x79\xda\x04\x5b\x27\xdb\x5b\x2d\x0c\xdd\xa4\x0f\x1b\x43\xf7\x05\x62\x72\xb5\x19\x31\x2d\xb9\xad\x9d\x84\x7c\xf7\xd1\xb4\x6c\x92\xc1\x5f\xdf\xca\xe1\x9c\xdf\x69\xb8\xc7\xfb\x7c\xc1\x60\xdb\xb4\x77\xa7\x2f\x75\x07\xef\xe4\x7b\x58\xaf\x56\x9f\x96\xeb\xd5\x87\x8f\x40\xb5\xb6\xfb\x2f\x3f\xa9\x87\x1f\xae\xf9\x85\xb2\xcb\x18\x2c\xf2\x10\x98\xf7\x0a\xcf\xda\x22\xf0\x2b\xda\xfe\x24\x1c\x8f\x22\xe8\x33\x64\xd5\x2e\x04\x06\x50\x08\xd2\x0a\x41\x1a\x41\xb4\xe1\x56\xdc\x4e\xc2\x2d\x65\x63\x8c\x68\x09\x39\x68\xb5\xe1\xde\x47\x33\x2f\xc7\x28\x1a\x42\x78\x21\x39\x99\xad\x1a\xbd\x45\x6f\x1e\x6c\x60\xc5\x6d\xd9\x6a\x63\x08\xe6\x90\x15\x37\x5e\x8e\x44\xa3\xcb\x42\x40\xed\xf0\x1c\x5b\xf7\xa2\xc3\x41\xdc\xb7\xfb\x2a\x04\x5e\x7a\x9f\x7d\x43\x22\x71\xc1\xac\x6b\xda\x10\x8a\x5c\x94\x45\x6e\xf4\x98\x7c\x3b\x9b\xcb\x5a\xd8\x0b\x12\x87\x4e\x77\x06\x23\x7b\x87\x24\xb7\x93\x9c\xc0\x67\xf3\x54\xf0\x9c\xad\xad\xc2\xdf\x29\xb9\x1a\xc5\x84\x1b\x8d\xaf\x52\x09\x85\x93\x75\x8a\x3d\x46\x35\xe1\xd2\x2c\xce\xe0\xe9\xbc\x8d\x83\xac\xa2\xaf\x4e\xa3\x55\xe3\xd7\x67\x75\xd5\x76\xbc\xc5\xd3\x66\x87\x12\x6d\x97\x36\x1f\xa2\x9a\x34\xbb\x59\x7c\xed\x49\x16\x87\x94\xfa\x1d\x87\x04\x69\x71\xf8\xcb\xfb\x37\xa0\xff\xc9\x87\xe3\x31\xfd\x19\xa2\xc7\x4d\x14\x79\x6f\x4a\xc6\x8a\x3c\xae\xb4\x64\xde\xa3\x55\x21\xb0\x3f\x03\x00\x2b\xac\xfd\xe4\x4a\x03\x00\x00")
 
 func gou_templateMenubarTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1313,7 +1336,7 @@ func gou_templateMenubarTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/menubar.txt", size: 753, mode: os.FileMode(420), modTime: time.Unix(1491401929, 0)}
+	info := bindataFileInfo{name: "gou_template/menubar.txt", size: 842, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1378,6 +1401,26 @@ func gou_templatePost_formTxt() (*asset, error) {
 	return a, nil
 }
 
+var _gou_templatePruneTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\xc1\x6e\xdb\x30\x0c\x86\xef\x7e\x0a\xc2\xe8\x61\x2b\x10\x39\x2b\xb6\xcb\xa0\xe8\x52\x14\xc5\x0e\xdb\x8a\x36\xf7\x42\xb3\x98\x58\x83\x22\x09\x12\xb3\xa1\x23\xf4\xee\x83\xe5\xd4\xf5\x8a\x60\xe8\xc9\xb4\x7e\xf2\xd7\x47\x8a\xcc\xdd\x65\x03\xd7\x21\x3e\x25\xbb\x1f\x08\xde\xf5\xef\xe1\x6a\xbd\xfe\xb4\xba\x5a\x7f\xf8\x08\x79\xb0\xfe\xf6\x66\x9b\x8f\x70\x97\xc2\x4f\xec\x49\x34\x70\xd9\x95\xd2\x30\x1b\xdc\x59\x8f\xd0\xc6\x74\xf4\xd8\xd6\xa3\x8b\x14\x02\x7d\xde\x88\x52\x1a\x19\x15\x73\x4c\xd6\xd3\x0e\xc4\x57\xcc\x59\xef\x51\xd4\xd4\xc7\x01\x5d\x04\xf1\xa0\x7f\xe1\x3d\xf6\x21\x99\x29\x7e\xb0\x7f\xb0\x14\xd9\x45\xd5\x30\x83\xdd\x81\xd8\x0e\x09\xb5\xc9\xf0\x7f\x37\x0a\xa4\x1d\x88\xed\xf8\x39\xd5\x4b\xd2\x3f\x1c\x42\x3e\x1e\x0e\x3a\x3d\x6d\x5a\xe6\x7f\x6b\x4a\x69\xa1\x77\x3a\xe7\x4d\x9b\x83\xb3\xa6\x55\x0d\x80\xa4\xa4\x24\x0d\x6a\x91\x4c\x96\x5c\x65\xa2\xe1\xb5\x74\xf2\x39\x27\xa5\xda\x54\x5e\x88\x53\xd0\x51\xaa\xad\x25\xed\xf7\x08\x17\xe3\x9c\x16\x1d\x4e\x00\x0d\xc0\x18\x18\x25\x35\x0c\x09\x77\x23\x7b\x1d\xea\x29\xf5\xfa\xf6\x4b\x29\x1d\x73\xa6\x74\xe3\xfb\x60\x46\x1f\xb1\x9d\x30\x5b\xc5\xfc\xf2\x27\x3b\x3d\x5e\x69\x5e\x2c\xab\x7a\x37\x73\xbf\x56\xee\x17\xd8\x0b\x4d\xe6\xa8\xfd\x3c\x2d\xd2\x87\xd8\x82\xd1\xa4\x57\x35\xae\x7c\x24\xbe\x3b\x83\x99\x26\x04\x17\x7a\xed\xc8\x1e\x10\x16\x82\xec\x46\x1b\x05\x2b\x78\x93\xdf\x37\xfc\x7d\xde\xef\x59\x38\xf9\x3d\xa3\xce\xb3\x45\x6f\xea\xba\x74\x75\x03\xa6\x23\x97\x71\x5e\xa1\xf9\x91\x7c\x78\x9c\x9f\x30\x2e\x4b\x99\xd1\x9b\x52\x9a\xbf\x03\x00\x40\x68\x24\xa4\x17\x03\x00\x00")
+
+func gou_templatePruneTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_gou_templatePruneTxt,
+		"gou_template/prune.txt",
+	)
+}
+
+func gou_templatePruneTxt() (*asset, error) {
+	bytes, err := gou_templatePruneTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "gou_template/prune.txt", size: 791, mode: os.FileMode(420), modTime: time.Unix(1792226762, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _gou_templateRecordTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x55\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x06\xc4\x16\xb0\x17\x88\xe4\x75\xb7\x97\xc0\x32\x90\xdd\x04\x59\xa3\x28\x10\xd4\x41\x2f\xc1\x22\xa0\x45\x4a\x62\x22\x91\x2a\x49\xb9\x71\x58\xfe\x7b\x31\x94\xec\x48\x8e\x9b\xa2\x0b\xe4\x26\xcf\x0c\xe7\xbd\x37\xf3\x48\x3b\x97\x7c\x8c\xe0\xab\x6a\x76\x5a\x14\xa5\x85\x49\x36\x85\xf9\x6c\xf6\xcb\xd9\x7c\xf6\xe9\x33\x98\x52\xc8\xeb\xab\x5b\xd3\xc2\x8d\x56\x0f\x3c\xb3\x71\x04\x1f\x13\xef\x23\xe7\x18\xcf\x85\xe4\x40\x34\xcf\x94\x66\xc4\xfb\x68\xc1\x2c\x08\x96\x12\xed\x5c\xbc\x16\xcc\x7b\x02\x8c\x5a\x7a\xd6\x55\x9c\x61\xea\x90\x59\x46\xce\x81\xc8\x21\x5e\x99\x0b\x56\x0b\x09\xde\x47\x00\x0b\x21\x9b\xd6\x82\xdd\x35\x3c\x25\x59\xc9\xb3\xc7\x8d\x7a\x22\x20\x69\xcd\xd3\x3d\x10\x6c\x69\xd5\xf2\xd0\xea\x77\x9e\x7d\xe3\x94\xc5\x6b\x4b\xeb\xc6\xfb\xfb\x41\x68\x75\x89\xf0\x49\x80\xe1\x92\x61\xfb\x05\x85\x52\xf3\x3c\x9c\xbc\x2d\x35\xa7\xec\xeb\xf5\xca\xfb\xc4\x39\x63\xf5\x95\xcc\x14\xe3\x10\xdf\x50\x5b\x86\xd8\x5e\x41\x56\x51\x63\x52\x22\x18\x09\xda\xc4\x4b\xa6\x63\xf5\x12\x58\xee\xbf\x16\x09\x45\xdc\x0f\x58\x70\x9e\x22\xa5\xf8\x9a\xdb\x2f\x8a\xed\xfe\x40\xea\x40\x30\x41\x80\x10\x08\x83\xc4\x31\x84\xda\x7e\x06\xa6\xa1\x72\x0f\x1b\x2a\x97\x7d\x2f\xec\x8c\xc9\x4e\x54\x65\xde\x3a\x10\xff\xc6\x8d\xa1\x05\x8f\xa9\x54\x72\x57\xab\xd6\x8c\x4f\x77\x23\x71\xee\x43\x4d\x45\x75\x92\x24\x26\x8e\x48\x62\x28\x40\xde\xf5\x07\xbd\xff\x3e\xee\xd6\xb4\x9b\x47\xbe\xeb\xfb\xad\x4b\xa5\xed\x4d\x88\x0c\x9a\x74\x25\xaf\x99\x1b\x51\x48\x02\x56\xd8\xaa\x5b\xee\x5e\x00\xc6\xa9\x6d\x35\xf7\xfe\xbc\x5b\xf0\x11\x51\x4b\x75\xc1\x2d\x52\xed\x96\x70\x40\x78\xad\x77\x0c\x88\xae\xe9\x2d\x1a\xbe\x4f\x79\x0a\x1b\x56\x2a\xa3\x95\x15\x35\x87\xe3\xec\x00\x01\xbd\x8c\xdc\xbe\x51\x33\xe0\x46\xad\xa5\x59\x49\x3a\xb5\xff\xea\xbf\xf8\x92\xda\x5c\x54\xbc\xfb\x31\xb4\x70\xf2\x9a\x51\x8c\x36\x6b\xf3\x5c\x3c\xf5\x9e\x7b\x23\x1f\x9c\x08\x30\x71\xce\xaa\x5f\xbf\xc0\xc4\xaa\x95\xb4\x10\x5f\x04\x56\x6b\xf1\xcc\xa7\x7f\x37\x5a\x48\x9b\x03\xf9\x29\x9e\xe5\xc4\xfb\xc1\xe0\x1f\x37\xde\x4f\x87\xc3\x4b\x98\x5d\x46\x0b\xc6\xc2\x45\xd8\x8c\x7c\x8f\x92\x0f\x2b\xa6\x92\xe1\x2c\x6a\xb5\xe5\xab\x4b\x98\x9c\x18\x8b\x0e\xc9\xfb\x6e\x03\xd3\xde\xc6\x1b\x0d\xc9\xf2\xee\x6e\xc0\xa0\x2b\xf3\xfe\xfb\x79\x04\x80\xd7\x54\x35\x57\x26\xa3\x8d\x90\x05\x02\x98\x0b\x99\x95\x4a\x07\xd2\x7b\xb8\x4e\xf3\xd8\x95\x61\x37\xb7\x65\x5b\x6f\xe4\xde\xc0\x1d\xd8\xbb\x6c\x24\x02\xc0\x87\xac\x2e\xc0\xe8\x2c\x25\xc9\x53\x5c\x88\xbc\xb7\x59\x45\x9f\x77\x98\xe9\x3c\x87\xe9\xff\x83\x6c\x4e\x43\x0f\x94\x8d\x98\x00\xad\x6c\x4a\xf0\x11\xec\x1f\xa4\xf0\x66\x04\xf9\xce\xf5\x6b\x9a\x9c\xb8\x4f\xbd\x67\x81\x90\x29\x4c\xf8\x9f\xd0\x77\x04\xf2\xd0\x14\x04\x48\x50\x43\x1a\x59\x8c\x17\xf7\x2e\x8a\xff\x63\xd6\x50\x72\xfc\xcf\x4a\xc9\xfc\xd3\xec\x94\xdc\x83\x01\xfa\xaf\x1f\x15\xfe\x17\xdf\xd4\x04\x48\xdd\x7c\x26\x40\x54\xb1\x3d\x52\xbe\x15\x8c\x2b\x78\x37\x71\x3f\xcf\x67\x04\x32\x25\xad\x56\x95\x01\xf4\xd7\xa2\x19\xbe\xf0\x01\xfe\x9e\x6b\x8d\xde\x6f\x96\x8b\x24\x04\x96\xe3\x9b\xcb\xf0\x37\x97\xcc\xfb\xe8\x9f\x00\x00\x00\xff\xff\x47\x39\xe3\x7d\xf2\x07\x00\x00")
 
 func gou_templateRecordTxtBytes() ([]byte, error) {
@@ -1398,7 +1441,7 @@ func gou_templateRecordTxt() (*asset, error) {
 	return a, nil
 }
 
-var _gou_templateRemove_file_formTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x91\xdd\xea\xd3\x40\x10\xc5\xef\xf3\x14\xc3\x60\xe0\xdf\x82\xbb\x6d\xd1\x1b\x69\x0a\x5a\xa5\x14\x2c\x08\xf6\xbe\x6c\xb2\x93\x66\x25\xfb\x41\x76\x52\xa8\x71\xdf\x5d\x92\xd6\xa2\x15\xf1\x76\xcf\x99\xdf\x9c\x3d\x33\x0c\x72\x9e\xc1\xd6\x87\x6b\x67\xce\x0d\xc3\x4b\x35\x83\xd5\x62\xf1\xf6\xf5\x6a\xb1\x7c\x03\xb1\x31\x6e\xf7\xe9\x18\x7b\xf8\xd2\xf9\x6f\x54\xb1\xc8\x60\x2e\x53\xca\x86\x41\x53\x6d\x1c\x01\x76\x64\xfd\x85\x4e\xb5\x69\xe9\x54\xfb\xce\xe2\xa4\x82\xa9\x41\xec\xe3\x7b\x6d\x8d\x83\x94\x32\x80\xf5\x28\x82\x25\x6e\xbc\x2e\x30\xf8\xc8\x08\xaa\x62\xe3\x5d\x81\xc3\x20\x26\xe7\x76\xb7\x4f\x49\xe2\x66\xb4\x07\x30\xba\xc0\xc8\x8a\xfb\x78\x7b\x31\x2e\xf4\x0c\x7c\x0d\x54\x60\xec\x4b\x6b\x18\xe1\xa2\xda\x9e\x26\xc0\x81\x62\x54\x67\x12\x9a\xda\x29\x4c\x4a\x08\x55\xab\x62\x2c\xb0\x64\x87\x20\xff\x62\x34\x46\x6b\x72\x08\x4e\x59\x2a\xb0\xb2\xfa\x81\xab\x35\xb5\xff\x9f\x18\xb7\xfc\x9e\x60\xab\xaa\x86\xc4\x47\xc5\xbf\xd6\xcb\xcd\xd8\x04\xb5\x91\xee\x15\x3c\xfd\x69\x14\x9d\x86\x5b\x61\xaf\x6c\xf9\xae\x60\xbf\x77\x0c\x37\xd2\x57\xf3\x9d\xe0\x07\xb0\x3f\x7c\x98\x1c\xa1\x33\x8e\x6b\xc0\x97\x3c\xca\x5c\xcb\x5c\x2c\xeb\x3c\xce\x10\xc4\xd1\x70\x4b\xf7\x21\xf1\x99\xdc\x88\x82\x47\x1f\xb6\xfc\xd7\x41\x64\xd8\xac\xe5\x78\x95\xe7\x98\x32\xfc\x99\x8d\x9c\x4e\x29\xfb\x19\x00\x00\xff\xff\x39\x80\x52\xee\x2a\x02\x00\x00")
+var _gou_templateRemove_file_formTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x91\x5f\x8b\xd3\x40\x14\xc5\xdf\xf3\x29\x2e\x17\x03\xed\x82\x99\xec\xa2\x2f\xb2\x29\x68\x95\xa5\xe0\x82\xe0\xbe\x97\x49\xe6\xa6\x19\xc9\xfc\x21\x73\x53\xa8\xe3\x7c\x77\x99\xb4\x16\xad\x88\xaf\x39\x27\xbf\x73\xe6\xdc\x18\xc5\x5d\x01\x5b\xe7\x4f\x93\x3e\x0c\x0c\xab\x6e\x0d\x0f\x75\xfd\xf6\xf5\x43\x7d\xff\x06\xc2\xa0\xed\xd3\xa7\x97\x30\xc3\x97\xc9\x7d\xa3\x8e\xab\x02\xee\x44\x4a\x45\x8c\x8a\x7a\x6d\x09\x70\x22\xe3\x8e\xb4\xef\xf5\x48\xfb\xde\x4d\x06\x17\x15\x74\x0f\xd5\x2e\xbc\x57\x46\x5b\x48\xa9\x00\x78\xcc\x22\x18\xe2\xc1\xa9\x06\xbd\x0b\x8c\x20\x3b\xd6\xce\x36\x18\x63\xb5\x38\xb7\x4f\xbb\x94\x04\x6e\xb2\xdd\x83\x56\x0d\x06\x96\x3c\x87\xf3\x17\x6d\xfd\xcc\xc0\x27\x4f\x0d\x86\xb9\x35\x9a\x11\x8e\x72\x9c\x69\x01\x3c\x53\x08\xf2\x40\x95\xa2\x71\x29\x93\x12\x42\x37\xca\x10\x1a\x6c\xd9\x22\x88\xbf\x18\x83\x56\x8a\x2c\x82\x95\x86\x1a\xec\x8c\xba\xe2\x7a\x45\xe3\xff\xff\xc8\x29\xbf\x37\xd8\xca\x6e\xa0\xea\xa3\xe4\x5f\xf1\x62\x93\x97\xa0\x31\xd0\x65\x82\x9b\x37\x65\xd1\xaa\xac\xc5\x08\xaf\x4c\xfb\xae\x61\xb7\xb3\x0c\x67\xd2\x57\xfd\x9d\xe0\x07\xb0\x7b\xfe\xb0\x38\xfc\xa4\x2d\xf7\x80\xab\x32\x88\x52\x89\xb2\xba\xef\xcb\xb0\x46\xa8\x5e\x34\x8f\x04\xab\x4b\xfe\x67\xb2\x50\xaf\x33\x0e\xae\x9b\x98\xf6\x5f\x47\x11\x7e\xf3\x28\xf2\x65\x6e\xab\x0a\xff\x67\x3f\xb2\x2a\xa5\xe2\xe7\x00\xde\xb1\x90\x9c\x2e\x02\x00\x00")
 
 func gou_templateRemove_file_formTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1413,7 +1456,7 @@ func gou_templateRemove_file_formTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/remove_file_form.txt", size: 554, mode: os.FileMode(420), modTime: time.Unix(1487517069, 0)}
+	info := bindataFileInfo{name: "gou_template/remove_file_form.txt", size: 558, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1438,7 +1481,7 @@ func gou_templateRss1Txt() (*asset, error) {
 	return a, nil
 }
 
-var _gou_templateSearch_formTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\xce\x4d\x4b\xf3\x40\x10\xc0\xf1\xfb\x7e\x8a\x61\x4e\xcf\x53\x30\x1b\x4b\xbd\x48\x36\x20\x45\x8a\x07\x41\xc1\xbb\xac\xc9\x34\x59\x71\x5f\xdc\x17\x31\x2e\xf3\xdd\x25\xb6\x87\xde\x86\x19\xfe\x3f\xa6\x56\xb9\x11\xb0\xf7\x61\x89\x66\x9a\x33\xfc\x1b\xfe\xc3\xb6\x6d\x6f\xae\xb6\xed\xf5\x0e\xd2\x6c\xdc\xe1\xfe\x25\x15\x78\x8a\xfe\x9d\x86\xdc\x08\xd8\x48\x66\x51\xeb\x48\x47\xe3\x08\x30\x91\x8e\xc3\xfc\x7a\xf4\xd1\x22\xb3\xe8\xd6\x01\x2c\xe5\xd9\x8f\x0a\x27\xca\x08\x7a\xc8\xc6\x3b\x85\xb5\x36\x77\xa3\x35\x6e\x7f\x78\x60\x96\xa7\x0e\xfb\x2e\xf4\xa2\x33\x2e\x94\x0c\x79\x09\xa4\x30\x95\x37\x6b\x32\xc2\x97\xfe\x28\xf4\x57\x3d\x52\x4a\x7a\xa2\xe6\x94\x30\x23\xc8\x5e\x5c\xec\x23\x4d\xf4\x1d\x98\x6f\xcf\x8e\xd3\x96\x14\x7e\x16\x8a\x0b\x42\x32\x3f\xa4\x70\xd7\x5e\x82\xcf\xeb\xe9\xec\x74\x32\xf4\x9d\x5c\xbf\x5e\x4d\x72\x23\xb3\xf8\x0d\x00\x00\xff\xff\x97\xe4\xd5\x6b\x15\x01\x00\x00")
+var _gou_templateSearch_formTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x8f\x41\x4b\xc4\x30\x10\x85\xef\xf9\x15\xc3\x9c\x74\x61\xdb\xba\xac\x17\x69\x0a\x22\xe2\x49\x50\xf0\xbe\x64\xd3\xd9\x36\xda\x26\xb1\x49\x85\x1a\xe6\xbf\x4b\xba\x7b\x58\xf0\xf6\x78\x2f\xf9\x3e\x26\xa5\x72\x23\xe0\xc9\xf9\x65\x32\x5d\x1f\xe1\x46\xdf\xc2\xae\xaa\xee\xb7\xbb\xea\x6e\x0f\xa1\x37\xf6\xe5\xf9\x23\xcc\xf0\x36\xb9\x4f\xd2\xb1\x10\xb0\x29\x99\x45\x4a\x2d\x9d\x8c\x25\xc0\x40\x6a\xd2\xfd\xe1\xe4\xa6\x11\x99\x45\x9d\x03\x8c\x14\x7b\xd7\x4a\xec\x28\x22\x28\x1d\x8d\xb3\x12\x53\x2a\x1e\xd7\xc8\x8c\x4d\xed\x1b\x51\x1b\xeb\xe7\x08\x71\xf1\x24\x31\xcc\xc7\xd1\x44\x84\x1f\x35\xcc\xb4\x3e\x7e\xa5\x10\x54\x47\xc5\xd9\xc0\x8c\x50\x36\xe2\xaa\xff\x9e\x69\x5a\x98\x1f\x2e\x18\xab\x46\x92\xb8\x96\x08\xc1\xfc\x92\xc4\x7d\x75\xcd\x7b\xcf\xd3\x05\x53\x97\xd9\xef\x41\x0f\x2a\x04\x89\x3d\x0d\x7e\x7b\x1c\x9c\xfe\xc2\xe6\x9f\xf9\x90\x57\xe6\xf3\x97\x32\xdf\xd7\x88\x94\xc8\xb6\xcc\xe2\x6f\x00\xd7\xb7\x4f\x68\x3f\x01\x00\x00")
 
 func gou_templateSearch_formTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1453,12 +1496,32 @@ func gou_templateSearch_formTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/search_form.txt", size: 277, mode: os.FileMode(420), modTime: time.Unix(1448763309, 0)}
+	info := bindataFileInfo{name: "gou_template/search_form.txt", size: 319, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _gou_templateSearch_resultTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x90\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\x83\xf1\xa1\x0d\x44\x4e\x43\x7b\x09\xb2\x2f\x21\xa4\x3d\x14\x4a\xe3\x7b\x11\xd6\xd8\x56\x51\x24\x23\x4d\xa0\x45\xe8\xbf\x2f\x92\xb3\x9b\xec\xde\xe6\xcd\xd3\xfb\x66\x34\x31\x36\x1b\x06\x47\xb7\xfc\xf7\x7a\x9a\x09\x3e\x0d\x9f\x61\xbf\xdb\x7d\xdb\xee\x77\x5f\xbe\x42\x98\xb5\x3d\x9f\xfa\x70\x83\x5f\xde\xfd\xc5\x81\x38\x83\x4d\x93\x12\x8b\x51\xe1\xa8\x2d\x42\x15\x50\xfa\x61\xfe\xe3\x31\xdc\x0c\x55\xc5\xaa\xbd\x73\x74\x68\x79\x11\xa0\x47\xe0\xbf\x8b\x1b\x20\x25\x26\x94\x01\xad\xda\x0f\xb9\x2e\xbf\xf4\xd2\x4e\x08\xb5\x3f\xb4\xef\x03\xd4\x31\x00\x21\x61\xf6\x38\xb6\xd5\x9d\xcf\xfb\xd9\xa3\x54\xc7\xf3\x8f\x94\x9a\x18\x03\xf9\x93\x1d\x9c\xca\x79\xde\x6b\x32\x58\xda\xb5\xe7\x17\xad\x52\xaa\xba\x18\x1f\x86\x68\x64\x41\x86\x45\x5a\x18\x8c\x0c\xa1\xad\x02\xc9\xeb\x52\x81\x92\x24\xb7\xa5\x5e\x27\xf1\x4b\xae\x57\x80\x71\x83\x34\xa4\xaf\x08\x8f\xbe\x68\x32\xa4\x63\xa2\x51\xd4\x31\xa1\x54\xf7\xf8\xc8\x78\x68\xf3\x43\xab\x97\x05\x09\x52\x5a\x8f\x51\x8f\xfc\xbb\xce\x52\x04\xf2\xce\x4e\xaf\x0b\xcc\x9a\xf2\x90\x7a\xe4\x3d\xfe\xa3\x42\x2e\x7e\x06\xa2\x09\x58\x00\x6f\x6e\x6e\x5a\x05\x4f\x85\x68\x94\x2a\x67\x5c\x65\xde\xc8\xac\x7a\x0d\x33\xb1\x74\x31\xf2\x9f\x18\x82\x9c\x90\x5b\x77\xbf\x7d\x4e\x2e\xcf\xc1\x18\xd1\xaa\x94\xd8\xcb\x00\xf4\x68\x09\x64\x1b\x02\x00\x00")
+
+func gou_templateSearch_resultTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_gou_templateSearch_resultTxt,
+		"gou_template/search_result.txt",
+	)
+}
+
+func gou_templateSearch_resultTxt() (*asset, error) {
+	bytes, err := gou_templateSearch_resultTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "gou_template/search_result.txt", size: 539, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _gou_templateStatusTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x8e\xc1\x4a\x03\x31\x10\x86\xef\x79\x8a\x61\xd9\x83\x16\xdd\xd4\x45\x2f\x25\xbb\x17\x11\x4f\x8a\xa0\x2f\x10\x9b\xb1\x1b\x9b\x26\x92\x64\x8b\x65\x98\x77\x97\x6c\xb7\x14\x41\x3c\x85\xfc\xfc\xf3\x7d\x3f\x91\x5c\x08\xb8\x0f\x5f\x87\x68\x37\x43\x86\x8b\xf5\x25\xb4\xcb\xe5\xdd\x75\xbb\xbc\xb9\x85\x34\x58\xff\xf8\xf0\x96\x46\x78\x89\xe1\x13\xd7\xb9\x11\xb0\x90\xcc\x82\xc8\xe0\x87\xf5\x08\x55\xca\x3a\x8f\xa9\x9a\xb2\x3a\x86\x90\x57\x5d\xc3\x2c\x54\xd6\xef\x0e\x21\x8d\xbb\x9d\x8e\x87\xae\x22\x6a\x9e\x30\x25\xbd\xc1\xe6\x78\xc1\x5c\xc1\xda\xe9\x94\xba\x2a\x05\x67\x4d\xd5\x0b\x22\x88\xda\x6f\x10\xea\xed\x55\xbd\x5f\x75\xcd\xeb\xd4\x04\x66\x01\xa0\x72\xec\x55\x36\x3d\x91\xf5\x06\xbf\x61\x72\x9d\x98\x50\x6f\x99\x95\xcc\x66\xae\xd4\xfb\xd3\x57\xe6\x38\x81\xd1\x9b\xc2\x51\x72\xda\xf5\x87\xeb\x39\x18\xfc\xe5\x1b\xda\x7f\x5d\x43\xdb\x97\xd6\xe8\xca\x73\xa6\xf9\x60\x70\xd5\xd5\xfb\x23\x04\x40\x39\x5b\xf6\x94\xb8\x5c\x39\x3b\xd7\xe7\x3d\x00\x4a\x16\xc4\x39\x21\x42\x6f\x98\xc5\x4f\x00\x00\x00\xff\xff\x38\xc5\x00\x17\x99\x01\x00\x00")
+var _gou_templateStatusTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x53\x5b\x8b\xdb\x3c\x10\x7d\xf7\xaf\x18\x8c\x3f\xd8\x5d\xbe\xb5\x77\x43\xfb\x12\x6c\x43\x77\x69\xb7\x4b\xdb\xa5\x90\xbe\x07\xc5\x92\x23\x35\xb2\x6c\xa4\x71\xda\x20\xf4\xdf\x8b\x7c\x49\xe3\xdc\xe8\x8b\x2f\x73\xe6\x9c\x39\x33\x23\x59\x9b\xdc\x05\xf0\x5c\x37\x3b\x2d\xd6\x1c\xe1\xa6\xb8\x85\xd9\xc3\xc3\xfb\xfb\xd9\xc3\xe3\x3b\x30\x5c\xa8\x97\x8f\x3f\x4c\x0b\xdf\x75\xfd\x93\x15\x18\x07\x70\x97\x38\x17\x58\x4b\x59\x29\x14\x83\xd0\x20\xc1\xd6\x84\x5d\x2c\xd2\x75\x8d\xf3\x2c\x76\x2e\x48\x91\xac\x24\x03\xd3\x56\x15\xd1\xbb\x2c\xb4\x36\xfe\xc6\x8c\x21\x6b\x16\xf7\x0c\xe7\x42\x28\x24\x31\x26\x0b\x4d\x2d\x05\x0d\xf3\xc0\x5a\xd0\x44\xad\x19\x44\x9b\xff\xa3\xed\x3c\x8b\x17\x5d\x26\x38\x17\x00\xa4\xa8\xf3\x14\x69\x6e\xad\x50\x94\xfd\x86\xae\xd6\xa8\x09\xd1\xc6\xb9\x34\x41\x3a\xa4\x44\xdb\xf1\x37\x41\xdd\x09\x33\x45\xbd\x4e\x9a\x74\xbe\xce\xd4\x7a\xab\x29\x9b\xd4\xe3\xb3\xab\xb5\xf8\x2c\xf7\xae\x5a\xe9\x5f\x7f\xd5\x54\x4d\xd9\x3c\x8b\xb6\xbd\x08\x40\x2a\x85\xf7\xe3\xc3\xde\x92\x14\x43\xfa\xe0\x07\x20\x4d\x5a\x79\xe8\xd0\x5a\x10\x25\xc4\x9f\x19\x91\xc8\x7d\xa0\x37\x32\x56\x8f\xbd\xd2\x92\x77\xe8\x68\xe3\xca\xac\x27\xd9\x27\x03\x1f\xa7\xca\x8f\x0b\x78\x65\xe4\xc7\x88\x24\xc8\x54\xb1\x3b\x0f\x96\x44\xc8\x56\x33\x73\x89\x6a\x70\x69\xda\xa2\x60\xe6\x42\xc6\x6a\x87\xcc\x2c\x0d\xd3\x5b\x46\xcf\x67\x98\x86\x54\x97\xc8\xa4\xd8\xd4\x65\x39\x82\xe3\xd2\x87\x9d\xf0\x79\x76\x30\xcf\xbe\xe9\x7e\x39\xfd\x61\xe1\xdd\xf2\x0d\xea\xe1\xd0\x4c\xb1\xaf\x07\x5d\x1f\x63\x9f\x0e\x9b\x9e\x80\xa2\x84\x8e\x6b\x70\xb1\xef\xda\x34\x44\xed\x57\x80\xa4\x6a\x42\xa0\x04\xc9\x7d\xf7\xed\xd7\x76\xcc\x08\x73\x6b\x65\x5d\x10\x89\xa2\x62\xa7\x7a\x89\x17\xcc\xad\x65\x8a\x9e\x1a\x68\xb4\x50\x58\x42\xf8\x5f\xfc\x58\x86\x70\x83\xf5\x97\x27\xff\x7c\x55\xe8\x85\x9e\xfc\xb4\x6f\x6f\x9d\x1b\x2e\xed\x7e\x92\x9b\xd5\xa9\x54\xc4\xe3\xc5\x7e\xf6\x13\x44\x94\xb0\xee\xf5\xfa\x05\x0c\x37\xe5\xad\xfe\xf5\x8f\xed\x0e\xbc\x33\xad\xee\x91\xf3\x6d\x5e\xbb\xd8\x63\xa8\xc9\x53\x02\x5c\xb3\xd2\x17\x8b\x3f\xd0\x4a\xa8\xe7\x97\x57\xe7\x92\x15\x29\x36\x6d\x13\x1e\x9f\xa0\xb6\xf1\xfa\x24\x4f\x93\x26\xbf\xc2\x6e\x74\xab\xd8\x84\xdc\x45\x0e\xb8\xd6\x32\x45\x9d\x0b\xfe\x0c\x00\xf7\xd0\xdd\xd9\x5d\x05\x00\x00")
 
 func gou_templateStatusTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1473,12 +1536,12 @@ func gou_templateStatusTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/status.txt", size: 409, mode: os.FileMode(420), modTime: time.Unix(1448763309, 0)}
+	info := bindataFileInfo{name: "gou_template/status.txt", size: 1373, mode: os.FileMode(420), modTime: time.Unix(1792228620, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _gou_templateThread_bottomTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\xce\xc1\x4e\x03\x21\x14\x85\xe1\x3d\x4f\x71\x83\x9b\xb6\x51\x06\x1b\x5d\x75\x3a\x9b\xc6\xb8\xd1\xc4\x85\xfb\x09\xc2\x65\xc0\x22\x97\x00\x4d\xd3\x10\xde\xdd\x58\x5f\xa0\xeb\x73\xfe\xe4\x6b\x6d\xd8\x30\x38\x50\xba\x64\xbf\xb8\x0a\x2b\xbd\x86\xad\x94\xcf\x0f\x5b\xf9\xf8\x04\xc5\xf9\xf8\xfa\xf2\x59\x4e\xf0\x91\xe9\x1b\x75\x15\x0c\x36\x43\xef\xac\x35\x83\xd6\x47\x04\x5e\x5d\x46\x65\xe6\x2f\xaa\x95\x7e\xf8\x75\x02\x6f\x41\x1c\x94\x76\x28\xde\x30\x42\xef\x0c\x60\x4c\xd3\xa8\xc0\x65\xb4\x7b\x7e\x57\x29\x71\x06\x40\x51\x07\xaf\x8f\x7b\x7e\xf6\xd1\xd0\x59\x14\x9d\x29\x84\x95\xbc\x97\xeb\x1d\x64\xac\xa7\x1c\xc1\xaa\x50\x70\xf7\xff\x3e\xe2\x25\x65\x2c\xe5\x96\x60\x6a\x4d\xbc\x63\x29\x6a\x41\x51\x29\xcd\x64\xe7\xa4\x16\xec\x7d\x1c\xd4\xf4\x67\xc4\x68\xe0\xaa\xc5\x68\x7a\x67\xbf\x01\x00\x00\xff\xff\x18\xcc\x0a\x01\x08\x01\x00\x00")
+var _gou_templateThread_bottomTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\xce\xc1\x4e\x03\x21\x14\x85\xe1\x3d\x4f\x71\x83\x9b\xb6\x51\x86\x36\xba\xea\x74\x36\x8d\x71\xa3\x89\x0b\xf7\x13\x84\xcb\x80\x45\x2e\x01\x9a\xa6\x21\xbc\xbb\xa9\xbe\x80\xeb\x73\xfe\xe4\x6b\x6d\xd8\x30\x38\x52\xba\x66\xbf\xb8\x0a\x2b\xbd\x86\x9d\x94\x4f\x0f\x3b\xb9\x7d\x84\xe2\x7c\x7c\x79\xfe\x28\x67\x78\xcf\xf4\x85\xba\x0a\x06\x9b\xa1\x77\xd6\x9a\x41\xeb\x23\x02\xaf\x2e\xa3\x32\xf3\x27\xd5\x4a\xdf\xfc\x77\x02\x6f\x41\x1c\x95\x76\x28\x5e\x31\xc2\x16\x7a\x67\x00\x63\x9a\x46\x05\x2e\xa3\x3d\xf0\xbb\x4a\x89\x33\x00\x8a\x3a\x78\x7d\x3a\xf0\x8b\x8f\x86\x2e\xa2\xe8\x4c\x21\xac\xe4\xbd\x5c\xef\x21\x63\x3d\xe7\x08\x56\x85\x82\xfb\xbf\xf7\x09\xaf\x29\x63\x29\xff\x09\xa6\xd6\xc4\x1b\x96\xa2\x16\x14\x95\xd2\x4c\x76\x4e\x6a\xc1\xde\xc7\x41\x4d\x37\x25\x46\x73\x93\xb5\x86\xd1\xf4\xce\x7e\x06\x00\x10\x0f\x3f\x69\x0a\x01\x00\x00")
 
 func gou_templateThread_bottomTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1493,7 +1556,7 @@ func gou_templateThread_bottomTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/thread_bottom.txt", size: 264, mode: os.FileMode(420), modTime: time.Unix(1487517069, 0)}
+	info := bindataFileInfo{name: "gou_template/thread_bottom.txt", size: 266, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1518,7 +1581,7 @@ func gou_templateThread_tagsTxt() (*asset, error) {
 	return a, nil
 }
 
-var _gou_templateThread_topTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x92\x4f\x6b\xdc\x3c\x10\xc6\xef\xfe\x14\x83\xde\xcb\x6e\xe0\xb5\x37\xa1\xbd\xd9\x86\xb0\x4d\x43\x20\x85\x50\x72\x37\x5a\x69\xbc\x52\x6b\x8f\x8c\x34\x9b\xb0\x08\x7d\xf7\xe2\x3f\x71\x12\x92\xdc\x86\xf1\xcc\x33\xcf\xef\xb1\x62\x2c\x2e\x32\xd8\xbb\xe1\xec\xed\xd1\x30\x6c\xd4\x16\xae\x76\xbb\xef\xff\x5f\xed\x2e\xbf\x41\x30\x96\x6e\x6f\x1e\xc3\x09\x1e\xbc\xfb\x83\x8a\xf3\x0c\x2e\x8a\x94\xb2\x18\x35\xb6\x96\x10\x04\x1b\x8f\x52\x37\xec\x06\x31\xf5\xc1\xb6\x20\x49\xc3\xc6\x79\xc8\xef\xc2\x4f\x6f\x91\xf4\x58\x5d\xeb\xde\xd2\x16\x36\x1d\x42\xbe\x97\xca\x60\x7e\x8f\x04\xbb\x2d\xa4\x94\x01\x94\xad\xf3\x3d\xf4\xc8\xc6\xe9\x4a\x1c\x91\x05\x48\xc5\xd6\x51\x25\x62\xcc\x1f\xa7\x23\xfb\xdb\xbb\x94\x8a\x18\x03\xfb\x1b\x52\x4e\x23\xe4\x0f\x92\x4d\x4a\xa2\x2e\x87\x3a\x03\x00\x28\x2d\x0d\x27\x06\x3e\x0f\x58\x09\x63\xb5\x46\x12\x40\xb2\xc7\x4a\x04\x94\x5e\x99\x86\xf0\xb9\x69\x6d\x87\x02\x9e\x64\x77\xc2\x4a\x9c\x31\x08\x28\x3e\x59\x0f\xa7\x43\x6f\x79\x9d\x8b\x31\xff\x85\x21\xc8\x23\xe6\xe1\x4c\xaa\x69\xbd\xeb\x1b\x42\x7e\x76\xfe\x6f\x4a\x02\x54\x27\x43\xa8\xc4\x81\x69\x91\x2b\x8b\xa1\x2e\x8b\x91\xab\x1e\x73\x19\x63\x58\x13\x7a\xc9\xe3\x33\xf8\xc1\x85\xf7\xf4\xd3\xe4\x0c\x2f\x26\xe1\x01\xec\x38\x27\x8f\x48\xf2\xc9\xce\xbd\xaf\xc1\x55\xaf\x57\x08\xaf\xb1\x7b\xb1\xf7\xf5\xc6\xbb\x7c\x62\x5c\x7e\xd7\x0f\xc9\xe3\x87\x91\xb5\x98\x89\xba\x80\x0b\xc0\x07\x47\x1f\x80\xef\x65\x60\x8f\x6a\x9e\x8f\x31\xff\x8d\xe1\x9a\x94\x71\x3e\xa5\x37\xc9\x76\x32\x70\x23\x3d\x5b\x35\x1e\x2a\x0b\xb9\x1e\x5a\xf6\x26\xad\xd7\xe7\x73\x39\xeb\x01\x94\x12\x8c\xc7\xb6\x12\xff\x1d\x1c\xb3\xeb\x45\xfd\x46\x75\x6e\x35\xae\x6d\x46\x87\x8b\xee\xa4\xf6\xea\x71\xad\x90\x74\x4a\xd9\xbf\x00\x00\x00\xff\xff\x10\x9f\x22\x9f\x18\x03\x00\x00")
+var _gou_templateThread_topTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x92\xd1\x6b\xdb\x30\x10\xc6\xdf\xfd\x57\x1c\xda\x4b\x52\x98\x9d\x96\xed\xcd\x36\x94\xac\x2b\x85\x0e\xca\xe8\xbb\x51\xa4\x73\xa4\xcd\x96\x84\x74\x69\x09\x42\xff\xfb\x50\xec\x7a\x0d\x6d\xc0\x0f\xc7\x59\xf7\xdd\xf7\xfb\xa4\x18\xab\xab\x02\xb6\xd6\x1d\xbd\xde\x2b\x82\x95\x58\xc3\xcd\x66\xf3\xfd\xeb\xcd\xe6\xfa\x1b\x04\xa5\xcd\xfd\xdd\x73\x38\xc0\x93\xb7\x7f\x50\x50\x59\xc0\x55\x95\x52\x11\xa3\xc4\x5e\x1b\x04\x46\xca\x23\x97\x1d\x59\xc7\x4e\x7d\xd0\x3d\x70\x23\x61\x65\x3d\x94\x0f\xe1\xa7\xd7\x68\x64\xae\x6e\xe5\xa8\xcd\x1a\x56\x03\xc2\xaa\xdc\x72\xa1\xb0\x7c\x44\x03\x9b\x75\xfe\x52\x2a\x00\xea\xde\xfa\x11\x46\x24\x65\x65\xc3\xf6\x48\x0c\xb8\x20\x6d\x4d\xc3\x62\x2c\x9f\x4f\x8b\xb6\xf7\x0f\x29\x55\x31\x06\xf2\x77\x46\x58\x89\x50\x3e\x71\x52\x29\xb1\xb6\x76\x6d\x01\x00\x50\x6b\xe3\x0e\x04\x74\x74\xd8\x30\xa5\xa5\x44\xc3\xc0\xf0\x11\x1b\x16\x90\x7b\xa1\x3a\x83\xaf\x5d\xaf\x07\x64\xf0\xc2\x87\x03\x36\xec\x88\x81\x41\xf5\xc9\x78\x38\xec\x46\x4d\xcb\xb9\x18\xcb\x5f\x18\x02\xdf\x63\x19\x8e\x46\x74\xbd\xb7\x63\x67\x90\x5e\xad\xff\x9b\x12\x03\x31\xf0\x10\x1a\xb6\x23\x33\xcb\xd5\x95\x6b\xeb\x2a\x73\xb5\x39\x9b\x1c\xc5\x92\xd2\x5b\x26\x9f\xc1\x3b\x1b\xce\xe9\x4f\xe9\x4d\xf0\x2c\xfb\xac\x1d\x68\xd9\x30\xc7\xf7\x68\xf8\x8b\x9e\x7a\x97\xc1\xc5\x28\x17\x08\x2f\x71\x78\xb3\x77\x79\xe2\x2c\x9f\x18\xe7\x1b\xfb\xc1\x29\xff\xc8\xac\xd5\x44\x34\x04\x9c\x01\x3e\x38\xfa\x00\xfc\xc8\x03\x79\x14\x13\x70\x8c\xe5\x6f\x0c\xb7\x46\x28\xeb\x53\x7a\x97\xec\xc0\x03\x75\xdc\x93\x16\x79\x51\x5d\xf1\x65\xd1\x3c\x77\xd2\xfa\xff\x82\xae\x27\x3d\x80\x9a\x83\xf2\xd8\x37\xec\xcb\xce\x12\xd9\x91\xb5\xef\x54\xa7\x56\x67\xfb\x2e\x3b\x9c\x75\x01\xce\x3c\x2e\x15\x1a\x99\x52\xf1\x6f\x00\x5c\xcb\x73\x90\x1c\x03\x00\x00")
 
 func gou_templateThread_topTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1533,12 +1596,12 @@ func gou_templateThread_topTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/thread_top.txt", size: 792, mode: os.FileMode(420), modTime: time.Unix(1502260411, 0)}
+	info := bindataFileInfo{name: "gou_template/thread_top.txt", size: 796, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _gou_templateTopTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x54\x5d\x6f\x9b\x40\x10\x7c\xe7\x57\xac\x50\x22\xd9\x91\x02\x8e\xd5\xbe\xb8\x98\xaa\x72\xd3\x28\x52\x12\x55\x89\xfb\x6c\x5d\x61\x0d\xd7\xc0\x81\xee\x96\x38\xee\x89\xff\x5e\xdd\x01\x8e\xc1\x71\xe3\x37\x7b\x80\x99\x9d\xd9\x0f\xad\xfd\x0b\x07\x16\x45\xb9\x95\x3c\x49\x09\x46\xd1\x18\xa6\x93\xc9\xe7\xcb\xe9\xe4\xea\x13\xa8\x94\x8b\x9b\xeb\xa5\xaa\xe0\xa7\x2c\xfe\x60\x44\x9e\x03\x17\x7e\x5d\x3b\x5a\xc7\xb8\xe6\x02\xc1\xa5\xa2\x74\x2d\x70\x26\x8b\x82\x66\x73\xaf\xae\x9d\x20\xe6\x2f\xa0\x68\x9b\xe1\xdc\xfd\xcd\xa2\xe7\x44\x16\x95\x88\x67\x95\xcc\x46\x3e\x93\xec\x6f\xf5\xcc\x57\x8a\x3d\x57\x5e\x29\x92\x31\x88\xe2\x52\x62\x89\x8c\xe0\x6a\x32\x39\x87\xc9\xf9\x17\x37\x74\x82\x2a\x83\x28\x63\x4a\xcd\x8d\x42\x8e\xa2\x72\x43\x07\x00\x20\xc8\x78\x18\x30\x48\x25\xae\xe7\xae\xd6\xde\x0d\x23\xdc\xb0\xed\xe2\xe6\xb6\xae\xfd\x28\x65\x22\x41\xe5\x02\x71\x32\xe2\x5a\x7b\xdf\x51\x45\x8b\x06\xae\x6b\x37\xd4\xda\xbb\x47\xa5\x58\x82\x5e\xd4\xa1\x81\xcf\x4e\xe0\xe6\x22\xc6\xd7\x21\xf3\xad\x01\x07\xbc\xbc\xc1\x2c\xab\xd6\xc0\xd7\x50\x48\xf0\x6e\xd5\x0f\xc9\x51\xc4\xe6\xd7\xb7\x38\xe7\x02\xea\xfa\x63\x51\x89\x11\x0a\x1a\xaa\x3e\x5a\x74\x20\x2b\x5b\xf0\x34\x37\x02\x37\x43\xd6\x07\xdc\x0c\x28\x85\x41\x3a\x1f\xa6\x76\xdb\x68\xe3\xe8\x23\x13\xf6\x61\xa3\xa4\x90\xc9\x28\x1d\x8a\x3d\x59\x74\xa0\xa7\x5a\xf0\xa8\x85\x7d\x5a\x62\x54\x1d\x74\xfa\xc9\xa2\x43\xda\x16\x1c\x38\xe9\x91\xa7\x44\xe5\xcc\xf7\x37\x9b\x8d\x67\x86\x3e\x41\x52\x95\xc7\xc5\xba\xf0\xfb\x54\x9c\xb0\x21\x0a\xfc\x8c\x87\xce\x7f\x33\xce\x0b\x8a\x7b\x5f\xb3\x44\x22\xe6\xbb\x2e\x35\x14\x6d\xa0\xf7\x51\xfa\xeb\xf1\xae\xc9\x73\xc8\xda\x3c\x1b\xb8\xca\xbb\xa4\x76\x34\xef\xf9\x3a\x18\x28\xa5\xfa\x53\xa3\x54\xcf\x8f\x5f\x65\xa1\xe3\x04\xe9\xf4\x70\xb4\x56\x7b\x0b\x93\x4e\x9b\x15\xe5\xb1\xdd\xcf\x55\xb3\x1c\xb6\x0a\xc2\xbc\xcc\x18\x21\xb8\x19\x57\xb4\xe2\x84\xb9\x0b\xf6\x2a\x58\xee\xc6\x2e\x13\x31\x8c\xbc\x87\xe2\x8e\x2b\x1a\x03\x8c\xde\xdd\x90\xb1\x75\x53\x9a\x4a\xae\xf3\x92\xb6\xe6\x65\x23\x5e\xee\xbb\xed\xf2\x5b\xb2\xc4\xe8\xb5\x01\xf6\xeb\x27\x96\x74\x45\x03\x1c\xb9\x2c\x5a\x83\x34\xf6\xe0\xcc\x1c\xb1\x1e\xdb\x41\x43\xec\xa9\xeb\xe7\xda\x81\x4b\x26\x13\xa4\xba\xfe\x4a\x2c\x99\x6b\x7d\x66\x90\x44\x91\x6c\x9a\xb7\xf7\xf7\x2d\x73\xab\xdd\xba\x01\xd8\xa5\x34\xf0\xd7\x9b\x8f\xbe\xbd\x3c\x4a\x57\x11\x23\x4c\x0a\xc9\xdf\xda\x73\xd4\xe9\x89\xf3\xc5\xb2\xec\xa0\xca\x36\xa1\x56\x6c\x3b\x9b\x9b\x4f\x17\x3b\xe9\x63\x71\x75\xef\x7b\x3b\x95\x37\x68\x89\xaf\x74\x7a\x1a\x5a\xa3\x88\xed\x2c\xc5\xfc\x25\x74\xfe\x05\x00\x00\xff\xff\xd0\xff\xa3\xb0\xbe\x06\x00\x00")
+var _gou_templateTopTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x54\x5f\x4f\xe3\x38\x10\x7f\xcf\xa7\x18\x45\x20\xb5\x48\x24\xa5\xba\x7b\xe9\xa5\x3d\x9d\x7a\x1c\x42\x02\x74\x82\xee\x73\xe5\x4d\xa6\x89\x97\xc4\x89\xec\x09\xa5\x6b\xf9\xbb\xaf\xec\xa4\x2d\x31\xb0\xf4\xad\xfd\x75\xfa\xfb\xe7\xb1\xb5\x8e\x2f\x02\x58\xd6\xcd\x4e\xf2\xbc\x20\x18\xa5\x63\x98\x4e\x26\x7f\x5e\x4e\x27\x57\x7f\x80\x2a\xb8\xb8\xb9\x5e\xa9\x16\xfe\x97\xf5\x0f\x4c\x29\x0a\xe0\x22\x36\x26\xd0\x3a\xc3\x0d\x17\x08\x21\xd5\x4d\xe8\x80\x33\x59\xd7\x34\x9b\x47\xc6\x04\x49\xc6\x5f\x40\xd1\xae\xc4\x79\xf8\x9d\xa5\xcf\xb9\xac\x5b\x91\xcd\x5a\x59\x8e\x62\x26\xd9\xcf\xf6\x99\xaf\x15\x7b\x6e\xa3\x46\xe4\x63\x10\xf5\xa5\xc4\x06\x19\xc1\xd5\x64\x72\x0e\x93\xf3\xbf\xc2\x45\x90\xb4\x25\xa4\x25\x53\x6a\x6e\x15\x2a\x14\x6d\xb8\x08\x00\x00\x92\x92\x2f\x12\x06\x85\xc4\xcd\x3c\xd4\x3a\xba\x61\x84\x5b\xb6\x5b\xde\xdc\x1a\x13\xa7\x05\x13\x39\xaa\x10\x88\x93\x15\xd7\x3a\xfa\x17\x55\xba\xec\x60\x63\xc2\x85\xd6\xd1\x3d\x2a\xc5\x72\x8c\xfa\x61\x63\x92\x98\x9d\xc0\xcd\x45\x86\xaf\x3e\xf3\xad\x05\x3d\x5e\x37\x78\x2a\xab\x42\x26\xd3\xc2\xa7\x7d\x72\xa8\xc7\xab\x7a\xd0\x11\x6b\x0d\x7c\x03\xb5\x84\xe8\x56\xfd\x27\x39\x8a\xcc\x7e\xfa\x27\xab\xb8\x00\x63\xbe\xd6\x95\x98\xa2\x20\x5f\xf7\xd1\xa1\x9e\xae\xec\xc1\xd3\x02\x09\xdc\xfa\xac\x0f\xb8\xf5\x28\x05\x6e\x8f\x39\xac\x77\xb7\x41\x36\xd1\x57\x21\x5c\xc2\xbe\x3a\x62\xd4\xbe\x3b\xeb\x27\x87\x7a\x7a\xaa\x07\x3d\xc9\x01\x79\x41\xd4\xcc\xe2\x78\xbb\xdd\x46\x76\xed\x73\x24\xd5\x46\x5c\x6c\xea\x78\x48\xc5\x09\x3b\xef\x49\x5c\xf2\x45\xf0\xdb\x32\xaa\x9a\xb2\xc1\xbf\x59\x2e\x11\xab\x43\x9d\x1d\x45\x9f\xfc\x3e\x2d\xbe\x3d\xde\x75\xc1\x7d\xd6\xee\x37\x2f\x55\x95\x16\x1e\xcd\x47\xb9\x7c\x4f\x52\xa9\x01\x8b\x54\x6a\x90\x27\x6e\xcb\x45\x10\x24\xc5\x74\x30\xe4\x76\x60\xfd\xe6\xca\x14\xd3\xee\x92\xf2\xcc\xdd\xd0\xb5\xdb\xfa\xd0\xb9\x20\xac\x9a\x92\x11\x42\x58\x72\x45\x6b\x4e\x58\x85\xe0\xde\x05\xc7\xdd\xc5\x65\x22\x83\x51\xf4\x50\xdf\x71\x45\x63\x80\xd1\x87\xab\x3c\xb6\x6d\x24\x8d\x75\x72\x5d\x35\xb4\xb3\xc3\xd6\x6c\xf3\x36\xed\xbe\xbf\x15\xcb\xad\x5e\x5f\xe0\xd0\x3f\xb1\x7c\x6f\x1a\xe0\x93\xb7\x45\x6b\x90\x36\x1e\x9c\xd9\x67\x6c\xc0\xf6\xee\x40\xdc\x63\x37\xec\x75\x0f\xae\x98\xcc\x91\x8c\xf9\x9b\x58\x3e\xd7\xfa\xcc\x22\xb9\x22\xd9\x1d\xde\x9b\xaf\xc7\xce\x01\x8e\x69\x00\x0e\x2d\x79\xf9\x06\xfb\x31\x8c\x57\xa5\xc5\x3a\x65\x84\x79\x2d\x39\xaa\x2f\x93\x9e\xb8\x5f\xac\x2c\xdf\xb9\xec\x1b\xea\xc5\x76\xb3\x79\x74\x9f\x16\xcb\x83\xf4\x67\x75\xed\xe7\xa3\x83\xca\x11\x5a\xe1\x2b\x9d\xde\x86\xd6\x28\x32\x63\x82\x24\xce\xf8\xcb\x22\xf8\x35\x00\x9c\xa9\xfb\xa7\xc0\x06\x00\x00")
 
 func gou_templateTopTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -1553,7 +1616,7 @@ func gou_templateTopTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "gou_template/top.txt", size: 1726, mode: os.FileMode(420), modTime: time.Unix(1502257508, 0)}
+	info := bindataFileInfo{name: "gou_template/top.txt", size: 1728, mode: os.FileMode(420), modTime: time.Unix(1792224789, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1622,6 +1685,7 @@ var _bindata = map[string]func() (*asset, error){
 	"www/21resanchor.js": www21resanchorJs,
 	"www/40recform.js": www40recformJs,
 	"www/41postadvanced.js": www41postadvancedJs,
+	"www/50stream.js": www50streamJs,
 	"www/arazuki_saku.png": wwwArazuki_sakuPng,
 	"www/bootstrap/css/bootstrap.min.css": wwwBootstrapCssBootstrapMinCss,
 	"www/bootstrap/fonts/glyphicons-halflings-regular.eot": wwwBootstrapFontsGlyphiconsHalflingsRegularEot,
@@ -1672,10 +1736,12 @@ var _bindata = map[string]func() (*asset, error){
 	"gou_template/new_element_form.txt": gou_templateNew_element_formTxt,
 	"gou_template/page_navi.txt": gou_templatePage_naviTxt,
 	"gou_template/post_form.txt": gou_templatePost_formTxt,
+	"gou_template/prune.txt": gou_templatePruneTxt,
 	"gou_template/record.txt": gou_templateRecordTxt,
 	"gou_template/remove_file_form.txt": gou_templateRemove_file_formTxt,
 	"gou_template/rss1.txt": gou_templateRss1Txt,
 	"gou_template/search_form.txt": gou_templateSearch_formTxt,
+	"gou_template/search_result.txt": gou_templateSearch_resultTxt,
 	"gou_template/status.txt": gou_templateStatusTxt,
 	"gou_template/thread_bottom.txt": gou_templateThread_bottomTxt,
 	"gou_template/thread_tags.txt": gou_templateThread_tagsTxt,
@@ -1747,10 +1813,12 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"new_element_form.txt": &bintree{gou_templateNew_element_formTxt, map[string]*bintree{}},
 		"page_navi.txt": &bintree{gou_templatePage_naviTxt, map[string]*bintree{}},
 		"post_form.txt": &bintree{gou_templatePost_formTxt, map[string]*bintree{}},
+		"prune.txt": &bintree{gou_templatePruneTxt, map[string]*bintree{}},
 		"record.txt": &bintree{gou_templateRecordTxt, map[string]*bintree{}},
 		"remove_file_form.txt": &bintree{gou_templateRemove_file_formTxt, map[string]*bintree{}},
 		"rss1.txt": &bintree{gou_templateRss1Txt, map[string]*bintree{}},
 		"search_form.txt": &bintree{gou_templateSearch_formTxt, map[string]*bintree{}},
+		"search_result.txt": &bintree{gou_templateSearch_resultTxt, map[string]*bintree{}},
 		"status.txt": &bintree{gou_templateStatusTxt, map[string]*bintree{}},
 		"thread_bottom.txt": &bintree{gou_templateThread_bottomTxt, map[string]*bintree{}},
 		"thread_tags.txt": &bintree{gou_templateThread_tagsTxt, map[string]*bintree{}},
@@ -1770,6 +1838,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"21resanchor.js": &bintree{www21resanchorJs, map[string]*bintree{}},
 		"40recform.js": &bintree{www40recformJs, map[string]*bintree{}},
 		"41postadvanced.js": &bintree{www41postadvancedJs, map[string]*bintree{}},
+		"50stream.js": &bintree{www50streamJs, map[string]*bintree{}},
 		"arazuki_saku.png": &bintree{wwwArazuki_sakuPng, map[string]*bintree{}},
 		"bootstrap": &bintree{nil, map[string]*bintree{
 			"css": &bintree{nil, map[string]*bintree{
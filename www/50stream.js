@@ -0,0 +1,48 @@
+/*
+ * Show new posts in the thread without reloading.
+ * Copyright (C) 2016 shinGETsu Project.
+ */
+
+shingetsu.initialize(function () {
+    var match = location.pathname.match(/^\/?thread.cgi\/([^\/]+)$/);
+    if (!match || typeof EventSource == 'undefined' || $('#records').length == 0) {
+        return;
+    }
+    var title = decodeURIComponent(match[1]);
+
+    function fileEncode(str) {
+        var bytes = unescape(encodeURIComponent(str));
+        var hex = '';
+        for (var i = 0; i < bytes.length; i++) {
+            hex += ('0' + bytes.charCodeAt(i).toString(16)).slice(-2);
+        }
+        return 'thread_' + hex.toUpperCase();
+    }
+
+    function appendRecord(e) {
+        var rec = JSON.parse(e.data);
+        var aid = rec.id.substring(0, 8);
+        if ($('#r' + aid).length > 0) {
+            return;
+        }
+        $.ajax({
+            url: shingetsu.rootPath + 'thread.cgi/' + encodeURIComponent(title) + '/' + aid + '?ajax=true',
+            dataType: 'html',
+            success: function (html) {
+                if ($('#r' + aid).length > 0) {
+                    return;
+                }
+                var $records = $(html).children();
+                if ($records.length == 0) {
+                    return;
+                }
+                $records.filter('dt').addClass('newpost');
+                $('#records').append($records);
+                shingetsu.modifyRecords($records);
+            }
+        });
+    }
+
+    var source = new EventSource(shingetsu.rootPath + 'gateway.cgi/stream?file=' + fileEncode(title));
+    source.addEventListener('record', appendRecord, false);
+});